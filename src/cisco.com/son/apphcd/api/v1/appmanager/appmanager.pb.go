@@ -50,7 +50,132 @@ func (x AppStateAfterDeployment) String() string {
 	return proto.EnumName(AppStateAfterDeployment_name, int32(x))
 }
 func (AppStateAfterDeployment) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{0}
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{0}
+}
+
+// Upgrade strategy modes
+type UpgradeStrategyType int32
+
+const (
+	// All the application instances are upgraded in parallel
+	UpgradeStrategyType_ALL_AT_ONCE UpgradeStrategyType = 0
+	// Application instances are upgraded in batches of max_unavailable instances
+	UpgradeStrategyType_ROLLING UpgradeStrategyType = 1
+	// Application instances of the canary groups are upgraded first and the rest once they are healthy
+	UpgradeStrategyType_CANARY UpgradeStrategyType = 2
+)
+
+var UpgradeStrategyType_name = map[int32]string{
+	0: "ALL_AT_ONCE",
+	1: "ROLLING",
+	2: "CANARY",
+}
+var UpgradeStrategyType_value = map[string]int32{
+	"ALL_AT_ONCE": 0,
+	"ROLLING":     1,
+	"CANARY":      2,
+}
+
+func (x UpgradeStrategyType) String() string {
+	return proto.EnumName(UpgradeStrategyType_name, int32(x))
+}
+func (UpgradeStrategyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{1}
+}
+
+// Change planned for an application instance
+type PlannedAction int32
+
+const (
+	// Application instance is left as it is
+	PlannedAction_NONE PlannedAction = 0
+	// Application instance will be created
+	PlannedAction_CREATE PlannedAction = 1
+	// Application instance will be upgraded or downgraded
+	PlannedAction_UPGRADE PlannedAction = 2
+	// Application instance will be deleted and created again
+	PlannedAction_RECREATE PlannedAction = 3
+	// Application instance will be deleted
+	PlannedAction_DELETE PlannedAction = 4
+)
+
+var PlannedAction_name = map[int32]string{
+	0: "NONE",
+	1: "CREATE",
+	2: "UPGRADE",
+	3: "RECREATE",
+	4: "DELETE",
+}
+var PlannedAction_value = map[string]int32{
+	"NONE":     0,
+	"CREATE":   1,
+	"UPGRADE":  2,
+	"RECREATE": 3,
+	"DELETE":   4,
+}
+
+func (x PlannedAction) String() string {
+	return proto.EnumName(PlannedAction_name, int32(x))
+}
+func (PlannedAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{2}
+}
+
+// Type of value change between two revisions
+type ValueChangeType int32
+
+const (
+	ValueChangeType_VALUE_MODIFIED ValueChangeType = 0
+	ValueChangeType_VALUE_ADDED    ValueChangeType = 1
+	ValueChangeType_VALUE_REMOVED  ValueChangeType = 2
+)
+
+var ValueChangeType_name = map[int32]string{
+	0: "VALUE_MODIFIED",
+	1: "VALUE_ADDED",
+	2: "VALUE_REMOVED",
+}
+var ValueChangeType_value = map[string]int32{
+	"VALUE_MODIFIED": 0,
+	"VALUE_ADDED":    1,
+	"VALUE_REMOVED":  2,
+}
+
+func (x ValueChangeType) String() string {
+	return proto.EnumName(ValueChangeType_name, int32(x))
+}
+func (ValueChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{3}
+}
+
+// Type of application instance change
+type EventType int32
+
+const (
+	// Application instance appeared. Sent for every existing instance once the watch is started
+	EventType_ADDED EventType = 0
+	// Application instance changed, e.g. its state or version
+	EventType_MODIFIED EventType = 1
+	// Application instance was removed
+	EventType_DELETED EventType = 2
+)
+
+var EventType_name = map[int32]string{
+	0: "ADDED",
+	1: "MODIFIED",
+	2: "DELETED",
+}
+var EventType_value = map[string]int32{
+	"ADDED":    0,
+	"MODIFIED": 1,
+	"DELETED":  2,
+}
+
+func (x EventType) String() string {
+	return proto.EnumName(EventType_name, int32(x))
+}
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{4}
 }
 
 // Status represents operation status.
@@ -65,6 +190,8 @@ const (
 	Status_NOT_FOUND Status = 2
 	// Operation had no results (e.g. upgrade identical, rollback to same, delete non-existent)
 	Status_UNCHANGED Status = 3
+	// Operation started and is running in background. The response body holds the operation
+	Status_IN_PROGRESS Status = 4
 )
 
 var Status_name = map[int32]string{
@@ -72,19 +199,21 @@ var Status_name = map[int32]string{
 	1: "ERROR",
 	2: "NOT_FOUND",
 	3: "UNCHANGED",
+	4: "IN_PROGRESS",
 }
 var Status_value = map[string]int32{
-	"SUCCESS":   0,
-	"ERROR":     1,
-	"NOT_FOUND": 2,
-	"UNCHANGED": 3,
+	"SUCCESS":     0,
+	"ERROR":       1,
+	"NOT_FOUND":   2,
+	"UNCHANGED":   3,
+	"IN_PROGRESS": 4,
 }
 
 func (x Status) String() string {
 	return proto.EnumName(Status_name, int32(x))
 }
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{1}
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{5}
 }
 
 // Protocol ("TCP" or "UDP").
@@ -108,7 +237,7 @@ func (x Spec_Port_Proto) String() string {
 	return proto.EnumName(Spec_Port_Proto_name, int32(x))
 }
 func (Spec_Port_Proto) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{9, 1, 0}
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{16, 1, 0}
 }
 
 // CreateAppRequest holds appropriate properties required for a particular request
@@ -151,7 +280,11 @@ type CreateAppRequest struct {
 	// Shared storage size
 	SharedStorage uint32 `protobuf:"varint,15,opt,name=shared_storage,json=sharedStorage,proto3" json:"shared_storage,omitempty"`
 	// Instance specifications
-	Spec                 *Spec    `protobuf:"bytes,16,opt,name=spec,proto3" json:"spec,omitempty"`
+	Spec *Spec `protobuf:"bytes,16,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Return right away with the operation running in background instead of waiting for the result
+	Async bool `protobuf:"varint,17,opt,name=async,proto3" json:"async,omitempty"`
+	// Plan the changes without applying them. The response body holds the plan
+	DryRun               bool     `protobuf:"varint,18,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -161,7 +294,7 @@ func (m *CreateAppRequest) Reset()         { *m = CreateAppRequest{} }
 func (m *CreateAppRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateAppRequest) ProtoMessage()    {}
 func (*CreateAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{0}
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{0}
 }
 func (m *CreateAppRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateAppRequest.Unmarshal(m, b)
@@ -293,6 +426,20 @@ func (m *CreateAppRequest) GetSpec() *Spec {
 	return nil
 }
 
+func (m *CreateAppRequest) GetAsync() bool {
+	if m != nil {
+		return m.Async
+	}
+	return false
+}
+
+func (m *CreateAppRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 // UpgradeAppRequest holds the attributes required for creating a new or upgrading existing application instance
 type UpgradeAppRequest struct {
 	// Application name.
@@ -330,17 +477,23 @@ type UpgradeAppRequest struct {
 	// Shared storage size
 	SharedStorage uint32 `protobuf:"varint,15,opt,name=shared_storage,json=sharedStorage,proto3" json:"shared_storage,omitempty"`
 	// Instance specifications
-	Spec                 *Spec    `protobuf:"bytes,16,opt,name=spec,proto3" json:"spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Spec *Spec `protobuf:"bytes,16,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Return right away with the operation running in background instead of waiting for the result
+	Async bool `protobuf:"varint,17,opt,name=async,proto3" json:"async,omitempty"`
+	// Plan the changes without applying them. The response body holds the plan
+	DryRun bool `protobuf:"varint,18,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Order in which the running instances are upgraded. By default all of them are upgraded at once
+	Strategy             *UpgradeStrategy `protobuf:"bytes,19,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *UpgradeAppRequest) Reset()         { *m = UpgradeAppRequest{} }
 func (m *UpgradeAppRequest) String() string { return proto.CompactTextString(m) }
 func (*UpgradeAppRequest) ProtoMessage()    {}
 func (*UpgradeAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{1}
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{1}
 }
 func (m *UpgradeAppRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeAppRequest.Unmarshal(m, b)
@@ -472,6 +625,27 @@ func (m *UpgradeAppRequest) GetSpec() *Spec {
 	return nil
 }
 
+func (m *UpgradeAppRequest) GetAsync() bool {
+	if m != nil {
+		return m.Async
+	}
+	return false
+}
+
+func (m *UpgradeAppRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *UpgradeAppRequest) GetStrategy() *UpgradeStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return nil
+}
+
 // UpdadeApp implements the logic of UpgradeApp but allows also to update currently running application
 // With the new configuration and if appropriate information is missed in the request, the information will
 // be obtained from one of running instances of a particular application
@@ -511,17 +685,23 @@ type UpdateAppRequest struct {
 	// Shared storage size
 	SharedStorage uint32 `protobuf:"varint,15,opt,name=shared_storage,json=sharedStorage,proto3" json:"shared_storage,omitempty"`
 	// Instance specifications.
-	Spec                 *Spec    `protobuf:"bytes,16,opt,name=spec,proto3" json:"spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Spec *Spec `protobuf:"bytes,16,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Return right away with the operation running in background instead of waiting for the result
+	Async bool `protobuf:"varint,17,opt,name=async,proto3" json:"async,omitempty"`
+	// Plan the changes without applying them. The response body holds the plan
+	DryRun bool `protobuf:"varint,18,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Order in which the running instances are upgraded. By default all of them are upgraded at once
+	Strategy             *UpgradeStrategy `protobuf:"bytes,19,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *UpdateAppRequest) Reset()         { *m = UpdateAppRequest{} }
 func (m *UpdateAppRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateAppRequest) ProtoMessage()    {}
 func (*UpdateAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{2}
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{2}
 }
 func (m *UpdateAppRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateAppRequest.Unmarshal(m, b)
@@ -653,1351 +833,2047 @@ func (m *UpdateAppRequest) GetSpec() *Spec {
 	return nil
 }
 
-// GetAppsRequest holds attributes required for obtaining information about
-// appropriate application and related instances
-type GetAppsRequest struct {
+func (m *UpdateAppRequest) GetAsync() bool {
+	if m != nil {
+		return m.Async
+	}
+	return false
+}
+
+func (m *UpdateAppRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *UpdateAppRequest) GetStrategy() *UpgradeStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return nil
+}
+
+// UpgradeStrategy tells how the running application instances are upgraded. The instances upgraded
+// by the batches done so far are rolled back as soon as a batch fails
+type UpgradeStrategy struct {
+	Type UpgradeStrategyType `protobuf:"varint,1,opt,name=type,proto3,enum=com.cisco.son.apphcd.api.v1.appmanager.UpgradeStrategyType" json:"type,omitempty"`
+	// Maximum number of application instances upgraded at once by the rolling strategy. Defaults to 1
+	MaxUnavailable uint32 `protobuf:"varint,2,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"max_unavailable,omitempty"`
+	// Group IDs of the application instances upgraded first by the canary strategy
+	CanaryGroupIds []string `protobuf:"bytes,3,rep,name=canary_group_ids,json=canaryGroupIds,proto3" json:"canary_group_ids,omitempty"`
+	// Seconds to wait after each batch before checking the application instances are still healthy
+	HealthCheckDelay     uint32   `protobuf:"varint,4,opt,name=health_check_delay,json=healthCheckDelay,proto3" json:"health_check_delay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpgradeStrategy) Reset()         { *m = UpgradeStrategy{} }
+func (m *UpgradeStrategy) String() string { return proto.CompactTextString(m) }
+func (*UpgradeStrategy) ProtoMessage()    {}
+func (*UpgradeStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{3}
+}
+func (m *UpgradeStrategy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeStrategy.Unmarshal(m, b)
+}
+func (m *UpgradeStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeStrategy.Marshal(b, m, deterministic)
+}
+func (dst *UpgradeStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeStrategy.Merge(dst, src)
+}
+func (m *UpgradeStrategy) XXX_Size() int {
+	return xxx_messageInfo_UpgradeStrategy.Size(m)
+}
+func (m *UpgradeStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeStrategy proto.InternalMessageInfo
+
+func (m *UpgradeStrategy) GetType() UpgradeStrategyType {
+	if m != nil {
+		return m.Type
+	}
+	return UpgradeStrategyType_ALL_AT_ONCE
+}
+
+func (m *UpgradeStrategy) GetMaxUnavailable() uint32 {
+	if m != nil {
+		return m.MaxUnavailable
+	}
+	return 0
+}
+
+func (m *UpgradeStrategy) GetCanaryGroupIds() []string {
+	if m != nil {
+		return m.CanaryGroupIds
+	}
+	return nil
+}
+
+func (m *UpgradeStrategy) GetHealthCheckDelay() uint32 {
+	if m != nil {
+		return m.HealthCheckDelay
+	}
+	return 0
+}
+
+// RollbackAppRequest holds the attributes required for rolling back application instances
+// to the chart of a previous version or a previous revision of the applications catalog
+type RollbackAppRequest struct {
 	// Application name
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Application version.
-	// If omitted, controller will provide information about all running application instances
+	// Version to roll back to. The running version if omitted
 	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// Application type
-	Cycle string `protobuf:"bytes,3,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	// Revision (commit hash, branch or tag) of the applications catalog the chart is restored from.
+	// If omitted, the chart is taken from the catalog or from the latest revision holding it if it was removed
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
 	// Root Group ID
 	RootGroupId string `protobuf:"bytes,4,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
-	// A list of group IDs
+	// A list of group IDs. All application instances are rolled back if omitted
 	GroupIds []string `protobuf:"bytes,5,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
-	// Printout a lot of information
-	Verbose              bool     `protobuf:"varint,6,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	// Return right away with the operation running in background instead of waiting for the result
+	Async                bool     `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAppsRequest) Reset()         { *m = GetAppsRequest{} }
-func (m *GetAppsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAppsRequest) ProtoMessage()    {}
-func (*GetAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{3}
+func (m *RollbackAppRequest) Reset()         { *m = RollbackAppRequest{} }
+func (m *RollbackAppRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackAppRequest) ProtoMessage()    {}
+func (*RollbackAppRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{4}
 }
-func (m *GetAppsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAppsRequest.Unmarshal(m, b)
+func (m *RollbackAppRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackAppRequest.Unmarshal(m, b)
 }
-func (m *GetAppsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAppsRequest.Marshal(b, m, deterministic)
+func (m *RollbackAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackAppRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetAppsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAppsRequest.Merge(dst, src)
+func (dst *RollbackAppRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackAppRequest.Merge(dst, src)
 }
-func (m *GetAppsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAppsRequest.Size(m)
+func (m *RollbackAppRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackAppRequest.Size(m)
 }
-func (m *GetAppsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAppsRequest.DiscardUnknown(m)
+func (m *RollbackAppRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackAppRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAppsRequest proto.InternalMessageInfo
+var xxx_messageInfo_RollbackAppRequest proto.InternalMessageInfo
 
-func (m *GetAppsRequest) GetName() string {
+func (m *RollbackAppRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *GetAppsRequest) GetVersion() string {
+func (m *RollbackAppRequest) GetVersion() string {
 	if m != nil {
 		return m.Version
 	}
 	return ""
 }
 
-func (m *GetAppsRequest) GetCycle() string {
+func (m *RollbackAppRequest) GetRevision() string {
 	if m != nil {
-		return m.Cycle
+		return m.Revision
 	}
 	return ""
 }
 
-func (m *GetAppsRequest) GetRootGroupId() string {
+func (m *RollbackAppRequest) GetRootGroupId() string {
 	if m != nil {
 		return m.RootGroupId
 	}
 	return ""
 }
 
-func (m *GetAppsRequest) GetGroupIds() []string {
+func (m *RollbackAppRequest) GetGroupIds() []string {
 	if m != nil {
 		return m.GroupIds
 	}
 	return nil
 }
 
-func (m *GetAppsRequest) GetVerbose() bool {
+func (m *RollbackAppRequest) GetAsync() bool {
 	if m != nil {
-		return m.Verbose
+		return m.Async
 	}
 	return false
 }
 
-// DeleteAppRequest holds attributes required for deleting
-// appropriate application and its related instances
-type DeleteAppRequest struct {
-	// Application name.
+// ScaleAppRequest holds the attributes required for changing the number of replicas of daemon application instances
+type ScaleAppRequest struct {
+	// Application name
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Application version.
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Number of replicas of every selected application instance
+	Replicas uint32 `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// Scale only the application instances running the version
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 	// Root Group ID
-	RootGroupId string `protobuf:"bytes,3,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
-	// A list of group IDs
-	GroupIds []string `protobuf:"bytes,4,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
-	// Indicates whether the app should be also removed from catalog
-	Purge                bool     `protobuf:"varint,5,opt,name=purge,proto3" json:"purge,omitempty"`
+	RootGroupId string `protobuf:"bytes,4,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	// A list of group IDs. All application instances are scaled if omitted
+	GroupIds []string `protobuf:"bytes,5,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	// Return right away with the operation running in background instead of waiting for the result
+	Async                bool     `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAppRequest) Reset()         { *m = DeleteAppRequest{} }
-func (m *DeleteAppRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteAppRequest) ProtoMessage()    {}
-func (*DeleteAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{4}
+func (m *ScaleAppRequest) Reset()         { *m = ScaleAppRequest{} }
+func (m *ScaleAppRequest) String() string { return proto.CompactTextString(m) }
+func (*ScaleAppRequest) ProtoMessage()    {}
+func (*ScaleAppRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{5}
 }
-func (m *DeleteAppRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAppRequest.Unmarshal(m, b)
+func (m *ScaleAppRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScaleAppRequest.Unmarshal(m, b)
 }
-func (m *DeleteAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAppRequest.Marshal(b, m, deterministic)
+func (m *ScaleAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScaleAppRequest.Marshal(b, m, deterministic)
 }
-func (dst *DeleteAppRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAppRequest.Merge(dst, src)
+func (dst *ScaleAppRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScaleAppRequest.Merge(dst, src)
 }
-func (m *DeleteAppRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteAppRequest.Size(m)
+func (m *ScaleAppRequest) XXX_Size() int {
+	return xxx_messageInfo_ScaleAppRequest.Size(m)
 }
-func (m *DeleteAppRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAppRequest.DiscardUnknown(m)
+func (m *ScaleAppRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScaleAppRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAppRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScaleAppRequest proto.InternalMessageInfo
 
-func (m *DeleteAppRequest) GetName() string {
+func (m *ScaleAppRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *DeleteAppRequest) GetVersion() string {
+func (m *ScaleAppRequest) GetReplicas() uint32 {
+	if m != nil {
+		return m.Replicas
+	}
+	return 0
+}
+
+func (m *ScaleAppRequest) GetVersion() string {
 	if m != nil {
 		return m.Version
 	}
 	return ""
 }
 
-func (m *DeleteAppRequest) GetRootGroupId() string {
+func (m *ScaleAppRequest) GetRootGroupId() string {
 	if m != nil {
 		return m.RootGroupId
 	}
 	return ""
 }
 
-func (m *DeleteAppRequest) GetGroupIds() []string {
+func (m *ScaleAppRequest) GetGroupIds() []string {
 	if m != nil {
 		return m.GroupIds
 	}
 	return nil
 }
 
-func (m *DeleteAppRequest) GetPurge() bool {
+func (m *ScaleAppRequest) GetAsync() bool {
 	if m != nil {
-		return m.Purge
+		return m.Async
 	}
 	return false
 }
 
-// DeleteAppMetadataRequest holds attributes required for deleting
-// metadata for appropriate application and related instances
-type DeleteAppMetadataRequest struct {
+// ListAppRevisionsRequest holds the attributes required for listing the revisions
+// of the application charts kept in the applications catalog
+type ListAppRevisionsRequest struct {
 	// Application name
-	AppName string `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
-	// Deletes only a particular version of application instance
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// Root Group ID
-	RootGroupId string `protobuf:"bytes,3,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
-	// A list of group IDs
-	GroupIds             []string `protobuf:"bytes,4,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Template (application instance) name. Revisions of all templates are listed if omitted
+	Template string `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	// Chart version. Revisions of all versions are listed if omitted
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Maximum number of revisions, the latest first. All revisions are listed if omitted
+	Limit                uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAppMetadataRequest) Reset()         { *m = DeleteAppMetadataRequest{} }
-func (m *DeleteAppMetadataRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteAppMetadataRequest) ProtoMessage()    {}
-func (*DeleteAppMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{5}
+func (m *ListAppRevisionsRequest) Reset()         { *m = ListAppRevisionsRequest{} }
+func (m *ListAppRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAppRevisionsRequest) ProtoMessage()    {}
+func (*ListAppRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{6}
 }
-func (m *DeleteAppMetadataRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAppMetadataRequest.Unmarshal(m, b)
+func (m *ListAppRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAppRevisionsRequest.Unmarshal(m, b)
 }
-func (m *DeleteAppMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAppMetadataRequest.Marshal(b, m, deterministic)
+func (m *ListAppRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAppRevisionsRequest.Marshal(b, m, deterministic)
 }
-func (dst *DeleteAppMetadataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAppMetadataRequest.Merge(dst, src)
+func (dst *ListAppRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAppRevisionsRequest.Merge(dst, src)
 }
-func (m *DeleteAppMetadataRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteAppMetadataRequest.Size(m)
+func (m *ListAppRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAppRevisionsRequest.Size(m)
 }
-func (m *DeleteAppMetadataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAppMetadataRequest.DiscardUnknown(m)
+func (m *ListAppRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAppRevisionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAppMetadataRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListAppRevisionsRequest proto.InternalMessageInfo
 
-func (m *DeleteAppMetadataRequest) GetAppName() string {
+func (m *ListAppRevisionsRequest) GetName() string {
 	if m != nil {
-		return m.AppName
+		return m.Name
 	}
 	return ""
 }
 
-func (m *DeleteAppMetadataRequest) GetVersion() string {
+func (m *ListAppRevisionsRequest) GetTemplate() string {
 	if m != nil {
-		return m.Version
+		return m.Template
 	}
 	return ""
 }
 
-func (m *DeleteAppMetadataRequest) GetRootGroupId() string {
+func (m *ListAppRevisionsRequest) GetVersion() string {
 	if m != nil {
-		return m.RootGroupId
+		return m.Version
 	}
 	return ""
 }
 
-func (m *DeleteAppMetadataRequest) GetGroupIds() []string {
+func (m *ListAppRevisionsRequest) GetLimit() uint32 {
 	if m != nil {
-		return m.GroupIds
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-// DeleteAppsRequest holds attributes required for removing applications
-// and their related instances.
-type DeleteAppsRequest struct {
-	// Indicates whether all the apps should be also removed from catalog
-	Purge                bool     `protobuf:"varint,1,opt,name=purge,proto3" json:"purge,omitempty"`
+// GetAppRevisionRequest holds the attributes required for getting a revision of an application chart
+// and the changes made by it
+type GetAppRevisionRequest struct {
+	// Application name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Revision (commit hash or its prefix) of the applications catalog
+	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Template (application instance) name. Required if the revision changed several charts of the application
+	Template string `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	// Chart version. Required if the revision changed several charts of the application
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Revision (commit hash, branch or tag) the chart is compared with.
+	// If omitted, the previous revision of the template is used
+	BaseRevision string `protobuf:"bytes,5,opt,name=base_revision,json=baseRevision,proto3" json:"base_revision,omitempty"`
+	// Chart version the chart is compared with. The version of the chart if omitted
+	BaseVersion          string   `protobuf:"bytes,6,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAppsRequest) Reset()         { *m = DeleteAppsRequest{} }
-func (m *DeleteAppsRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteAppsRequest) ProtoMessage()    {}
-func (*DeleteAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{6}
+func (m *GetAppRevisionRequest) Reset()         { *m = GetAppRevisionRequest{} }
+func (m *GetAppRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAppRevisionRequest) ProtoMessage()    {}
+func (*GetAppRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{7}
 }
-func (m *DeleteAppsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAppsRequest.Unmarshal(m, b)
+func (m *GetAppRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAppRevisionRequest.Unmarshal(m, b)
 }
-func (m *DeleteAppsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAppsRequest.Marshal(b, m, deterministic)
+func (m *GetAppRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAppRevisionRequest.Marshal(b, m, deterministic)
 }
-func (dst *DeleteAppsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAppsRequest.Merge(dst, src)
+func (dst *GetAppRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAppRevisionRequest.Merge(dst, src)
 }
-func (m *DeleteAppsRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteAppsRequest.Size(m)
+func (m *GetAppRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAppRevisionRequest.Size(m)
 }
-func (m *DeleteAppsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAppsRequest.DiscardUnknown(m)
+func (m *GetAppRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAppRevisionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAppsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetAppRevisionRequest proto.InternalMessageInfo
 
-func (m *DeleteAppsRequest) GetPurge() bool {
+func (m *GetAppRevisionRequest) GetName() string {
 	if m != nil {
-		return m.Purge
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-// EnableDisableAppRequest holds attributes required for disabling or enabling appropriate application instance
-type EnableDisableAppRequest struct {
+func (m *GetAppRevisionRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *GetAppRevisionRequest) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *GetAppRevisionRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetAppRevisionRequest) GetBaseRevision() string {
+	if m != nil {
+		return m.BaseRevision
+	}
+	return ""
+}
+
+func (m *GetAppRevisionRequest) GetBaseVersion() string {
+	if m != nil {
+		return m.BaseVersion
+	}
+	return ""
+}
+
+// GetAppsRequest holds attributes required for obtaining information about
+// appropriate application and related instances
+type GetAppsRequest struct {
 	// Application name
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Application version
+	// Application version.
+	// If omitted, controller will provide information about all running application instances
 	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Application type
+	Cycle string `protobuf:"bytes,3,opt,name=cycle,proto3" json:"cycle,omitempty"`
 	// Root Group ID
-	RootGroupId string `protobuf:"bytes,3,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	RootGroupId string `protobuf:"bytes,4,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
 	// A list of group IDs
-	GroupIds []string `protobuf:"bytes,4,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
-	// Enable or disable application instances
-	Disable              bool     `protobuf:"varint,5,opt,name=disable,proto3" json:"disable,omitempty"`
+	GroupIds []string `protobuf:"bytes,5,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	// Printout a lot of information
+	Verbose              bool     `protobuf:"varint,6,opt,name=verbose,proto3" json:"verbose,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EnableDisableAppRequest) Reset()         { *m = EnableDisableAppRequest{} }
-func (m *EnableDisableAppRequest) String() string { return proto.CompactTextString(m) }
-func (*EnableDisableAppRequest) ProtoMessage()    {}
-func (*EnableDisableAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{7}
+func (m *GetAppsRequest) Reset()         { *m = GetAppsRequest{} }
+func (m *GetAppsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAppsRequest) ProtoMessage()    {}
+func (*GetAppsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{8}
 }
-func (m *EnableDisableAppRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EnableDisableAppRequest.Unmarshal(m, b)
+func (m *GetAppsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAppsRequest.Unmarshal(m, b)
 }
-func (m *EnableDisableAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EnableDisableAppRequest.Marshal(b, m, deterministic)
+func (m *GetAppsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAppsRequest.Marshal(b, m, deterministic)
 }
-func (dst *EnableDisableAppRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EnableDisableAppRequest.Merge(dst, src)
+func (dst *GetAppsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAppsRequest.Merge(dst, src)
 }
-func (m *EnableDisableAppRequest) XXX_Size() int {
-	return xxx_messageInfo_EnableDisableAppRequest.Size(m)
+func (m *GetAppsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAppsRequest.Size(m)
 }
-func (m *EnableDisableAppRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EnableDisableAppRequest.DiscardUnknown(m)
+func (m *GetAppsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAppsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EnableDisableAppRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetAppsRequest proto.InternalMessageInfo
 
-func (m *EnableDisableAppRequest) GetName() string {
+func (m *GetAppsRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *EnableDisableAppRequest) GetVersion() string {
+func (m *GetAppsRequest) GetVersion() string {
 	if m != nil {
 		return m.Version
 	}
 	return ""
 }
 
-func (m *EnableDisableAppRequest) GetRootGroupId() string {
+func (m *GetAppsRequest) GetCycle() string {
+	if m != nil {
+		return m.Cycle
+	}
+	return ""
+}
+
+func (m *GetAppsRequest) GetRootGroupId() string {
 	if m != nil {
 		return m.RootGroupId
 	}
 	return ""
 }
 
-func (m *EnableDisableAppRequest) GetGroupIds() []string {
+func (m *GetAppsRequest) GetGroupIds() []string {
 	if m != nil {
 		return m.GroupIds
 	}
 	return nil
 }
 
-func (m *EnableDisableAppRequest) GetDisable() bool {
+func (m *GetAppsRequest) GetVerbose() bool {
 	if m != nil {
-		return m.Disable
+		return m.Verbose
 	}
 	return false
 }
 
-// CyclePeriodicReqAttr message holds information for an application of type periodic
-type CyclePeriodicReqAttr struct {
-	WorkingDays          *CyclePeriodicReqAttr_Sched `protobuf:"bytes,1,opt,name=working_days,json=workingDays,proto3" json:"working_days,omitempty"`
-	MinStartHour         uint32                      `protobuf:"varint,2,opt,name=min_start_hour,json=minStartHour,proto3" json:"min_start_hour,omitempty"`
-	MaxStartHour         uint32                      `protobuf:"varint,3,opt,name=max_start_hour,json=maxStartHour,proto3" json:"max_start_hour,omitempty"`
-	IntervalMin          uint32                      `protobuf:"varint,4,opt,name=interval_min,json=intervalMin,proto3" json:"interval_min,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+// WatchAppsRequest holds attributes required for watching changes of
+// appropriate application instances
+type WatchAppsRequest struct {
+	// Application name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Root Group ID
+	RootGroupId string `protobuf:"bytes,2,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	// A list of group IDs
+	GroupIds []string `protobuf:"bytes,3,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	// Application type
+	Cycle                string   `protobuf:"bytes,4,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CyclePeriodicReqAttr) Reset()         { *m = CyclePeriodicReqAttr{} }
-func (m *CyclePeriodicReqAttr) String() string { return proto.CompactTextString(m) }
-func (*CyclePeriodicReqAttr) ProtoMessage()    {}
-func (*CyclePeriodicReqAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{8}
+func (m *WatchAppsRequest) Reset()         { *m = WatchAppsRequest{} }
+func (m *WatchAppsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchAppsRequest) ProtoMessage()    {}
+func (*WatchAppsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{9}
 }
-func (m *CyclePeriodicReqAttr) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CyclePeriodicReqAttr.Unmarshal(m, b)
+func (m *WatchAppsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchAppsRequest.Unmarshal(m, b)
 }
-func (m *CyclePeriodicReqAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CyclePeriodicReqAttr.Marshal(b, m, deterministic)
+func (m *WatchAppsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchAppsRequest.Marshal(b, m, deterministic)
 }
-func (dst *CyclePeriodicReqAttr) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CyclePeriodicReqAttr.Merge(dst, src)
+func (dst *WatchAppsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchAppsRequest.Merge(dst, src)
 }
-func (m *CyclePeriodicReqAttr) XXX_Size() int {
-	return xxx_messageInfo_CyclePeriodicReqAttr.Size(m)
+func (m *WatchAppsRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchAppsRequest.Size(m)
 }
-func (m *CyclePeriodicReqAttr) XXX_DiscardUnknown() {
-	xxx_messageInfo_CyclePeriodicReqAttr.DiscardUnknown(m)
+func (m *WatchAppsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchAppsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CyclePeriodicReqAttr proto.InternalMessageInfo
+var xxx_messageInfo_WatchAppsRequest proto.InternalMessageInfo
 
-func (m *CyclePeriodicReqAttr) GetWorkingDays() *CyclePeriodicReqAttr_Sched {
+func (m *WatchAppsRequest) GetName() string {
 	if m != nil {
-		return m.WorkingDays
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *CyclePeriodicReqAttr) GetMinStartHour() uint32 {
+func (m *WatchAppsRequest) GetRootGroupId() string {
 	if m != nil {
-		return m.MinStartHour
+		return m.RootGroupId
 	}
-	return 0
+	return ""
 }
 
-func (m *CyclePeriodicReqAttr) GetMaxStartHour() uint32 {
+func (m *WatchAppsRequest) GetGroupIds() []string {
 	if m != nil {
-		return m.MaxStartHour
+		return m.GroupIds
 	}
-	return 0
+	return nil
 }
 
-func (m *CyclePeriodicReqAttr) GetIntervalMin() uint32 {
+func (m *WatchAppsRequest) GetCycle() string {
 	if m != nil {
-		return m.IntervalMin
+		return m.Cycle
 	}
-	return 0
+	return ""
 }
 
-type CyclePeriodicReqAttr_Sched struct {
-	Saturday             bool     `protobuf:"varint,1,opt,name=Saturday,proto3" json:"Saturday,omitempty"`
-	Sunday               bool     `protobuf:"varint,2,opt,name=Sunday,proto3" json:"Sunday,omitempty"`
-	Monday               bool     `protobuf:"varint,3,opt,name=Monday,proto3" json:"Monday,omitempty"`
-	Tuesday              bool     `protobuf:"varint,4,opt,name=Tuesday,proto3" json:"Tuesday,omitempty"`
-	Wednesday            bool     `protobuf:"varint,5,opt,name=Wednesday,proto3" json:"Wednesday,omitempty"`
-	Thursday             bool     `protobuf:"varint,6,opt,name=Thursday,proto3" json:"Thursday,omitempty"`
-	Friday               bool     `protobuf:"varint,7,opt,name=Friday,proto3" json:"Friday,omitempty"`
+// StreamAppLogsRequest holds attributes required for streaming logs of
+// appropriate application instances
+type StreamAppLogsRequest struct {
+	// Application name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Group ID. If omitted, logs of all application instances are streamed
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Container name. If omitted, logs of all containers are streamed
+	Container string `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	// Number of lines from the end of the logs to show. If omitted, all lines are shown
+	TailLines int64 `protobuf:"varint,4,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	// Show only the lines written since the time
+	SinceTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	// Keep streaming the new lines
+	Follow               bool     `protobuf:"varint,6,opt,name=follow,proto3" json:"follow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CyclePeriodicReqAttr_Sched) Reset()         { *m = CyclePeriodicReqAttr_Sched{} }
-func (m *CyclePeriodicReqAttr_Sched) String() string { return proto.CompactTextString(m) }
-func (*CyclePeriodicReqAttr_Sched) ProtoMessage()    {}
-func (*CyclePeriodicReqAttr_Sched) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{8, 0}
+func (m *StreamAppLogsRequest) Reset()         { *m = StreamAppLogsRequest{} }
+func (m *StreamAppLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamAppLogsRequest) ProtoMessage()    {}
+func (*StreamAppLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{10}
 }
-func (m *CyclePeriodicReqAttr_Sched) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CyclePeriodicReqAttr_Sched.Unmarshal(m, b)
+func (m *StreamAppLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamAppLogsRequest.Unmarshal(m, b)
 }
-func (m *CyclePeriodicReqAttr_Sched) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CyclePeriodicReqAttr_Sched.Marshal(b, m, deterministic)
+func (m *StreamAppLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamAppLogsRequest.Marshal(b, m, deterministic)
 }
-func (dst *CyclePeriodicReqAttr_Sched) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CyclePeriodicReqAttr_Sched.Merge(dst, src)
+func (dst *StreamAppLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamAppLogsRequest.Merge(dst, src)
 }
-func (m *CyclePeriodicReqAttr_Sched) XXX_Size() int {
-	return xxx_messageInfo_CyclePeriodicReqAttr_Sched.Size(m)
+func (m *StreamAppLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamAppLogsRequest.Size(m)
 }
-func (m *CyclePeriodicReqAttr_Sched) XXX_DiscardUnknown() {
-	xxx_messageInfo_CyclePeriodicReqAttr_Sched.DiscardUnknown(m)
+func (m *StreamAppLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamAppLogsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CyclePeriodicReqAttr_Sched proto.InternalMessageInfo
-
-func (m *CyclePeriodicReqAttr_Sched) GetSaturday() bool {
-	if m != nil {
-		return m.Saturday
-	}
-	return false
-}
+var xxx_messageInfo_StreamAppLogsRequest proto.InternalMessageInfo
 
-func (m *CyclePeriodicReqAttr_Sched) GetSunday() bool {
+func (m *StreamAppLogsRequest) GetName() string {
 	if m != nil {
-		return m.Sunday
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-func (m *CyclePeriodicReqAttr_Sched) GetMonday() bool {
+func (m *StreamAppLogsRequest) GetGroupId() string {
 	if m != nil {
-		return m.Monday
+		return m.GroupId
 	}
-	return false
+	return ""
 }
 
-func (m *CyclePeriodicReqAttr_Sched) GetTuesday() bool {
+func (m *StreamAppLogsRequest) GetContainer() string {
 	if m != nil {
-		return m.Tuesday
+		return m.Container
 	}
-	return false
+	return ""
 }
 
-func (m *CyclePeriodicReqAttr_Sched) GetWednesday() bool {
+func (m *StreamAppLogsRequest) GetTailLines() int64 {
 	if m != nil {
-		return m.Wednesday
+		return m.TailLines
 	}
-	return false
+	return 0
 }
 
-func (m *CyclePeriodicReqAttr_Sched) GetThursday() bool {
+func (m *StreamAppLogsRequest) GetSinceTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.Thursday
+		return m.SinceTime
 	}
-	return false
+	return nil
 }
 
-func (m *CyclePeriodicReqAttr_Sched) GetFriday() bool {
+func (m *StreamAppLogsRequest) GetFollow() bool {
 	if m != nil {
-		return m.Friday
+		return m.Follow
 	}
 	return false
 }
 
-// Specification message
-type Spec struct {
-	Image                *Spec_Image     `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Ports                []*Spec_Port    `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
-	Resources            *Spec_Resources `protobuf:"bytes,3,opt,name=resources,proto3" json:"resources,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// DeleteAppRequest holds attributes required for deleting
+// appropriate application and its related instances
+type DeleteAppRequest struct {
+	// Application name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Application version.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Root Group ID
+	RootGroupId string `protobuf:"bytes,3,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	// A list of group IDs
+	GroupIds []string `protobuf:"bytes,4,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	// Indicates whether the app should be also removed from catalog
+	Purge bool `protobuf:"varint,5,opt,name=purge,proto3" json:"purge,omitempty"`
+	// Return right away with the operation running in background instead of waiting for the result
+	Async                bool     `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Spec) Reset()         { *m = Spec{} }
-func (m *Spec) String() string { return proto.CompactTextString(m) }
-func (*Spec) ProtoMessage()    {}
-func (*Spec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{9}
+func (m *DeleteAppRequest) Reset()         { *m = DeleteAppRequest{} }
+func (m *DeleteAppRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAppRequest) ProtoMessage()    {}
+func (*DeleteAppRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{11}
 }
-func (m *Spec) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Spec.Unmarshal(m, b)
+func (m *DeleteAppRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAppRequest.Unmarshal(m, b)
 }
-func (m *Spec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Spec.Marshal(b, m, deterministic)
+func (m *DeleteAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAppRequest.Marshal(b, m, deterministic)
 }
-func (dst *Spec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Spec.Merge(dst, src)
+func (dst *DeleteAppRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAppRequest.Merge(dst, src)
 }
-func (m *Spec) XXX_Size() int {
-	return xxx_messageInfo_Spec.Size(m)
+func (m *DeleteAppRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAppRequest.Size(m)
 }
-func (m *Spec) XXX_DiscardUnknown() {
-	xxx_messageInfo_Spec.DiscardUnknown(m)
+func (m *DeleteAppRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAppRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Spec proto.InternalMessageInfo
+var xxx_messageInfo_DeleteAppRequest proto.InternalMessageInfo
 
-func (m *Spec) GetImage() *Spec_Image {
+func (m *DeleteAppRequest) GetName() string {
 	if m != nil {
-		return m.Image
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *Spec) GetPorts() []*Spec_Port {
+func (m *DeleteAppRequest) GetVersion() string {
 	if m != nil {
-		return m.Ports
+		return m.Version
 	}
-	return nil
+	return ""
 }
 
-func (m *Spec) GetResources() *Spec_Resources {
+func (m *DeleteAppRequest) GetRootGroupId() string {
 	if m != nil {
-		return m.Resources
+		return m.RootGroupId
 	}
-	return nil
-}
-
-// Image structure.
-type Spec_Image struct {
-	// Repository name.
-	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	// Image tag.
-	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return ""
 }
 
-func (m *Spec_Image) Reset()         { *m = Spec_Image{} }
-func (m *Spec_Image) String() string { return proto.CompactTextString(m) }
-func (*Spec_Image) ProtoMessage()    {}
-func (*Spec_Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{9, 0}
-}
-func (m *Spec_Image) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Spec_Image.Unmarshal(m, b)
-}
-func (m *Spec_Image) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Spec_Image.Marshal(b, m, deterministic)
-}
-func (dst *Spec_Image) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Spec_Image.Merge(dst, src)
-}
-func (m *Spec_Image) XXX_Size() int {
-	return xxx_messageInfo_Spec_Image.Size(m)
-}
-func (m *Spec_Image) XXX_DiscardUnknown() {
-	xxx_messageInfo_Spec_Image.DiscardUnknown(m)
+func (m *DeleteAppRequest) GetGroupIds() []string {
+	if m != nil {
+		return m.GroupIds
+	}
+	return nil
 }
 
-var xxx_messageInfo_Spec_Image proto.InternalMessageInfo
-
-func (m *Spec_Image) GetRepo() string {
+func (m *DeleteAppRequest) GetPurge() bool {
 	if m != nil {
-		return m.Repo
+		return m.Purge
 	}
-	return ""
+	return false
 }
 
-func (m *Spec_Image) GetTag() string {
+func (m *DeleteAppRequest) GetAsync() bool {
 	if m != nil {
-		return m.Tag
+		return m.Async
 	}
-	return ""
+	return false
 }
 
-// Network structure
-type Spec_Port struct {
-	// Port label.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Port number.
-	Number               uint32          `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
-	Proto                Spec_Port_Proto `protobuf:"varint,3,opt,name=proto,proto3,enum=com.cisco.son.apphcd.api.v1.appmanager.Spec_Port_Proto" json:"proto,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// DeleteAppMetadataRequest holds attributes required for deleting
+// metadata for appropriate application and related instances
+type DeleteAppMetadataRequest struct {
+	// Application name
+	AppName string `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	// Deletes only a particular version of application instance
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Root Group ID
+	RootGroupId string `protobuf:"bytes,3,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	// A list of group IDs
+	GroupIds             []string `protobuf:"bytes,4,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Spec_Port) Reset()         { *m = Spec_Port{} }
-func (m *Spec_Port) String() string { return proto.CompactTextString(m) }
-func (*Spec_Port) ProtoMessage()    {}
-func (*Spec_Port) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{9, 1}
+func (m *DeleteAppMetadataRequest) Reset()         { *m = DeleteAppMetadataRequest{} }
+func (m *DeleteAppMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAppMetadataRequest) ProtoMessage()    {}
+func (*DeleteAppMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{12}
 }
-func (m *Spec_Port) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Spec_Port.Unmarshal(m, b)
+func (m *DeleteAppMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAppMetadataRequest.Unmarshal(m, b)
 }
-func (m *Spec_Port) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Spec_Port.Marshal(b, m, deterministic)
+func (m *DeleteAppMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAppMetadataRequest.Marshal(b, m, deterministic)
 }
-func (dst *Spec_Port) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Spec_Port.Merge(dst, src)
+func (dst *DeleteAppMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAppMetadataRequest.Merge(dst, src)
 }
-func (m *Spec_Port) XXX_Size() int {
-	return xxx_messageInfo_Spec_Port.Size(m)
+func (m *DeleteAppMetadataRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAppMetadataRequest.Size(m)
 }
-func (m *Spec_Port) XXX_DiscardUnknown() {
-	xxx_messageInfo_Spec_Port.DiscardUnknown(m)
+func (m *DeleteAppMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAppMetadataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Spec_Port proto.InternalMessageInfo
+var xxx_messageInfo_DeleteAppMetadataRequest proto.InternalMessageInfo
 
-func (m *Spec_Port) GetName() string {
+func (m *DeleteAppMetadataRequest) GetAppName() string {
 	if m != nil {
-		return m.Name
+		return m.AppName
 	}
 	return ""
 }
 
-func (m *Spec_Port) GetNumber() uint32 {
+func (m *DeleteAppMetadataRequest) GetVersion() string {
 	if m != nil {
-		return m.Number
+		return m.Version
 	}
-	return 0
+	return ""
 }
 
-func (m *Spec_Port) GetProto() Spec_Port_Proto {
+func (m *DeleteAppMetadataRequest) GetRootGroupId() string {
 	if m != nil {
-		return m.Proto
+		return m.RootGroupId
 	}
-	return Spec_Port_TCP
+	return ""
 }
 
-type Spec_Resources struct {
-	PersistentStorage    uint32                 `protobuf:"varint,1,opt,name=persistent_storage,json=persistentStorage,proto3" json:"persistent_storage,omitempty"`
-	Limits               *Spec_Resources_Limits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *DeleteAppMetadataRequest) GetGroupIds() []string {
+	if m != nil {
+		return m.GroupIds
+	}
+	return nil
 }
 
-func (m *Spec_Resources) Reset()         { *m = Spec_Resources{} }
-func (m *Spec_Resources) String() string { return proto.CompactTextString(m) }
-func (*Spec_Resources) ProtoMessage()    {}
-func (*Spec_Resources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{9, 2}
+// DeleteAppsRequest holds attributes required for removing applications
+// and their related instances.
+type DeleteAppsRequest struct {
+	// Indicates whether all the apps should be also removed from catalog
+	Purge bool `protobuf:"varint,1,opt,name=purge,proto3" json:"purge,omitempty"`
+	// Return right away with the operation running in background instead of waiting for the result
+	Async                bool     `protobuf:"varint,2,opt,name=async,proto3" json:"async,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *Spec_Resources) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Spec_Resources.Unmarshal(m, b)
+
+func (m *DeleteAppsRequest) Reset()         { *m = DeleteAppsRequest{} }
+func (m *DeleteAppsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAppsRequest) ProtoMessage()    {}
+func (*DeleteAppsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{13}
 }
-func (m *Spec_Resources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Spec_Resources.Marshal(b, m, deterministic)
+func (m *DeleteAppsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAppsRequest.Unmarshal(m, b)
 }
-func (dst *Spec_Resources) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Spec_Resources.Merge(dst, src)
+func (m *DeleteAppsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAppsRequest.Marshal(b, m, deterministic)
 }
-func (m *Spec_Resources) XXX_Size() int {
-	return xxx_messageInfo_Spec_Resources.Size(m)
+func (dst *DeleteAppsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAppsRequest.Merge(dst, src)
 }
-func (m *Spec_Resources) XXX_DiscardUnknown() {
-	xxx_messageInfo_Spec_Resources.DiscardUnknown(m)
+func (m *DeleteAppsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAppsRequest.Size(m)
+}
+func (m *DeleteAppsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAppsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Spec_Resources proto.InternalMessageInfo
+var xxx_messageInfo_DeleteAppsRequest proto.InternalMessageInfo
 
-func (m *Spec_Resources) GetPersistentStorage() uint32 {
+func (m *DeleteAppsRequest) GetPurge() bool {
 	if m != nil {
-		return m.PersistentStorage
+		return m.Purge
 	}
-	return 0
+	return false
 }
 
-func (m *Spec_Resources) GetLimits() *Spec_Resources_Limits {
+func (m *DeleteAppsRequest) GetAsync() bool {
 	if m != nil {
-		return m.Limits
+		return m.Async
 	}
-	return nil
+	return false
 }
 
-type Spec_Resources_Limits struct {
-	Memory               uint32   `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`
-	Cpu                  float64  `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+// EnableDisableAppRequest holds attributes required for disabling or enabling appropriate application instance
+type EnableDisableAppRequest struct {
+	// Application name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Application version
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Root Group ID
+	RootGroupId string `protobuf:"bytes,3,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	// A list of group IDs
+	GroupIds []string `protobuf:"bytes,4,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	// Enable or disable application instances
+	Disable              bool     `protobuf:"varint,5,opt,name=disable,proto3" json:"disable,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Spec_Resources_Limits) Reset()         { *m = Spec_Resources_Limits{} }
-func (m *Spec_Resources_Limits) String() string { return proto.CompactTextString(m) }
-func (*Spec_Resources_Limits) ProtoMessage()    {}
-func (*Spec_Resources_Limits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{9, 2, 0}
+func (m *EnableDisableAppRequest) Reset()         { *m = EnableDisableAppRequest{} }
+func (m *EnableDisableAppRequest) String() string { return proto.CompactTextString(m) }
+func (*EnableDisableAppRequest) ProtoMessage()    {}
+func (*EnableDisableAppRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{14}
 }
-func (m *Spec_Resources_Limits) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Spec_Resources_Limits.Unmarshal(m, b)
+func (m *EnableDisableAppRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnableDisableAppRequest.Unmarshal(m, b)
 }
-func (m *Spec_Resources_Limits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Spec_Resources_Limits.Marshal(b, m, deterministic)
+func (m *EnableDisableAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnableDisableAppRequest.Marshal(b, m, deterministic)
 }
-func (dst *Spec_Resources_Limits) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Spec_Resources_Limits.Merge(dst, src)
+func (dst *EnableDisableAppRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableDisableAppRequest.Merge(dst, src)
 }
-func (m *Spec_Resources_Limits) XXX_Size() int {
-	return xxx_messageInfo_Spec_Resources_Limits.Size(m)
+func (m *EnableDisableAppRequest) XXX_Size() int {
+	return xxx_messageInfo_EnableDisableAppRequest.Size(m)
 }
-func (m *Spec_Resources_Limits) XXX_DiscardUnknown() {
-	xxx_messageInfo_Spec_Resources_Limits.DiscardUnknown(m)
+func (m *EnableDisableAppRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableDisableAppRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Spec_Resources_Limits proto.InternalMessageInfo
+var xxx_messageInfo_EnableDisableAppRequest proto.InternalMessageInfo
 
-func (m *Spec_Resources_Limits) GetMemory() uint32 {
+func (m *EnableDisableAppRequest) GetName() string {
 	if m != nil {
-		return m.Memory
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *Spec_Resources_Limits) GetCpu() float64 {
+func (m *EnableDisableAppRequest) GetVersion() string {
 	if m != nil {
-		return m.Cpu
+		return m.Version
 	}
-	return 0
+	return ""
 }
 
-// CyclePeriodicRespAttr message holds information for an application of type periodic
-type CyclePeriodicRespAttr struct {
-	WorkingDays          []string `protobuf:"bytes,1,rep,name=working_days,json=workingDays,proto3" json:"working_days,omitempty"`
-	MinStartHour         uint32   `protobuf:"varint,2,opt,name=min_start_hour,json=minStartHour,proto3" json:"min_start_hour,omitempty"`
-	MaxStartHour         uint32   `protobuf:"varint,3,opt,name=max_start_hour,json=maxStartHour,proto3" json:"max_start_hour,omitempty"`
-	IntervalMin          uint32   `protobuf:"varint,4,opt,name=interval_min,json=intervalMin,proto3" json:"interval_min,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CyclePeriodicRespAttr) Reset()         { *m = CyclePeriodicRespAttr{} }
-func (m *CyclePeriodicRespAttr) String() string { return proto.CompactTextString(m) }
-func (*CyclePeriodicRespAttr) ProtoMessage()    {}
-func (*CyclePeriodicRespAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{10}
-}
-func (m *CyclePeriodicRespAttr) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CyclePeriodicRespAttr.Unmarshal(m, b)
-}
-func (m *CyclePeriodicRespAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CyclePeriodicRespAttr.Marshal(b, m, deterministic)
-}
-func (dst *CyclePeriodicRespAttr) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CyclePeriodicRespAttr.Merge(dst, src)
-}
-func (m *CyclePeriodicRespAttr) XXX_Size() int {
-	return xxx_messageInfo_CyclePeriodicRespAttr.Size(m)
-}
-func (m *CyclePeriodicRespAttr) XXX_DiscardUnknown() {
-	xxx_messageInfo_CyclePeriodicRespAttr.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CyclePeriodicRespAttr proto.InternalMessageInfo
-
-func (m *CyclePeriodicRespAttr) GetWorkingDays() []string {
-	if m != nil {
-		return m.WorkingDays
-	}
-	return nil
-}
-
-func (m *CyclePeriodicRespAttr) GetMinStartHour() uint32 {
+func (m *EnableDisableAppRequest) GetRootGroupId() string {
 	if m != nil {
-		return m.MinStartHour
+		return m.RootGroupId
 	}
-	return 0
+	return ""
 }
 
-func (m *CyclePeriodicRespAttr) GetMaxStartHour() uint32 {
+func (m *EnableDisableAppRequest) GetGroupIds() []string {
 	if m != nil {
-		return m.MaxStartHour
+		return m.GroupIds
 	}
-	return 0
+	return nil
 }
 
-func (m *CyclePeriodicRespAttr) GetIntervalMin() uint32 {
+func (m *EnableDisableAppRequest) GetDisable() bool {
 	if m != nil {
-		return m.IntervalMin
+		return m.Disable
 	}
-	return 0
+	return false
 }
 
-type PeriodicFields struct {
-	Schedule                   string   `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	LastScheduleTime           string   `protobuf:"bytes,2,opt,name=last_schedule_time,json=lastScheduleTime,proto3" json:"last_schedule_time,omitempty"`
-	FailedJobsHistoryLimit     int64    `protobuf:"varint,3,opt,name=failed_jobs_history_limit,json=failedJobsHistoryLimit,proto3" json:"failed_jobs_history_limit,omitempty"`
-	SuccessfulJobsHistoryLimit int64    `protobuf:"varint,4,opt,name=successful_jobs_history_limit,json=successfulJobsHistoryLimit,proto3" json:"successful_jobs_history_limit,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
-	XXX_unrecognized           []byte   `json:"-"`
-	XXX_sizecache              int32    `json:"-"`
+// CyclePeriodicReqAttr message holds information for an application of type periodic
+type CyclePeriodicReqAttr struct {
+	WorkingDays          *CyclePeriodicReqAttr_Sched `protobuf:"bytes,1,opt,name=working_days,json=workingDays,proto3" json:"working_days,omitempty"`
+	MinStartHour         uint32                      `protobuf:"varint,2,opt,name=min_start_hour,json=minStartHour,proto3" json:"min_start_hour,omitempty"`
+	MaxStartHour         uint32                      `protobuf:"varint,3,opt,name=max_start_hour,json=maxStartHour,proto3" json:"max_start_hour,omitempty"`
+	IntervalMin          uint32                      `protobuf:"varint,4,opt,name=interval_min,json=intervalMin,proto3" json:"interval_min,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-func (m *PeriodicFields) Reset()         { *m = PeriodicFields{} }
-func (m *PeriodicFields) String() string { return proto.CompactTextString(m) }
-func (*PeriodicFields) ProtoMessage()    {}
-func (*PeriodicFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{11}
+func (m *CyclePeriodicReqAttr) Reset()         { *m = CyclePeriodicReqAttr{} }
+func (m *CyclePeriodicReqAttr) String() string { return proto.CompactTextString(m) }
+func (*CyclePeriodicReqAttr) ProtoMessage()    {}
+func (*CyclePeriodicReqAttr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{15}
 }
-func (m *PeriodicFields) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PeriodicFields.Unmarshal(m, b)
+func (m *CyclePeriodicReqAttr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CyclePeriodicReqAttr.Unmarshal(m, b)
 }
-func (m *PeriodicFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PeriodicFields.Marshal(b, m, deterministic)
+func (m *CyclePeriodicReqAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CyclePeriodicReqAttr.Marshal(b, m, deterministic)
 }
-func (dst *PeriodicFields) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PeriodicFields.Merge(dst, src)
+func (dst *CyclePeriodicReqAttr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CyclePeriodicReqAttr.Merge(dst, src)
 }
-func (m *PeriodicFields) XXX_Size() int {
-	return xxx_messageInfo_PeriodicFields.Size(m)
+func (m *CyclePeriodicReqAttr) XXX_Size() int {
+	return xxx_messageInfo_CyclePeriodicReqAttr.Size(m)
 }
-func (m *PeriodicFields) XXX_DiscardUnknown() {
-	xxx_messageInfo_PeriodicFields.DiscardUnknown(m)
+func (m *CyclePeriodicReqAttr) XXX_DiscardUnknown() {
+	xxx_messageInfo_CyclePeriodicReqAttr.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PeriodicFields proto.InternalMessageInfo
+var xxx_messageInfo_CyclePeriodicReqAttr proto.InternalMessageInfo
 
-func (m *PeriodicFields) GetSchedule() string {
+func (m *CyclePeriodicReqAttr) GetWorkingDays() *CyclePeriodicReqAttr_Sched {
 	if m != nil {
-		return m.Schedule
+		return m.WorkingDays
 	}
-	return ""
+	return nil
 }
 
-func (m *PeriodicFields) GetLastScheduleTime() string {
+func (m *CyclePeriodicReqAttr) GetMinStartHour() uint32 {
 	if m != nil {
-		return m.LastScheduleTime
+		return m.MinStartHour
 	}
-	return ""
+	return 0
 }
 
-func (m *PeriodicFields) GetFailedJobsHistoryLimit() int64 {
+func (m *CyclePeriodicReqAttr) GetMaxStartHour() uint32 {
 	if m != nil {
-		return m.FailedJobsHistoryLimit
+		return m.MaxStartHour
 	}
 	return 0
 }
 
-func (m *PeriodicFields) GetSuccessfulJobsHistoryLimit() int64 {
+func (m *CyclePeriodicReqAttr) GetIntervalMin() uint32 {
 	if m != nil {
-		return m.SuccessfulJobsHistoryLimit
+		return m.IntervalMin
 	}
 	return 0
 }
 
-type RunOnceFields struct {
-	Active               int64    `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
-	Completions          int64    `protobuf:"varint,2,opt,name=completions,proto3" json:"completions,omitempty"`
-	Failed               int64    `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
-	Succeeded            int64    `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
-	StartTime            string   `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	CompletionTime       string   `protobuf:"bytes,6,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+type CyclePeriodicReqAttr_Sched struct {
+	Saturday             bool     `protobuf:"varint,1,opt,name=Saturday,proto3" json:"Saturday,omitempty"`
+	Sunday               bool     `protobuf:"varint,2,opt,name=Sunday,proto3" json:"Sunday,omitempty"`
+	Monday               bool     `protobuf:"varint,3,opt,name=Monday,proto3" json:"Monday,omitempty"`
+	Tuesday              bool     `protobuf:"varint,4,opt,name=Tuesday,proto3" json:"Tuesday,omitempty"`
+	Wednesday            bool     `protobuf:"varint,5,opt,name=Wednesday,proto3" json:"Wednesday,omitempty"`
+	Thursday             bool     `protobuf:"varint,6,opt,name=Thursday,proto3" json:"Thursday,omitempty"`
+	Friday               bool     `protobuf:"varint,7,opt,name=Friday,proto3" json:"Friday,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RunOnceFields) Reset()         { *m = RunOnceFields{} }
-func (m *RunOnceFields) String() string { return proto.CompactTextString(m) }
-func (*RunOnceFields) ProtoMessage()    {}
-func (*RunOnceFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{12}
+func (m *CyclePeriodicReqAttr_Sched) Reset()         { *m = CyclePeriodicReqAttr_Sched{} }
+func (m *CyclePeriodicReqAttr_Sched) String() string { return proto.CompactTextString(m) }
+func (*CyclePeriodicReqAttr_Sched) ProtoMessage()    {}
+func (*CyclePeriodicReqAttr_Sched) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{15, 0}
 }
-func (m *RunOnceFields) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RunOnceFields.Unmarshal(m, b)
+func (m *CyclePeriodicReqAttr_Sched) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CyclePeriodicReqAttr_Sched.Unmarshal(m, b)
 }
-func (m *RunOnceFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RunOnceFields.Marshal(b, m, deterministic)
+func (m *CyclePeriodicReqAttr_Sched) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CyclePeriodicReqAttr_Sched.Marshal(b, m, deterministic)
 }
-func (dst *RunOnceFields) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RunOnceFields.Merge(dst, src)
+func (dst *CyclePeriodicReqAttr_Sched) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CyclePeriodicReqAttr_Sched.Merge(dst, src)
 }
-func (m *RunOnceFields) XXX_Size() int {
-	return xxx_messageInfo_RunOnceFields.Size(m)
+func (m *CyclePeriodicReqAttr_Sched) XXX_Size() int {
+	return xxx_messageInfo_CyclePeriodicReqAttr_Sched.Size(m)
 }
-func (m *RunOnceFields) XXX_DiscardUnknown() {
-	xxx_messageInfo_RunOnceFields.DiscardUnknown(m)
+func (m *CyclePeriodicReqAttr_Sched) XXX_DiscardUnknown() {
+	xxx_messageInfo_CyclePeriodicReqAttr_Sched.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RunOnceFields proto.InternalMessageInfo
+var xxx_messageInfo_CyclePeriodicReqAttr_Sched proto.InternalMessageInfo
 
-func (m *RunOnceFields) GetActive() int64 {
+func (m *CyclePeriodicReqAttr_Sched) GetSaturday() bool {
 	if m != nil {
-		return m.Active
+		return m.Saturday
 	}
-	return 0
+	return false
 }
 
-func (m *RunOnceFields) GetCompletions() int64 {
+func (m *CyclePeriodicReqAttr_Sched) GetSunday() bool {
 	if m != nil {
-		return m.Completions
+		return m.Sunday
 	}
-	return 0
+	return false
 }
 
-func (m *RunOnceFields) GetFailed() int64 {
+func (m *CyclePeriodicReqAttr_Sched) GetMonday() bool {
 	if m != nil {
-		return m.Failed
+		return m.Monday
 	}
-	return 0
+	return false
 }
 
-func (m *RunOnceFields) GetSucceeded() int64 {
+func (m *CyclePeriodicReqAttr_Sched) GetTuesday() bool {
 	if m != nil {
-		return m.Succeeded
+		return m.Tuesday
 	}
-	return 0
+	return false
 }
 
-func (m *RunOnceFields) GetStartTime() string {
+func (m *CyclePeriodicReqAttr_Sched) GetWednesday() bool {
 	if m != nil {
-		return m.StartTime
+		return m.Wednesday
 	}
-	return ""
+	return false
 }
 
-func (m *RunOnceFields) GetCompletionTime() string {
+func (m *CyclePeriodicReqAttr_Sched) GetThursday() bool {
 	if m != nil {
-		return m.CompletionTime
+		return m.Thursday
 	}
-	return ""
+	return false
 }
 
-type Resources struct {
-	Requests             *Resources_Requests `protobuf:"bytes,1,opt,name=requests,proto3" json:"requests,omitempty"`
-	Limits               *Resources_Limits   `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
-	PersistentStorage    uint32              `protobuf:"varint,3,opt,name=persistent_storage,json=persistentStorage,proto3" json:"persistent_storage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *CyclePeriodicReqAttr_Sched) GetFriday() bool {
+	if m != nil {
+		return m.Friday
+	}
+	return false
 }
 
-func (m *Resources) Reset()         { *m = Resources{} }
-func (m *Resources) String() string { return proto.CompactTextString(m) }
-func (*Resources) ProtoMessage()    {}
-func (*Resources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{13}
+// Specification message
+type Spec struct {
+	Image     *Spec_Image     `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Ports     []*Spec_Port    `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	Resources *Spec_Resources `protobuf:"bytes,3,opt,name=resources,proto3" json:"resources,omitempty"`
+	// Number of replicas of every application instance. Defaults to 1
+	Replicas             uint32            `protobuf:"varint,4,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Autoscaling          *Spec_Autoscaling `protobuf:"bytes,5,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *Resources) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Resources.Unmarshal(m, b)
+
+func (m *Spec) Reset()         { *m = Spec{} }
+func (m *Spec) String() string { return proto.CompactTextString(m) }
+func (*Spec) ProtoMessage()    {}
+func (*Spec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{16}
 }
-func (m *Resources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Resources.Marshal(b, m, deterministic)
+func (m *Spec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Spec.Unmarshal(m, b)
 }
-func (dst *Resources) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Resources.Merge(dst, src)
+func (m *Spec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Spec.Marshal(b, m, deterministic)
 }
-func (m *Resources) XXX_Size() int {
-	return xxx_messageInfo_Resources.Size(m)
+func (dst *Spec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spec.Merge(dst, src)
 }
-func (m *Resources) XXX_DiscardUnknown() {
-	xxx_messageInfo_Resources.DiscardUnknown(m)
+func (m *Spec) XXX_Size() int {
+	return xxx_messageInfo_Spec.Size(m)
+}
+func (m *Spec) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Resources proto.InternalMessageInfo
+var xxx_messageInfo_Spec proto.InternalMessageInfo
 
-func (m *Resources) GetRequests() *Resources_Requests {
+func (m *Spec) GetImage() *Spec_Image {
 	if m != nil {
-		return m.Requests
+		return m.Image
 	}
 	return nil
 }
 
-func (m *Resources) GetLimits() *Resources_Limits {
+func (m *Spec) GetPorts() []*Spec_Port {
 	if m != nil {
-		return m.Limits
+		return m.Ports
 	}
 	return nil
 }
 
-func (m *Resources) GetPersistentStorage() uint32 {
+func (m *Spec) GetResources() *Spec_Resources {
 	if m != nil {
-		return m.PersistentStorage
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *Spec) GetReplicas() uint32 {
+	if m != nil {
+		return m.Replicas
 	}
 	return 0
 }
 
-type Resources_Requests struct {
-	// Memory in MiB
-	Memory uint32 `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`
-	// CPU in millicores
-	Cpu                  float64  `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+func (m *Spec) GetAutoscaling() *Spec_Autoscaling {
+	if m != nil {
+		return m.Autoscaling
+	}
+	return nil
+}
+
+// Image structure.
+type Spec_Image struct {
+	// Repository name.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Image tag.
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Resources_Requests) Reset()         { *m = Resources_Requests{} }
-func (m *Resources_Requests) String() string { return proto.CompactTextString(m) }
-func (*Resources_Requests) ProtoMessage()    {}
-func (*Resources_Requests) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{13, 0}
+func (m *Spec_Image) Reset()         { *m = Spec_Image{} }
+func (m *Spec_Image) String() string { return proto.CompactTextString(m) }
+func (*Spec_Image) ProtoMessage()    {}
+func (*Spec_Image) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{16, 0}
 }
-func (m *Resources_Requests) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Resources_Requests.Unmarshal(m, b)
+func (m *Spec_Image) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Spec_Image.Unmarshal(m, b)
 }
-func (m *Resources_Requests) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Resources_Requests.Marshal(b, m, deterministic)
+func (m *Spec_Image) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Spec_Image.Marshal(b, m, deterministic)
 }
-func (dst *Resources_Requests) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Resources_Requests.Merge(dst, src)
+func (dst *Spec_Image) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spec_Image.Merge(dst, src)
 }
-func (m *Resources_Requests) XXX_Size() int {
-	return xxx_messageInfo_Resources_Requests.Size(m)
+func (m *Spec_Image) XXX_Size() int {
+	return xxx_messageInfo_Spec_Image.Size(m)
 }
-func (m *Resources_Requests) XXX_DiscardUnknown() {
-	xxx_messageInfo_Resources_Requests.DiscardUnknown(m)
+func (m *Spec_Image) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spec_Image.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Resources_Requests proto.InternalMessageInfo
+var xxx_messageInfo_Spec_Image proto.InternalMessageInfo
 
-func (m *Resources_Requests) GetMemory() uint32 {
+func (m *Spec_Image) GetRepo() string {
 	if m != nil {
-		return m.Memory
+		return m.Repo
 	}
-	return 0
+	return ""
 }
 
-func (m *Resources_Requests) GetCpu() float64 {
+func (m *Spec_Image) GetTag() string {
 	if m != nil {
-		return m.Cpu
+		return m.Tag
 	}
-	return 0
+	return ""
 }
 
-type Resources_Limits struct {
-	// Memory in MiB
-	Memory uint32 `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`
-	// CPU in milli cores
-	Cpu                  float64  `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Network structure
+type Spec_Port struct {
+	// Port label.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Port number.
+	Number               uint32          `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Proto                Spec_Port_Proto `protobuf:"varint,3,opt,name=proto,proto3,enum=com.cisco.son.apphcd.api.v1.appmanager.Spec_Port_Proto" json:"proto,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *Resources_Limits) Reset()         { *m = Resources_Limits{} }
-func (m *Resources_Limits) String() string { return proto.CompactTextString(m) }
-func (*Resources_Limits) ProtoMessage()    {}
-func (*Resources_Limits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{13, 1}
+func (m *Spec_Port) Reset()         { *m = Spec_Port{} }
+func (m *Spec_Port) String() string { return proto.CompactTextString(m) }
+func (*Spec_Port) ProtoMessage()    {}
+func (*Spec_Port) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{16, 1}
 }
-func (m *Resources_Limits) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Resources_Limits.Unmarshal(m, b)
+func (m *Spec_Port) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Spec_Port.Unmarshal(m, b)
 }
-func (m *Resources_Limits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Resources_Limits.Marshal(b, m, deterministic)
+func (m *Spec_Port) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Spec_Port.Marshal(b, m, deterministic)
 }
-func (dst *Resources_Limits) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Resources_Limits.Merge(dst, src)
+func (dst *Spec_Port) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spec_Port.Merge(dst, src)
 }
-func (m *Resources_Limits) XXX_Size() int {
-	return xxx_messageInfo_Resources_Limits.Size(m)
+func (m *Spec_Port) XXX_Size() int {
+	return xxx_messageInfo_Spec_Port.Size(m)
 }
-func (m *Resources_Limits) XXX_DiscardUnknown() {
-	xxx_messageInfo_Resources_Limits.DiscardUnknown(m)
+func (m *Spec_Port) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spec_Port.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Resources_Limits proto.InternalMessageInfo
+var xxx_messageInfo_Spec_Port proto.InternalMessageInfo
 
-func (m *Resources_Limits) GetMemory() uint32 {
+func (m *Spec_Port) GetName() string {
 	if m != nil {
-		return m.Memory
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *Resources_Limits) GetCpu() float64 {
+func (m *Spec_Port) GetNumber() uint32 {
 	if m != nil {
-		return m.Cpu
+		return m.Number
 	}
 	return 0
 }
 
-// Instance message holds information about a particular workload
-type Instance struct {
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Version     string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	RootGroupId string `protobuf:"bytes,4,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
-	GroupId     string `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	State       string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
-	Cycle       string `protobuf:"bytes,7,opt,name=cycle,proto3" json:"cycle,omitempty"`
-	ImageRepo   string `protobuf:"bytes,8,opt,name=image_repo,json=imageRepo,proto3" json:"image_repo,omitempty"`
-	ImageName   string `protobuf:"bytes,9,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
-	ImageTag    string `protobuf:"bytes,10,opt,name=image_tag,json=imageTag,proto3" json:"image_tag,omitempty"`
-	// Types that are valid to be assigned to CycleFields:
-	//	*Instance_PeriodicFields
-	//	*Instance_RunOnceFields
-	CycleFields          isInstance_CycleFields     `protobuf_oneof:"CycleFields"`
-	CreateDate           string                     `protobuf:"bytes,13,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`
-	UpdateDate           string                     `protobuf:"bytes,14,opt,name=update_date,json=updateDate,proto3" json:"update_date,omitempty"`
-	ProjectId            string                     `protobuf:"bytes,15,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Namespace            string                     `protobuf:"bytes,16,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Scale                string                     `protobuf:"bytes,17,opt,name=scale,proto3" json:"scale,omitempty"`
-	Resources            *Resources                 `protobuf:"bytes,18,opt,name=resources,proto3" json:"resources,omitempty"`
-	Containers           []*Instance_Container      `protobuf:"bytes,19,rep,name=containers,proto3" json:"containers,omitempty"`
-	PublicEndpoints      []*Instance_PublicEndpoint `protobuf:"bytes,20,rep,name=public_endpoints,json=publicEndpoints,proto3" json:"public_endpoints,omitempty"`
-	Template             *Template                  `protobuf:"bytes,21,opt,name=template,proto3" json:"template,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *Spec_Port) GetProto() Spec_Port_Proto {
+	if m != nil {
+		return m.Proto
+	}
+	return Spec_Port_TCP
 }
 
-func (m *Instance) Reset()         { *m = Instance{} }
-func (m *Instance) String() string { return proto.CompactTextString(m) }
-func (*Instance) ProtoMessage()    {}
-func (*Instance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{14}
+type Spec_Resources struct {
+	PersistentStorage    uint32                 `protobuf:"varint,1,opt,name=persistent_storage,json=persistentStorage,proto3" json:"persistent_storage,omitempty"`
+	Limits               *Spec_Resources_Limits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
-func (m *Instance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Instance.Unmarshal(m, b)
+
+func (m *Spec_Resources) Reset()         { *m = Spec_Resources{} }
+func (m *Spec_Resources) String() string { return proto.CompactTextString(m) }
+func (*Spec_Resources) ProtoMessage()    {}
+func (*Spec_Resources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{16, 2}
 }
-func (m *Instance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Instance.Marshal(b, m, deterministic)
+func (m *Spec_Resources) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Spec_Resources.Unmarshal(m, b)
 }
-func (dst *Instance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Instance.Merge(dst, src)
+func (m *Spec_Resources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Spec_Resources.Marshal(b, m, deterministic)
 }
-func (m *Instance) XXX_Size() int {
-	return xxx_messageInfo_Instance.Size(m)
+func (dst *Spec_Resources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spec_Resources.Merge(dst, src)
 }
-func (m *Instance) XXX_DiscardUnknown() {
-	xxx_messageInfo_Instance.DiscardUnknown(m)
+func (m *Spec_Resources) XXX_Size() int {
+	return xxx_messageInfo_Spec_Resources.Size(m)
+}
+func (m *Spec_Resources) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spec_Resources.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Instance proto.InternalMessageInfo
+var xxx_messageInfo_Spec_Resources proto.InternalMessageInfo
 
-func (m *Instance) GetName() string {
+func (m *Spec_Resources) GetPersistentStorage() uint32 {
 	if m != nil {
-		return m.Name
+		return m.PersistentStorage
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetId() string {
+func (m *Spec_Resources) GetLimits() *Spec_Resources_Limits {
 	if m != nil {
-		return m.Id
+		return m.Limits
 	}
-	return ""
+	return nil
 }
 
-func (m *Instance) GetVersion() string {
+type Spec_Resources_Limits struct {
+	Memory               uint32   `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	Cpu                  float64  `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Spec_Resources_Limits) Reset()         { *m = Spec_Resources_Limits{} }
+func (m *Spec_Resources_Limits) String() string { return proto.CompactTextString(m) }
+func (*Spec_Resources_Limits) ProtoMessage()    {}
+func (*Spec_Resources_Limits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{16, 2, 0}
+}
+func (m *Spec_Resources_Limits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Spec_Resources_Limits.Unmarshal(m, b)
+}
+func (m *Spec_Resources_Limits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Spec_Resources_Limits.Marshal(b, m, deterministic)
+}
+func (dst *Spec_Resources_Limits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spec_Resources_Limits.Merge(dst, src)
+}
+func (m *Spec_Resources_Limits) XXX_Size() int {
+	return xxx_messageInfo_Spec_Resources_Limits.Size(m)
+}
+func (m *Spec_Resources_Limits) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spec_Resources_Limits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Spec_Resources_Limits proto.InternalMessageInfo
+
+func (m *Spec_Resources_Limits) GetMemory() uint32 {
 	if m != nil {
-		return m.Version
+		return m.Memory
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetRootGroupId() string {
+func (m *Spec_Resources_Limits) GetCpu() float64 {
 	if m != nil {
-		return m.RootGroupId
+		return m.Cpu
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetGroupId() string {
+// Horizontal pod autoscaler settings. Supported by daemon applications only
+type Spec_Autoscaling struct {
+	// Minimum number of replicas. Defaults to 1
+	MinReplicas uint32 `protobuf:"varint,1,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
+	// Maximum number of replicas. The autoscaler is removed if set to 0
+	MaxReplicas uint32 `protobuf:"varint,2,opt,name=max_replicas,json=maxReplicas,proto3" json:"max_replicas,omitempty"`
+	// Target average CPU utilization in percent of the requested CPU
+	TargetCpuUtilization uint32 `protobuf:"varint,3,opt,name=target_cpu_utilization,json=targetCpuUtilization,proto3" json:"target_cpu_utilization,omitempty"`
+	// Target average memory utilization in percent of the requested memory
+	TargetMemoryUtilization uint32   `protobuf:"varint,4,opt,name=target_memory_utilization,json=targetMemoryUtilization,proto3" json:"target_memory_utilization,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *Spec_Autoscaling) Reset()         { *m = Spec_Autoscaling{} }
+func (m *Spec_Autoscaling) String() string { return proto.CompactTextString(m) }
+func (*Spec_Autoscaling) ProtoMessage()    {}
+func (*Spec_Autoscaling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{16, 3}
+}
+func (m *Spec_Autoscaling) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Spec_Autoscaling.Unmarshal(m, b)
+}
+func (m *Spec_Autoscaling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Spec_Autoscaling.Marshal(b, m, deterministic)
+}
+func (dst *Spec_Autoscaling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spec_Autoscaling.Merge(dst, src)
+}
+func (m *Spec_Autoscaling) XXX_Size() int {
+	return xxx_messageInfo_Spec_Autoscaling.Size(m)
+}
+func (m *Spec_Autoscaling) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spec_Autoscaling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Spec_Autoscaling proto.InternalMessageInfo
+
+func (m *Spec_Autoscaling) GetMinReplicas() uint32 {
 	if m != nil {
-		return m.GroupId
+		return m.MinReplicas
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetState() string {
+func (m *Spec_Autoscaling) GetMaxReplicas() uint32 {
 	if m != nil {
-		return m.State
+		return m.MaxReplicas
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetCycle() string {
+func (m *Spec_Autoscaling) GetTargetCpuUtilization() uint32 {
 	if m != nil {
-		return m.Cycle
+		return m.TargetCpuUtilization
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetImageRepo() string {
+func (m *Spec_Autoscaling) GetTargetMemoryUtilization() uint32 {
 	if m != nil {
-		return m.ImageRepo
+		return m.TargetMemoryUtilization
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetImageName() string {
+// CyclePeriodicRespAttr message holds information for an application of type periodic
+type CyclePeriodicRespAttr struct {
+	WorkingDays          []string `protobuf:"bytes,1,rep,name=working_days,json=workingDays,proto3" json:"working_days,omitempty"`
+	MinStartHour         uint32   `protobuf:"varint,2,opt,name=min_start_hour,json=minStartHour,proto3" json:"min_start_hour,omitempty"`
+	MaxStartHour         uint32   `protobuf:"varint,3,opt,name=max_start_hour,json=maxStartHour,proto3" json:"max_start_hour,omitempty"`
+	IntervalMin          uint32   `protobuf:"varint,4,opt,name=interval_min,json=intervalMin,proto3" json:"interval_min,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CyclePeriodicRespAttr) Reset()         { *m = CyclePeriodicRespAttr{} }
+func (m *CyclePeriodicRespAttr) String() string { return proto.CompactTextString(m) }
+func (*CyclePeriodicRespAttr) ProtoMessage()    {}
+func (*CyclePeriodicRespAttr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{17}
+}
+func (m *CyclePeriodicRespAttr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CyclePeriodicRespAttr.Unmarshal(m, b)
+}
+func (m *CyclePeriodicRespAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CyclePeriodicRespAttr.Marshal(b, m, deterministic)
+}
+func (dst *CyclePeriodicRespAttr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CyclePeriodicRespAttr.Merge(dst, src)
+}
+func (m *CyclePeriodicRespAttr) XXX_Size() int {
+	return xxx_messageInfo_CyclePeriodicRespAttr.Size(m)
+}
+func (m *CyclePeriodicRespAttr) XXX_DiscardUnknown() {
+	xxx_messageInfo_CyclePeriodicRespAttr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CyclePeriodicRespAttr proto.InternalMessageInfo
+
+func (m *CyclePeriodicRespAttr) GetWorkingDays() []string {
 	if m != nil {
-		return m.ImageName
+		return m.WorkingDays
 	}
-	return ""
+	return nil
 }
 
-func (m *Instance) GetImageTag() string {
+func (m *CyclePeriodicRespAttr) GetMinStartHour() uint32 {
 	if m != nil {
-		return m.ImageTag
+		return m.MinStartHour
 	}
-	return ""
+	return 0
 }
 
-type isInstance_CycleFields interface {
-	isInstance_CycleFields()
+func (m *CyclePeriodicRespAttr) GetMaxStartHour() uint32 {
+	if m != nil {
+		return m.MaxStartHour
+	}
+	return 0
 }
 
-type Instance_PeriodicFields struct {
-	PeriodicFields *PeriodicFields `protobuf:"bytes,11,opt,name=periodic_fields,json=periodicFields,proto3,oneof"`
+func (m *CyclePeriodicRespAttr) GetIntervalMin() uint32 {
+	if m != nil {
+		return m.IntervalMin
+	}
+	return 0
 }
 
-type Instance_RunOnceFields struct {
-	RunOnceFields *RunOnceFields `protobuf:"bytes,12,opt,name=run_once_fields,json=runOnceFields,proto3,oneof"`
+type PeriodicFields struct {
+	Schedule                   string   `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	LastScheduleTime           string   `protobuf:"bytes,2,opt,name=last_schedule_time,json=lastScheduleTime,proto3" json:"last_schedule_time,omitempty"`
+	FailedJobsHistoryLimit     int64    `protobuf:"varint,3,opt,name=failed_jobs_history_limit,json=failedJobsHistoryLimit,proto3" json:"failed_jobs_history_limit,omitempty"`
+	SuccessfulJobsHistoryLimit int64    `protobuf:"varint,4,opt,name=successful_jobs_history_limit,json=successfulJobsHistoryLimit,proto3" json:"successful_jobs_history_limit,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
 }
 
-func (*Instance_PeriodicFields) isInstance_CycleFields() {}
+func (m *PeriodicFields) Reset()         { *m = PeriodicFields{} }
+func (m *PeriodicFields) String() string { return proto.CompactTextString(m) }
+func (*PeriodicFields) ProtoMessage()    {}
+func (*PeriodicFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{18}
+}
+func (m *PeriodicFields) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeriodicFields.Unmarshal(m, b)
+}
+func (m *PeriodicFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeriodicFields.Marshal(b, m, deterministic)
+}
+func (dst *PeriodicFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicFields.Merge(dst, src)
+}
+func (m *PeriodicFields) XXX_Size() int {
+	return xxx_messageInfo_PeriodicFields.Size(m)
+}
+func (m *PeriodicFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicFields.DiscardUnknown(m)
+}
 
-func (*Instance_RunOnceFields) isInstance_CycleFields() {}
+var xxx_messageInfo_PeriodicFields proto.InternalMessageInfo
 
-func (m *Instance) GetCycleFields() isInstance_CycleFields {
+func (m *PeriodicFields) GetSchedule() string {
 	if m != nil {
-		return m.CycleFields
+		return m.Schedule
 	}
-	return nil
+	return ""
 }
 
-func (m *Instance) GetPeriodicFields() *PeriodicFields {
-	if x, ok := m.GetCycleFields().(*Instance_PeriodicFields); ok {
-		return x.PeriodicFields
+func (m *PeriodicFields) GetLastScheduleTime() string {
+	if m != nil {
+		return m.LastScheduleTime
 	}
-	return nil
+	return ""
 }
 
-func (m *Instance) GetRunOnceFields() *RunOnceFields {
-	if x, ok := m.GetCycleFields().(*Instance_RunOnceFields); ok {
-		return x.RunOnceFields
+func (m *PeriodicFields) GetFailedJobsHistoryLimit() int64 {
+	if m != nil {
+		return m.FailedJobsHistoryLimit
 	}
-	return nil
+	return 0
 }
 
-func (m *Instance) GetCreateDate() string {
+func (m *PeriodicFields) GetSuccessfulJobsHistoryLimit() int64 {
 	if m != nil {
-		return m.CreateDate
+		return m.SuccessfulJobsHistoryLimit
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetUpdateDate() string {
+type RunOnceFields struct {
+	Active               int64    `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Completions          int64    `protobuf:"varint,2,opt,name=completions,proto3" json:"completions,omitempty"`
+	Failed               int64    `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Succeeded            int64    `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	StartTime            string   `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CompletionTime       string   `protobuf:"bytes,6,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RunOnceFields) Reset()         { *m = RunOnceFields{} }
+func (m *RunOnceFields) String() string { return proto.CompactTextString(m) }
+func (*RunOnceFields) ProtoMessage()    {}
+func (*RunOnceFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{19}
+}
+func (m *RunOnceFields) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunOnceFields.Unmarshal(m, b)
+}
+func (m *RunOnceFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunOnceFields.Marshal(b, m, deterministic)
+}
+func (dst *RunOnceFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunOnceFields.Merge(dst, src)
+}
+func (m *RunOnceFields) XXX_Size() int {
+	return xxx_messageInfo_RunOnceFields.Size(m)
+}
+func (m *RunOnceFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunOnceFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunOnceFields proto.InternalMessageInfo
+
+func (m *RunOnceFields) GetActive() int64 {
 	if m != nil {
-		return m.UpdateDate
+		return m.Active
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetProjectId() string {
+func (m *RunOnceFields) GetCompletions() int64 {
 	if m != nil {
-		return m.ProjectId
+		return m.Completions
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetNamespace() string {
+func (m *RunOnceFields) GetFailed() int64 {
 	if m != nil {
-		return m.Namespace
+		return m.Failed
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetScale() string {
+func (m *RunOnceFields) GetSucceeded() int64 {
 	if m != nil {
-		return m.Scale
+		return m.Succeeded
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance) GetResources() *Resources {
+func (m *RunOnceFields) GetStartTime() string {
 	if m != nil {
-		return m.Resources
+		return m.StartTime
 	}
-	return nil
+	return ""
 }
 
-func (m *Instance) GetContainers() []*Instance_Container {
+func (m *RunOnceFields) GetCompletionTime() string {
 	if m != nil {
-		return m.Containers
+		return m.CompletionTime
 	}
-	return nil
+	return ""
 }
 
-func (m *Instance) GetPublicEndpoints() []*Instance_PublicEndpoint {
+type Resources struct {
+	Requests             *Resources_Requests `protobuf:"bytes,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	Limits               *Resources_Limits   `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	PersistentStorage    uint32              `protobuf:"varint,3,opt,name=persistent_storage,json=persistentStorage,proto3" json:"persistent_storage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Resources) Reset()         { *m = Resources{} }
+func (m *Resources) String() string { return proto.CompactTextString(m) }
+func (*Resources) ProtoMessage()    {}
+func (*Resources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{20}
+}
+func (m *Resources) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resources.Unmarshal(m, b)
+}
+func (m *Resources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resources.Marshal(b, m, deterministic)
+}
+func (dst *Resources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resources.Merge(dst, src)
+}
+func (m *Resources) XXX_Size() int {
+	return xxx_messageInfo_Resources.Size(m)
+}
+func (m *Resources) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resources.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resources proto.InternalMessageInfo
+
+func (m *Resources) GetRequests() *Resources_Requests {
 	if m != nil {
-		return m.PublicEndpoints
+		return m.Requests
 	}
 	return nil
 }
 
-func (m *Instance) GetTemplate() *Template {
+func (m *Resources) GetLimits() *Resources_Limits {
 	if m != nil {
-		return m.Template
+		return m.Limits
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Instance) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Instance_OneofMarshaler, _Instance_OneofUnmarshaler, _Instance_OneofSizer, []interface{}{
-		(*Instance_PeriodicFields)(nil),
-		(*Instance_RunOnceFields)(nil),
+func (m *Resources) GetPersistentStorage() uint32 {
+	if m != nil {
+		return m.PersistentStorage
 	}
+	return 0
 }
 
-func _Instance_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Instance)
-	// CycleFields
-	switch x := m.CycleFields.(type) {
-	case *Instance_PeriodicFields:
-		b.EncodeVarint(11<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.PeriodicFields); err != nil {
-			return err
-		}
+type Resources_Requests struct {
+	// Memory in MiB
+	Memory uint32 `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	// CPU in millicores
+	Cpu                  float64  `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Resources_Requests) Reset()         { *m = Resources_Requests{} }
+func (m *Resources_Requests) String() string { return proto.CompactTextString(m) }
+func (*Resources_Requests) ProtoMessage()    {}
+func (*Resources_Requests) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{20, 0}
+}
+func (m *Resources_Requests) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resources_Requests.Unmarshal(m, b)
+}
+func (m *Resources_Requests) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resources_Requests.Marshal(b, m, deterministic)
+}
+func (dst *Resources_Requests) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resources_Requests.Merge(dst, src)
+}
+func (m *Resources_Requests) XXX_Size() int {
+	return xxx_messageInfo_Resources_Requests.Size(m)
+}
+func (m *Resources_Requests) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resources_Requests.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resources_Requests proto.InternalMessageInfo
+
+func (m *Resources_Requests) GetMemory() uint32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *Resources_Requests) GetCpu() float64 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+type Resources_Limits struct {
+	// Memory in MiB
+	Memory uint32 `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	// CPU in milli cores
+	Cpu                  float64  `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Resources_Limits) Reset()         { *m = Resources_Limits{} }
+func (m *Resources_Limits) String() string { return proto.CompactTextString(m) }
+func (*Resources_Limits) ProtoMessage()    {}
+func (*Resources_Limits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{20, 1}
+}
+func (m *Resources_Limits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resources_Limits.Unmarshal(m, b)
+}
+func (m *Resources_Limits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resources_Limits.Marshal(b, m, deterministic)
+}
+func (dst *Resources_Limits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resources_Limits.Merge(dst, src)
+}
+func (m *Resources_Limits) XXX_Size() int {
+	return xxx_messageInfo_Resources_Limits.Size(m)
+}
+func (m *Resources_Limits) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resources_Limits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resources_Limits proto.InternalMessageInfo
+
+func (m *Resources_Limits) GetMemory() uint32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *Resources_Limits) GetCpu() float64 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+// Instance message holds information about a particular workload
+type Instance struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Version     string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	RootGroupId string `protobuf:"bytes,4,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	GroupId     string `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	State       string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Cycle       string `protobuf:"bytes,7,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	ImageRepo   string `protobuf:"bytes,8,opt,name=image_repo,json=imageRepo,proto3" json:"image_repo,omitempty"`
+	ImageName   string `protobuf:"bytes,9,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
+	ImageTag    string `protobuf:"bytes,10,opt,name=image_tag,json=imageTag,proto3" json:"image_tag,omitempty"`
+	// Types that are valid to be assigned to CycleFields:
+	//	*Instance_PeriodicFields
+	//	*Instance_RunOnceFields
+	CycleFields     isInstance_CycleFields     `protobuf_oneof:"CycleFields"`
+	CreateDate      string                     `protobuf:"bytes,13,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`
+	UpdateDate      string                     `protobuf:"bytes,14,opt,name=update_date,json=updateDate,proto3" json:"update_date,omitempty"`
+	ProjectId       string                     `protobuf:"bytes,15,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Namespace       string                     `protobuf:"bytes,16,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Scale           string                     `protobuf:"bytes,17,opt,name=scale,proto3" json:"scale,omitempty"`
+	Resources       *Resources                 `protobuf:"bytes,18,opt,name=resources,proto3" json:"resources,omitempty"`
+	Containers      []*Instance_Container      `protobuf:"bytes,19,rep,name=containers,proto3" json:"containers,omitempty"`
+	PublicEndpoints []*Instance_PublicEndpoint `protobuf:"bytes,20,rep,name=public_endpoints,json=publicEndpoints,proto3" json:"public_endpoints,omitempty"`
+	Template        *Template                  `protobuf:"bytes,21,opt,name=template,proto3" json:"template,omitempty"`
+	// Replicas of the workload of a daemon application instance
+	DesiredReplicas      uint32   `protobuf:"varint,22,opt,name=desired_replicas,json=desiredReplicas,proto3" json:"desired_replicas,omitempty"`
+	ReadyReplicas        uint32   `protobuf:"varint,23,opt,name=ready_replicas,json=readyReplicas,proto3" json:"ready_replicas,omitempty"`
+	AvailableReplicas    uint32   `protobuf:"varint,24,opt,name=available_replicas,json=availableReplicas,proto3" json:"available_replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Instance) Reset()         { *m = Instance{} }
+func (m *Instance) String() string { return proto.CompactTextString(m) }
+func (*Instance) ProtoMessage()    {}
+func (*Instance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{21}
+}
+func (m *Instance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Instance.Unmarshal(m, b)
+}
+func (m *Instance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Instance.Marshal(b, m, deterministic)
+}
+func (dst *Instance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Instance.Merge(dst, src)
+}
+func (m *Instance) XXX_Size() int {
+	return xxx_messageInfo_Instance.Size(m)
+}
+func (m *Instance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Instance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Instance proto.InternalMessageInfo
+
+func (m *Instance) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Instance) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Instance) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Instance) GetRootGroupId() string {
+	if m != nil {
+		return m.RootGroupId
+	}
+	return ""
+}
+
+func (m *Instance) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *Instance) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Instance) GetCycle() string {
+	if m != nil {
+		return m.Cycle
+	}
+	return ""
+}
+
+func (m *Instance) GetImageRepo() string {
+	if m != nil {
+		return m.ImageRepo
+	}
+	return ""
+}
+
+func (m *Instance) GetImageName() string {
+	if m != nil {
+		return m.ImageName
+	}
+	return ""
+}
+
+func (m *Instance) GetImageTag() string {
+	if m != nil {
+		return m.ImageTag
+	}
+	return ""
+}
+
+type isInstance_CycleFields interface {
+	isInstance_CycleFields()
+}
+
+type Instance_PeriodicFields struct {
+	PeriodicFields *PeriodicFields `protobuf:"bytes,11,opt,name=periodic_fields,json=periodicFields,proto3,oneof"`
+}
+
+type Instance_RunOnceFields struct {
+	RunOnceFields *RunOnceFields `protobuf:"bytes,12,opt,name=run_once_fields,json=runOnceFields,proto3,oneof"`
+}
+
+func (*Instance_PeriodicFields) isInstance_CycleFields() {}
+
+func (*Instance_RunOnceFields) isInstance_CycleFields() {}
+
+func (m *Instance) GetCycleFields() isInstance_CycleFields {
+	if m != nil {
+		return m.CycleFields
+	}
+	return nil
+}
+
+func (m *Instance) GetPeriodicFields() *PeriodicFields {
+	if x, ok := m.GetCycleFields().(*Instance_PeriodicFields); ok {
+		return x.PeriodicFields
+	}
+	return nil
+}
+
+func (m *Instance) GetRunOnceFields() *RunOnceFields {
+	if x, ok := m.GetCycleFields().(*Instance_RunOnceFields); ok {
+		return x.RunOnceFields
+	}
+	return nil
+}
+
+func (m *Instance) GetCreateDate() string {
+	if m != nil {
+		return m.CreateDate
+	}
+	return ""
+}
+
+func (m *Instance) GetUpdateDate() string {
+	if m != nil {
+		return m.UpdateDate
+	}
+	return ""
+}
+
+func (m *Instance) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *Instance) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *Instance) GetScale() string {
+	if m != nil {
+		return m.Scale
+	}
+	return ""
+}
+
+func (m *Instance) GetResources() *Resources {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *Instance) GetContainers() []*Instance_Container {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+func (m *Instance) GetPublicEndpoints() []*Instance_PublicEndpoint {
+	if m != nil {
+		return m.PublicEndpoints
+	}
+	return nil
+}
+
+func (m *Instance) GetTemplate() *Template {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *Instance) GetDesiredReplicas() uint32 {
+	if m != nil {
+		return m.DesiredReplicas
+	}
+	return 0
+}
+
+func (m *Instance) GetReadyReplicas() uint32 {
+	if m != nil {
+		return m.ReadyReplicas
+	}
+	return 0
+}
+
+func (m *Instance) GetAvailableReplicas() uint32 {
+	if m != nil {
+		return m.AvailableReplicas
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Instance) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Instance_OneofMarshaler, _Instance_OneofUnmarshaler, _Instance_OneofSizer, []interface{}{
+		(*Instance_PeriodicFields)(nil),
+		(*Instance_RunOnceFields)(nil),
+	}
+}
+
+func _Instance_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Instance)
+	// CycleFields
+	switch x := m.CycleFields.(type) {
+	case *Instance_PeriodicFields:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PeriodicFields); err != nil {
+			return err
+		}
 	case *Instance_RunOnceFields:
 		b.EncodeVarint(12<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.RunOnceFields); err != nil {
@@ -2005,942 +2881,1510 @@ func _Instance_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		}
 	case nil:
 	default:
-		return fmt.Errorf("Instance.CycleFields has unexpected type %T", x)
+		return fmt.Errorf("Instance.CycleFields has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Instance_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Instance)
+	switch tag {
+	case 11: // CycleFields.periodic_fields
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PeriodicFields)
+		err := b.DecodeMessage(msg)
+		m.CycleFields = &Instance_PeriodicFields{msg}
+		return true, err
+	case 12: // CycleFields.run_once_fields
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RunOnceFields)
+		err := b.DecodeMessage(msg)
+		m.CycleFields = &Instance_RunOnceFields{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Instance_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Instance)
+	// CycleFields
+	switch x := m.CycleFields.(type) {
+	case *Instance_PeriodicFields:
+		s := proto.Size(x.PeriodicFields)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Instance_RunOnceFields:
+		s := proto.Size(x.RunOnceFields)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Instance_Container struct {
+	Name                 string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image                string                            `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	State                string                            `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Ports                []*Instance_Container_Port        `protobuf:"bytes,4,rep,name=ports,proto3" json:"ports,omitempty"`
+	VolMounts            []*Instance_Container_VolumeMount `protobuf:"bytes,5,rep,name=vol_mounts,json=volMounts,proto3" json:"vol_mounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *Instance_Container) Reset()         { *m = Instance_Container{} }
+func (m *Instance_Container) String() string { return proto.CompactTextString(m) }
+func (*Instance_Container) ProtoMessage()    {}
+func (*Instance_Container) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{21, 0}
+}
+func (m *Instance_Container) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Instance_Container.Unmarshal(m, b)
+}
+func (m *Instance_Container) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Instance_Container.Marshal(b, m, deterministic)
+}
+func (dst *Instance_Container) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Instance_Container.Merge(dst, src)
+}
+func (m *Instance_Container) XXX_Size() int {
+	return xxx_messageInfo_Instance_Container.Size(m)
+}
+func (m *Instance_Container) XXX_DiscardUnknown() {
+	xxx_messageInfo_Instance_Container.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Instance_Container proto.InternalMessageInfo
+
+func (m *Instance_Container) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Instance_Container) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *Instance_Container) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Instance_Container) GetPorts() []*Instance_Container_Port {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *Instance_Container) GetVolMounts() []*Instance_Container_VolumeMount {
+	if m != nil {
+		return m.VolMounts
+	}
+	return nil
+}
+
+type Instance_Container_Port struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Port                 int64    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	DnsName              string   `protobuf:"bytes,3,opt,name=dns_name,json=dnsName,proto3" json:"dns_name,omitempty"`
+	HostIp               string   `protobuf:"bytes,4,opt,name=host_ip,json=hostIp,proto3" json:"host_ip,omitempty"`
+	Kind                 string   `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	Proto                string   `protobuf:"bytes,6,opt,name=proto,proto3" json:"proto,omitempty"`
+	SrcPort              int64    `protobuf:"varint,7,opt,name=src_port,json=srcPort,proto3" json:"src_port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Instance_Container_Port) Reset()         { *m = Instance_Container_Port{} }
+func (m *Instance_Container_Port) String() string { return proto.CompactTextString(m) }
+func (*Instance_Container_Port) ProtoMessage()    {}
+func (*Instance_Container_Port) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{21, 0, 0}
+}
+func (m *Instance_Container_Port) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Instance_Container_Port.Unmarshal(m, b)
+}
+func (m *Instance_Container_Port) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Instance_Container_Port.Marshal(b, m, deterministic)
+}
+func (dst *Instance_Container_Port) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Instance_Container_Port.Merge(dst, src)
+}
+func (m *Instance_Container_Port) XXX_Size() int {
+	return xxx_messageInfo_Instance_Container_Port.Size(m)
+}
+func (m *Instance_Container_Port) XXX_DiscardUnknown() {
+	xxx_messageInfo_Instance_Container_Port.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Instance_Container_Port proto.InternalMessageInfo
+
+func (m *Instance_Container_Port) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Instance_Container_Port) GetPort() int64 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *Instance_Container_Port) GetDnsName() string {
+	if m != nil {
+		return m.DnsName
+	}
+	return ""
+}
+
+func (m *Instance_Container_Port) GetHostIp() string {
+	if m != nil {
+		return m.HostIp
+	}
+	return ""
+}
+
+func (m *Instance_Container_Port) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Instance_Container_Port) GetProto() string {
+	if m != nil {
+		return m.Proto
+	}
+	return ""
+}
+
+func (m *Instance_Container_Port) GetSrcPort() int64 {
+	if m != nil {
+		return m.SrcPort
+	}
+	return 0
+}
+
+type Instance_Container_VolumeMount struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MountPath            string   `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	ReadOnly             bool     `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	SubPath              string   `protobuf:"bytes,4,opt,name=sub_path,json=subPath,proto3" json:"sub_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Instance_Container_VolumeMount) Reset()         { *m = Instance_Container_VolumeMount{} }
+func (m *Instance_Container_VolumeMount) String() string { return proto.CompactTextString(m) }
+func (*Instance_Container_VolumeMount) ProtoMessage()    {}
+func (*Instance_Container_VolumeMount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{21, 0, 1}
+}
+func (m *Instance_Container_VolumeMount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Instance_Container_VolumeMount.Unmarshal(m, b)
+}
+func (m *Instance_Container_VolumeMount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Instance_Container_VolumeMount.Marshal(b, m, deterministic)
+}
+func (dst *Instance_Container_VolumeMount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Instance_Container_VolumeMount.Merge(dst, src)
+}
+func (m *Instance_Container_VolumeMount) XXX_Size() int {
+	return xxx_messageInfo_Instance_Container_VolumeMount.Size(m)
+}
+func (m *Instance_Container_VolumeMount) XXX_DiscardUnknown() {
+	xxx_messageInfo_Instance_Container_VolumeMount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Instance_Container_VolumeMount proto.InternalMessageInfo
+
+func (m *Instance_Container_VolumeMount) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Instance_Container_VolumeMount) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
+	}
+	return ""
+}
+
+func (m *Instance_Container_VolumeMount) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *Instance_Container_VolumeMount) GetSubPath() string {
+	if m != nil {
+		return m.SubPath
+	}
+	return ""
+}
+
+type Instance_PublicEndpoint struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	AllNodes             bool     `protobuf:"varint,2,opt,name=all_nodes,json=allNodes,proto3" json:"all_nodes,omitempty"`
+	Hostname             string   `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	IngressId            string   `protobuf:"bytes,4,opt,name=ingress_id,json=ingressId,proto3" json:"ingress_id,omitempty"`
+	NodeId               string   `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Path                 string   `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	PodId                string   `protobuf:"bytes,7,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
+	Port                 int64    `protobuf:"varint,8,opt,name=port,proto3" json:"port,omitempty"`
+	Proto                string   `protobuf:"bytes,9,opt,name=proto,proto3" json:"proto,omitempty"`
+	ServiceId            string   `protobuf:"bytes,10,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Instance_PublicEndpoint) Reset()         { *m = Instance_PublicEndpoint{} }
+func (m *Instance_PublicEndpoint) String() string { return proto.CompactTextString(m) }
+func (*Instance_PublicEndpoint) ProtoMessage()    {}
+func (*Instance_PublicEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{21, 1}
+}
+func (m *Instance_PublicEndpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Instance_PublicEndpoint.Unmarshal(m, b)
+}
+func (m *Instance_PublicEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Instance_PublicEndpoint.Marshal(b, m, deterministic)
+}
+func (dst *Instance_PublicEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Instance_PublicEndpoint.Merge(dst, src)
+}
+func (m *Instance_PublicEndpoint) XXX_Size() int {
+	return xxx_messageInfo_Instance_PublicEndpoint.Size(m)
+}
+func (m *Instance_PublicEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Instance_PublicEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Instance_PublicEndpoint proto.InternalMessageInfo
+
+func (m *Instance_PublicEndpoint) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
 	return nil
 }
 
-func _Instance_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Instance)
-	switch tag {
-	case 11: // CycleFields.periodic_fields
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(PeriodicFields)
-		err := b.DecodeMessage(msg)
-		m.CycleFields = &Instance_PeriodicFields{msg}
-		return true, err
-	case 12: // CycleFields.run_once_fields
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(RunOnceFields)
-		err := b.DecodeMessage(msg)
-		m.CycleFields = &Instance_RunOnceFields{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *Instance_PublicEndpoint) GetAllNodes() bool {
+	if m != nil {
+		return m.AllNodes
 	}
+	return false
 }
 
-func _Instance_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Instance)
-	// CycleFields
-	switch x := m.CycleFields.(type) {
-	case *Instance_PeriodicFields:
-		s := proto.Size(x.PeriodicFields)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Instance_RunOnceFields:
-		s := proto.Size(x.RunOnceFields)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *Instance_PublicEndpoint) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *Instance_PublicEndpoint) GetIngressId() string {
+	if m != nil {
+		return m.IngressId
+	}
+	return ""
+}
+
+func (m *Instance_PublicEndpoint) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *Instance_PublicEndpoint) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *Instance_PublicEndpoint) GetPodId() string {
+	if m != nil {
+		return m.PodId
+	}
+	return ""
+}
+
+func (m *Instance_PublicEndpoint) GetPort() int64 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *Instance_PublicEndpoint) GetProto() string {
+	if m != nil {
+		return m.Proto
+	}
+	return ""
+}
+
+func (m *Instance_PublicEndpoint) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+// Template message holds information about metadata for a particular application instance
+type Template struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Versions             []string `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	CatalogId            string   `protobuf:"bytes,3,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Template) Reset()         { *m = Template{} }
+func (m *Template) String() string { return proto.CompactTextString(m) }
+func (*Template) ProtoMessage()    {}
+func (*Template) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{22}
+}
+func (m *Template) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Template.Unmarshal(m, b)
+}
+func (m *Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Template.Marshal(b, m, deterministic)
+}
+func (dst *Template) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Template.Merge(dst, src)
+}
+func (m *Template) XXX_Size() int {
+	return xxx_messageInfo_Template.Size(m)
+}
+func (m *Template) XXX_DiscardUnknown() {
+	xxx_messageInfo_Template.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Template proto.InternalMessageInfo
+
+func (m *Template) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Template) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *Template) GetCatalogId() string {
+	if m != nil {
+		return m.CatalogId
+	}
+	return ""
+}
+
+// AppInstance holds information about appropriate application instance
+type AppInstance struct {
+	Name                 string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id                   string    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Version              string    `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	RootGroupId          string    `protobuf:"bytes,4,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	GroupId              string    `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Template             *Template `protobuf:"bytes,6,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AppInstance) Reset()         { *m = AppInstance{} }
+func (m *AppInstance) String() string { return proto.CompactTextString(m) }
+func (*AppInstance) ProtoMessage()    {}
+func (*AppInstance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{23}
+}
+func (m *AppInstance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppInstance.Unmarshal(m, b)
+}
+func (m *AppInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppInstance.Marshal(b, m, deterministic)
+}
+func (dst *AppInstance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppInstance.Merge(dst, src)
+}
+func (m *AppInstance) XXX_Size() int {
+	return xxx_messageInfo_AppInstance.Size(m)
+}
+func (m *AppInstance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppInstance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppInstance proto.InternalMessageInfo
+
+func (m *AppInstance) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AppInstance) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AppInstance) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *AppInstance) GetRootGroupId() string {
+	if m != nil {
+		return m.RootGroupId
+	}
+	return ""
+}
+
+func (m *AppInstance) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
 	}
-	return n
+	return ""
 }
 
-type Instance_Container struct {
-	Name                 string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Image                string                            `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
-	State                string                            `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	Ports                []*Instance_Container_Port        `protobuf:"bytes,4,rep,name=ports,proto3" json:"ports,omitempty"`
-	VolMounts            []*Instance_Container_VolumeMount `protobuf:"bytes,5,rep,name=vol_mounts,json=volMounts,proto3" json:"vol_mounts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+func (m *AppInstance) GetTemplate() *Template {
+	if m != nil {
+		return m.Template
+	}
+	return nil
 }
 
-func (m *Instance_Container) Reset()         { *m = Instance_Container{} }
-func (m *Instance_Container) String() string { return proto.CompactTextString(m) }
-func (*Instance_Container) ProtoMessage()    {}
-func (*Instance_Container) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{14, 0}
+// App holds information about appropriate application
+type App struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cycle                string         `protobuf:"bytes,2,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	Instances            []*AppInstance `protobuf:"bytes,3,rep,name=instances,proto3" json:"instances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (m *Instance_Container) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Instance_Container.Unmarshal(m, b)
+
+func (m *App) Reset()         { *m = App{} }
+func (m *App) String() string { return proto.CompactTextString(m) }
+func (*App) ProtoMessage()    {}
+func (*App) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{24}
 }
-func (m *Instance_Container) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Instance_Container.Marshal(b, m, deterministic)
+func (m *App) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_App.Unmarshal(m, b)
 }
-func (dst *Instance_Container) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Instance_Container.Merge(dst, src)
+func (m *App) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_App.Marshal(b, m, deterministic)
 }
-func (m *Instance_Container) XXX_Size() int {
-	return xxx_messageInfo_Instance_Container.Size(m)
+func (dst *App) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_App.Merge(dst, src)
 }
-func (m *Instance_Container) XXX_DiscardUnknown() {
-	xxx_messageInfo_Instance_Container.DiscardUnknown(m)
+func (m *App) XXX_Size() int {
+	return xxx_messageInfo_App.Size(m)
+}
+func (m *App) XXX_DiscardUnknown() {
+	xxx_messageInfo_App.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Instance_Container proto.InternalMessageInfo
+var xxx_messageInfo_App proto.InternalMessageInfo
 
-func (m *Instance_Container) GetName() string {
+func (m *App) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *Instance_Container) GetImage() string {
+func (m *App) GetCycle() string {
 	if m != nil {
-		return m.Image
+		return m.Cycle
 	}
 	return ""
 }
 
-func (m *Instance_Container) GetState() string {
+func (m *App) GetInstances() []*AppInstance {
 	if m != nil {
-		return m.State
+		return m.Instances
 	}
-	return ""
+	return nil
 }
 
-func (m *Instance_Container) GetPorts() []*Instance_Container_Port {
-	if m != nil {
-		return m.Ports
-	}
-	return nil
+// Apps holds information about applications
+type Apps struct {
+	Apps                 []*App   `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Instance_Container) GetVolMounts() []*Instance_Container_VolumeMount {
+func (m *Apps) Reset()         { *m = Apps{} }
+func (m *Apps) String() string { return proto.CompactTextString(m) }
+func (*Apps) ProtoMessage()    {}
+func (*Apps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{25}
+}
+func (m *Apps) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Apps.Unmarshal(m, b)
+}
+func (m *Apps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Apps.Marshal(b, m, deterministic)
+}
+func (dst *Apps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Apps.Merge(dst, src)
+}
+func (m *Apps) XXX_Size() int {
+	return xxx_messageInfo_Apps.Size(m)
+}
+func (m *Apps) XXX_DiscardUnknown() {
+	xxx_messageInfo_Apps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Apps proto.InternalMessageInfo
+
+func (m *Apps) GetApps() []*App {
 	if m != nil {
-		return m.VolMounts
+		return m.Apps
 	}
 	return nil
 }
 
-type Instance_Container_Port struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Port                 int64    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	DnsName              string   `protobuf:"bytes,3,opt,name=dns_name,json=dnsName,proto3" json:"dns_name,omitempty"`
-	HostIp               string   `protobuf:"bytes,4,opt,name=host_ip,json=hostIp,proto3" json:"host_ip,omitempty"`
-	Kind                 string   `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
-	Proto                string   `protobuf:"bytes,6,opt,name=proto,proto3" json:"proto,omitempty"`
-	SrcPort              int64    `protobuf:"varint,7,opt,name=src_port,json=srcPort,proto3" json:"src_port,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// AppInfo holds information about particular application running in the cluster
+type AppInfo struct {
+	MonitorUrl           string                 `protobuf:"bytes,1,opt,name=monitor_url,json=monitorUrl,proto3" json:"monitor_url,omitempty"`
+	LogsUrl              string                 `protobuf:"bytes,2,opt,name=logs_url,json=logsUrl,proto3" json:"logs_url,omitempty"`
+	TotalResources       *Resources             `protobuf:"bytes,3,opt,name=total_resources,json=totalResources,proto3" json:"total_resources,omitempty"`
+	SharedStorage        uint32                 `protobuf:"varint,4,opt,name=shared_storage,json=sharedStorage,proto3" json:"shared_storage,omitempty"`
+	CyclePeriodicFields  *CyclePeriodicRespAttr `protobuf:"bytes,5,opt,name=cycle_periodic_fields,json=cyclePeriodicFields,proto3" json:"cycle_periodic_fields,omitempty"`
+	Instances            []*Instance            `protobuf:"bytes,6,rep,name=instances,proto3" json:"instances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *Instance_Container_Port) Reset()         { *m = Instance_Container_Port{} }
-func (m *Instance_Container_Port) String() string { return proto.CompactTextString(m) }
-func (*Instance_Container_Port) ProtoMessage()    {}
-func (*Instance_Container_Port) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{14, 0, 0}
+func (m *AppInfo) Reset()         { *m = AppInfo{} }
+func (m *AppInfo) String() string { return proto.CompactTextString(m) }
+func (*AppInfo) ProtoMessage()    {}
+func (*AppInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{26}
 }
-func (m *Instance_Container_Port) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Instance_Container_Port.Unmarshal(m, b)
+func (m *AppInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppInfo.Unmarshal(m, b)
 }
-func (m *Instance_Container_Port) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Instance_Container_Port.Marshal(b, m, deterministic)
+func (m *AppInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppInfo.Marshal(b, m, deterministic)
 }
-func (dst *Instance_Container_Port) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Instance_Container_Port.Merge(dst, src)
+func (dst *AppInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppInfo.Merge(dst, src)
 }
-func (m *Instance_Container_Port) XXX_Size() int {
-	return xxx_messageInfo_Instance_Container_Port.Size(m)
+func (m *AppInfo) XXX_Size() int {
+	return xxx_messageInfo_AppInfo.Size(m)
 }
-func (m *Instance_Container_Port) XXX_DiscardUnknown() {
-	xxx_messageInfo_Instance_Container_Port.DiscardUnknown(m)
+func (m *AppInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Instance_Container_Port proto.InternalMessageInfo
+var xxx_messageInfo_AppInfo proto.InternalMessageInfo
 
-func (m *Instance_Container_Port) GetName() string {
+func (m *AppInfo) GetMonitorUrl() string {
 	if m != nil {
-		return m.Name
+		return m.MonitorUrl
 	}
 	return ""
 }
 
-func (m *Instance_Container_Port) GetPort() int64 {
+func (m *AppInfo) GetLogsUrl() string {
 	if m != nil {
-		return m.Port
+		return m.LogsUrl
 	}
-	return 0
+	return ""
 }
 
-func (m *Instance_Container_Port) GetDnsName() string {
+func (m *AppInfo) GetTotalResources() *Resources {
 	if m != nil {
-		return m.DnsName
+		return m.TotalResources
 	}
-	return ""
+	return nil
 }
 
-func (m *Instance_Container_Port) GetHostIp() string {
+func (m *AppInfo) GetSharedStorage() uint32 {
 	if m != nil {
-		return m.HostIp
+		return m.SharedStorage
 	}
-	return ""
+	return 0
 }
 
-func (m *Instance_Container_Port) GetKind() string {
+func (m *AppInfo) GetCyclePeriodicFields() *CyclePeriodicRespAttr {
 	if m != nil {
-		return m.Kind
+		return m.CyclePeriodicFields
 	}
-	return ""
+	return nil
 }
 
-func (m *Instance_Container_Port) GetProto() string {
+func (m *AppInfo) GetInstances() []*Instance {
 	if m != nil {
-		return m.Proto
+		return m.Instances
 	}
-	return ""
+	return nil
 }
 
-func (m *Instance_Container_Port) GetSrcPort() int64 {
+// AppsInfo holds information about applications running in the cluster
+type AppsInfo struct {
+	Apps                 map[string]*AppInfo `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AppsInfo) Reset()         { *m = AppsInfo{} }
+func (m *AppsInfo) String() string { return proto.CompactTextString(m) }
+func (*AppsInfo) ProtoMessage()    {}
+func (*AppsInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{27}
+}
+func (m *AppsInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppsInfo.Unmarshal(m, b)
+}
+func (m *AppsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppsInfo.Marshal(b, m, deterministic)
+}
+func (dst *AppsInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppsInfo.Merge(dst, src)
+}
+func (m *AppsInfo) XXX_Size() int {
+	return xxx_messageInfo_AppsInfo.Size(m)
+}
+func (m *AppsInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppsInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppsInfo proto.InternalMessageInfo
+
+func (m *AppsInfo) GetApps() map[string]*AppInfo {
 	if m != nil {
-		return m.SrcPort
+		return m.Apps
 	}
-	return 0
+	return nil
 }
 
-type Instance_Container_VolumeMount struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	MountPath            string   `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
-	ReadOnly             bool     `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
-	SubPath              string   `protobuf:"bytes,4,opt,name=sub_path,json=subPath,proto3" json:"sub_path,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// AppTemplates holds information about metadata related to the instances of a particular application
+type AppTemplates struct {
+	AppName              string      `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Templates            []*Template `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Instance_Container_VolumeMount) Reset()         { *m = Instance_Container_VolumeMount{} }
-func (m *Instance_Container_VolumeMount) String() string { return proto.CompactTextString(m) }
-func (*Instance_Container_VolumeMount) ProtoMessage()    {}
-func (*Instance_Container_VolumeMount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{14, 0, 1}
+func (m *AppTemplates) Reset()         { *m = AppTemplates{} }
+func (m *AppTemplates) String() string { return proto.CompactTextString(m) }
+func (*AppTemplates) ProtoMessage()    {}
+func (*AppTemplates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{28}
 }
-func (m *Instance_Container_VolumeMount) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Instance_Container_VolumeMount.Unmarshal(m, b)
+func (m *AppTemplates) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppTemplates.Unmarshal(m, b)
 }
-func (m *Instance_Container_VolumeMount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Instance_Container_VolumeMount.Marshal(b, m, deterministic)
+func (m *AppTemplates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppTemplates.Marshal(b, m, deterministic)
 }
-func (dst *Instance_Container_VolumeMount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Instance_Container_VolumeMount.Merge(dst, src)
+func (dst *AppTemplates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppTemplates.Merge(dst, src)
 }
-func (m *Instance_Container_VolumeMount) XXX_Size() int {
-	return xxx_messageInfo_Instance_Container_VolumeMount.Size(m)
+func (m *AppTemplates) XXX_Size() int {
+	return xxx_messageInfo_AppTemplates.Size(m)
 }
-func (m *Instance_Container_VolumeMount) XXX_DiscardUnknown() {
-	xxx_messageInfo_Instance_Container_VolumeMount.DiscardUnknown(m)
+func (m *AppTemplates) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppTemplates.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Instance_Container_VolumeMount proto.InternalMessageInfo
-
-func (m *Instance_Container_VolumeMount) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_AppTemplates proto.InternalMessageInfo
 
-func (m *Instance_Container_VolumeMount) GetMountPath() string {
+func (m *AppTemplates) GetAppName() string {
 	if m != nil {
-		return m.MountPath
+		return m.AppName
 	}
 	return ""
 }
 
-func (m *Instance_Container_VolumeMount) GetReadOnly() bool {
-	if m != nil {
-		return m.ReadOnly
-	}
-	return false
-}
-
-func (m *Instance_Container_VolumeMount) GetSubPath() string {
+func (m *AppTemplates) GetTemplates() []*Template {
 	if m != nil {
-		return m.SubPath
+		return m.Templates
 	}
-	return ""
+	return nil
 }
 
-type Instance_PublicEndpoint struct {
-	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	AllNodes             bool     `protobuf:"varint,2,opt,name=all_nodes,json=allNodes,proto3" json:"all_nodes,omitempty"`
-	Hostname             string   `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	IngressId            string   `protobuf:"bytes,4,opt,name=ingress_id,json=ingressId,proto3" json:"ingress_id,omitempty"`
-	NodeId               string   `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Path                 string   `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
-	PodId                string   `protobuf:"bytes,7,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
-	Port                 int64    `protobuf:"varint,8,opt,name=port,proto3" json:"port,omitempty"`
-	Proto                string   `protobuf:"bytes,9,opt,name=proto,proto3" json:"proto,omitempty"`
-	ServiceId            string   `protobuf:"bytes,10,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+// AppInstanceActivation holds information about appropriate enabled or disabled application instance
+type AffectedAppInstance struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	RootGroupId          string   `protobuf:"bytes,4,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	GroupId              string   `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Instance_PublicEndpoint) Reset()         { *m = Instance_PublicEndpoint{} }
-func (m *Instance_PublicEndpoint) String() string { return proto.CompactTextString(m) }
-func (*Instance_PublicEndpoint) ProtoMessage()    {}
-func (*Instance_PublicEndpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{14, 1}
-}
-func (m *Instance_PublicEndpoint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Instance_PublicEndpoint.Unmarshal(m, b)
+func (m *AffectedAppInstance) Reset()         { *m = AffectedAppInstance{} }
+func (m *AffectedAppInstance) String() string { return proto.CompactTextString(m) }
+func (*AffectedAppInstance) ProtoMessage()    {}
+func (*AffectedAppInstance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{29}
 }
-func (m *Instance_PublicEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Instance_PublicEndpoint.Marshal(b, m, deterministic)
+func (m *AffectedAppInstance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AffectedAppInstance.Unmarshal(m, b)
 }
-func (dst *Instance_PublicEndpoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Instance_PublicEndpoint.Merge(dst, src)
+func (m *AffectedAppInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AffectedAppInstance.Marshal(b, m, deterministic)
 }
-func (m *Instance_PublicEndpoint) XXX_Size() int {
-	return xxx_messageInfo_Instance_PublicEndpoint.Size(m)
+func (dst *AffectedAppInstance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AffectedAppInstance.Merge(dst, src)
 }
-func (m *Instance_PublicEndpoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_Instance_PublicEndpoint.DiscardUnknown(m)
+func (m *AffectedAppInstance) XXX_Size() int {
+	return xxx_messageInfo_AffectedAppInstance.Size(m)
 }
-
-var xxx_messageInfo_Instance_PublicEndpoint proto.InternalMessageInfo
-
-func (m *Instance_PublicEndpoint) GetAddresses() []string {
-	if m != nil {
-		return m.Addresses
-	}
-	return nil
+func (m *AffectedAppInstance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AffectedAppInstance.DiscardUnknown(m)
 }
 
-func (m *Instance_PublicEndpoint) GetAllNodes() bool {
-	if m != nil {
-		return m.AllNodes
-	}
-	return false
-}
+var xxx_messageInfo_AffectedAppInstance proto.InternalMessageInfo
 
-func (m *Instance_PublicEndpoint) GetHostname() string {
+func (m *AffectedAppInstance) GetName() string {
 	if m != nil {
-		return m.Hostname
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Instance_PublicEndpoint) GetIngressId() string {
+func (m *AffectedAppInstance) GetId() string {
 	if m != nil {
-		return m.IngressId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *Instance_PublicEndpoint) GetNodeId() string {
+func (m *AffectedAppInstance) GetVersion() string {
 	if m != nil {
-		return m.NodeId
+		return m.Version
 	}
 	return ""
 }
 
-func (m *Instance_PublicEndpoint) GetPath() string {
+func (m *AffectedAppInstance) GetRootGroupId() string {
 	if m != nil {
-		return m.Path
+		return m.RootGroupId
 	}
 	return ""
 }
 
-func (m *Instance_PublicEndpoint) GetPodId() string {
+func (m *AffectedAppInstance) GetGroupId() string {
 	if m != nil {
-		return m.PodId
+		return m.GroupId
 	}
 	return ""
 }
 
-func (m *Instance_PublicEndpoint) GetPort() int64 {
-	if m != nil {
-		return m.Port
-	}
-	return 0
+// AffectedAppInstances holds information about application instances
+type AffectedAppInstances struct {
+	Instances            []*AffectedAppInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *Instance_PublicEndpoint) GetProto() string {
-	if m != nil {
-		return m.Proto
-	}
-	return ""
+func (m *AffectedAppInstances) Reset()         { *m = AffectedAppInstances{} }
+func (m *AffectedAppInstances) String() string { return proto.CompactTextString(m) }
+func (*AffectedAppInstances) ProtoMessage()    {}
+func (*AffectedAppInstances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{30}
+}
+func (m *AffectedAppInstances) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AffectedAppInstances.Unmarshal(m, b)
+}
+func (m *AffectedAppInstances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AffectedAppInstances.Marshal(b, m, deterministic)
+}
+func (dst *AffectedAppInstances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AffectedAppInstances.Merge(dst, src)
+}
+func (m *AffectedAppInstances) XXX_Size() int {
+	return xxx_messageInfo_AffectedAppInstances.Size(m)
+}
+func (m *AffectedAppInstances) XXX_DiscardUnknown() {
+	xxx_messageInfo_AffectedAppInstances.DiscardUnknown(m)
 }
 
-func (m *Instance_PublicEndpoint) GetServiceId() string {
+var xxx_messageInfo_AffectedAppInstances proto.InternalMessageInfo
+
+func (m *AffectedAppInstances) GetInstances() []*AffectedAppInstance {
 	if m != nil {
-		return m.ServiceId
+		return m.Instances
 	}
-	return ""
+	return nil
 }
 
-// Template message holds information about metadata for a particular application instance
-type Template struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Versions             []string `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
-	CatalogId            string   `protobuf:"bytes,3,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// AppsActivation holds information about applications affected by EnableDisableApps request
+type AppsActivation struct {
+	Apps                 map[string]*AffectedAppInstances `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
-func (m *Template) Reset()         { *m = Template{} }
-func (m *Template) String() string { return proto.CompactTextString(m) }
-func (*Template) ProtoMessage()    {}
-func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{15}
+func (m *AppsActivation) Reset()         { *m = AppsActivation{} }
+func (m *AppsActivation) String() string { return proto.CompactTextString(m) }
+func (*AppsActivation) ProtoMessage()    {}
+func (*AppsActivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{31}
 }
-func (m *Template) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Template.Unmarshal(m, b)
+func (m *AppsActivation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppsActivation.Unmarshal(m, b)
 }
-func (m *Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Template.Marshal(b, m, deterministic)
+func (m *AppsActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppsActivation.Marshal(b, m, deterministic)
 }
-func (dst *Template) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Template.Merge(dst, src)
+func (dst *AppsActivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppsActivation.Merge(dst, src)
 }
-func (m *Template) XXX_Size() int {
-	return xxx_messageInfo_Template.Size(m)
+func (m *AppsActivation) XXX_Size() int {
+	return xxx_messageInfo_AppsActivation.Size(m)
 }
-func (m *Template) XXX_DiscardUnknown() {
-	xxx_messageInfo_Template.DiscardUnknown(m)
+func (m *AppsActivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppsActivation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Template proto.InternalMessageInfo
-
-func (m *Template) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_AppsActivation proto.InternalMessageInfo
 
-func (m *Template) GetVersions() []string {
+func (m *AppsActivation) GetApps() map[string]*AffectedAppInstances {
 	if m != nil {
-		return m.Versions
+		return m.Apps
 	}
 	return nil
 }
 
-func (m *Template) GetCatalogId() string {
-	if m != nil {
-		return m.CatalogId
-	}
-	return ""
-}
-
-// AppInstance holds information about appropriate application instance
-type AppInstance struct {
-	Name                 string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Id                   string    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Version              string    `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	RootGroupId          string    `protobuf:"bytes,4,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
-	GroupId              string    `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Template             *Template `protobuf:"bytes,6,opt,name=template,proto3" json:"template,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+// InstancePlan describes the change planned for an application instance
+type InstancePlan struct {
+	Name   string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action PlannedAction `protobuf:"varint,2,opt,name=action,proto3,enum=com.cisco.son.apphcd.api.v1.appmanager.PlannedAction" json:"action,omitempty"`
+	// Version the instance is running. Empty if the instance is not deployed
+	CurrentVersion string `protobuf:"bytes,3,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// Version the instance will be running
+	Version     string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	RootGroupId string `protobuf:"bytes,5,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
+	GroupId     string `protobuf:"bytes,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Rendered values.yaml of the instance chart
+	Values string `protobuf:"bytes,7,opt,name=values,proto3" json:"values,omitempty"`
+	// Unified diff of values.yaml against the one of the last good chart in the applications catalog
+	Diff                 string   `protobuf:"bytes,8,opt,name=diff,proto3" json:"diff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AppInstance) Reset()         { *m = AppInstance{} }
-func (m *AppInstance) String() string { return proto.CompactTextString(m) }
-func (*AppInstance) ProtoMessage()    {}
-func (*AppInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{16}
-}
-func (m *AppInstance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AppInstance.Unmarshal(m, b)
+func (m *InstancePlan) Reset()         { *m = InstancePlan{} }
+func (m *InstancePlan) String() string { return proto.CompactTextString(m) }
+func (*InstancePlan) ProtoMessage()    {}
+func (*InstancePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{32}
 }
-func (m *AppInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AppInstance.Marshal(b, m, deterministic)
+func (m *InstancePlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstancePlan.Unmarshal(m, b)
 }
-func (dst *AppInstance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppInstance.Merge(dst, src)
+func (m *InstancePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstancePlan.Marshal(b, m, deterministic)
 }
-func (m *AppInstance) XXX_Size() int {
-	return xxx_messageInfo_AppInstance.Size(m)
+func (dst *InstancePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstancePlan.Merge(dst, src)
 }
-func (m *AppInstance) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppInstance.DiscardUnknown(m)
+func (m *InstancePlan) XXX_Size() int {
+	return xxx_messageInfo_InstancePlan.Size(m)
+}
+func (m *InstancePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstancePlan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AppInstance proto.InternalMessageInfo
+var xxx_messageInfo_InstancePlan proto.InternalMessageInfo
 
-func (m *AppInstance) GetName() string {
+func (m *InstancePlan) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *AppInstance) GetId() string {
+func (m *InstancePlan) GetAction() PlannedAction {
 	if m != nil {
-		return m.Id
+		return m.Action
+	}
+	return PlannedAction_NONE
+}
+
+func (m *InstancePlan) GetCurrentVersion() string {
+	if m != nil {
+		return m.CurrentVersion
 	}
 	return ""
 }
 
-func (m *AppInstance) GetVersion() string {
+func (m *InstancePlan) GetVersion() string {
 	if m != nil {
 		return m.Version
 	}
 	return ""
 }
 
-func (m *AppInstance) GetRootGroupId() string {
+func (m *InstancePlan) GetRootGroupId() string {
 	if m != nil {
 		return m.RootGroupId
 	}
 	return ""
 }
 
-func (m *AppInstance) GetGroupId() string {
+func (m *InstancePlan) GetGroupId() string {
 	if m != nil {
 		return m.GroupId
 	}
 	return ""
 }
 
-func (m *AppInstance) GetTemplate() *Template {
+func (m *InstancePlan) GetValues() string {
 	if m != nil {
-		return m.Template
+		return m.Values
 	}
-	return nil
+	return ""
 }
 
-// App holds information about appropriate application
-type App struct {
-	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Cycle                string         `protobuf:"bytes,2,opt,name=cycle,proto3" json:"cycle,omitempty"`
-	Instances            []*AppInstance `protobuf:"bytes,3,rep,name=instances,proto3" json:"instances,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *InstancePlan) GetDiff() string {
+	if m != nil {
+		return m.Diff
+	}
+	return ""
 }
 
-func (m *App) Reset()         { *m = App{} }
-func (m *App) String() string { return proto.CompactTextString(m) }
-func (*App) ProtoMessage()    {}
-func (*App) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{17}
+// Plan holds the changes the dry run request would apply
+type Plan struct {
+	Name                 string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cycle                string          `protobuf:"bytes,2,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	Instances            []*InstancePlan `protobuf:"bytes,3,rep,name=instances,proto3" json:"instances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *App) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_App.Unmarshal(m, b)
+
+func (m *Plan) Reset()         { *m = Plan{} }
+func (m *Plan) String() string { return proto.CompactTextString(m) }
+func (*Plan) ProtoMessage()    {}
+func (*Plan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{33}
 }
-func (m *App) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_App.Marshal(b, m, deterministic)
+func (m *Plan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Plan.Unmarshal(m, b)
 }
-func (dst *App) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_App.Merge(dst, src)
+func (m *Plan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Plan.Marshal(b, m, deterministic)
 }
-func (m *App) XXX_Size() int {
-	return xxx_messageInfo_App.Size(m)
+func (dst *Plan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Plan.Merge(dst, src)
 }
-func (m *App) XXX_DiscardUnknown() {
-	xxx_messageInfo_App.DiscardUnknown(m)
+func (m *Plan) XXX_Size() int {
+	return xxx_messageInfo_Plan.Size(m)
+}
+func (m *Plan) XXX_DiscardUnknown() {
+	xxx_messageInfo_Plan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_App proto.InternalMessageInfo
+var xxx_messageInfo_Plan proto.InternalMessageInfo
 
-func (m *App) GetName() string {
+func (m *Plan) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *App) GetCycle() string {
+func (m *Plan) GetCycle() string {
 	if m != nil {
 		return m.Cycle
 	}
 	return ""
 }
 
-func (m *App) GetInstances() []*AppInstance {
+func (m *Plan) GetInstances() []*InstancePlan {
 	if m != nil {
 		return m.Instances
 	}
 	return nil
 }
 
-// Apps holds information about applications
-type Apps struct {
-	Apps                 []*App   `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
+// AppRevision is a revision of the applications catalog changing an application chart
+type AppRevision struct {
+	// Commit hash
+	Revision  string               `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Description of the request that made the change
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Template (application instance) name
+	Template string `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	// Chart version
+	Version              string   `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Apps) Reset()         { *m = Apps{} }
-func (m *Apps) String() string { return proto.CompactTextString(m) }
-func (*Apps) ProtoMessage()    {}
-func (*Apps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{18}
+func (m *AppRevision) Reset()         { *m = AppRevision{} }
+func (m *AppRevision) String() string { return proto.CompactTextString(m) }
+func (*AppRevision) ProtoMessage()    {}
+func (*AppRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{34}
 }
-func (m *Apps) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Apps.Unmarshal(m, b)
+func (m *AppRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppRevision.Unmarshal(m, b)
 }
-func (m *Apps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Apps.Marshal(b, m, deterministic)
+func (m *AppRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppRevision.Marshal(b, m, deterministic)
 }
-func (dst *Apps) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Apps.Merge(dst, src)
+func (dst *AppRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppRevision.Merge(dst, src)
 }
-func (m *Apps) XXX_Size() int {
-	return xxx_messageInfo_Apps.Size(m)
+func (m *AppRevision) XXX_Size() int {
+	return xxx_messageInfo_AppRevision.Size(m)
 }
-func (m *Apps) XXX_DiscardUnknown() {
-	xxx_messageInfo_Apps.DiscardUnknown(m)
+func (m *AppRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppRevision.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Apps proto.InternalMessageInfo
+var xxx_messageInfo_AppRevision proto.InternalMessageInfo
 
-func (m *Apps) GetApps() []*App {
+func (m *AppRevision) GetRevision() string {
 	if m != nil {
-		return m.Apps
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *AppRevision) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
 	return nil
 }
 
-// AppInfo holds information about particular application running in the cluster
-type AppInfo struct {
-	MonitorUrl           string                 `protobuf:"bytes,1,opt,name=monitor_url,json=monitorUrl,proto3" json:"monitor_url,omitempty"`
-	LogsUrl              string                 `protobuf:"bytes,2,opt,name=logs_url,json=logsUrl,proto3" json:"logs_url,omitempty"`
-	TotalResources       *Resources             `protobuf:"bytes,3,opt,name=total_resources,json=totalResources,proto3" json:"total_resources,omitempty"`
-	SharedStorage        uint32                 `protobuf:"varint,4,opt,name=shared_storage,json=sharedStorage,proto3" json:"shared_storage,omitempty"`
-	CyclePeriodicFields  *CyclePeriodicRespAttr `protobuf:"bytes,5,opt,name=cycle_periodic_fields,json=cyclePeriodicFields,proto3" json:"cycle_periodic_fields,omitempty"`
-	Instances            []*Instance            `protobuf:"bytes,6,rep,name=instances,proto3" json:"instances,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *AppRevision) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *AppInfo) Reset()         { *m = AppInfo{} }
-func (m *AppInfo) String() string { return proto.CompactTextString(m) }
-func (*AppInfo) ProtoMessage()    {}
-func (*AppInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{19}
+func (m *AppRevision) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
 }
-func (m *AppInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AppInfo.Unmarshal(m, b)
+
+func (m *AppRevision) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
 }
-func (m *AppInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AppInfo.Marshal(b, m, deterministic)
+
+// AppRevisions holds the revisions of the application charts, the latest first
+type AppRevisions struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Revisions            []*AppRevision `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (dst *AppInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppInfo.Merge(dst, src)
+
+func (m *AppRevisions) Reset()         { *m = AppRevisions{} }
+func (m *AppRevisions) String() string { return proto.CompactTextString(m) }
+func (*AppRevisions) ProtoMessage()    {}
+func (*AppRevisions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{35}
 }
-func (m *AppInfo) XXX_Size() int {
-	return xxx_messageInfo_AppInfo.Size(m)
+func (m *AppRevisions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppRevisions.Unmarshal(m, b)
 }
-func (m *AppInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppInfo.DiscardUnknown(m)
+func (m *AppRevisions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppRevisions.Marshal(b, m, deterministic)
+}
+func (dst *AppRevisions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppRevisions.Merge(dst, src)
+}
+func (m *AppRevisions) XXX_Size() int {
+	return xxx_messageInfo_AppRevisions.Size(m)
+}
+func (m *AppRevisions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppRevisions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AppInfo proto.InternalMessageInfo
+var xxx_messageInfo_AppRevisions proto.InternalMessageInfo
 
-func (m *AppInfo) GetMonitorUrl() string {
+func (m *AppRevisions) GetName() string {
 	if m != nil {
-		return m.MonitorUrl
+		return m.Name
 	}
 	return ""
 }
 
-func (m *AppInfo) GetLogsUrl() string {
+func (m *AppRevisions) GetRevisions() []*AppRevision {
 	if m != nil {
-		return m.LogsUrl
+		return m.Revisions
 	}
-	return ""
+	return nil
 }
 
-func (m *AppInfo) GetTotalResources() *Resources {
+// ValueChange describes a value changed between two revisions
+type ValueChange struct {
+	// Key of the value, e.g. image.tag
+	Key  string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type ValueChangeType `protobuf:"varint,2,opt,name=type,proto3,enum=com.cisco.son.apphcd.api.v1.appmanager.ValueChangeType" json:"type,omitempty"`
+	// Previous value. Empty if the value was added
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// New value. Empty if the value was removed
+	To                   string   `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValueChange) Reset()         { *m = ValueChange{} }
+func (m *ValueChange) String() string { return proto.CompactTextString(m) }
+func (*ValueChange) ProtoMessage()    {}
+func (*ValueChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{36}
+}
+func (m *ValueChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueChange.Unmarshal(m, b)
+}
+func (m *ValueChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueChange.Marshal(b, m, deterministic)
+}
+func (dst *ValueChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueChange.Merge(dst, src)
+}
+func (m *ValueChange) XXX_Size() int {
+	return xxx_messageInfo_ValueChange.Size(m)
+}
+func (m *ValueChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueChange proto.InternalMessageInfo
+
+func (m *ValueChange) GetKey() string {
 	if m != nil {
-		return m.TotalResources
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-func (m *AppInfo) GetSharedStorage() uint32 {
+func (m *ValueChange) GetType() ValueChangeType {
 	if m != nil {
-		return m.SharedStorage
+		return m.Type
 	}
-	return 0
+	return ValueChangeType_VALUE_MODIFIED
 }
 
-func (m *AppInfo) GetCyclePeriodicFields() *CyclePeriodicRespAttr {
+func (m *ValueChange) GetFrom() string {
 	if m != nil {
-		return m.CyclePeriodicFields
+		return m.From
 	}
-	return nil
+	return ""
 }
 
-func (m *AppInfo) GetInstances() []*Instance {
+func (m *ValueChange) GetTo() string {
 	if m != nil {
-		return m.Instances
+		return m.To
 	}
-	return nil
+	return ""
 }
 
-// AppsInfo holds information about applications running in the cluster
-type AppsInfo struct {
-	Apps                 map[string]*AppInfo `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+// AppRevisionDiff holds a revision of an application chart and the changes between the chart
+// and the one of the base revision
+type AppRevisionDiff struct {
+	Revision *AppRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Revision the chart is compared with. Empty if the chart was created by the revision
+	Base *AppRevision `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// Image repository and tag
+	Image   *ValueChange   `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	EnvVars []*ValueChange `protobuf:"bytes,4,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty"`
+	// Keys of the application configuration files. The content is not shown
+	Configs []*ValueChange `protobuf:"bytes,5,rep,name=configs,proto3" json:"configs,omitempty"`
+	// Other values of values.yaml by their dotted keys
+	Values               []*ValueChange `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *AppsInfo) Reset()         { *m = AppsInfo{} }
-func (m *AppsInfo) String() string { return proto.CompactTextString(m) }
-func (*AppsInfo) ProtoMessage()    {}
-func (*AppsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{20}
+func (m *AppRevisionDiff) Reset()         { *m = AppRevisionDiff{} }
+func (m *AppRevisionDiff) String() string { return proto.CompactTextString(m) }
+func (*AppRevisionDiff) ProtoMessage()    {}
+func (*AppRevisionDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{37}
 }
-func (m *AppsInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AppsInfo.Unmarshal(m, b)
+func (m *AppRevisionDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppRevisionDiff.Unmarshal(m, b)
 }
-func (m *AppsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AppsInfo.Marshal(b, m, deterministic)
+func (m *AppRevisionDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppRevisionDiff.Marshal(b, m, deterministic)
 }
-func (dst *AppsInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppsInfo.Merge(dst, src)
+func (dst *AppRevisionDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppRevisionDiff.Merge(dst, src)
 }
-func (m *AppsInfo) XXX_Size() int {
-	return xxx_messageInfo_AppsInfo.Size(m)
+func (m *AppRevisionDiff) XXX_Size() int {
+	return xxx_messageInfo_AppRevisionDiff.Size(m)
 }
-func (m *AppsInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppsInfo.DiscardUnknown(m)
+func (m *AppRevisionDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppRevisionDiff.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AppsInfo proto.InternalMessageInfo
+var xxx_messageInfo_AppRevisionDiff proto.InternalMessageInfo
 
-func (m *AppsInfo) GetApps() map[string]*AppInfo {
+func (m *AppRevisionDiff) GetRevision() *AppRevision {
 	if m != nil {
-		return m.Apps
+		return m.Revision
 	}
 	return nil
 }
 
-// AppTemplates holds information about metadata related to the instances of a particular application
-type AppTemplates struct {
-	AppName              string      `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
-	Templates            []*Template `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *AppRevisionDiff) GetBase() *AppRevision {
+	if m != nil {
+		return m.Base
+	}
+	return nil
 }
 
-func (m *AppTemplates) Reset()         { *m = AppTemplates{} }
-func (m *AppTemplates) String() string { return proto.CompactTextString(m) }
-func (*AppTemplates) ProtoMessage()    {}
-func (*AppTemplates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{21}
-}
-func (m *AppTemplates) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AppTemplates.Unmarshal(m, b)
-}
-func (m *AppTemplates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AppTemplates.Marshal(b, m, deterministic)
-}
-func (dst *AppTemplates) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppTemplates.Merge(dst, src)
-}
-func (m *AppTemplates) XXX_Size() int {
-	return xxx_messageInfo_AppTemplates.Size(m)
-}
-func (m *AppTemplates) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppTemplates.DiscardUnknown(m)
+func (m *AppRevisionDiff) GetImage() *ValueChange {
+	if m != nil {
+		return m.Image
+	}
+	return nil
 }
 
-var xxx_messageInfo_AppTemplates proto.InternalMessageInfo
+func (m *AppRevisionDiff) GetEnvVars() []*ValueChange {
+	if m != nil {
+		return m.EnvVars
+	}
+	return nil
+}
 
-func (m *AppTemplates) GetAppName() string {
+func (m *AppRevisionDiff) GetConfigs() []*ValueChange {
 	if m != nil {
-		return m.AppName
+		return m.Configs
 	}
-	return ""
+	return nil
 }
 
-func (m *AppTemplates) GetTemplates() []*Template {
+func (m *AppRevisionDiff) GetValues() []*ValueChange {
 	if m != nil {
-		return m.Templates
+		return m.Values
 	}
 	return nil
 }
 
-// AppInstanceActivation holds information about appropriate enabled or disabled application instance
-type AffectedAppInstance struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	RootGroupId          string   `protobuf:"bytes,4,opt,name=root_group_id,json=rootGroupId,proto3" json:"root_group_id,omitempty"`
-	GroupId              string   `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// WatchAppsEvent describes a change of an application instance
+type WatchAppsEvent struct {
+	Type EventType `protobuf:"varint,1,opt,name=type,proto3,enum=com.cisco.son.apphcd.api.v1.appmanager.EventType" json:"type,omitempty"`
+	// Application name
+	AppName string `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	// Latest application instance. The last known one for DELETED events
+	Instance             *Instance            `protobuf:"bytes,3,opt,name=instance,proto3" json:"instance,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *AffectedAppInstance) Reset()         { *m = AffectedAppInstance{} }
-func (m *AffectedAppInstance) String() string { return proto.CompactTextString(m) }
-func (*AffectedAppInstance) ProtoMessage()    {}
-func (*AffectedAppInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{22}
+func (m *WatchAppsEvent) Reset()         { *m = WatchAppsEvent{} }
+func (m *WatchAppsEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchAppsEvent) ProtoMessage()    {}
+func (*WatchAppsEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{38}
 }
-func (m *AffectedAppInstance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AffectedAppInstance.Unmarshal(m, b)
+func (m *WatchAppsEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchAppsEvent.Unmarshal(m, b)
 }
-func (m *AffectedAppInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AffectedAppInstance.Marshal(b, m, deterministic)
+func (m *WatchAppsEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchAppsEvent.Marshal(b, m, deterministic)
 }
-func (dst *AffectedAppInstance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AffectedAppInstance.Merge(dst, src)
+func (dst *WatchAppsEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchAppsEvent.Merge(dst, src)
 }
-func (m *AffectedAppInstance) XXX_Size() int {
-	return xxx_messageInfo_AffectedAppInstance.Size(m)
+func (m *WatchAppsEvent) XXX_Size() int {
+	return xxx_messageInfo_WatchAppsEvent.Size(m)
 }
-func (m *AffectedAppInstance) XXX_DiscardUnknown() {
-	xxx_messageInfo_AffectedAppInstance.DiscardUnknown(m)
+func (m *WatchAppsEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchAppsEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AffectedAppInstance proto.InternalMessageInfo
-
-func (m *AffectedAppInstance) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_WatchAppsEvent proto.InternalMessageInfo
 
-func (m *AffectedAppInstance) GetId() string {
+func (m *WatchAppsEvent) GetType() EventType {
 	if m != nil {
-		return m.Id
+		return m.Type
 	}
-	return ""
+	return EventType_ADDED
 }
 
-func (m *AffectedAppInstance) GetVersion() string {
+func (m *WatchAppsEvent) GetAppName() string {
 	if m != nil {
-		return m.Version
+		return m.AppName
 	}
 	return ""
 }
 
-func (m *AffectedAppInstance) GetRootGroupId() string {
+func (m *WatchAppsEvent) GetInstance() *Instance {
 	if m != nil {
-		return m.RootGroupId
+		return m.Instance
 	}
-	return ""
+	return nil
 }
 
-func (m *AffectedAppInstance) GetGroupId() string {
+func (m *WatchAppsEvent) GetTimestamp() *timestamp.Timestamp {
 	if m != nil {
-		return m.GroupId
+		return m.Timestamp
 	}
-	return ""
+	return nil
 }
 
-// AffectedAppInstances holds information about application instances
-type AffectedAppInstances struct {
-	Instances            []*AffectedAppInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+// LogLine is a line of application instance logs
+type LogLine struct {
+	// Application instance name
+	Instance string `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Pod name
+	Pod string `protobuf:"bytes,2,opt,name=pod,proto3" json:"pod,omitempty"`
+	// Container name
+	Container string `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	// Time the line was written
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Line                 string               `protobuf:"bytes,5,opt,name=line,proto3" json:"line,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *AffectedAppInstances) Reset()         { *m = AffectedAppInstances{} }
-func (m *AffectedAppInstances) String() string { return proto.CompactTextString(m) }
-func (*AffectedAppInstances) ProtoMessage()    {}
-func (*AffectedAppInstances) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{23}
+func (m *LogLine) Reset()         { *m = LogLine{} }
+func (m *LogLine) String() string { return proto.CompactTextString(m) }
+func (*LogLine) ProtoMessage()    {}
+func (*LogLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{39}
 }
-func (m *AffectedAppInstances) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AffectedAppInstances.Unmarshal(m, b)
+func (m *LogLine) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLine.Unmarshal(m, b)
 }
-func (m *AffectedAppInstances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AffectedAppInstances.Marshal(b, m, deterministic)
+func (m *LogLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLine.Marshal(b, m, deterministic)
 }
-func (dst *AffectedAppInstances) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AffectedAppInstances.Merge(dst, src)
+func (dst *LogLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLine.Merge(dst, src)
 }
-func (m *AffectedAppInstances) XXX_Size() int {
-	return xxx_messageInfo_AffectedAppInstances.Size(m)
+func (m *LogLine) XXX_Size() int {
+	return xxx_messageInfo_LogLine.Size(m)
 }
-func (m *AffectedAppInstances) XXX_DiscardUnknown() {
-	xxx_messageInfo_AffectedAppInstances.DiscardUnknown(m)
+func (m *LogLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLine.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AffectedAppInstances proto.InternalMessageInfo
+var xxx_messageInfo_LogLine proto.InternalMessageInfo
 
-func (m *AffectedAppInstances) GetInstances() []*AffectedAppInstance {
+func (m *LogLine) GetInstance() string {
 	if m != nil {
-		return m.Instances
+		return m.Instance
 	}
-	return nil
+	return ""
 }
 
-// AppsActivation holds information about applications affected by EnableDisableApps request
-type AppsActivation struct {
-	Apps                 map[string]*AffectedAppInstances `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+func (m *LogLine) GetPod() string {
+	if m != nil {
+		return m.Pod
+	}
+	return ""
 }
 
-func (m *AppsActivation) Reset()         { *m = AppsActivation{} }
-func (m *AppsActivation) String() string { return proto.CompactTextString(m) }
-func (*AppsActivation) ProtoMessage()    {}
-func (*AppsActivation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{24}
-}
-func (m *AppsActivation) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AppsActivation.Unmarshal(m, b)
-}
-func (m *AppsActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AppsActivation.Marshal(b, m, deterministic)
-}
-func (dst *AppsActivation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppsActivation.Merge(dst, src)
-}
-func (m *AppsActivation) XXX_Size() int {
-	return xxx_messageInfo_AppsActivation.Size(m)
-}
-func (m *AppsActivation) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppsActivation.DiscardUnknown(m)
+func (m *LogLine) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
 }
 
-var xxx_messageInfo_AppsActivation proto.InternalMessageInfo
-
-func (m *AppsActivation) GetApps() map[string]*AffectedAppInstances {
+func (m *LogLine) GetTimestamp() *timestamp.Timestamp {
 	if m != nil {
-		return m.Apps
+		return m.Timestamp
 	}
 	return nil
 }
 
+func (m *LogLine) GetLine() string {
+	if m != nil {
+		return m.Line
+	}
+	return ""
+}
+
 // Response holds information related to a response message that is sent on appropriate request
 type Response struct {
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Status               Status               `protobuf:"varint,2,opt,name=status,proto3,enum=com.cisco.son.apphcd.api.v1.appmanager.Status" json:"status,omitempty"`
-	Message              string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Body                 *any.Any             `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Status    Status               `protobuf:"varint,2,opt,name=status,proto3,enum=com.cisco.son.apphcd.api.v1.appmanager.Status" json:"status,omitempty"`
+	Message   string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Body      *any.Any             `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// Request ID the response correlates with in the controller logs
+	RequestId            string   `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_appmanager_545acda625c91d98, []int{25}
+	return fileDescriptor_appmanager_f5392d4c4e5f6727, []int{40}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -2988,6 +4432,13 @@ func (m *Response) GetBody() *any.Any {
 	return nil
 }
 
+func (m *Response) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CreateAppRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.CreateAppRequest")
 	proto.RegisterMapType((map[string]string)(nil), "com.cisco.son.apphcd.api.v1.appmanager.CreateAppRequest.AnnotationsEntry")
@@ -3007,7 +4458,14 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "com.cisco.son.apphcd.api.v1.appmanager.UpdateAppRequest.EnvVarsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "com.cisco.son.apphcd.api.v1.appmanager.UpdateAppRequest.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "com.cisco.son.apphcd.api.v1.appmanager.UpdateAppRequest.SecretsEntry")
+	proto.RegisterType((*UpgradeStrategy)(nil), "com.cisco.son.apphcd.api.v1.appmanager.UpgradeStrategy")
+	proto.RegisterType((*RollbackAppRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.RollbackAppRequest")
+	proto.RegisterType((*ScaleAppRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.ScaleAppRequest")
+	proto.RegisterType((*ListAppRevisionsRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.ListAppRevisionsRequest")
+	proto.RegisterType((*GetAppRevisionRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.GetAppRevisionRequest")
 	proto.RegisterType((*GetAppsRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.GetAppsRequest")
+	proto.RegisterType((*WatchAppsRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.WatchAppsRequest")
+	proto.RegisterType((*StreamAppLogsRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.StreamAppLogsRequest")
 	proto.RegisterType((*DeleteAppRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.DeleteAppRequest")
 	proto.RegisterType((*DeleteAppMetadataRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.DeleteAppMetadataRequest")
 	proto.RegisterType((*DeleteAppsRequest)(nil), "com.cisco.son.apphcd.api.v1.appmanager.DeleteAppsRequest")
@@ -3019,6 +4477,7 @@ func init() {
 	proto.RegisterType((*Spec_Port)(nil), "com.cisco.son.apphcd.api.v1.appmanager.Spec.Port")
 	proto.RegisterType((*Spec_Resources)(nil), "com.cisco.son.apphcd.api.v1.appmanager.Spec.Resources")
 	proto.RegisterType((*Spec_Resources_Limits)(nil), "com.cisco.son.apphcd.api.v1.appmanager.Spec.Resources.Limits")
+	proto.RegisterType((*Spec_Autoscaling)(nil), "com.cisco.son.apphcd.api.v1.appmanager.Spec.Autoscaling")
 	proto.RegisterType((*CyclePeriodicRespAttr)(nil), "com.cisco.son.apphcd.api.v1.appmanager.CyclePeriodicRespAttr")
 	proto.RegisterType((*PeriodicFields)(nil), "com.cisco.son.apphcd.api.v1.appmanager.PeriodicFields")
 	proto.RegisterType((*RunOnceFields)(nil), "com.cisco.son.apphcd.api.v1.appmanager.RunOnceFields")
@@ -3042,8 +4501,20 @@ func init() {
 	proto.RegisterType((*AffectedAppInstances)(nil), "com.cisco.son.apphcd.api.v1.appmanager.AffectedAppInstances")
 	proto.RegisterType((*AppsActivation)(nil), "com.cisco.son.apphcd.api.v1.appmanager.AppsActivation")
 	proto.RegisterMapType((map[string]*AffectedAppInstances)(nil), "com.cisco.son.apphcd.api.v1.appmanager.AppsActivation.AppsEntry")
+	proto.RegisterType((*InstancePlan)(nil), "com.cisco.son.apphcd.api.v1.appmanager.InstancePlan")
+	proto.RegisterType((*Plan)(nil), "com.cisco.son.apphcd.api.v1.appmanager.Plan")
+	proto.RegisterType((*AppRevision)(nil), "com.cisco.son.apphcd.api.v1.appmanager.AppRevision")
+	proto.RegisterType((*AppRevisions)(nil), "com.cisco.son.apphcd.api.v1.appmanager.AppRevisions")
+	proto.RegisterType((*ValueChange)(nil), "com.cisco.son.apphcd.api.v1.appmanager.ValueChange")
+	proto.RegisterType((*AppRevisionDiff)(nil), "com.cisco.son.apphcd.api.v1.appmanager.AppRevisionDiff")
+	proto.RegisterType((*WatchAppsEvent)(nil), "com.cisco.son.apphcd.api.v1.appmanager.WatchAppsEvent")
+	proto.RegisterType((*LogLine)(nil), "com.cisco.son.apphcd.api.v1.appmanager.LogLine")
 	proto.RegisterType((*Response)(nil), "com.cisco.son.apphcd.api.v1.appmanager.Response")
 	proto.RegisterEnum("com.cisco.son.apphcd.api.v1.appmanager.AppStateAfterDeployment", AppStateAfterDeployment_name, AppStateAfterDeployment_value)
+	proto.RegisterEnum("com.cisco.son.apphcd.api.v1.appmanager.UpgradeStrategyType", UpgradeStrategyType_name, UpgradeStrategyType_value)
+	proto.RegisterEnum("com.cisco.son.apphcd.api.v1.appmanager.PlannedAction", PlannedAction_name, PlannedAction_value)
+	proto.RegisterEnum("com.cisco.son.apphcd.api.v1.appmanager.ValueChangeType", ValueChangeType_name, ValueChangeType_value)
+	proto.RegisterEnum("com.cisco.son.apphcd.api.v1.appmanager.EventType", EventType_name, EventType_value)
 	proto.RegisterEnum("com.cisco.son.apphcd.api.v1.appmanager.Status", Status_name, Status_value)
 	proto.RegisterEnum("com.cisco.son.apphcd.api.v1.appmanager.Spec_Port_Proto", Spec_Port_Proto_name, Spec_Port_Proto_value)
 }
@@ -3081,6 +4552,19 @@ type AppManagerClient interface {
 	// running, the method will return "UNCHANGED" status and message "Nothing to update/upgrade".
 	// This method always recreates existing application instance
 	UpdateApp(ctx context.Context, in *UpdateAppRequest, opts ...grpc.CallOption) (*Response, error)
+	// RollbackApp rolls back application instances to the chart of a previous version or a previous revision
+	// of the applications catalog. The chart is restored from the catalog history if it was removed or replaced since.
+	// Instances already running the requested chart are left as they are
+	RollbackApp(ctx context.Context, in *RollbackAppRequest, opts ...grpc.CallOption) (*Response, error)
+	// ScaleApp changes the number of replicas of daemon application instances without recreating them.
+	// Instances scaled by the horizontal pod autoscaler are not supported
+	ScaleApp(ctx context.Context, in *ScaleAppRequest, opts ...grpc.CallOption) (*Response, error)
+	// ListAppRevisions lists the revisions of the application charts kept in the applications catalog, the latest first.
+	// Every change of a chart is a commit of the catalog described by the request that made it
+	ListAppRevisions(ctx context.Context, in *ListAppRevisionsRequest, opts ...grpc.CallOption) (*Response, error)
+	// GetAppRevision gets a revision of an application chart with the changes of the image, the environment variables,
+	// the configuration files and the other values against the base revision
+	GetAppRevision(ctx context.Context, in *GetAppRevisionRequest, opts ...grpc.CallOption) (*Response, error)
 	// EnableDisableApp disables or enables an application
 	EnableDisableApp(ctx context.Context, in *EnableDisableAppRequest, opts ...grpc.CallOption) (*Response, error)
 	// DeleteApp deletes instances of a particular running application.
@@ -3091,6 +4575,12 @@ type AppManagerClient interface {
 	DeleteApps(ctx context.Context, in *DeleteAppsRequest, opts ...grpc.CallOption) (*Response, error)
 	// GetApps shows appropriate information about running application
 	GetApps(ctx context.Context, in *GetAppsRequest, opts ...grpc.CallOption) (*Response, error)
+	// WatchApps streams changes of application instances. Every existing instance is sent as ADDED
+	// once the watch is started. Over HTTP the events are streamed as newline-delimited JSON
+	WatchApps(ctx context.Context, in *WatchAppsRequest, opts ...grpc.CallOption) (AppManager_WatchAppsClient, error)
+	// StreamAppLogs streams logs of application instances through the controller, so they are available
+	// even if the logging stack isn't. Over HTTP the lines are streamed as newline-delimited JSON
+	StreamAppLogs(ctx context.Context, in *StreamAppLogsRequest, opts ...grpc.CallOption) (AppManager_StreamAppLogsClient, error)
 	// DeleteAppMetadata deletes metadata for a particular application instance
 	DeleteAppMetadata(ctx context.Context, in *DeleteAppMetadataRequest, opts ...grpc.CallOption) (*Response, error)
 }
@@ -3130,6 +4620,42 @@ func (c *appManagerClient) UpdateApp(ctx context.Context, in *UpdateAppRequest,
 	return out, nil
 }
 
+func (c *appManagerClient) RollbackApp(ctx context.Context, in *RollbackAppRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/RollbackApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) ScaleApp(ctx context.Context, in *ScaleAppRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/ScaleApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) ListAppRevisions(ctx context.Context, in *ListAppRevisionsRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/ListAppRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) GetAppRevision(ctx context.Context, in *GetAppRevisionRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/GetAppRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appManagerClient) EnableDisableApp(ctx context.Context, in *EnableDisableAppRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/EnableDisableApp", in, out, opts...)
@@ -3166,6 +4692,70 @@ func (c *appManagerClient) GetApps(ctx context.Context, in *GetAppsRequest, opts
 	return out, nil
 }
 
+func (c *appManagerClient) WatchApps(ctx context.Context, in *WatchAppsRequest, opts ...grpc.CallOption) (AppManager_WatchAppsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AppManager_serviceDesc.Streams[0], "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/WatchApps", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appManagerWatchAppsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppManager_WatchAppsClient interface {
+	Recv() (*WatchAppsEvent, error)
+	grpc.ClientStream
+}
+
+type appManagerWatchAppsClient struct {
+	grpc.ClientStream
+}
+
+func (x *appManagerWatchAppsClient) Recv() (*WatchAppsEvent, error) {
+	m := new(WatchAppsEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *appManagerClient) StreamAppLogs(ctx context.Context, in *StreamAppLogsRequest, opts ...grpc.CallOption) (AppManager_StreamAppLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AppManager_serviceDesc.Streams[1], "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/StreamAppLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appManagerStreamAppLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppManager_StreamAppLogsClient interface {
+	Recv() (*LogLine, error)
+	grpc.ClientStream
+}
+
+type appManagerStreamAppLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *appManagerStreamAppLogsClient) Recv() (*LogLine, error) {
+	m := new(LogLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *appManagerClient) DeleteAppMetadata(ctx context.Context, in *DeleteAppMetadataRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/DeleteAppMetadata", in, out, opts...)
@@ -3198,6 +4788,19 @@ type AppManagerServer interface {
 	// running, the method will return "UNCHANGED" status and message "Nothing to update/upgrade".
 	// This method always recreates existing application instance
 	UpdateApp(context.Context, *UpdateAppRequest) (*Response, error)
+	// RollbackApp rolls back application instances to the chart of a previous version or a previous revision
+	// of the applications catalog. The chart is restored from the catalog history if it was removed or replaced since.
+	// Instances already running the requested chart are left as they are
+	RollbackApp(context.Context, *RollbackAppRequest) (*Response, error)
+	// ScaleApp changes the number of replicas of daemon application instances without recreating them.
+	// Instances scaled by the horizontal pod autoscaler are not supported
+	ScaleApp(context.Context, *ScaleAppRequest) (*Response, error)
+	// ListAppRevisions lists the revisions of the application charts kept in the applications catalog, the latest first.
+	// Every change of a chart is a commit of the catalog described by the request that made it
+	ListAppRevisions(context.Context, *ListAppRevisionsRequest) (*Response, error)
+	// GetAppRevision gets a revision of an application chart with the changes of the image, the environment variables,
+	// the configuration files and the other values against the base revision
+	GetAppRevision(context.Context, *GetAppRevisionRequest) (*Response, error)
 	// EnableDisableApp disables or enables an application
 	EnableDisableApp(context.Context, *EnableDisableAppRequest) (*Response, error)
 	// DeleteApp deletes instances of a particular running application.
@@ -3208,6 +4811,12 @@ type AppManagerServer interface {
 	DeleteApps(context.Context, *DeleteAppsRequest) (*Response, error)
 	// GetApps shows appropriate information about running application
 	GetApps(context.Context, *GetAppsRequest) (*Response, error)
+	// WatchApps streams changes of application instances. Every existing instance is sent as ADDED
+	// once the watch is started. Over HTTP the events are streamed as newline-delimited JSON
+	WatchApps(*WatchAppsRequest, AppManager_WatchAppsServer) error
+	// StreamAppLogs streams logs of application instances through the controller, so they are available
+	// even if the logging stack isn't. Over HTTP the lines are streamed as newline-delimited JSON
+	StreamAppLogs(*StreamAppLogsRequest, AppManager_StreamAppLogsServer) error
 	// DeleteAppMetadata deletes metadata for a particular application instance
 	DeleteAppMetadata(context.Context, *DeleteAppMetadataRequest) (*Response, error)
 }
@@ -3270,6 +4879,78 @@ func _AppManager_UpdateApp_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManager_RollbackApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).RollbackApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/RollbackApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).RollbackApp(ctx, req.(*RollbackAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_ScaleApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).ScaleApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/ScaleApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).ScaleApp(ctx, req.(*ScaleAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_ListAppRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).ListAppRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/ListAppRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).ListAppRevisions(ctx, req.(*ListAppRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_GetAppRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).GetAppRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/GetAppRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).GetAppRevision(ctx, req.(*GetAppRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppManager_EnableDisableApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnableDisableAppRequest)
 	if err := dec(in); err != nil {
@@ -3342,6 +5023,48 @@ func _AppManager_GetApps_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManager_WatchApps_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAppsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppManagerServer).WatchApps(m, &appManagerWatchAppsServer{stream})
+}
+
+type AppManager_WatchAppsServer interface {
+	Send(*WatchAppsEvent) error
+	grpc.ServerStream
+}
+
+type appManagerWatchAppsServer struct {
+	grpc.ServerStream
+}
+
+func (x *appManagerWatchAppsServer) Send(m *WatchAppsEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AppManager_StreamAppLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAppLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppManagerServer).StreamAppLogs(m, &appManagerStreamAppLogsServer{stream})
+}
+
+type AppManager_StreamAppLogsServer interface {
+	Send(*LogLine) error
+	grpc.ServerStream
+}
+
+type appManagerStreamAppLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *appManagerStreamAppLogsServer) Send(m *LogLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AppManager_DeleteAppMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAppMetadataRequest)
 	if err := dec(in); err != nil {
@@ -3376,6 +5099,22 @@ var _AppManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateApp",
 			Handler:    _AppManager_UpdateApp_Handler,
 		},
+		{
+			MethodName: "RollbackApp",
+			Handler:    _AppManager_RollbackApp_Handler,
+		},
+		{
+			MethodName: "ScaleApp",
+			Handler:    _AppManager_ScaleApp_Handler,
+		},
+		{
+			MethodName: "ListAppRevisions",
+			Handler:    _AppManager_ListAppRevisions_Handler,
+		},
+		{
+			MethodName: "GetAppRevision",
+			Handler:    _AppManager_GetAppRevision_Handler,
+		},
 		{
 			MethodName: "EnableDisableApp",
 			Handler:    _AppManager_EnableDisableApp_Handler,
@@ -3397,210 +5136,307 @@ var _AppManager_serviceDesc = grpc.ServiceDesc{
 			Handler:    _AppManager_DeleteAppMetadata_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchApps",
+			Handler:       _AppManager_WatchApps_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAppLogs",
+			Handler:       _AppManager_StreamAppLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "appmanager.proto",
 }
 
-func init() { proto.RegisterFile("appmanager.proto", fileDescriptor_appmanager_545acda625c91d98) }
-
-var fileDescriptor_appmanager_545acda625c91d98 = []byte{
-	// 3168 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x70, 0x1b, 0xc7,
-	0x95, 0xe6, 0xe0, 0x7f, 0x1e, 0x48, 0x10, 0x6a, 0x51, 0x16, 0x04, 0x4b, 0x36, 0x0d, 0xcb, 0x6b,
-	0x8a, 0x32, 0x41, 0x09, 0xf6, 0x7a, 0x2d, 0xf9, 0x17, 0x22, 0x29, 0x89, 0x2e, 0x8b, 0xe2, 0x0e,
-	0x49, 0x6f, 0xd9, 0x96, 0x34, 0x6e, 0xce, 0x34, 0xc1, 0x91, 0x06, 0x33, 0xad, 0xe9, 0x01, 0x2d,
-	0xac, 0xd7, 0x17, 0xdf, 0x76, 0x37, 0x55, 0x49, 0xd9, 0x87, 0x24, 0x95, 0x9b, 0x73, 0x48, 0xf9,
-	0x90, 0x4b, 0x2a, 0xa7, 0x5c, 0x92, 0x43, 0x52, 0xb9, 0xe5, 0x92, 0x4a, 0x2a, 0x55, 0x49, 0x6e,
-	0xa9, 0x54, 0xe5, 0x96, 0x63, 0x6e, 0x4e, 0xf5, 0xcf, 0x00, 0x83, 0x1f, 0xda, 0x00, 0x68, 0x57,
-	0xd9, 0x29, 0x9d, 0x80, 0xf7, 0xba, 0xfb, 0xbd, 0xd7, 0xef, 0xbd, 0xfe, 0xfa, 0x75, 0xf7, 0x40,
-	0x11, 0x53, 0xda, 0xc4, 0x1e, 0x6e, 0x90, 0xa0, 0x4a, 0x03, 0x3f, 0xf4, 0xd1, 0xbf, 0x59, 0x7e,
-	0xb3, 0x6a, 0x39, 0xcc, 0xf2, 0xab, 0xcc, 0xf7, 0xaa, 0x98, 0xd2, 0x7d, 0xcb, 0xae, 0x62, 0xea,
-	0x54, 0x0f, 0x2e, 0x56, 0xbb, 0xbd, 0xcb, 0xa7, 0x1b, 0xbe, 0xdf, 0x70, 0xc9, 0x32, 0xa6, 0xce,
-	0x32, 0xf6, 0x3c, 0x3f, 0xc4, 0xa1, 0xe3, 0x7b, 0x4c, 0x4a, 0x29, 0x3f, 0xae, 0x5a, 0x05, 0xb5,
-	0xdb, 0xda, 0x5b, 0x0e, 0x9d, 0x26, 0x61, 0x21, 0x6e, 0x52, 0xd5, 0xa1, 0xde, 0x70, 0xc2, 0xfd,
-	0xd6, 0x6e, 0xd5, 0xf2, 0x9b, 0xcb, 0xc4, 0x3b, 0xf0, 0xdb, 0x34, 0xf0, 0x1f, 0xb4, 0x65, 0x7f,
-	0x6b, 0xa9, 0x41, 0xbc, 0xa5, 0x03, 0xec, 0x3a, 0x36, 0x0e, 0xc9, 0xf2, 0xc0, 0x1f, 0x25, 0xe2,
-	0x54, 0xbf, 0x0e, 0xec, 0xb5, 0x65, 0x53, 0xe5, 0xa3, 0x3c, 0x14, 0x57, 0x02, 0x82, 0x43, 0x52,
-	0xa7, 0xd4, 0x20, 0xf7, 0x5b, 0x84, 0x85, 0xe8, 0x0c, 0xa4, 0x3c, 0xdc, 0x24, 0x25, 0x6d, 0x5e,
-	0x5b, 0xd0, 0xaf, 0xe8, 0x3f, 0xfb, 0xdb, 0x2f, 0x92, 0xa9, 0x20, 0x31, 0xaf, 0x19, 0x82, 0x8d,
-	0x6e, 0x81, 0x8e, 0x29, 0x35, 0x59, 0x88, 0x43, 0x52, 0x4a, 0xcc, 0x6b, 0x0b, 0x85, 0xda, 0xab,
-	0xd5, 0xd1, 0x9c, 0x51, 0xad, 0x53, 0xba, 0xc5, 0xc7, 0xd5, 0xf7, 0x42, 0x12, 0xac, 0x12, 0xea,
-	0xfa, 0xed, 0x26, 0xf1, 0x42, 0x23, 0x87, 0x55, 0x03, 0xaa, 0x41, 0xf6, 0x80, 0x04, 0xcc, 0xf1,
-	0xbd, 0x52, 0x52, 0xe8, 0x2f, 0x71, 0xfd, 0xc7, 0x83, 0x63, 0xb5, 0xd9, 0x3b, 0xb7, 0xde, 0x5b,
-	0xbc, 0x65, 0x9f, 0x5f, 0xb8, 0x55, 0xbd, 0x65, 0x9f, 0x5b, 0x3c, 0x6b, 0x44, 0x1d, 0xd1, 0x13,
-	0x30, 0xbd, 0x17, 0xf8, 0x4d, 0xd3, 0xc2, 0x21, 0x76, 0xfd, 0x46, 0x29, 0x35, 0xaf, 0x2d, 0xe4,
-	0x8c, 0x3c, 0xe7, 0xad, 0x48, 0x16, 0x9a, 0x87, 0xbc, 0x4d, 0x98, 0x15, 0x38, 0x94, 0x7b, 0xbf,
-	0x94, 0xe6, 0xa2, 0x8d, 0x38, 0x0b, 0x5d, 0x82, 0xb4, 0xd5, 0xb6, 0x5c, 0x52, 0xca, 0x08, 0xb5,
-	0x4f, 0x72, 0xb5, 0x8f, 0x05, 0xa7, 0x8d, 0x1c, 0x25, 0x81, 0xe3, 0xdb, 0x8e, 0x65, 0x64, 0x6c,
-	0x4c, 0x9a, 0xbe, 0x67, 0xe4, 0x82, 0x96, 0x67, 0xfa, 0x9e, 0x45, 0x0c, 0x39, 0x02, 0xb9, 0x70,
-	0x5c, 0xfc, 0x31, 0xa3, 0xae, 0x26, 0x0e, 0xc3, 0xa0, 0x94, 0x9d, 0xd7, 0x16, 0xf2, 0xb5, 0x97,
-	0x46, 0xf5, 0xcd, 0x0a, 0x17, 0xb1, 0x19, 0x29, 0x23, 0xf7, 0xeb, 0x61, 0x18, 0x18, 0xc7, 0xac,
-	0x38, 0x97, 0xb3, 0x50, 0x05, 0x66, 0x02, 0xdf, 0x0f, 0xcd, 0x46, 0xe0, 0xb7, 0xa8, 0xe9, 0xd8,
-	0xa5, 0x9c, 0x9c, 0x0c, 0x67, 0x5e, 0xe3, 0xbc, 0x75, 0x1b, 0x3d, 0x0d, 0x7a, 0xd4, 0xcc, 0x4a,
-	0xfa, 0x7c, 0x72, 0x41, 0xbf, 0x02, 0x7c, 0x42, 0xe9, 0x8f, 0xb4, 0x44, 0x4e, 0x33, 0x72, 0x0d,
-	0xd9, 0x8f, 0x21, 0x07, 0xf2, 0x3c, 0x98, 0x96, 0xef, 0xed, 0x39, 0x0d, 0x56, 0x82, 0xf9, 0xe4,
-	0x42, 0xbe, 0x76, 0x7d, 0x64, 0x93, 0xfb, 0x52, 0x87, 0xc7, 0x77, 0x45, 0x8a, 0x5a, 0xf3, 0xc2,
-	0xa0, 0x6d, 0x00, 0xee, 0x30, 0xd0, 0xbb, 0x90, 0x23, 0xde, 0x81, 0x79, 0x80, 0x03, 0x56, 0xca,
-	0x0b, 0x3d, 0x6b, 0x13, 0xeb, 0x59, 0xf3, 0x0e, 0xde, 0xc4, 0x81, 0x52, 0x92, 0x25, 0x92, 0x42,
-	0x26, 0x64, 0x19, 0xb1, 0x02, 0x12, 0xb2, 0xd2, 0xf4, 0x11, 0x15, 0x6c, 0x49, 0x39, 0x4a, 0x81,
-	0x92, 0x8a, 0x6e, 0x41, 0xc6, 0xc5, 0xbb, 0xc4, 0x65, 0xa5, 0x19, 0x21, 0x7f, 0x75, 0x62, 0xf9,
-	0x6f, 0x08, 0x31, 0x52, 0xbc, 0x92, 0x89, 0xee, 0x41, 0x3e, 0x06, 0x10, 0xa5, 0x82, 0x50, 0xb1,
-	0x3e, 0x79, 0x2c, 0xba, 0xb2, 0xa4, 0x9e, 0xb8, 0x74, 0xf4, 0x14, 0x14, 0xd8, 0x3e, 0x0e, 0x88,
-	0x6d, 0xb2, 0xd0, 0x0f, 0x70, 0x83, 0x94, 0x66, 0xe7, 0xb5, 0x85, 0x19, 0x63, 0x46, 0x72, 0xb7,
-	0x24, 0x13, 0xbd, 0x06, 0x29, 0x46, 0x89, 0x55, 0x2a, 0x8a, 0x5c, 0x7e, 0x66, 0x54, 0x63, 0xb6,
-	0x28, 0xb1, 0x0c, 0x31, 0xb2, 0xfc, 0x32, 0xcc, 0xf6, 0x65, 0x05, 0x2a, 0x42, 0xf2, 0x1e, 0x69,
-	0x4b, 0x7c, 0x31, 0xf8, 0x5f, 0x34, 0x07, 0xe9, 0x03, 0xec, 0xb6, 0x24, 0x9e, 0xe8, 0x86, 0x24,
-	0x2e, 0x27, 0x5e, 0xd0, 0xca, 0x97, 0x61, 0x3a, 0x1e, 0xec, 0x71, 0xc7, 0xc6, 0xe3, 0x38, 0xd6,
-	0xd8, 0x4b, 0x90, 0x8f, 0xc5, 0x68, 0xac, 0xa1, 0xaf, 0x40, 0xb1, 0xdf, 0xf7, 0xe3, 0x8c, 0xaf,
-	0x7c, 0x2b, 0x0f, 0xc7, 0x76, 0x68, 0x23, 0xc0, 0xf6, 0x43, 0x54, 0xfe, 0x97, 0x42, 0xe5, 0x47,
-	0x07, 0x50, 0x39, 0x86, 0xc4, 0x77, 0x87, 0x21, 0xf1, 0xc8, 0xab, 0x7f, 0x20, 0x5f, 0x3e, 0x17,
-	0x8a, 0xf1, 0x00, 0x14, 0x5f, 0x9d, 0x5c, 0xd1, 0x70, 0x2c, 0x7e, 0xb7, 0x1f, 0x8b, 0x8f, 0xa0,
-	0x61, 0x38, 0x18, 0xdf, 0xee, 0x03, 0xe3, 0xb5, 0xc9, 0x15, 0x0c, 0x43, 0x63, 0x77, 0x18, 0x1a,
-	0xbf, 0x7e, 0x84, 0x78, 0x3c, 0x84, 0xe3, 0x6f, 0x26, 0x1c, 0xff, 0x03, 0xa0, 0xb8, 0x43, 0xed,
-	0xaf, 0x51, 0x8d, 0x7c, 0xb6, 0x1f, 0x8d, 0x65, 0x6d, 0x17, 0x24, 0xbf, 0xa7, 0x4d, 0x3d, 0xc4,
-	0xdf, 0x09, 0xf1, 0xf7, 0x68, 0x95, 0x70, 0x7f, 0x82, 0x7c, 0x55, 0x95, 0xf0, 0x80, 0x9e, 0x2f,
-	0xbb, 0x12, 0x1e, 0x50, 0xf0, 0x25, 0x57, 0xc2, 0x03, 0xf2, 0xbf, 0xfc, 0x4a, 0x78, 0x30, 0x16,
-	0x0f, 0xa1, 0xf7, 0x9b, 0x09, 0xbd, 0xbf, 0xd7, 0xa0, 0x70, 0x8d, 0x84, 0x75, 0x4a, 0x59, 0x04,
-	0xbc, 0x28, 0x0e, 0xbc, 0x0a, 0x6d, 0x4b, 0x5d, 0x3c, 0x94, 0x22, 0x3a, 0x18, 0xf8, 0x62, 0x04,
-	0x5f, 0x12, 0x27, 0x9f, 0xe2, 0xf0, 0x35, 0x1f, 0x3c, 0xf6, 0xb9, 0xf0, 0x35, 0x15, 0x01, 0xd8,
-	0x00, 0xa4, 0xa4, 0xbe, 0x00, 0x52, 0xd2, 0x7d, 0x90, 0x22, 0xed, 0xda, 0xf5, 0x99, 0x84, 0xcf,
-	0x9c, 0x11, 0x91, 0x95, 0x4f, 0x34, 0x28, 0xae, 0x12, 0x97, 0x8c, 0xb3, 0xa7, 0x1c, 0x3e, 0xcb,
-	0x01, 0x43, 0x93, 0x5f, 0x60, 0x68, 0xaa, 0xcf, 0xd0, 0x39, 0x48, 0xd3, 0x56, 0xd0, 0x20, 0x62,
-	0x07, 0xc8, 0x19, 0x92, 0xa8, 0xfc, 0x40, 0x83, 0x52, 0xc7, 0xc8, 0x1b, 0x24, 0xc4, 0x36, 0x0e,
-	0x71, 0x64, 0xec, 0x59, 0xe0, 0xfb, 0x91, 0x39, 0xdc, 0xe0, 0x2c, 0xa6, 0x74, 0xe3, 0xab, 0xb5,
-	0xb9, 0x72, 0x0e, 0x8e, 0x75, 0x8c, 0xeb, 0x64, 0x47, 0x67, 0x22, 0x5a, 0x7c, 0x22, 0x9f, 0x68,
-	0x70, 0x72, 0xcd, 0xc3, 0xbb, 0x2e, 0x59, 0x75, 0x18, 0xff, 0x89, 0x39, 0x7d, 0xbc, 0x7c, 0x3a,
-	0xb2, 0xa7, 0x4b, 0x90, 0xb5, 0xa5, 0x0d, 0xca, 0xd7, 0x11, 0x59, 0xf9, 0x5d, 0x12, 0xe6, 0x86,
-	0x6d, 0x76, 0x88, 0xc0, 0xf4, 0x7b, 0x7e, 0x70, 0xcf, 0xf1, 0x1a, 0xa6, 0x8d, 0xdb, 0x4c, 0x58,
-	0x9a, 0xaf, 0x5d, 0x39, 0xca, 0x06, 0x5a, 0xdd, 0xb2, 0xf6, 0x89, 0x6d, 0xe4, 0x95, 0xdc, 0x55,
-	0xdc, 0x66, 0xe8, 0x22, 0x14, 0x9a, 0x8e, 0xc7, 0x4b, 0x96, 0x20, 0x34, 0xf7, 0xfd, 0x56, 0x20,
-	0xe6, 0x3e, 0x73, 0x25, 0xcf, 0xc3, 0x9a, 0x59, 0x4c, 0x95, 0x4e, 0x2e, 0x4c, 0x19, 0xd3, 0x4d,
-	0xc7, 0xdb, 0xe2, 0x3d, 0xae, 0xfb, 0xad, 0x40, 0x0c, 0xc1, 0x0f, 0xe2, 0x43, 0x92, 0xc3, 0x86,
-	0xe0, 0x07, 0xdd, 0x21, 0x55, 0x98, 0x76, 0xbc, 0x90, 0x04, 0x07, 0xd8, 0x35, 0x9b, 0x8e, 0x27,
-	0x96, 0x54, 0x6c, 0xc0, 0x8b, 0x0b, 0x9a, 0x91, 0x8f, 0x3a, 0xdc, 0x70, 0xbc, 0xf2, 0xcf, 0x35,
-	0x48, 0x0b, 0x63, 0x51, 0x19, 0x72, 0x5b, 0x38, 0x6c, 0x05, 0x36, 0x6e, 0xab, 0xe8, 0x76, 0x68,
-	0xf4, 0x08, 0x64, 0xb6, 0x5a, 0x1e, 0x6f, 0x49, 0x88, 0x16, 0x45, 0x71, 0xfe, 0x0d, 0x5f, 0xf0,
-	0x93, 0x92, 0x2f, 0x29, 0x1e, 0x85, 0xed, 0x16, 0x61, 0xbc, 0x41, 0x56, 0x45, 0x11, 0x89, 0x4e,
-	0x83, 0xfe, 0x5f, 0xc4, 0xf6, 0x64, 0x9b, 0x8c, 0x50, 0x97, 0xc1, 0x6d, 0xd8, 0xde, 0x6f, 0x05,
-	0xa2, 0x51, 0xae, 0xe8, 0x0e, 0xcd, 0x75, 0x5d, 0x0d, 0x1c, 0xde, 0x92, 0x95, 0xba, 0x24, 0x55,
-	0xf9, 0x6b, 0x1a, 0x52, 0x7c, 0x13, 0x40, 0xdb, 0x90, 0x76, 0x9a, 0x58, 0xe5, 0x66, 0xbe, 0x56,
-	0x1b, 0x67, 0x07, 0xa9, 0xae, 0xf3, 0x91, 0xaa, 0xce, 0xfb, 0x3f, 0x2d, 0x51, 0xd4, 0x0c, 0x29,
-	0x0c, 0x5d, 0x83, 0x34, 0xf5, 0x83, 0x90, 0x95, 0x12, 0x62, 0x93, 0xbc, 0x38, 0x96, 0xd4, 0x4d,
-	0x3f, 0x08, 0x0d, 0x39, 0x1e, 0x6d, 0x83, 0x1e, 0x10, 0xe6, 0xb7, 0x02, 0x8b, 0x30, 0xe1, 0xae,
-	0x7c, 0xed, 0xf9, 0xb1, 0x84, 0x19, 0xd1, 0x68, 0xa3, 0x2b, 0xa8, 0xbc, 0x02, 0x69, 0x61, 0x3a,
-	0x07, 0xb7, 0x80, 0x50, 0x7f, 0x08, 0xb8, 0x71, 0x36, 0x7a, 0x14, 0x92, 0x21, 0x6e, 0xc8, 0xe5,
-	0x16, 0x6f, 0xe5, 0xdc, 0xf2, 0x1f, 0x35, 0x48, 0x71, 0x53, 0xd1, 0x6a, 0x0f, 0x42, 0x5e, 0xe0,
-	0xdd, 0xce, 0x07, 0xe7, 0x6a, 0x4f, 0x2f, 0xdc, 0xb9, 0xc5, 0x16, 0xcf, 0xfe, 0xcf, 0x9d, 0x77,
-	0xee, 0x2c, 0x55, 0x2f, 0x2c, 0x5d, 0xba, 0xfd, 0x0e, 0x5e, 0xfa, 0xef, 0x0b, 0x4b, 0x97, 0xaa,
-	0x4b, 0xb7, 0xdf, 0xbf, 0xf8, 0xcc, 0xf3, 0xcf, 0x7e, 0xc0, 0xf9, 0xb7, 0xcf, 0x9e, 0x53, 0xcb,
-	0xfb, 0x49, 0xc8, 0x78, 0xad, 0xe6, 0x2e, 0x19, 0xc8, 0xf0, 0xcf, 0x3e, 0x4b, 0x1a, 0xaa, 0x09,
-	0xdd, 0x80, 0xb4, 0xb8, 0x22, 0x17, 0xae, 0x28, 0xd4, 0xfe, 0x63, 0x6c, 0xbf, 0x56, 0x37, 0xf9,
-	0x70, 0x43, 0x4a, 0xa9, 0x9c, 0x82, 0xb4, 0xa0, 0x51, 0x16, 0x92, 0xdb, 0x2b, 0x9b, 0xc5, 0x29,
-	0xfe, 0x67, 0x67, 0x75, 0xb3, 0xa8, 0x95, 0x7f, 0xa5, 0x81, 0xde, 0xf1, 0x1d, 0x5a, 0x02, 0x44,
-	0x39, 0xd8, 0xb0, 0x90, 0x78, 0x61, 0xa7, 0x22, 0xd1, 0x44, 0x45, 0x72, 0xac, 0xdb, 0x12, 0x55,
-	0x25, 0x3b, 0x90, 0x71, 0x9d, 0xa6, 0x23, 0xe2, 0xcf, 0x43, 0xf6, 0xf2, 0x64, 0x21, 0xab, 0xbe,
-	0x21, 0x84, 0x18, 0x4a, 0x58, 0xb9, 0x06, 0x19, 0xc9, 0xe1, 0x69, 0xdd, 0x24, 0x4d, 0x3f, 0x68,
-	0x2b, 0x1b, 0x14, 0xc5, 0xb7, 0x71, 0x8b, 0xb6, 0x84, 0x56, 0xcd, 0xe0, 0x7f, 0x2b, 0x9f, 0x6a,
-	0x70, 0xa2, 0x0f, 0x6c, 0x18, 0x15, 0x08, 0xf6, 0xc4, 0x00, 0x82, 0x71, 0x50, 0xec, 0x41, 0x9f,
-	0xb3, 0xc3, 0xd1, 0xa7, 0x0f, 0x70, 0xce, 0x0e, 0x07, 0x9c, 0x3e, 0x8c, 0x79, 0x62, 0x18, 0xc6,
-	0xf4, 0xc0, 0x4a, 0xe5, 0x0f, 0x1a, 0x14, 0x22, 0x33, 0xaf, 0x3a, 0xc4, 0xb5, 0x19, 0x5f, 0xdb,
-	0x8c, 0x03, 0x4d, 0xcb, 0x8d, 0x36, 0x83, 0x0e, 0x8d, 0x9e, 0x01, 0xe4, 0x62, 0x16, 0x9a, 0x11,
-	0xc3, 0x0c, 0x9d, 0x66, 0x54, 0xae, 0x14, 0x79, 0xcb, 0x96, 0x6a, 0xd8, 0x76, 0x9a, 0x04, 0x5d,
-	0x82, 0x53, 0x7b, 0xd8, 0x71, 0x89, 0x6d, 0xde, 0xf5, 0x77, 0x99, 0xb9, 0xef, 0xf0, 0x28, 0xb6,
-	0x4d, 0xe1, 0x5a, 0x61, 0x70, 0xd2, 0x78, 0x44, 0x76, 0x78, 0xdd, 0xdf, 0x65, 0xd7, 0x65, 0xb3,
-	0x70, 0x37, 0xaa, 0xc3, 0x19, 0xd6, 0xb2, 0x2c, 0xc2, 0xd8, 0x5e, 0xcb, 0x1d, 0x36, 0x3c, 0x25,
-	0x86, 0x97, 0xbb, 0x9d, 0xfa, 0x45, 0x54, 0x7e, 0xad, 0xc1, 0x8c, 0xd1, 0xf2, 0x6e, 0x7a, 0x16,
-	0x51, 0x33, 0x7b, 0x04, 0x32, 0xd8, 0x0a, 0x9d, 0x03, 0x39, 0xaf, 0xa4, 0xa1, 0x28, 0x7e, 0xfa,
-	0xb3, 0xfc, 0x26, 0x75, 0x89, 0xac, 0xb2, 0x13, 0xa2, 0x31, 0xce, 0xe2, 0x23, 0xa5, 0xa1, 0xca,
-	0x6c, 0x45, 0x71, 0x94, 0x14, 0x16, 0x10, 0x9b, 0xd8, 0xca, 0xa4, 0x2e, 0x03, 0x9d, 0x01, 0x90,
-	0x11, 0x12, 0x5e, 0x92, 0x87, 0x4a, 0x5d, 0x70, 0x84, 0x7b, 0x9e, 0x86, 0xd9, 0xae, 0x0e, 0xd9,
-	0x47, 0x1c, 0x2e, 0x8d, 0x42, 0x97, 0xcd, 0x3b, 0x56, 0x7e, 0x93, 0x88, 0x2f, 0x8c, 0x37, 0x21,
-	0x17, 0xc8, 0x3d, 0x3b, 0xda, 0x02, 0x2f, 0x8f, 0x9a, 0xeb, 0xdd, 0x34, 0x57, 0xbb, 0x3e, 0x33,
-	0x3a, 0xb2, 0xd0, 0x66, 0xdf, 0x0a, 0x7a, 0x61, 0x7c, 0xa9, 0xbd, 0x8b, 0xe7, 0x90, 0x25, 0x9c,
-	0x3c, 0x64, 0x09, 0x97, 0x9f, 0x83, 0x5c, 0x64, 0xd6, 0xe8, 0xab, 0x6d, 0xa2, 0x15, 0xfa, 0xc3,
-	0x02, 0xe4, 0xd6, 0x3d, 0x16, 0x62, 0xcf, 0x22, 0x43, 0x0b, 0x9f, 0x02, 0x24, 0x1c, 0x5b, 0xe5,
-	0x75, 0xc2, 0xb1, 0xe3, 0x85, 0x50, 0xf2, 0x0b, 0x0a, 0xa1, 0x21, 0xb5, 0xf1, 0x29, 0xc8, 0x75,
-	0x9a, 0x65, 0x16, 0x64, 0x55, 0x1d, 0xc4, 0xeb, 0x34, 0x79, 0x37, 0x22, 0x23, 0x2f, 0x09, 0xce,
-	0x95, 0xd5, 0x7a, 0x56, 0x72, 0x65, 0x19, 0x7e, 0x06, 0x40, 0x6c, 0x75, 0xa6, 0xd8, 0x3f, 0xe4,
-	0xb1, 0x5e, 0x17, 0x1c, 0x83, 0xef, 0x1c, 0x9d, 0x66, 0x31, 0x1b, 0x3d, 0xd6, 0x2c, 0x2a, 0xd0,
-	0x47, 0x41, 0x12, 0x26, 0xdf, 0x5e, 0x40, 0xae, 0x6b, 0xc1, 0xd8, 0xc6, 0x0d, 0x84, 0x61, 0xb6,
-	0x73, 0x39, 0xb1, 0x27, 0x16, 0x4b, 0x29, 0x3f, 0xde, 0xce, 0xd7, 0x0b, 0x22, 0xd7, 0xa7, 0x8c,
-	0x02, 0xed, 0x85, 0x15, 0x13, 0x66, 0xa3, 0x93, 0x45, 0xa4, 0x62, 0x5a, 0xa8, 0xf8, 0xf7, 0x91,
-	0xf3, 0x2c, 0xbe, 0x98, 0xaf, 0x4f, 0x19, 0x33, 0x41, 0xcf, 0xea, 0x7e, 0x1c, 0xf2, 0x96, 0x78,
-	0xfa, 0x31, 0xf9, 0xa9, 0xb7, 0x34, 0x23, 0xa6, 0x08, 0x92, 0xb5, 0xca, 0xbd, 0xfa, 0x38, 0xe4,
-	0x5b, 0xe2, 0x44, 0x2c, 0x3b, 0x14, 0x64, 0x07, 0xc9, 0x12, 0x1d, 0xce, 0x00, 0xd0, 0xc0, 0xbf,
-	0x4b, 0xac, 0x90, 0x47, 0x6a, 0x56, 0x7a, 0x50, 0x71, 0xd6, 0xc5, 0x62, 0xe7, 0xae, 0x65, 0x14,
-	0x5b, 0x44, 0x9c, 0x7e, 0x75, 0xa3, 0xcb, 0x10, 0x91, 0xb4, 0xb0, 0x4b, 0x4a, 0xc7, 0x54, 0x24,
-	0x39, 0x81, 0x6e, 0xc6, 0x8b, 0x09, 0x24, 0xe6, 0x7b, 0x71, 0xec, 0x75, 0x15, 0xab, 0x23, 0xd0,
-	0xdb, 0x00, 0x96, 0xef, 0x85, 0xd8, 0xf1, 0x48, 0xc0, 0x4a, 0xc7, 0x45, 0xad, 0x33, 0xf2, 0xfa,
-	0x8f, 0x72, 0xbe, 0xba, 0x12, 0x89, 0x30, 0x62, 0xd2, 0xd0, 0x5d, 0x28, 0xd2, 0xd6, 0xae, 0xeb,
-	0x58, 0x26, 0xf1, 0x6c, 0xea, 0x3b, 0x5e, 0xc8, 0x4a, 0x73, 0x42, 0xc3, 0xab, 0x63, 0x6b, 0xd8,
-	0x14, 0x82, 0xd6, 0x94, 0x1c, 0x63, 0x96, 0xf6, 0xd0, 0x0c, 0xbd, 0x01, 0xb9, 0x90, 0x34, 0xa9,
-	0xcb, 0x23, 0x71, 0x42, 0xf8, 0xe5, 0xc2, 0xa8, 0x3a, 0xb6, 0xd5, 0x38, 0xa3, 0x23, 0xa1, 0xfc,
-	0xe3, 0x14, 0xe8, 0x9d, 0x39, 0x0d, 0x5d, 0xd1, 0x73, 0x51, 0xd1, 0xa9, 0xce, 0xd6, 0xb2, 0x68,
-	0xec, 0x2c, 0xbf, 0x64, 0x7c, 0xf9, 0xed, 0x44, 0xa5, 0x64, 0x6a, 0xc2, 0xc9, 0x77, 0x4c, 0xe9,
-	0x29, 0x2c, 0x09, 0xc0, 0x81, 0xef, 0x9a, 0x4d, 0xbf, 0xc5, 0x1d, 0x9b, 0x1e, 0xef, 0x31, 0x60,
-	0x88, 0xec, 0x37, 0x7d, 0xb7, 0xd5, 0x24, 0x37, 0xb8, 0x38, 0x43, 0x3f, 0xf0, 0x5d, 0xf1, 0x8f,
-	0x95, 0x7f, 0x14, 0x15, 0x89, 0xc3, 0xdc, 0x80, 0x20, 0xc5, 0x8d, 0x51, 0x7b, 0x9c, 0xf8, 0xcf,
-	0xe1, 0xc9, 0xf6, 0x98, 0x84, 0x0d, 0x85, 0x6e, 0xb6, 0xc7, 0x04, 0x68, 0x9c, 0x84, 0xec, 0xbe,
-	0xcf, 0x42, 0xd3, 0xa1, 0x0a, 0xd7, 0x32, 0x9c, 0x5c, 0xa7, 0x5c, 0xce, 0x3d, 0xc7, 0x8b, 0xe0,
-	0x4c, 0xfc, 0x17, 0x67, 0x4e, 0x51, 0x29, 0x2a, 0x2c, 0x93, 0x9f, 0x87, 0x9c, 0x82, 0x1c, 0x0b,
-	0x2c, 0x53, 0x68, 0xcd, 0x0a, 0xad, 0x59, 0x16, 0x58, 0xdc, 0xc0, 0xf2, 0x03, 0xc8, 0xc7, 0xe6,
-	0x30, 0xd4, 0xde, 0x33, 0x00, 0xc2, 0x5f, 0x26, 0xc5, 0xe1, 0xbe, 0x8a, 0x9d, 0x2e, 0x38, 0x9b,
-	0x38, 0xdc, 0xe7, 0xa0, 0x16, 0x10, 0x6c, 0x9b, 0xbe, 0xe7, 0x46, 0x47, 0x9b, 0x1c, 0x67, 0xdc,
-	0xf4, 0xdc, 0xb6, 0xd0, 0xdc, 0xda, 0x95, 0x23, 0xa5, 0xf5, 0x59, 0xd6, 0xda, 0xe5, 0xe3, 0xca,
-	0x1f, 0x27, 0xa0, 0xd0, 0x9b, 0xa1, 0x7c, 0x75, 0x63, 0xdb, 0x0e, 0x08, 0x63, 0x24, 0x2a, 0xcc,
-	0xba, 0x0c, 0xae, 0x08, 0xbb, 0xae, 0xe9, 0xf9, 0x36, 0x61, 0xea, 0x6c, 0x95, 0xc3, 0xae, 0xbb,
-	0xc1, 0x69, 0x5e, 0x31, 0x71, 0xb7, 0xc4, 0x1c, 0xd8, 0xa1, 0x05, 0x2a, 0x7b, 0x0d, 0x2e, 0xa5,
-	0xbb, 0x39, 0xe8, 0x8a, 0xb3, 0x6e, 0x73, 0x07, 0x73, 0x99, 0xdd, 0x9d, 0x21, 0xc3, 0xc9, 0x75,
-	0x5b, 0x04, 0x8a, 0x1b, 0x2e, 0x7d, 0x29, 0xfe, 0xa3, 0x13, 0x90, 0xa1, 0xbe, 0xcd, 0xfb, 0xaa,
-	0x7d, 0x81, 0xfa, 0xb6, 0xea, 0xca, 0xbd, 0x9b, 0x8b, 0xc5, 0xb4, 0x13, 0x0b, 0x3d, 0x1e, 0x0b,
-	0x5e, 0x90, 0x90, 0xe0, 0xc0, 0xb1, 0x84, 0x42, 0x50, 0x05, 0x89, 0xe4, 0xac, 0xdb, 0x57, 0x66,
-	0x20, 0x2f, 0xea, 0x56, 0x09, 0xa8, 0x95, 0xb7, 0x20, 0x17, 0x2d, 0xb5, 0xa1, 0xb1, 0x29, 0x43,
-	0x4e, 0xed, 0x82, 0xf2, 0xd0, 0xa5, 0x1b, 0x1d, 0x9a, 0x6b, 0x52, 0xd7, 0xed, 0xdd, 0xcb, 0x01,
-	0x5d, 0x71, 0xd6, 0x6d, 0x5e, 0x76, 0xe6, 0xeb, 0x94, 0x7e, 0x3d, 0xf6, 0xe0, 0x38, 0x14, 0x65,
-	0x8e, 0x0a, 0x45, 0x95, 0x0f, 0x35, 0x48, 0xd6, 0x29, 0x3d, 0x0c, 0x84, 0xe4, 0xbe, 0x9e, 0x88,
-	0xef, 0xeb, 0xff, 0x09, 0xba, 0xa3, 0x1c, 0xc1, 0x0f, 0x9c, 0x1c, 0x16, 0x9e, 0x1d, 0xe3, 0x8d,
-	0x24, 0x72, 0xa2, 0xd1, 0x95, 0x52, 0xb9, 0x06, 0xa9, 0x3a, 0xa5, 0x0c, 0xbd, 0x0a, 0x29, 0x4c,
-	0xa9, 0xcc, 0xe7, 0x7c, 0xed, 0xfc, 0x18, 0x52, 0x0d, 0x31, 0xb0, 0xf2, 0xff, 0x49, 0xc8, 0x0a,
-	0x1d, 0x7b, 0x3e, 0xdf, 0x3f, 0x9b, 0xbe, 0xe7, 0x84, 0x7e, 0x60, 0xb6, 0x02, 0x57, 0x4d, 0x0c,
-	0x14, 0x6b, 0x27, 0x70, 0xb9, 0x8f, 0x5d, 0xbf, 0xc1, 0x44, 0xab, 0xba, 0x2f, 0xe2, 0x34, 0x6f,
-	0x7a, 0x1b, 0x66, 0x43, 0x3f, 0xc4, 0xae, 0xd9, 0x7f, 0xb4, 0x9e, 0x60, 0x37, 0x2c, 0x08, 0x49,
-	0xdd, 0x82, 0x78, 0xf0, 0xde, 0x3a, 0x35, 0xec, 0xde, 0xfa, 0x3e, 0x9c, 0xe8, 0x7b, 0x86, 0x51,
-	0x65, 0x48, 0x7a, 0xbc, 0x03, 0xe3, 0xd0, 0xa3, 0x9d, 0x71, 0xbc, 0xe7, 0x25, 0x46, 0x95, 0x24,
-	0x1b, 0xf1, 0xc8, 0x66, 0x44, 0x0c, 0x2e, 0x8c, 0x0b, 0xf8, 0xf1, 0xb0, 0xfe, 0x52, 0x83, 0x1c,
-	0x8f, 0xab, 0x08, 0xc7, 0x46, 0x4f, 0x6c, 0x2f, 0x8f, 0x11, 0x5b, 0x31, 0x5e, 0xfc, 0x91, 0xaf,
-	0x00, 0x42, 0x4e, 0x79, 0x1f, 0xf4, 0x0e, 0x6b, 0xc8, 0xe5, 0xf4, 0x5a, 0xfc, 0x72, 0x3a, 0x5f,
-	0x5b, 0x1e, 0x2b, 0x43, 0xf7, 0xfc, 0xf8, 0x6d, 0x76, 0x1b, 0xa6, 0xeb, 0x94, 0x46, 0x6b, 0x87,
-	0xf1, 0xbc, 0xe9, 0xbd, 0x42, 0xed, 0xde, 0x9b, 0x6e, 0x80, 0x1e, 0xad, 0xac, 0xe8, 0x66, 0x67,
-	0xfc, 0xc5, 0xd9, 0x15, 0x51, 0xf9, 0x48, 0x83, 0xe3, 0xf5, 0xbd, 0x3d, 0x62, 0x85, 0xc4, 0xfe,
-	0xba, 0x00, 0x50, 0xe5, 0x3e, 0xcc, 0x0d, 0xb1, 0x89, 0xa1, 0xb7, 0xe2, 0xe9, 0x23, 0xc3, 0xfc,
-	0xe2, 0xc8, 0x6e, 0x1f, 0x14, 0x18, 0xcf, 0xa4, 0x3f, 0x6b, 0x50, 0xe0, 0xd1, 0xae, 0xf3, 0x13,
-	0xb0, 0x78, 0x95, 0x40, 0xdb, 0x3d, 0xf9, 0xf4, 0xda, 0x38, 0xf9, 0xd4, 0x95, 0x32, 0x90, 0x55,
-	0xad, 0xcf, 0xcf, 0x2a, 0xa3, 0x37, 0xab, 0x5e, 0x3a, 0xc2, 0xf4, 0x58, 0x3c, 0xc5, 0xfe, 0xa4,
-	0xf1, 0xc3, 0x24, 0xa3, 0xbe, 0xc7, 0x08, 0x5a, 0x05, 0xbd, 0xf3, 0x35, 0xa9, 0x3a, 0x32, 0x97,
-	0xab, 0xf2, 0x5b, 0xd0, 0x6a, 0xf4, 0x2d, 0x68, 0x75, 0x3b, 0xea, 0xa1, 0x2e, 0x17, 0x7f, 0x22,
-	0x3e, 0x10, 0xec, 0x0e, 0x44, 0x57, 0x21, 0xc3, 0xcb, 0xc3, 0x16, 0x53, 0xef, 0xd8, 0xd5, 0x91,
-	0x6f, 0x98, 0xc4, 0x28, 0x43, 0x8d, 0xe6, 0x69, 0xd4, 0x24, 0x8c, 0x45, 0x47, 0x61, 0xdd, 0x88,
-	0x48, 0xb4, 0x00, 0xa9, 0x5d, 0xdf, 0x96, 0x57, 0xb1, 0xf9, 0xda, 0xdc, 0x80, 0x89, 0x75, 0xaf,
-	0x6d, 0x88, 0x1e, 0x8b, 0xcf, 0xc1, 0xc9, 0x43, 0x5e, 0xc7, 0xd1, 0x34, 0xe4, 0xd4, 0x4d, 0xba,
-	0x5d, 0x9c, 0x42, 0x79, 0xc8, 0x12, 0x4f, 0x12, 0xda, 0xe2, 0x2b, 0x90, 0x91, 0xb6, 0x70, 0xf6,
-	0xd6, 0xce, 0xca, 0xca, 0xda, 0xd6, 0x56, 0x71, 0x0a, 0xe9, 0x90, 0x5e, 0x33, 0x8c, 0x9b, 0x46,
-	0x51, 0x43, 0x33, 0xa0, 0x6f, 0xdc, 0xdc, 0x36, 0xaf, 0xde, 0xdc, 0xd9, 0x58, 0x2d, 0x26, 0x38,
-	0xb9, 0xb3, 0xb1, 0x72, 0xbd, 0xbe, 0x71, 0x6d, 0x6d, 0xb5, 0x98, 0xac, 0xfd, 0x5d, 0x07, 0xa8,
-	0x53, 0x7a, 0x43, 0xce, 0x0b, 0x7d, 0x47, 0x03, 0xbd, 0xf3, 0xb1, 0x1d, 0x7a, 0x61, 0xd2, 0xef,
-	0xf3, 0xca, 0x17, 0xc6, 0xd8, 0x02, 0x44, 0x40, 0x2b, 0x27, 0x3f, 0xfc, 0xed, 0x5f, 0x3e, 0x4e,
-	0x1c, 0xab, 0x4c, 0x8b, 0x8f, 0x89, 0x0f, 0x2e, 0x2e, 0xf3, 0x54, 0xbb, 0xac, 0x2d, 0xa2, 0xef,
-	0x6b, 0x00, 0xdd, 0x2f, 0x4e, 0xd0, 0xa5, 0x89, 0xbf, 0x52, 0x99, 0xc0, 0xa8, 0xc7, 0x84, 0x51,
-	0xa5, 0xf2, 0xf1, 0xb8, 0x51, 0xcb, 0xef, 0x73, 0x28, 0xf9, 0x80, 0xdb, 0xf6, 0x5d, 0x0d, 0xf4,
-	0xce, 0x8b, 0xec, 0xe8, 0xee, 0xea, 0x7f, 0xc4, 0x9d, 0xdc, 0xb2, 0xda, 0x61, 0x96, 0x7d, 0xaa,
-	0x41, 0xb1, 0xff, 0x59, 0x08, 0x8d, 0x7c, 0xea, 0x39, 0xe4, 0x41, 0x69, 0x02, 0x3b, 0x2b, 0xc2,
-	0xce, 0xd3, 0x95, 0x93, 0x3d, 0x76, 0xe2, 0x0e, 0xb8, 0x44, 0x5e, 0xec, 0x3c, 0x77, 0x8d, 0xee,
-	0xc5, 0xfe, 0x37, 0xc6, 0xc9, 0xbd, 0xb8, 0x78, 0x98, 0x17, 0xbf, 0xad, 0x01, 0x74, 0x1f, 0xe2,
-	0x46, 0xcf, 0xbd, 0x81, 0xc7, 0xbb, 0x09, 0x6c, 0x9b, 0x13, 0xb6, 0x15, 0x16, 0x7b, 0x16, 0x04,
-	0xfa, 0x5f, 0x0d, 0xb2, 0xea, 0xd5, 0x18, 0x8d, 0x7c, 0x91, 0xd3, 0xfb, 0xcc, 0x3c, 0xb9, 0x2d,
-	0xa8, 0xd7, 0x96, 0x9f, 0x6a, 0xb1, 0x67, 0xca, 0xe8, 0x0d, 0x15, 0xbd, 0x36, 0xb6, 0x93, 0xfa,
-	0x9e, 0x5f, 0x27, 0xb0, 0xef, 0xbc, 0xb0, 0xef, 0xa9, 0xc5, 0xf9, 0x9e, 0x38, 0x36, 0x95, 0xdc,
-	0xe5, 0xf7, 0xa3, 0x52, 0x84, 0x07, 0xf5, 0xca, 0xf4, 0xdb, 0xd0, 0x95, 0xb1, 0x9b, 0x11, 0x50,
-	0xfc, 0xec, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xb5, 0x5f, 0xe6, 0x05, 0x31, 0x00, 0x00,
+func init() { proto.RegisterFile("appmanager.proto", fileDescriptor_appmanager_f5392d4c4e5f6727) }
+
+var fileDescriptor_appmanager_f5392d4c4e5f6727 = []byte{
+	// 4530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x8c, 0x1b, 0x47,
+	0x76, 0x9e, 0x26, 0x39, 0x1c, 0xf2, 0x71, 0x86, 0xc3, 0x29, 0x8d, 0x25, 0x8a, 0x96, 0xec, 0x71,
+	0x5b, 0x8a, 0xc6, 0x63, 0x8b, 0x23, 0xd1, 0x3f, 0x2b, 0xd9, 0xde, 0x95, 0xa9, 0x21, 0x25, 0xcd,
+	0xee, 0xfc, 0x6d, 0xcd, 0x8c, 0x16, 0xf6, 0xca, 0xee, 0xad, 0xe9, 0xae, 0xe1, 0xb4, 0xd5, 0xec,
+	0x6e, 0x77, 0x37, 0xc7, 0xe2, 0x3a, 0xbe, 0x2c, 0x72, 0x49, 0x72, 0x88, 0xb1, 0x8b, 0x20, 0x09,
+	0x7c, 0x08, 0xb2, 0x31, 0x10, 0x2c, 0x90, 0x5c, 0x92, 0x1c, 0x82, 0xe4, 0x90, 0x04, 0x48, 0x10,
+	0x20, 0x87, 0x5c, 0x82, 0x04, 0x01, 0x02, 0x5f, 0x82, 0x5c, 0x82, 0x1c, 0x82, 0x5c, 0x93, 0x00,
+	0x1b, 0xd4, 0x4f, 0x37, 0x9b, 0x3f, 0x63, 0xb1, 0x39, 0x36, 0xe0, 0x04, 0x3a, 0xb1, 0xeb, 0x55,
+	0xd5, 0x7b, 0xaf, 0xea, 0xbd, 0xfa, 0xea, 0x55, 0xd5, 0x23, 0x94, 0x88, 0xeb, 0xb6, 0x89, 0x4d,
+	0x5a, 0xd4, 0xab, 0xba, 0x9e, 0x13, 0x38, 0xe8, 0x17, 0x74, 0xa7, 0x5d, 0xd5, 0x4d, 0x5f, 0x77,
+	0xaa, 0xbe, 0x63, 0x57, 0x89, 0xeb, 0x1e, 0xe9, 0x46, 0x95, 0xb8, 0x66, 0xf5, 0xf8, 0x7a, 0xb5,
+	0xd7, 0xba, 0x72, 0xa1, 0xe5, 0x38, 0x2d, 0x8b, 0xae, 0x12, 0xd7, 0x5c, 0x25, 0xb6, 0xed, 0x04,
+	0x24, 0x30, 0x1d, 0xdb, 0x17, 0x5c, 0x2a, 0xcf, 0xca, 0x5a, 0x5e, 0x3a, 0xe8, 0x1c, 0xae, 0x06,
+	0x66, 0x9b, 0xfa, 0x01, 0x69, 0xbb, 0xb2, 0x41, 0xbd, 0x65, 0x06, 0x47, 0x9d, 0x83, 0xaa, 0xee,
+	0xb4, 0x57, 0xa9, 0x7d, 0xec, 0x74, 0x5d, 0xcf, 0x79, 0xd4, 0x15, 0xed, 0xf5, 0xab, 0x2d, 0x6a,
+	0x5f, 0x3d, 0x26, 0x96, 0x69, 0x90, 0x80, 0xae, 0x0e, 0x7d, 0x48, 0x16, 0xe7, 0x07, 0x65, 0x10,
+	0xbb, 0x2b, 0xaa, 0xd4, 0xbf, 0x2c, 0x40, 0x69, 0xcd, 0xa3, 0x24, 0xa0, 0x75, 0xd7, 0xc5, 0xf4,
+	0x83, 0x0e, 0xf5, 0x03, 0x74, 0x11, 0x32, 0x36, 0x69, 0xd3, 0xb2, 0xb2, 0xa4, 0x2c, 0xe7, 0x6f,
+	0xe7, 0xff, 0xf4, 0xdf, 0xfe, 0x22, 0x9d, 0xf1, 0x52, 0x4b, 0x0a, 0xe6, 0x64, 0xf4, 0x00, 0xf2,
+	0xc4, 0x75, 0x35, 0x3f, 0x20, 0x01, 0x2d, 0xa7, 0x96, 0x94, 0xe5, 0x62, 0xed, 0x56, 0x75, 0xbc,
+	0xc9, 0xa8, 0xd6, 0x5d, 0x77, 0x97, 0xf5, 0xab, 0x1f, 0x06, 0xd4, 0x6b, 0x50, 0xd7, 0x72, 0xba,
+	0x6d, 0x6a, 0x07, 0x38, 0x47, 0x64, 0x05, 0xaa, 0xc1, 0xcc, 0x31, 0xf5, 0x7c, 0xd3, 0xb1, 0xcb,
+	0x69, 0x2e, 0xbf, 0xcc, 0xe4, 0x9f, 0xf1, 0x16, 0x6a, 0xf3, 0xef, 0x3d, 0xf8, 0x70, 0xe5, 0x81,
+	0xf1, 0xe2, 0xf2, 0x83, 0xea, 0x03, 0xe3, 0x85, 0x95, 0x4b, 0x38, 0x6c, 0x88, 0x9e, 0x83, 0xd9,
+	0x43, 0xcf, 0x69, 0x6b, 0x3a, 0x09, 0x88, 0xe5, 0xb4, 0xca, 0x99, 0x25, 0x65, 0x39, 0x87, 0x0b,
+	0x8c, 0xb6, 0x26, 0x48, 0x68, 0x09, 0x0a, 0x06, 0xf5, 0x75, 0xcf, 0x74, 0xd9, 0xec, 0x97, 0xa7,
+	0x19, 0x6b, 0x1c, 0x27, 0xa1, 0x9b, 0x30, 0xad, 0x77, 0x75, 0x8b, 0x96, 0xb3, 0x5c, 0xec, 0xf3,
+	0x4c, 0xec, 0x33, 0xde, 0x05, 0x9c, 0x73, 0xa9, 0x67, 0x3a, 0x86, 0xa9, 0xe3, 0xac, 0x41, 0x68,
+	0xdb, 0xb1, 0x71, 0xce, 0xeb, 0xd8, 0x9a, 0x63, 0xeb, 0x14, 0x8b, 0x1e, 0xc8, 0x82, 0x33, 0xfc,
+	0x43, 0x0b, 0x9b, 0x6a, 0x24, 0x08, 0xbc, 0xf2, 0xcc, 0x92, 0xb2, 0x5c, 0xa8, 0xbd, 0x39, 0xee,
+	0xdc, 0xac, 0x31, 0x16, 0x3b, 0xa1, 0x30, 0xfa, 0x41, 0x3d, 0x08, 0x3c, 0xbc, 0xa0, 0xc7, 0xa9,
+	0x8c, 0x84, 0x54, 0x98, 0xf3, 0x1c, 0x27, 0xd0, 0x5a, 0x9e, 0xd3, 0x71, 0x35, 0xd3, 0x28, 0xe7,
+	0xc4, 0x60, 0x18, 0xf1, 0x2e, 0xa3, 0xad, 0x1b, 0xe8, 0x0a, 0xe4, 0xc3, 0x6a, 0xbf, 0x9c, 0x5f,
+	0x4a, 0x2f, 0xe7, 0x6f, 0x03, 0x1b, 0xd0, 0xf4, 0x8f, 0x95, 0x54, 0x4e, 0xc1, 0xb9, 0x96, 0x68,
+	0xe7, 0x23, 0x13, 0x0a, 0xcc, 0x98, 0xba, 0x63, 0x1f, 0x9a, 0x2d, 0xbf, 0x0c, 0x4b, 0xe9, 0xe5,
+	0x42, 0xed, 0xde, 0xd8, 0x2a, 0x0f, 0xb8, 0x0e, 0xb3, 0xef, 0x9a, 0x60, 0xd5, 0xb4, 0x03, 0xaf,
+	0x8b, 0x81, 0x44, 0x04, 0xf4, 0x03, 0xc8, 0x51, 0xfb, 0x58, 0x3b, 0x26, 0x9e, 0x5f, 0x2e, 0x70,
+	0x39, 0xcd, 0x89, 0xe5, 0x34, 0xed, 0xe3, 0xfb, 0xc4, 0x93, 0x42, 0x66, 0xa8, 0x28, 0x21, 0x0d,
+	0x66, 0x7c, 0xaa, 0x7b, 0x34, 0xf0, 0xcb, 0xb3, 0xa7, 0x14, 0xb0, 0x2b, 0xf8, 0x48, 0x01, 0x92,
+	0x2b, 0x7a, 0x00, 0x59, 0x8b, 0x1c, 0x50, 0xcb, 0x2f, 0xcf, 0x71, 0xfe, 0x8d, 0x89, 0xf9, 0x6f,
+	0x70, 0x36, 0x82, 0xbd, 0xe4, 0x89, 0x1e, 0x42, 0x21, 0x06, 0x10, 0xe5, 0x22, 0x17, 0xb1, 0x3e,
+	0xb9, 0x2d, 0x7a, 0xbc, 0x84, 0x9c, 0x38, 0x77, 0x74, 0x19, 0x8a, 0xfe, 0x11, 0xf1, 0xa8, 0xa1,
+	0xf9, 0x81, 0xe3, 0x91, 0x16, 0x2d, 0xcf, 0x2f, 0x29, 0xcb, 0x73, 0x78, 0x4e, 0x50, 0x77, 0x05,
+	0x11, 0xbd, 0x05, 0x19, 0xdf, 0xa5, 0x7a, 0xb9, 0xc4, 0x7d, 0xf9, 0xa5, 0x71, 0x95, 0xd9, 0x75,
+	0xa9, 0x8e, 0x79, 0x4f, 0xb4, 0x08, 0xd3, 0xc4, 0xef, 0xda, 0x7a, 0x79, 0x81, 0xaf, 0x4a, 0x51,
+	0x40, 0xe7, 0x60, 0xc6, 0xf0, 0xba, 0x9a, 0xd7, 0xb1, 0xcb, 0x88, 0xd3, 0xb3, 0x86, 0xd7, 0xc5,
+	0x1d, 0xbb, 0xf2, 0x4d, 0x98, 0x1f, 0x70, 0x22, 0x54, 0x82, 0xf4, 0x43, 0xda, 0x15, 0x70, 0x84,
+	0xd9, 0x27, 0xe3, 0x79, 0x4c, 0xac, 0x8e, 0x80, 0x9f, 0x3c, 0x16, 0x85, 0xd7, 0x53, 0x37, 0x94,
+	0xca, 0xeb, 0x30, 0x1b, 0xf7, 0x8d, 0xa4, 0x7d, 0xe3, 0x66, 0x4f, 0xd4, 0xf7, 0x26, 0x14, 0x62,
+	0x26, 0x4d, 0xd4, 0xf5, 0x5b, 0x50, 0x1a, 0x34, 0x55, 0x92, 0xfe, 0xea, 0x27, 0xb3, 0xb0, 0xb0,
+	0xef, 0xb6, 0x3c, 0x62, 0x3c, 0x01, 0xf1, 0xff, 0x57, 0x20, 0xfe, 0xf4, 0x10, 0x88, 0xc7, 0x80,
+	0xfb, 0xfd, 0x51, 0xc0, 0x3d, 0x36, 0x58, 0x0c, 0xf9, 0xcb, 0x17, 0x22, 0x37, 0x19, 0x42, 0xee,
+	0x3b, 0x93, 0x0b, 0x1a, 0x0d, 0xdd, 0x3f, 0x18, 0x84, 0xee, 0x53, 0x48, 0x18, 0x8d, 0xdd, 0xef,
+	0x0e, 0x60, 0x77, 0x73, 0x72, 0x01, 0xa3, 0xc0, 0xdb, 0x1a, 0x05, 0xde, 0xdf, 0x3e, 0x85, 0x3d,
+	0xfe, 0x2f, 0xa2, 0x37, 0xda, 0x85, 0x9c, 0x1f, 0x78, 0x24, 0xa0, 0xad, 0x6e, 0xf9, 0x0c, 0x17,
+	0xfa, 0x8d, 0x84, 0x53, 0xb0, 0x2b, 0xbb, 0xe3, 0x88, 0xd1, 0x93, 0x2d, 0x21, 0xf1, 0x96, 0xf0,
+	0x3f, 0x05, 0x28, 0xed, 0xbb, 0xc6, 0xd7, 0x28, 0xac, 0xbf, 0x34, 0xb8, 0x23, 0x88, 0x70, 0xd4,
+	0x4b, 0xff, 0xa6, 0x32, 0xf5, 0x64, 0x0f, 0x98, 0x70, 0x0f, 0x38, 0x5d, 0xf0, 0x3e, 0xe8, 0x20,
+	0x5f, 0x55, 0xf0, 0x3e, 0x24, 0xe7, 0xcb, 0x0e, 0xde, 0x87, 0x04, 0x7c, 0xc9, 0xc1, 0xfb, 0x10,
+	0xff, 0x2f, 0x3f, 0x78, 0x1f, 0xb6, 0xc5, 0x13, 0xf8, 0x7f, 0x02, 0xff, 0x93, 0xc2, 0xff, 0x7f,
+	0x28, 0x30, 0x3f, 0x30, 0x9f, 0x68, 0x1b, 0x32, 0x41, 0xd7, 0x15, 0xe8, 0x5f, 0xac, 0xbd, 0x31,
+	0xa1, 0x59, 0xf6, 0xba, 0x2e, 0xc5, 0x9c, 0x11, 0xba, 0x02, 0xf3, 0x6d, 0xf2, 0x48, 0xeb, 0xd8,
+	0xe4, 0x98, 0x98, 0x16, 0x39, 0xb0, 0x84, 0x22, 0x73, 0xb8, 0xd8, 0x26, 0x8f, 0xf6, 0x7b, 0x54,
+	0xb4, 0x0c, 0x25, 0x9d, 0xd8, 0xc4, 0xeb, 0x6a, 0x3d, 0x24, 0x4b, 0x73, 0x24, 0x2b, 0x0a, 0xfa,
+	0xdd, 0x10, 0xcf, 0x6e, 0x00, 0x3a, 0xa2, 0xc4, 0x0a, 0x8e, 0x34, 0xfd, 0x88, 0xea, 0x0f, 0x35,
+	0x83, 0x5a, 0xa4, 0xcb, 0x37, 0x81, 0x39, 0xb9, 0x5f, 0xac, 0xa4, 0xcb, 0x9f, 0x5c, 0xc0, 0x25,
+	0xd1, 0x6a, 0x8d, 0x35, 0x6a, 0xb0, 0x36, 0xea, 0xe7, 0x0a, 0x20, 0xec, 0x58, 0xd6, 0x01, 0xd1,
+	0x1f, 0x8e, 0xbf, 0xe5, 0xbd, 0xda, 0xdb, 0x94, 0xf8, 0x1c, 0xde, 0x7e, 0x9a, 0xb5, 0x38, 0xeb,
+	0x2d, 0xd6, 0xd0, 0x7b, 0xcb, 0x7d, 0xe7, 0x94, 0x17, 0x6e, 0xc5, 0x4e, 0x2a, 0x15, 0xc8, 0x79,
+	0xf4, 0xd8, 0xec, 0x6d, 0x66, 0x38, 0x2a, 0x0f, 0x63, 0x7a, 0xe6, 0x31, 0x98, 0x3e, 0x3d, 0x80,
+	0xe9, 0xd1, 0x8a, 0xcb, 0xc6, 0x56, 0x9c, 0xfa, 0xb7, 0x0a, 0xcc, 0xef, 0xea, 0xc4, 0x4a, 0xb0,
+	0x9f, 0x5f, 0x61, 0x5a, 0xba, 0x96, 0xa9, 0x13, 0x5f, 0x18, 0xe6, 0x76, 0x81, 0x35, 0xc9, 0xae,
+	0x64, 0xca, 0xc6, 0xb2, 0x82, 0xa3, 0x4a, 0x54, 0x1e, 0xd8, 0x9a, 0x7b, 0x03, 0xfd, 0x8a, 0x06,
+	0xf3, 0x3b, 0x0a, 0x9c, 0xdb, 0x30, 0xfd, 0x80, 0x8f, 0x45, 0xcc, 0x9b, 0x3f, 0xe6, 0xa0, 0x2a,
+	0x90, 0x0b, 0x68, 0xdb, 0xb5, 0xc2, 0x18, 0x25, 0x8f, 0xa3, 0x32, 0x7a, 0x75, 0x60, 0x1c, 0x63,
+	0x5a, 0x73, 0x11, 0xa6, 0x2d, 0xb3, 0x6d, 0x06, 0xc2, 0xcf, 0xb0, 0x28, 0xa8, 0xbf, 0x9e, 0x82,
+	0xa7, 0xee, 0xd2, 0xb8, 0x8a, 0x63, 0x6a, 0x78, 0x39, 0xe6, 0x1c, 0xa9, 0xc1, 0x26, 0x3d, 0x3f,
+	0x89, 0x0f, 0x24, 0x7d, 0xf2, 0x40, 0x32, 0x09, 0x06, 0xf2, 0x3c, 0xcc, 0x1d, 0x10, 0x9f, 0x6a,
+	0x91, 0x78, 0x11, 0x1b, 0xcd, 0x32, 0x62, 0x38, 0x08, 0xf4, 0x2d, 0xe0, 0x65, 0x2d, 0x14, 0x90,
+	0x7d, 0xbc, 0x80, 0x02, 0xeb, 0x70, 0x5f, 0xb4, 0x57, 0xff, 0x51, 0x81, 0xa2, 0x98, 0x97, 0xc8,
+	0x64, 0x28, 0x3e, 0x21, 0x72, 0x16, 0xca, 0x03, 0x2b, 0xab, 0xa7, 0xe5, 0x1b, 0x61, 0x74, 0x26,
+	0x6c, 0x74, 0x99, 0x49, 0x5e, 0xf2, 0x9e, 0xf9, 0xc2, 0xe8, 0x6c, 0x2a, 0x8c, 0xcf, 0x4e, 0xed,
+	0x90, 0x42, 0xaf, 0x03, 0xc7, 0xa7, 0xd2, 0x25, 0xc3, 0xa2, 0xfa, 0x99, 0x02, 0xa5, 0xef, 0x91,
+	0x40, 0x3f, 0x7a, 0xdc, 0xd0, 0x86, 0x74, 0x48, 0x3d, 0x46, 0x87, 0xf4, 0x80, 0x0e, 0xd1, 0x0c,
+	0x64, 0x92, 0xcf, 0x80, 0xfa, 0xef, 0x0a, 0x2c, 0xee, 0x06, 0x1e, 0x25, 0xed, 0xba, 0xeb, 0x6e,
+	0x38, 0xad, 0x71, 0x17, 0xce, 0x79, 0xc8, 0x0d, 0x28, 0x3c, 0x23, 0x15, 0x42, 0x17, 0x20, 0xaf,
+	0x3b, 0x76, 0x40, 0x4c, 0x9b, 0x7a, 0xd2, 0x17, 0x7b, 0x04, 0xb4, 0x0c, 0x10, 0x10, 0xd3, 0xd2,
+	0x2c, 0xd3, 0xa6, 0x3e, 0x57, 0x39, 0x2d, 0xb9, 0xab, 0xa9, 0xe5, 0x29, 0x9c, 0x67, 0x95, 0x1b,
+	0xac, 0x0e, 0xdd, 0x04, 0xf0, 0x4d, 0x5b, 0xa7, 0x1a, 0x7b, 0xc2, 0xe0, 0xce, 0x57, 0xa8, 0x55,
+	0xaa, 0xe2, 0xed, 0xa1, 0x1a, 0xbe, 0x3d, 0x54, 0xf7, 0xc2, 0xf7, 0x0d, 0x9c, 0xe7, 0xad, 0x59,
+	0x19, 0x9d, 0x85, 0xec, 0xa1, 0x63, 0x59, 0xce, 0x87, 0xd2, 0x2a, 0xb2, 0xa4, 0xfe, 0x89, 0x02,
+	0xa5, 0x06, 0xb5, 0x68, 0x92, 0x73, 0xcc, 0xc9, 0xae, 0x37, 0x64, 0xb9, 0xf4, 0x63, 0x2c, 0x97,
+	0x19, 0x86, 0x33, 0xb7, 0xe3, 0xb5, 0xc4, 0xe0, 0x72, 0x58, 0x14, 0x4e, 0x00, 0xb9, 0x4f, 0x15,
+	0x28, 0x47, 0xaa, 0x6f, 0xd2, 0x80, 0x18, 0x24, 0x20, 0xe1, 0x10, 0x2e, 0x01, 0x3b, 0x19, 0x69,
+	0xa3, 0x87, 0x31, 0x43, 0x5c, 0x77, 0xeb, 0xab, 0x1d, 0x89, 0x7a, 0x0b, 0x16, 0x22, 0xe5, 0x22,
+	0x17, 0x8a, 0x86, 0xa7, 0x8c, 0x1c, 0x5e, 0x2a, 0x3e, 0xbc, 0x9f, 0x2a, 0x70, 0xae, 0x69, 0xb3,
+	0xfd, 0xbd, 0x61, 0xfa, 0xec, 0x27, 0x66, 0xa0, 0x64, 0x80, 0x70, 0x6a, 0xab, 0x94, 0x61, 0xc6,
+	0x10, 0x3a, 0x48, 0xbb, 0x84, 0x45, 0xf5, 0x1f, 0xd2, 0xb0, 0x38, 0xea, 0x30, 0x86, 0x28, 0xcc,
+	0x7e, 0xe8, 0x78, 0x0f, 0x4d, 0xbb, 0xa5, 0x19, 0xa4, 0xeb, 0x73, 0x4d, 0x0b, 0xb5, 0xdb, 0xa7,
+	0x39, 0xe0, 0x55, 0x77, 0xf5, 0x23, 0x6a, 0xe0, 0x82, 0xe4, 0xdb, 0x20, 0x5d, 0x1f, 0x5d, 0x87,
+	0x62, 0xdb, 0xb4, 0xd9, 0x91, 0xda, 0x0b, 0xb4, 0x23, 0xa7, 0xe3, 0x0d, 0x6e, 0xc4, 0xe7, 0x96,
+	0xa7, 0xf0, 0x6c, 0xdb, 0xb4, 0x77, 0x59, 0x8b, 0x7b, 0x4e, 0xc7, 0xe3, 0x5d, 0xc8, 0xa3, 0x78,
+	0x97, 0xf4, 0xa8, 0x2e, 0xe4, 0x51, 0xaf, 0x4b, 0x15, 0x66, 0x4d, 0x3b, 0xa0, 0xde, 0x31, 0xb1,
+	0xb4, 0xb6, 0x69, 0x97, 0x33, 0xfd, 0x1d, 0xde, 0x58, 0x56, 0x70, 0x21, 0x6c, 0xb0, 0x69, 0xda,
+	0x95, 0x3f, 0x57, 0x60, 0x9a, 0x2b, 0xcb, 0x36, 0xa1, 0x5d, 0x12, 0x74, 0x3c, 0x83, 0x74, 0xa5,
+	0xcd, 0xa3, 0x32, 0x5b, 0x92, 0xbb, 0x1d, 0x9b, 0xd5, 0x08, 0xbb, 0xcb, 0x12, 0xa3, 0x6f, 0x3a,
+	0x9c, 0x9e, 0x16, 0x74, 0x51, 0x62, 0x56, 0xd8, 0xeb, 0x50, 0xdf, 0x90, 0x01, 0x5b, 0x0e, 0x87,
+	0x45, 0x86, 0x2f, 0xdf, 0xa3, 0x86, 0x2d, 0xea, 0x84, 0x85, 0x7a, 0x04, 0xa6, 0xc3, 0xde, 0x51,
+	0xc7, 0xe3, 0x95, 0x62, 0x01, 0x45, 0x65, 0x26, 0xeb, 0x8e, 0x67, 0xb2, 0x9a, 0x19, 0x21, 0x4b,
+	0x94, 0xd4, 0xcf, 0x73, 0x90, 0x61, 0x87, 0x14, 0xb4, 0x07, 0xd3, 0x66, 0x9b, 0x48, 0x8f, 0x2d,
+	0xd4, 0x6a, 0x49, 0x4e, 0x38, 0xd5, 0x75, 0xd6, 0x53, 0xc6, 0x95, 0xbf, 0xa2, 0xa4, 0x4a, 0x0a,
+	0x16, 0xcc, 0xd0, 0x5d, 0x98, 0x76, 0x1d, 0x2f, 0x60, 0x61, 0x13, 0x3b, 0xc4, 0x5d, 0x4f, 0xc4,
+	0x75, 0xc7, 0xf1, 0x02, 0x2c, 0xfa, 0xa3, 0x3d, 0xc8, 0x7b, 0xd4, 0x77, 0x3a, 0x9e, 0x4e, 0x7d,
+	0x3e, 0x5d, 0x85, 0xda, 0x6b, 0x89, 0x98, 0xe1, 0xb0, 0x37, 0xee, 0x31, 0x12, 0x11, 0x86, 0x0c,
+	0xec, 0x84, 0xad, 0x05, 0x78, 0xac, 0xa4, 0xca, 0x46, 0x2c, 0xac, 0x7b, 0x07, 0x0a, 0xa4, 0x13,
+	0x38, 0xbe, 0x4e, 0x2c, 0xd3, 0x6e, 0x49, 0x3c, 0xbe, 0x91, 0x48, 0x7c, 0xbd, 0xd7, 0x1f, 0xc7,
+	0x99, 0x55, 0xd6, 0x60, 0x9a, 0xcf, 0x1e, 0xc3, 0x62, 0x8f, 0xba, 0xce, 0x08, 0x2c, 0x66, 0x64,
+	0xf4, 0x34, 0xa4, 0x03, 0xd2, 0x1a, 0x8e, 0x83, 0x18, 0xb5, 0xf2, 0xcf, 0x0a, 0x64, 0xd8, 0x6c,
+	0xa1, 0x46, 0x1f, 0xa0, 0x5f, 0x63, 0xcd, 0x5e, 0xf4, 0x5e, 0xa8, 0x5d, 0x59, 0x7e, 0xef, 0x81,
+	0xbf, 0x72, 0xe9, 0x17, 0xdf, 0xfb, 0xfe, 0x7b, 0x57, 0xab, 0xd7, 0xae, 0xde, 0x7c, 0xf7, 0xfb,
+	0xe4, 0xea, 0x0f, 0xaf, 0x5d, 0xbd, 0x59, 0xbd, 0xfa, 0xee, 0x47, 0xd7, 0x5f, 0x7a, 0xed, 0xe5,
+	0x8f, 0x19, 0xfd, 0xdd, 0x4b, 0x2f, 0x48, 0x84, 0x79, 0x1e, 0xb2, 0x76, 0xa7, 0x7d, 0x40, 0x87,
+	0x16, 0xd9, 0xcf, 0x7f, 0x9e, 0xc6, 0xb2, 0x0a, 0x6d, 0xc2, 0x34, 0xdf, 0x89, 0xb8, 0x35, 0x8a,
+	0xb5, 0x6f, 0x24, 0x9a, 0x0e, 0xa6, 0x6c, 0x75, 0x87, 0x75, 0xc7, 0x82, 0x8b, 0x7a, 0x1e, 0xa6,
+	0x79, 0x19, 0xcd, 0x40, 0x7a, 0x6f, 0x6d, 0xa7, 0x34, 0xc5, 0x3e, 0xf6, 0x1b, 0x3b, 0x25, 0xa5,
+	0xf2, 0xd7, 0x0a, 0xe4, 0x23, 0xf3, 0xa1, 0xab, 0x80, 0x5c, 0x86, 0x77, 0x7e, 0x40, 0xed, 0x20,
+	0x3a, 0xb4, 0x2b, 0x3c, 0xe2, 0x5c, 0xe8, 0xd5, 0x84, 0x07, 0xf7, 0x7d, 0xc8, 0xf2, 0x30, 0x54,
+	0x44, 0xee, 0x85, 0xda, 0x37, 0x27, 0xf3, 0x9a, 0xea, 0x06, 0x67, 0x82, 0x25, 0xb3, 0x4a, 0x0d,
+	0xb2, 0x82, 0xc2, 0x56, 0x56, 0x9b, 0xb6, 0x1d, 0xaf, 0x2b, 0x75, 0x90, 0x25, 0x76, 0xca, 0xd4,
+	0xdd, 0x0e, 0x97, 0xaa, 0x60, 0xf6, 0x59, 0xf9, 0x4f, 0x05, 0x0a, 0x31, 0x3f, 0x40, 0x2f, 0x01,
+	0x03, 0x2c, 0x2d, 0xf2, 0x40, 0x65, 0xd0, 0x03, 0x0b, 0x6d, 0xd3, 0xc6, 0xa1, 0x13, 0xb2, 0xd6,
+	0xe4, 0x91, 0x36, 0x70, 0x10, 0xe9, 0x6b, 0x4d, 0x1e, 0x45, 0xad, 0x6f, 0xc1, 0xd9, 0x80, 0x78,
+	0x2d, 0x1a, 0x68, 0xba, 0xdb, 0xd1, 0x3a, 0x81, 0x69, 0x99, 0x3f, 0xe4, 0x67, 0xe0, 0x72, 0x7a,
+	0xb0, 0xdf, 0xa2, 0x68, 0xb8, 0xe6, 0x76, 0xf6, 0x7b, 0xcd, 0x50, 0x13, 0xce, 0x4b, 0x06, 0x62,
+	0x3c, 0x7d, 0x3c, 0x86, 0xd6, 0xca, 0x39, 0xd1, 0x76, 0x93, 0x37, 0x8d, 0xb1, 0x51, 0x7f, 0xa6,
+	0xc0, 0x53, 0x03, 0x18, 0xef, 0xbb, 0x7c, 0xe3, 0x78, 0x6e, 0x68, 0xe3, 0x60, 0x7b, 0x51, 0x1f,
+	0xe8, 0x5f, 0x1a, 0x0d, 0xfa, 0x03, 0x38, 0x7f, 0x69, 0x34, 0xce, 0x0f, 0x40, 0xfb, 0x73, 0xa3,
+	0xa0, 0xbd, 0x0f, 0xcd, 0xd5, 0x7f, 0x52, 0xa0, 0x18, 0xaa, 0x79, 0xc7, 0xa4, 0x96, 0xe1, 0x33,
+	0x48, 0xf5, 0x19, 0xbe, 0x77, 0xac, 0x70, 0x0f, 0x8e, 0xca, 0xe8, 0x25, 0x40, 0x16, 0xf1, 0x03,
+	0x2d, 0x24, 0x88, 0x60, 0x4d, 0x6c, 0xc9, 0x25, 0x56, 0xb3, 0x2b, 0x2b, 0x78, 0x5c, 0x76, 0x13,
+	0xce, 0x1f, 0x12, 0xd3, 0xa2, 0x86, 0xf6, 0xbe, 0x73, 0xe0, 0x6b, 0x47, 0x26, 0xf3, 0xdc, 0xae,
+	0x26, 0xce, 0x4b, 0x4c, 0xe1, 0x34, 0x3e, 0x2b, 0x1a, 0x7c, 0xdb, 0x39, 0xf0, 0xef, 0x89, 0x6a,
+	0xee, 0x62, 0xa8, 0x0e, 0x17, 0xfd, 0x8e, 0xae, 0x53, 0xdf, 0x3f, 0xec, 0x58, 0xa3, 0xba, 0xf3,
+	0x50, 0x12, 0x57, 0x7a, 0x8d, 0x06, 0x59, 0xa8, 0x7f, 0xa3, 0xc0, 0x1c, 0xee, 0xd8, 0xdb, 0xb6,
+	0x4e, 0xe5, 0xc8, 0xce, 0x42, 0x96, 0xe8, 0x81, 0x79, 0x2c, 0xc6, 0x95, 0xc6, 0xb2, 0xc4, 0x2e,
+	0x85, 0x75, 0xa7, 0xed, 0x5a, 0x54, 0x5c, 0xbe, 0xa5, 0x78, 0x65, 0x9c, 0xc4, 0x23, 0x4c, 0xae,
+	0xa8, 0x54, 0x5b, 0x96, 0xd8, 0xe6, 0xc4, 0x35, 0xa0, 0x06, 0x35, 0xa4, 0x4a, 0x3d, 0x02, 0xba,
+	0x08, 0x20, 0x2c, 0x14, 0x85, 0xb4, 0x79, 0x9c, 0xe7, 0x14, 0x3e, 0x3d, 0x57, 0x60, 0xbe, 0x27,
+	0x43, 0xb4, 0xe1, 0xe7, 0x29, 0x5c, 0xec, 0x91, 0x59, 0x43, 0xf5, 0xef, 0x52, 0x71, 0x30, 0xb8,
+	0xcf, 0x00, 0x9c, 0x87, 0x4a, 0x61, 0xe4, 0xf1, 0xfa, 0xb8, 0xeb, 0xbb, 0xb7, 0xb4, 0x65, 0xb0,
+	0xe5, 0xe3, 0x88, 0x17, 0xda, 0x19, 0x40, 0x8d, 0x1b, 0xc9, 0xb9, 0xf6, 0x03, 0xc6, 0x09, 0xb0,
+	0x95, 0x3e, 0x01, 0xb6, 0x2a, 0xaf, 0x40, 0x2e, 0x54, 0x2b, 0x01, 0xc2, 0x4c, 0x80, 0x4a, 0xea,
+	0x67, 0xf3, 0x90, 0x5b, 0xb7, 0xfd, 0x80, 0xd8, 0x3a, 0x1d, 0x19, 0x6f, 0x16, 0x21, 0x15, 0x9d,
+	0x74, 0x52, 0xa6, 0x71, 0xca, 0x4b, 0x8e, 0xf8, 0xe9, 0x69, 0xba, 0xff, 0xf4, 0xb4, 0x08, 0xd3,
+	0xe2, 0xc9, 0x44, 0x58, 0x5e, 0x14, 0x18, 0x55, 0x9c, 0xf1, 0x66, 0x04, 0x95, 0x17, 0x98, 0x3b,
+	0xf1, 0x08, 0x43, 0xe3, 0x7b, 0xa6, 0xb8, 0xed, 0xcf, 0x73, 0x0a, 0x66, 0xbb, 0x65, 0x54, 0xcd,
+	0x47, 0x93, 0x8f, 0x55, 0xf3, 0xe3, 0xc0, 0xd3, 0x20, 0x0a, 0x1a, 0xdb, 0x52, 0x41, 0xac, 0x6b,
+	0x4e, 0xd8, 0x23, 0x2d, 0x44, 0x60, 0x3e, 0x7a, 0xb3, 0x38, 0xe4, 0x8b, 0xa5, 0x5c, 0x48, 0x16,
+	0x70, 0xf4, 0x83, 0xc8, 0xbd, 0x29, 0x5c, 0x74, 0xfb, 0x28, 0x48, 0x83, 0xf9, 0xf0, 0x3c, 0x1a,
+	0x8a, 0x98, 0xe5, 0x22, 0x5e, 0x1d, 0xdb, 0xcf, 0xe2, 0x8b, 0xf9, 0xde, 0x14, 0x9e, 0xf3, 0xfa,
+	0x56, 0xf7, 0xb3, 0x50, 0xd0, 0x79, 0x12, 0x8b, 0xc6, 0x2e, 0xc3, 0xcb, 0x73, 0x7c, 0x88, 0x20,
+	0x48, 0x0d, 0x36, 0xab, 0xcf, 0x42, 0xa1, 0xe3, 0x1a, 0x51, 0x83, 0xa2, 0x68, 0x20, 0x48, 0xbc,
+	0xc1, 0x45, 0x00, 0xd7, 0x73, 0xde, 0xa7, 0x7a, 0xc0, 0x2c, 0x35, 0x2f, 0x66, 0x50, 0x52, 0xc4,
+	0x49, 0x97, 0x4d, 0xad, 0xef, 0x12, 0x9d, 0xf2, 0x4b, 0xf1, 0x3c, 0xee, 0x11, 0xb8, 0x25, 0xd9,
+	0x15, 0x5b, 0x79, 0x41, 0x5a, 0x92, 0x15, 0xd0, 0x76, 0x3c, 0x86, 0x43, 0x4b, 0x4a, 0x92, 0x80,
+	0x70, 0x64, 0xf8, 0xf6, 0x0e, 0x40, 0x74, 0xba, 0xf6, 0xcb, 0x67, 0x96, 0xd2, 0x49, 0xd6, 0x7f,
+	0xe8, 0xf3, 0xd5, 0xb5, 0x90, 0x05, 0x8e, 0x71, 0x43, 0xef, 0x43, 0xc9, 0xed, 0x1c, 0x58, 0xa6,
+	0xae, 0x51, 0xdb, 0x70, 0x1d, 0xd3, 0x0e, 0xfc, 0xf2, 0x22, 0x97, 0x70, 0x2b, 0xb1, 0x84, 0x1d,
+	0xce, 0xa8, 0x29, 0xf9, 0xe0, 0x79, 0xb7, 0xaf, 0xec, 0xa3, 0x8d, 0xd8, 0x0d, 0xd6, 0x53, 0x7c,
+	0x5e, 0xae, 0x8d, 0x2b, 0x63, 0x4f, 0xf6, 0x8b, 0xdd, 0x79, 0xbd, 0x00, 0x25, 0x83, 0xfa, 0x26,
+	0x7b, 0xd2, 0x88, 0x82, 0x85, 0xb3, 0x1c, 0x04, 0xe6, 0x25, 0x3d, 0x8a, 0x12, 0x2e, 0x43, 0xd1,
+	0xa3, 0xc4, 0xe8, 0xf6, 0x1a, 0x9e, 0x13, 0x8f, 0x1f, 0x9c, 0x1a, 0x35, 0xbb, 0x0a, 0x28, 0xba,
+	0x83, 0xee, 0x35, 0x2d, 0x0b, 0xec, 0x8a, 0x6a, 0xc2, 0xe6, 0x95, 0x3f, 0xc8, 0x40, 0x3e, 0x9a,
+	0xd4, 0x91, 0x90, 0xb2, 0x18, 0x1e, 0x36, 0xe4, 0x7d, 0x3b, 0x2f, 0xf4, 0xd6, 0x7f, 0x3a, 0xbe,
+	0xfe, 0xf7, 0xc3, 0x23, 0x44, 0x66, 0xc2, 0xd9, 0x8f, 0x54, 0xe9, 0x3b, 0x50, 0x50, 0x80, 0x63,
+	0xc7, 0xd2, 0xda, 0x4e, 0xc7, 0x0e, 0xc4, 0xe5, 0x56, 0x82, 0x44, 0x89, 0x11, 0xbc, 0xef, 0x3b,
+	0x56, 0xa7, 0x4d, 0x37, 0x19, 0x3b, 0x9c, 0x3f, 0x76, 0x2c, 0xfe, 0xe5, 0x57, 0x7e, 0x2f, 0x8c,
+	0xcc, 0x47, 0x4d, 0x03, 0x82, 0x0c, 0x53, 0x46, 0x6e, 0xb2, 0xfc, 0x9b, 0xe1, 0xa3, 0x61, 0xfb,
+	0x02, 0xb7, 0x24, 0xbc, 0x1a, 0xb6, 0xcf, 0x51, 0xeb, 0x1c, 0xcc, 0x1c, 0x39, 0x7e, 0xa0, 0x99,
+	0xae, 0x04, 0xd6, 0x2c, 0x2b, 0xae, 0xbb, 0x8c, 0xcf, 0x43, 0xd3, 0x0e, 0xf1, 0x94, 0x7f, 0xb3,
+	0xc9, 0x14, 0xe1, 0xb9, 0x04, 0x53, 0x5e, 0x60, 0xdc, 0x7d, 0x4f, 0xd7, 0xb8, 0xd4, 0x19, 0x2e,
+	0x75, 0xc6, 0xf7, 0x74, 0xa6, 0x60, 0xe5, 0x11, 0x14, 0x62, 0x63, 0x18, 0xa9, 0xef, 0x45, 0x00,
+	0x3e, 0x5f, 0x9a, 0x4b, 0x82, 0x23, 0x69, 0xbb, 0x3c, 0xa7, 0xec, 0x90, 0xe0, 0x88, 0xa1, 0x2a,
+	0xf3, 0x1b, 0xcd, 0xb1, 0xad, 0xf0, 0x48, 0x9b, 0x63, 0x84, 0x6d, 0xdb, 0xea, 0x72, 0xc9, 0x9d,
+	0x03, 0xd1, 0x53, 0x68, 0x3f, 0xe3, 0x77, 0x0e, 0x58, 0xbf, 0xca, 0x4f, 0x52, 0x50, 0xec, 0x5f,
+	0x22, 0x0c, 0x5e, 0x88, 0x61, 0x78, 0xd4, 0xf7, 0x69, 0x18, 0x19, 0xf6, 0x08, 0x4c, 0x10, 0xb1,
+	0x2c, 0xcd, 0x76, 0x0c, 0xea, 0xcb, 0x33, 0x75, 0x8e, 0x58, 0xd6, 0x16, 0x2b, 0xb3, 0x90, 0x8d,
+	0x4d, 0x4b, 0x6c, 0x02, 0xa3, 0x32, 0xdf, 0x16, 0xec, 0x16, 0xe3, 0xd2, 0xdb, 0x9d, 0xf2, 0x92,
+	0xb2, 0x6e, 0xb0, 0x09, 0x66, 0x3c, 0x7b, 0x5b, 0x53, 0x96, 0x15, 0xd7, 0x0d, 0x6e, 0x28, 0xa6,
+	0xb8, 0x98, 0x4b, 0xfe, 0x8d, 0x9e, 0x82, 0xac, 0xeb, 0x18, 0xac, 0xad, 0xdc, 0x98, 0x5c, 0xc7,
+	0x90, 0x4d, 0xd9, 0xec, 0xe6, 0x62, 0x36, 0x8d, 0x6c, 0x91, 0x8f, 0xdb, 0x82, 0x45, 0x44, 0xd4,
+	0x3b, 0x36, 0x75, 0x2e, 0x10, 0x64, 0x44, 0x24, 0x28, 0xeb, 0xc6, 0xed, 0x39, 0x28, 0xf0, 0xc0,
+	0x59, 0x20, 0xba, 0xfa, 0x36, 0xe4, 0xc2, 0xb5, 0x3e, 0xd2, 0x36, 0x15, 0xc8, 0xc9, 0x6d, 0x58,
+	0x1c, 0xb6, 0xf3, 0x38, 0x2a, 0x33, 0x49, 0x32, 0x0d, 0xa0, 0x77, 0x29, 0x94, 0x97, 0x94, 0x75,
+	0x83, 0xc5, 0xbd, 0x85, 0xba, 0xeb, 0x7e, 0x3d, 0x82, 0x80, 0x38, 0x16, 0x66, 0x4f, 0x8b, 0x85,
+	0xea, 0x8f, 0x14, 0x48, 0xd7, 0x5d, 0xf7, 0x24, 0x10, 0x12, 0x81, 0x45, 0x2a, 0x1e, 0x58, 0x7c,
+	0x17, 0xf2, 0xa6, 0x9c, 0x08, 0x71, 0xdf, 0x5c, 0xa8, 0xbd, 0x9c, 0x20, 0x77, 0x23, 0x9c, 0x44,
+	0xdc, 0xe3, 0xa2, 0xde, 0x85, 0x0c, 0xbb, 0x1b, 0x44, 0xb7, 0x20, 0x43, 0x5c, 0x57, 0xf8, 0x73,
+	0xa1, 0xf6, 0x62, 0x02, 0xae, 0x98, 0x77, 0x54, 0x7f, 0x35, 0x0d, 0x33, 0x5c, 0xc6, 0xa1, 0xc3,
+	0x36, 0xf0, 0xb6, 0x63, 0x9b, 0x81, 0xe3, 0x69, 0x1d, 0xcf, 0x92, 0x03, 0x03, 0x49, 0xda, 0xf7,
+	0x2c, 0x36, 0xc7, 0x96, 0xd3, 0xf2, 0x79, 0xad, 0xbc, 0x27, 0x64, 0x65, 0x56, 0xf5, 0x0e, 0xcc,
+	0x07, 0x4e, 0x40, 0x2c, 0x6d, 0xf0, 0x4a, 0x65, 0x82, 0xed, 0xb8, 0xc8, 0x39, 0xe1, 0xd8, 0x95,
+	0xca, 0xe0, 0x7b, 0x7a, 0x66, 0xd4, 0x7b, 0xfa, 0x07, 0xf0, 0xd4, 0x40, 0x7a, 0x88, 0x8c, 0x83,
+	0xa6, 0x93, 0x9d, 0xd2, 0x47, 0x9e, 0x2d, 0xf1, 0x99, 0xbe, 0x0c, 0x11, 0x19, 0x13, 0x6d, 0xc5,
+	0x2d, 0x9b, 0x5d, 0x4a, 0x27, 0x71, 0xad, 0x51, 0x66, 0xfd, 0x2b, 0x05, 0x72, 0xcc, 0xae, 0xdc,
+	0x1c, 0x5b, 0x7d, 0xb6, 0x7d, 0x3d, 0x81, 0x6d, 0x79, 0x7f, 0xfe, 0x21, 0xb2, 0x13, 0x38, 0x9f,
+	0xca, 0x11, 0xe4, 0x23, 0xd2, 0x88, 0x07, 0xeb, 0x66, 0xfc, 0xc1, 0xba, 0x50, 0x5b, 0x4d, 0xe4,
+	0xa1, 0x87, 0x4e, 0xfc, 0x85, 0xbb, 0x0b, 0xb3, 0x75, 0xd7, 0x0d, 0xd7, 0x8e, 0x8f, 0xce, 0x0f,
+	0x5e, 0xa8, 0xf7, 0x6e, 0xd1, 0xb7, 0x20, 0x1f, 0xae, 0xac, 0xf0, 0x46, 0x2f, 0xf9, 0xe2, 0xec,
+	0xb1, 0x50, 0x7f, 0xac, 0xc0, 0x99, 0xfa, 0xe1, 0x21, 0xd5, 0x03, 0x6a, 0x7c, 0x5d, 0x00, 0x48,
+	0xfd, 0x00, 0x16, 0x47, 0xe8, 0xe4, 0xa3, 0xb7, 0xe3, 0xee, 0x23, 0xcc, 0x3c, 0xf6, 0xd3, 0xff,
+	0x08, 0x86, 0x71, 0x4f, 0xfa, 0x5c, 0x81, 0x22, 0xb3, 0x76, 0x9d, 0x1d, 0xc1, 0xc5, 0xf5, 0xcb,
+	0x5e, 0x9f, 0x3f, 0xbd, 0x95, 0xc4, 0x9f, 0x7a, 0x5c, 0x86, 0xbc, 0xaa, 0xf3, 0xc5, 0x5e, 0x85,
+	0xfb, 0xbd, 0xea, 0xcd, 0x53, 0x0c, 0xcf, 0x8f, 0xbb, 0xd8, 0x6f, 0xa7, 0x60, 0x36, 0xac, 0xd8,
+	0xb1, 0x88, 0x3d, 0xd2, 0xc0, 0x9b, 0xe2, 0x42, 0x42, 0xbe, 0x6a, 0x14, 0xc7, 0x3f, 0x0a, 0x31,
+	0x8e, 0x36, 0x35, 0xea, 0xbc, 0x33, 0x96, 0x4c, 0xf8, 0x85, 0x42, 0xc7, 0xf3, 0xd8, 0x61, 0xbb,
+	0xdf, 0x4f, 0x8a, 0x92, 0x2c, 0x9f, 0x61, 0xe3, 0x8e, 0x94, 0x79, 0x8c, 0x23, 0x4d, 0x7f, 0xb1,
+	0x23, 0x65, 0xfb, 0x77, 0xb2, 0xb3, 0x90, 0xe5, 0x53, 0xe0, 0xcb, 0x00, 0x41, 0x96, 0xd8, 0xe0,
+	0x0d, 0xf3, 0xf0, 0x50, 0x1e, 0x5a, 0xf9, 0xb7, 0xfa, 0x4b, 0x2c, 0x4c, 0x3c, 0x69, 0x66, 0x46,
+	0x6f, 0x54, 0x78, 0x78, 0xa3, 0x7a, 0x25, 0x29, 0x9c, 0x31, 0x91, 0x71, 0x47, 0xfc, 0x33, 0x11,
+	0x09, 0xe0, 0xd8, 0xd3, 0x7a, 0xf4, 0x04, 0xae, 0x0c, 0xa4, 0x67, 0xdc, 0x80, 0x7c, 0xf4, 0x07,
+	0xab, 0x72, 0xea, 0xf1, 0x4f, 0x94, 0x51, 0xe3, 0xc1, 0xbc, 0xc3, 0xf4, 0x70, 0xde, 0x61, 0xfc,
+	0x49, 0x3f, 0x33, 0xf0, 0xa4, 0x1f, 0xb3, 0xd7, 0x74, 0x9f, 0xbd, 0xd4, 0x0e, 0xcc, 0xc6, 0x94,
+	0xf7, 0x47, 0xce, 0xe5, 0x77, 0x59, 0x8c, 0x2a, 0x1b, 0x94, 0x53, 0x89, 0xb7, 0xf7, 0x90, 0x39,
+	0xee, 0x71, 0x51, 0x3f, 0x51, 0xa0, 0x70, 0x9f, 0x99, 0x76, 0xed, 0x88, 0xd8, 0x2d, 0x3a, 0x62,
+	0x5d, 0x7d, 0x47, 0x26, 0x0c, 0xa5, 0x92, 0xdd, 0x94, 0xc7, 0x98, 0xc6, 0x92, 0x85, 0x10, 0x64,
+	0x58, 0x12, 0xa7, 0x9c, 0x36, 0xfe, 0xcd, 0xc0, 0x31, 0x70, 0xe4, 0x4c, 0xa5, 0x02, 0x47, 0xfd,
+	0x97, 0x34, 0xcc, 0xc7, 0xb4, 0x6d, 0x98, 0x87, 0x87, 0x68, 0x7b, 0xc0, 0x96, 0x13, 0x0e, 0xbc,
+	0xe7, 0x00, 0x77, 0x21, 0xc3, 0xd2, 0x19, 0xca, 0xa9, 0xc9, 0x99, 0x71, 0x06, 0x68, 0x3d, 0x3c,
+	0x0d, 0xa6, 0x93, 0x71, 0x8a, 0xcd, 0x4f, 0x78, 0x84, 0xdc, 0x8a, 0xe5, 0x56, 0x66, 0x96, 0xd2,
+	0x93, 0x72, 0x8b, 0x32, 0x29, 0x37, 0x61, 0x26, 0x4c, 0x09, 0x9d, 0x3e, 0x05, 0x3b, 0xc9, 0x03,
+	0x7d, 0x27, 0x82, 0x84, 0xec, 0xe4, 0xdc, 0x24, 0x0b, 0xf5, 0xbf, 0x14, 0x28, 0x46, 0x69, 0x16,
+	0xcd, 0x63, 0x6a, 0x07, 0xa8, 0xd9, 0x97, 0x99, 0x36, 0x76, 0x34, 0xc7, 0x3b, 0xc7, 0x5c, 0x2c,
+	0x1e, 0x02, 0xa4, 0xfa, 0x43, 0x80, 0x0d, 0xc8, 0x85, 0x70, 0x21, 0xcd, 0x95, 0x3c, 0x86, 0x8a,
+	0x38, 0xf4, 0x63, 0x48, 0x26, 0x01, 0x86, 0xa8, 0xbf, 0xab, 0xc0, 0xcc, 0x86, 0xd3, 0x62, 0xe9,
+	0x12, 0x0c, 0x2d, 0x22, 0x9d, 0x24, 0x4a, 0x45, 0x12, 0x4a, 0x90, 0x76, 0x9d, 0x30, 0x6e, 0x60,
+	0x9f, 0x8f, 0xc9, 0xd1, 0x98, 0x58, 0x23, 0xb6, 0x2e, 0x59, 0x62, 0x47, 0x78, 0x08, 0x67, 0xdf,
+	0xea, 0x7f, 0x2b, 0xec, 0x1a, 0xd7, 0x77, 0x1d, 0xdb, 0xa7, 0xa8, 0x11, 0x67, 0xad, 0x3c, 0x8e,
+	0xb5, 0x7c, 0x4d, 0xfd, 0x43, 0xfe, 0x27, 0xc3, 0x98, 0x98, 0x3b, 0x90, 0x65, 0xf7, 0x22, 0x1d,
+	0x5f, 0xa2, 0x49, 0x75, 0xec, 0xf7, 0x2c, 0xde, 0x0b, 0xcb, 0xde, 0x0c, 0x46, 0xdb, 0xd4, 0xf7,
+	0xc3, 0x65, 0x97, 0xc7, 0x61, 0x11, 0x2d, 0x43, 0xe6, 0xc0, 0x31, 0xba, 0x72, 0xf4, 0x8b, 0x43,
+	0x2a, 0xd6, 0xed, 0x2e, 0xe6, 0x2d, 0xd8, 0xb9, 0x52, 0xde, 0x98, 0xf7, 0x76, 0xc7, 0xbc, 0xa4,
+	0xac, 0x1b, 0x2b, 0xaf, 0xc0, 0xb9, 0x13, 0xb2, 0xd9, 0xd1, 0x2c, 0xe4, 0x64, 0x66, 0x81, 0x51,
+	0x9a, 0x42, 0x05, 0x98, 0xa1, 0xb6, 0x28, 0x28, 0x2b, 0xb7, 0xe0, 0xcc, 0x88, 0x4c, 0x49, 0x34,
+	0x0f, 0x85, 0xfa, 0xc6, 0x86, 0x56, 0xdf, 0xd3, 0xb6, 0xb7, 0xd6, 0x9a, 0xa2, 0x13, 0xde, 0xde,
+	0xd8, 0x58, 0xdf, 0xba, 0x5b, 0x52, 0x10, 0x40, 0x76, 0xad, 0xbe, 0x55, 0xc7, 0x6f, 0x97, 0x52,
+	0x2b, 0x1b, 0x30, 0xd7, 0x17, 0x12, 0xa0, 0x1c, 0x64, 0xb6, 0xb6, 0xb7, 0x58, 0x1f, 0xd6, 0x0c,
+	0x37, 0xeb, 0x7b, 0xcd, 0x92, 0xc2, 0xfa, 0xef, 0xef, 0xdc, 0xc5, 0xf5, 0x46, 0xb3, 0x94, 0x62,
+	0xfa, 0xe0, 0xa6, 0xac, 0x4a, 0xb3, 0x66, 0x8d, 0xe6, 0x46, 0x73, 0xaf, 0x59, 0xca, 0xac, 0xac,
+	0xc3, 0xfc, 0x00, 0x0e, 0x23, 0x04, 0xc5, 0xfb, 0xf5, 0x8d, 0xfd, 0xa6, 0xb6, 0xb9, 0xdd, 0x58,
+	0xbf, 0xb3, 0xde, 0x6c, 0x94, 0xa6, 0x98, 0x7a, 0x82, 0x56, 0x6f, 0x34, 0x9a, 0x8d, 0x92, 0x82,
+	0x16, 0x60, 0x4e, 0x10, 0x70, 0x73, 0x73, 0xfb, 0x7e, 0xb3, 0x51, 0x4a, 0xad, 0x5c, 0x87, 0x7c,
+	0xb4, 0xd2, 0x50, 0x1e, 0xa6, 0x45, 0xd3, 0x29, 0x26, 0x3c, 0xe2, 0xc4, 0xf5, 0x12, 0xc2, 0x59,
+	0x97, 0x6d, 0xc8, 0x0a, 0xbb, 0x31, 0xf2, 0xee, 0xfe, 0xda, 0x5a, 0x73, 0x77, 0xb7, 0x34, 0xc5,
+	0x3a, 0x37, 0x31, 0xde, 0xc6, 0x25, 0x05, 0xcd, 0x41, 0x7e, 0x6b, 0x7b, 0x4f, 0xbb, 0xb3, 0xbd,
+	0xbf, 0xd5, 0x28, 0xa5, 0x58, 0x71, 0x7f, 0x6b, 0xed, 0x5e, 0x7d, 0xeb, 0x6e, 0xb3, 0x51, 0x4a,
+	0x33, 0xb5, 0xd6, 0xb7, 0xb4, 0x1d, 0xbc, 0x7d, 0x17, 0xb3, 0x9e, 0x99, 0xda, 0xe7, 0x0b, 0x00,
+	0x2c, 0x8b, 0x46, 0x38, 0x05, 0xfa, 0x44, 0x81, 0x7c, 0xf4, 0x6f, 0x47, 0x74, 0x63, 0xd2, 0x3f,
+	0x48, 0x56, 0xae, 0x25, 0x38, 0x38, 0xf2, 0xd5, 0xa0, 0x9e, 0xfb, 0xd1, 0xdf, 0xff, 0xeb, 0x4f,
+	0x52, 0x0b, 0xea, 0x2c, 0xff, 0x37, 0xf7, 0xf1, 0xf5, 0x55, 0xe2, 0xba, 0xfe, 0xeb, 0xca, 0x0a,
+	0xfa, 0x2d, 0x05, 0xa0, 0xf7, 0x1f, 0x1e, 0x74, 0x73, 0xe2, 0xff, 0xfd, 0x4c, 0xa0, 0xd4, 0x33,
+	0x5c, 0xa9, 0x72, 0xe5, 0x4c, 0x5c, 0xa9, 0xd5, 0x8f, 0x18, 0x10, 0x7e, 0xcc, 0x74, 0xfb, 0x0d,
+	0x05, 0xf2, 0x51, 0x7e, 0xf9, 0xf8, 0xd3, 0x35, 0x98, 0x92, 0x3e, 0xb9, 0x66, 0xb5, 0x93, 0x34,
+	0xfb, 0x4c, 0x81, 0x42, 0x2c, 0x6b, 0x17, 0x8d, 0xff, 0x0c, 0x36, 0x94, 0xea, 0x3b, 0x81, 0x76,
+	0x57, 0xb8, 0x76, 0xcf, 0xa9, 0x17, 0x46, 0x68, 0xb7, 0xea, 0x49, 0x09, 0x4c, 0xcd, 0x4f, 0x15,
+	0xc8, 0x85, 0xc9, 0xb7, 0x68, 0xfc, 0x94, 0x81, 0xfe, 0x74, 0xdd, 0x09, 0x14, 0xbc, 0xc4, 0x15,
+	0x7c, 0x46, 0x3d, 0x3f, 0x4a, 0x41, 0xfe, 0x3a, 0xc1, 0xb4, 0xfb, 0x7d, 0x05, 0x4a, 0x83, 0xd9,
+	0xb4, 0x68, 0xec, 0x0b, 0xe7, 0x13, 0xf2, 0x70, 0x27, 0xd0, 0xf6, 0x32, 0xd7, 0xf6, 0x59, 0x74,
+	0x71, 0xe4, 0x74, 0x46, 0x9a, 0xfd, 0x51, 0x94, 0x40, 0x1a, 0x05, 0xec, 0x63, 0xdf, 0x9b, 0x8c,
+	0x4c, 0xc8, 0x9d, 0x40, 0xd5, 0x6b, 0x5c, 0xd5, 0x15, 0xb4, 0xfc, 0x85, 0xaa, 0xae, 0x7e, 0x14,
+	0x7e, 0x7e, 0x8c, 0x7e, 0xa6, 0x40, 0x69, 0x30, 0xdd, 0x6d, 0xfc, 0x49, 0x3e, 0x21, 0x51, 0x6e,
+	0x02, 0xcd, 0x55, 0xae, 0xf9, 0x05, 0xf5, 0x5c, 0x9f, 0xe6, 0x24, 0x3a, 0x3c, 0x87, 0xeb, 0x3d,
+	0x4a, 0xee, 0x1b, 0x7f, 0xbd, 0x0f, 0xe6, 0x59, 0x4e, 0xbe, 0xde, 0x57, 0x4e, 0x5a, 0xef, 0xbf,
+	0xa6, 0x00, 0x44, 0x62, 0xfc, 0xf1, 0x51, 0x72, 0x28, 0x55, 0x71, 0x02, 0xdd, 0x16, 0xb9, 0x6e,
+	0xc5, 0x95, 0x3e, 0xe8, 0x46, 0xbf, 0xac, 0xc0, 0x8c, 0x4c, 0x67, 0x46, 0xaf, 0x25, 0x73, 0xc3,
+	0xd3, 0xeb, 0x82, 0xfa, 0x75, 0xf9, 0x54, 0x81, 0x7c, 0x14, 0x1a, 0x8f, 0x6f, 0xb7, 0xc1, 0xa4,
+	0xe5, 0xca, 0x6b, 0x89, 0x7b, 0xf2, 0x0d, 0x5e, 0xad, 0x70, 0xad, 0x16, 0x11, 0xea, 0xb3, 0xde,
+	0x87, 0xac, 0xd1, 0x35, 0x05, 0xfd, 0x54, 0x81, 0xb9, 0xbe, 0xc4, 0x63, 0xf4, 0xe6, 0xf8, 0x41,
+	0xdc, 0x70, 0xbe, 0x72, 0x65, 0xec, 0xdb, 0x3f, 0x19, 0x30, 0xab, 0x4b, 0x5c, 0xbd, 0x0a, 0x2a,
+	0x8f, 0x5a, 0xb4, 0xec, 0x92, 0xf8, 0x9a, 0x82, 0xfe, 0x58, 0x81, 0x85, 0xa1, 0xa4, 0x5b, 0xf4,
+	0x56, 0x62, 0x3f, 0x1b, 0xc8, 0xd7, 0x9d, 0xc0, 0xc4, 0x2f, 0x72, 0x6d, 0x2f, 0xaf, 0x2c, 0xf5,
+	0x69, 0xdb, 0x96, 0x7c, 0x57, 0x3f, 0x0a, 0x8f, 0x2a, 0x6c, 0x5d, 0xdc, 0x9e, 0x7d, 0x07, 0x7a,
+	0x3c, 0x0e, 0xb2, 0x3c, 0x68, 0x7d, 0xf9, 0x7f, 0x07, 0x00, 0x0d, 0x9c, 0x71, 0xcd, 0x73, 0x46,
+	0x00, 0x00,
 }
@@ -109,6 +109,10 @@ func (m *CreateAppRequest) Validate() error {
 		}
 	}
 
+	// no validation rules for Async
+
+	// no validation rules for DryRun
+
 	return nil
 }
 
@@ -243,6 +247,20 @@ func (m *UpgradeAppRequest) Validate() error {
 		}
 	}
 
+	// no validation rules for Async
+
+	// no validation rules for DryRun
+
+	if v, ok := interface{}(m.GetStrategy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeAppRequestValidationError{
+				field:  "Strategy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -380,6 +398,20 @@ func (m *UpdateAppRequest) Validate() error {
 		}
 	}
 
+	// no validation rules for Async
+
+	// no validation rules for DryRun
+
+	if v, ok := interface{}(m.GetStrategy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAppRequestValidationError{
+				field:  "Strategy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -443,35 +475,31 @@ var _UpdateAppRequest_Cycle_InLookup = map[string]struct{}{
 	"run_once": {},
 }
 
-// Validate checks the field values on GetAppsRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *GetAppsRequest) Validate() error {
+// Validate checks the field values on UpgradeStrategy with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *UpgradeStrategy) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Name
+	// no validation rules for Type
 
-	// no validation rules for Version
+	// no validation rules for MaxUnavailable
 
-	if _, ok := _GetAppsRequest_Cycle_InLookup[m.GetCycle()]; !ok {
-		return GetAppsRequestValidationError{
-			field:  "Cycle",
-			reason: "value must be in list [periodic daemon run_once ]",
+	if m.GetHealthCheckDelay() > 3600 {
+		return UpgradeStrategyValidationError{
+			field:  "HealthCheckDelay",
+			reason: "value must be less than or equal to 3600",
 		}
 	}
 
-	// no validation rules for RootGroupId
-
-	// no validation rules for Verbose
-
 	return nil
 }
 
-// GetAppsRequestValidationError is the validation error returned by
-// GetAppsRequest.Validate if the designated constraints aren't met.
-type GetAppsRequestValidationError struct {
+// UpgradeStrategyValidationError is the validation error returned by
+// UpgradeStrategy.Validate if the designated constraints aren't met.
+type UpgradeStrategyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -479,22 +507,22 @@ type GetAppsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAppsRequestValidationError) Field() string { return e.field }
+func (e UpgradeStrategyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAppsRequestValidationError) Reason() string { return e.reason }
+func (e UpgradeStrategyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAppsRequestValidationError) Cause() error { return e.cause }
+func (e UpgradeStrategyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAppsRequestValidationError) Key() bool { return e.key }
+func (e UpgradeStrategyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAppsRequestValidationError) ErrorName() string { return "GetAppsRequestValidationError" }
+func (e UpgradeStrategyValidationError) ErrorName() string { return "UpgradeStrategyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAppsRequestValidationError) Error() string {
+func (e UpgradeStrategyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -506,14 +534,14 @@ func (e GetAppsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAppsRequest.%s: %s%s",
+		"invalid %sUpgradeStrategy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAppsRequestValidationError{}
+var _ error = UpgradeStrategyValidationError{}
 
 var _ interface {
 	Field() string
@@ -521,42 +549,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAppsRequestValidationError{}
-
-var _GetAppsRequest_Cycle_InLookup = map[string]struct{}{
-	"periodic": {},
-	"daemon":   {},
-	"run_once": {},
-	"":         {},
-}
+} = UpgradeStrategyValidationError{}
 
-// Validate checks the field values on DeleteAppRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *DeleteAppRequest) Validate() error {
+// Validate checks the field values on RollbackAppRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RollbackAppRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	if len(m.GetName()) < 1 {
-		return DeleteAppRequestValidationError{
+		return RollbackAppRequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 bytes",
 		}
 	}
 
-	// no validation rules for Version
+	if !_RollbackAppRequest_Version_Pattern.MatchString(m.GetVersion()) {
+		return RollbackAppRequestValidationError{
+			field:  "Version",
+			reason: "value does not match regex pattern \"^(\\\\w*\\\\d+(\\\\.\\\\d)*)?$\"",
+		}
+	}
+
+	// no validation rules for Revision
 
 	// no validation rules for RootGroupId
 
-	// no validation rules for Purge
+	// no validation rules for Async
 
 	return nil
 }
 
-// DeleteAppRequestValidationError is the validation error returned by
-// DeleteAppRequest.Validate if the designated constraints aren't met.
-type DeleteAppRequestValidationError struct {
+// RollbackAppRequestValidationError is the validation error returned by
+// RollbackAppRequest.Validate if the designated constraints aren't met.
+type RollbackAppRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -564,22 +592,24 @@ type DeleteAppRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAppRequestValidationError) Field() string { return e.field }
+func (e RollbackAppRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAppRequestValidationError) Reason() string { return e.reason }
+func (e RollbackAppRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAppRequestValidationError) Cause() error { return e.cause }
+func (e RollbackAppRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAppRequestValidationError) Key() bool { return e.key }
+func (e RollbackAppRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAppRequestValidationError) ErrorName() string { return "DeleteAppRequestValidationError" }
+func (e RollbackAppRequestValidationError) ErrorName() string {
+	return "RollbackAppRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteAppRequestValidationError) Error() string {
+func (e RollbackAppRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -591,14 +621,14 @@ func (e DeleteAppRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAppRequest.%s: %s%s",
+		"invalid %sRollbackAppRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAppRequestValidationError{}
+var _ error = RollbackAppRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -606,33 +636,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAppRequestValidationError{}
+} = RollbackAppRequestValidationError{}
 
-// Validate checks the field values on DeleteAppMetadataRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *DeleteAppMetadataRequest) Validate() error {
+var _RollbackAppRequest_Version_Pattern = regexp.MustCompile("^(\\w*\\d+(\\.\\d)*)?$")
+
+// Validate checks the field values on ScaleAppRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ScaleAppRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if len(m.GetAppName()) < 1 {
-		return DeleteAppMetadataRequestValidationError{
-			field:  "AppName",
+	if len(m.GetName()) < 1 {
+		return ScaleAppRequestValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 bytes",
 		}
 	}
 
+	if val := m.GetReplicas(); val < 1 || val > 100 {
+		return ScaleAppRequestValidationError{
+			field:  "Replicas",
+			reason: "value must be inside range [1, 100]",
+		}
+	}
+
 	// no validation rules for Version
 
 	// no validation rules for RootGroupId
 
+	// no validation rules for Async
+
 	return nil
 }
 
-// DeleteAppMetadataRequestValidationError is the validation error returned by
-// DeleteAppMetadataRequest.Validate if the designated constraints aren't met.
-type DeleteAppMetadataRequestValidationError struct {
+// ScaleAppRequestValidationError is the validation error returned by
+// ScaleAppRequest.Validate if the designated constraints aren't met.
+type ScaleAppRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -640,24 +681,22 @@ type DeleteAppMetadataRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAppMetadataRequestValidationError) Field() string { return e.field }
+func (e ScaleAppRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAppMetadataRequestValidationError) Reason() string { return e.reason }
+func (e ScaleAppRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAppMetadataRequestValidationError) Cause() error { return e.cause }
+func (e ScaleAppRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAppMetadataRequestValidationError) Key() bool { return e.key }
+func (e ScaleAppRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAppMetadataRequestValidationError) ErrorName() string {
-	return "DeleteAppMetadataRequestValidationError"
-}
+func (e ScaleAppRequestValidationError) ErrorName() string { return "ScaleAppRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteAppMetadataRequestValidationError) Error() string {
+func (e ScaleAppRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -669,14 +708,14 @@ func (e DeleteAppMetadataRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAppMetadataRequest.%s: %s%s",
+		"invalid %sScaleAppRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAppMetadataRequestValidationError{}
+var _ error = ScaleAppRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -684,24 +723,40 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAppMetadataRequestValidationError{}
+} = ScaleAppRequestValidationError{}
 
-// Validate checks the field values on DeleteAppsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *DeleteAppsRequest) Validate() error {
+// Validate checks the field values on ListAppRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAppRevisionsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Purge
+	if len(m.GetName()) < 1 {
+		return ListAppRevisionsRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	// no validation rules for Template
+
+	if !_ListAppRevisionsRequest_Version_Pattern.MatchString(m.GetVersion()) {
+		return ListAppRevisionsRequestValidationError{
+			field:  "Version",
+			reason: "value does not match regex pattern \"^(\\\\w*\\\\d+(\\\\.\\\\d)*)?$\"",
+		}
+	}
+
+	// no validation rules for Limit
 
 	return nil
 }
 
-// DeleteAppsRequestValidationError is the validation error returned by
-// DeleteAppsRequest.Validate if the designated constraints aren't met.
-type DeleteAppsRequestValidationError struct {
+// ListAppRevisionsRequestValidationError is the validation error returned by
+// ListAppRevisionsRequest.Validate if the designated constraints aren't met.
+type ListAppRevisionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -709,24 +764,24 @@ type DeleteAppsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAppsRequestValidationError) Field() string { return e.field }
+func (e ListAppRevisionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAppsRequestValidationError) Reason() string { return e.reason }
+func (e ListAppRevisionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAppsRequestValidationError) Cause() error { return e.cause }
+func (e ListAppRevisionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAppsRequestValidationError) Key() bool { return e.key }
+func (e ListAppRevisionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAppsRequestValidationError) ErrorName() string {
-	return "DeleteAppsRequestValidationError"
+func (e ListAppRevisionsRequestValidationError) ErrorName() string {
+	return "ListAppRevisionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteAppsRequestValidationError) Error() string {
+func (e ListAppRevisionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -738,14 +793,14 @@ func (e DeleteAppsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAppsRequest.%s: %s%s",
+		"invalid %sListAppRevisionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAppsRequestValidationError{}
+var _ error = ListAppRevisionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -753,30 +808,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAppsRequestValidationError{}
+} = ListAppRevisionsRequestValidationError{}
 
-// Validate checks the field values on EnableDisableAppRequest with the rules
+var _ListAppRevisionsRequest_Version_Pattern = regexp.MustCompile("^(\\w*\\d+(\\.\\d)*)?$")
+
+// Validate checks the field values on GetAppRevisionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *EnableDisableAppRequest) Validate() error {
+func (m *GetAppRevisionRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Name
+	if len(m.GetName()) < 1 {
+		return GetAppRevisionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
 
-	// no validation rules for Version
+	if len(m.GetRevision()) < 1 {
+		return GetAppRevisionRequestValidationError{
+			field:  "Revision",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
 
-	// no validation rules for RootGroupId
+	// no validation rules for Template
 
-	// no validation rules for Disable
+	if !_GetAppRevisionRequest_Version_Pattern.MatchString(m.GetVersion()) {
+		return GetAppRevisionRequestValidationError{
+			field:  "Version",
+			reason: "value does not match regex pattern \"^(\\\\w*\\\\d+(\\\\.\\\\d)*)?$\"",
+		}
+	}
+
+	// no validation rules for BaseRevision
+
+	if !_GetAppRevisionRequest_BaseVersion_Pattern.MatchString(m.GetBaseVersion()) {
+		return GetAppRevisionRequestValidationError{
+			field:  "BaseVersion",
+			reason: "value does not match regex pattern \"^(\\\\w*\\\\d+(\\\\.\\\\d)*)?$\"",
+		}
+	}
 
 	return nil
 }
 
-// EnableDisableAppRequestValidationError is the validation error returned by
-// EnableDisableAppRequest.Validate if the designated constraints aren't met.
-type EnableDisableAppRequestValidationError struct {
+// GetAppRevisionRequestValidationError is the validation error returned by
+// GetAppRevisionRequest.Validate if the designated constraints aren't met.
+type GetAppRevisionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -784,24 +865,24 @@ type EnableDisableAppRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnableDisableAppRequestValidationError) Field() string { return e.field }
+func (e GetAppRevisionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnableDisableAppRequestValidationError) Reason() string { return e.reason }
+func (e GetAppRevisionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnableDisableAppRequestValidationError) Cause() error { return e.cause }
+func (e GetAppRevisionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnableDisableAppRequestValidationError) Key() bool { return e.key }
+func (e GetAppRevisionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnableDisableAppRequestValidationError) ErrorName() string {
-	return "EnableDisableAppRequestValidationError"
+func (e GetAppRevisionRequestValidationError) ErrorName() string {
+	return "GetAppRevisionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EnableDisableAppRequestValidationError) Error() string {
+func (e GetAppRevisionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -813,14 +894,14 @@ func (e EnableDisableAppRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnableDisableAppRequest.%s: %s%s",
+		"invalid %sGetAppRevisionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnableDisableAppRequestValidationError{}
+var _ error = GetAppRevisionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -828,53 +909,41 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnableDisableAppRequestValidationError{}
+} = GetAppRevisionRequestValidationError{}
 
-// Validate checks the field values on CyclePeriodicReqAttr with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *CyclePeriodicReqAttr) Validate() error {
+var _GetAppRevisionRequest_Version_Pattern = regexp.MustCompile("^(\\w*\\d+(\\.\\d)*)?$")
+
+var _GetAppRevisionRequest_BaseVersion_Pattern = regexp.MustCompile("^(\\w*\\d+(\\.\\d)*)?$")
+
+// Validate checks the field values on GetAppsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *GetAppsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetWorkingDays()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CyclePeriodicReqAttrValidationError{
-				field:  "WorkingDays",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Name
 
-	if val := m.GetMinStartHour(); val < 0 || val > 23 {
-		return CyclePeriodicReqAttrValidationError{
-			field:  "MinStartHour",
-			reason: "value must be inside range [0, 23]",
-		}
-	}
+	// no validation rules for Version
 
-	if val := m.GetMaxStartHour(); val < 0 || val > 23 {
-		return CyclePeriodicReqAttrValidationError{
-			field:  "MaxStartHour",
-			reason: "value must be inside range [0, 23]",
+	if _, ok := _GetAppsRequest_Cycle_InLookup[m.GetCycle()]; !ok {
+		return GetAppsRequestValidationError{
+			field:  "Cycle",
+			reason: "value must be in list [periodic daemon run_once ]",
 		}
 	}
 
-	if val := m.GetIntervalMin(); val < 1 || val > 59 {
-		return CyclePeriodicReqAttrValidationError{
-			field:  "IntervalMin",
-			reason: "value must be inside range [1, 59]",
-		}
-	}
+	// no validation rules for RootGroupId
+
+	// no validation rules for Verbose
 
 	return nil
 }
 
-// CyclePeriodicReqAttrValidationError is the validation error returned by
-// CyclePeriodicReqAttr.Validate if the designated constraints aren't met.
-type CyclePeriodicReqAttrValidationError struct {
+// GetAppsRequestValidationError is the validation error returned by
+// GetAppsRequest.Validate if the designated constraints aren't met.
+type GetAppsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -882,24 +951,22 @@ type CyclePeriodicReqAttrValidationError struct {
 }
 
 // Field function returns field value.
-func (e CyclePeriodicReqAttrValidationError) Field() string { return e.field }
+func (e GetAppsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CyclePeriodicReqAttrValidationError) Reason() string { return e.reason }
+func (e GetAppsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CyclePeriodicReqAttrValidationError) Cause() error { return e.cause }
+func (e GetAppsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CyclePeriodicReqAttrValidationError) Key() bool { return e.key }
+func (e GetAppsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CyclePeriodicReqAttrValidationError) ErrorName() string {
-	return "CyclePeriodicReqAttrValidationError"
-}
+func (e GetAppsRequestValidationError) ErrorName() string { return "GetAppsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CyclePeriodicReqAttrValidationError) Error() string {
+func (e GetAppsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -911,14 +978,14 @@ func (e CyclePeriodicReqAttrValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCyclePeriodicReqAttr.%s: %s%s",
+		"invalid %sGetAppsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CyclePeriodicReqAttrValidationError{}
+var _ error = GetAppsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -926,63 +993,40 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CyclePeriodicReqAttrValidationError{}
-
-// Validate checks the field values on Spec with the rules defined in the proto
-// definition for this message. If any rules are violated, an error is returned.
-func (m *Spec) Validate() error {
-	if m == nil {
-		return nil
-	}
+} = GetAppsRequestValidationError{}
 
-	if m.GetImage() == nil {
-		return SpecValidationError{
-			field:  "Image",
-			reason: "value is required",
-		}
-	}
+var _GetAppsRequest_Cycle_InLookup = map[string]struct{}{
+	"periodic": {},
+	"daemon":   {},
+	"run_once": {},
+	"":         {},
+}
 
-	if v, ok := interface{}(m.GetImage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SpecValidationError{
-				field:  "Image",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// Validate checks the field values on WatchAppsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *WatchAppsRequest) Validate() error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetPorts() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SpecValidationError{
-					field:  fmt.Sprintf("Ports[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Name
 
-	}
+	// no validation rules for RootGroupId
 
-	if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SpecValidationError{
-				field:  "Resources",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	if _, ok := _WatchAppsRequest_Cycle_InLookup[m.GetCycle()]; !ok {
+		return WatchAppsRequestValidationError{
+			field:  "Cycle",
+			reason: "value must be in list [periodic daemon run_once ]",
 		}
 	}
 
 	return nil
 }
 
-// SpecValidationError is the validation error returned by Spec.Validate if the
-// designated constraints aren't met.
-type SpecValidationError struct {
+// WatchAppsRequestValidationError is the validation error returned by
+// WatchAppsRequest.Validate if the designated constraints aren't met.
+type WatchAppsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -990,22 +1034,22 @@ type SpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e SpecValidationError) Field() string { return e.field }
+func (e WatchAppsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SpecValidationError) Reason() string { return e.reason }
+func (e WatchAppsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SpecValidationError) Cause() error { return e.cause }
+func (e WatchAppsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SpecValidationError) Key() bool { return e.key }
+func (e WatchAppsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SpecValidationError) ErrorName() string { return "SpecValidationError" }
+func (e WatchAppsRequestValidationError) ErrorName() string { return "WatchAppsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SpecValidationError) Error() string {
+func (e WatchAppsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1017,14 +1061,14 @@ func (e SpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSpec.%s: %s%s",
+		"invalid %sWatchAppsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SpecValidationError{}
+var _ error = WatchAppsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1032,28 +1076,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SpecValidationError{}
+} = WatchAppsRequestValidationError{}
 
-// Validate checks the field values on CyclePeriodicRespAttr with the rules
+var _WatchAppsRequest_Cycle_InLookup = map[string]struct{}{
+	"periodic": {},
+	"daemon":   {},
+	"run_once": {},
+	"":         {},
+}
+
+// Validate checks the field values on StreamAppLogsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CyclePeriodicRespAttr) Validate() error {
+func (m *StreamAppLogsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for MinStartHour
+	if len(m.GetName()) < 1 {
+		return StreamAppLogsRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
 
-	// no validation rules for MaxStartHour
+	// no validation rules for GroupId
 
-	// no validation rules for IntervalMin
+	// no validation rules for Container
+
+	if m.GetTailLines() < 0 {
+		return StreamAppLogsRequestValidationError{
+			field:  "TailLines",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	if v, ok := interface{}(m.GetSinceTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamAppLogsRequestValidationError{
+				field:  "SinceTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Follow
 
 	return nil
 }
 
-// CyclePeriodicRespAttrValidationError is the validation error returned by
-// CyclePeriodicRespAttr.Validate if the designated constraints aren't met.
-type CyclePeriodicRespAttrValidationError struct {
+// StreamAppLogsRequestValidationError is the validation error returned by
+// StreamAppLogsRequest.Validate if the designated constraints aren't met.
+type StreamAppLogsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1061,24 +1136,24 @@ type CyclePeriodicRespAttrValidationError struct {
 }
 
 // Field function returns field value.
-func (e CyclePeriodicRespAttrValidationError) Field() string { return e.field }
+func (e StreamAppLogsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CyclePeriodicRespAttrValidationError) Reason() string { return e.reason }
+func (e StreamAppLogsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CyclePeriodicRespAttrValidationError) Cause() error { return e.cause }
+func (e StreamAppLogsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CyclePeriodicRespAttrValidationError) Key() bool { return e.key }
+func (e StreamAppLogsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CyclePeriodicRespAttrValidationError) ErrorName() string {
-	return "CyclePeriodicRespAttrValidationError"
+func (e StreamAppLogsRequestValidationError) ErrorName() string {
+	return "StreamAppLogsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CyclePeriodicRespAttrValidationError) Error() string {
+func (e StreamAppLogsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1090,14 +1165,14 @@ func (e CyclePeriodicRespAttrValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCyclePeriodicRespAttr.%s: %s%s",
+		"invalid %sStreamAppLogsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CyclePeriodicRespAttrValidationError{}
+var _ error = StreamAppLogsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1105,30 +1180,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CyclePeriodicRespAttrValidationError{}
+} = StreamAppLogsRequestValidationError{}
 
-// Validate checks the field values on PeriodicFields with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *PeriodicFields) Validate() error {
+// Validate checks the field values on DeleteAppRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *DeleteAppRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Schedule
+	if len(m.GetName()) < 1 {
+		return DeleteAppRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
 
-	// no validation rules for LastScheduleTime
+	// no validation rules for Version
 
-	// no validation rules for FailedJobsHistoryLimit
+	// no validation rules for RootGroupId
 
-	// no validation rules for SuccessfulJobsHistoryLimit
+	// no validation rules for Purge
+
+	// no validation rules for Async
 
 	return nil
 }
 
-// PeriodicFieldsValidationError is the validation error returned by
-// PeriodicFields.Validate if the designated constraints aren't met.
-type PeriodicFieldsValidationError struct {
+// DeleteAppRequestValidationError is the validation error returned by
+// DeleteAppRequest.Validate if the designated constraints aren't met.
+type DeleteAppRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1136,22 +1218,22 @@ type PeriodicFieldsValidationError struct {
 }
 
 // Field function returns field value.
-func (e PeriodicFieldsValidationError) Field() string { return e.field }
+func (e DeleteAppRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PeriodicFieldsValidationError) Reason() string { return e.reason }
+func (e DeleteAppRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PeriodicFieldsValidationError) Cause() error { return e.cause }
+func (e DeleteAppRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PeriodicFieldsValidationError) Key() bool { return e.key }
+func (e DeleteAppRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PeriodicFieldsValidationError) ErrorName() string { return "PeriodicFieldsValidationError" }
+func (e DeleteAppRequestValidationError) ErrorName() string { return "DeleteAppRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PeriodicFieldsValidationError) Error() string {
+func (e DeleteAppRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1163,14 +1245,14 @@ func (e PeriodicFieldsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPeriodicFields.%s: %s%s",
+		"invalid %sDeleteAppRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PeriodicFieldsValidationError{}
+var _ error = DeleteAppRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1178,34 +1260,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PeriodicFieldsValidationError{}
+} = DeleteAppRequestValidationError{}
 
-// Validate checks the field values on RunOnceFields with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *RunOnceFields) Validate() error {
+// Validate checks the field values on DeleteAppMetadataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteAppMetadataRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Active
-
-	// no validation rules for Completions
-
-	// no validation rules for Failed
-
-	// no validation rules for Succeeded
+	if len(m.GetAppName()) < 1 {
+		return DeleteAppMetadataRequestValidationError{
+			field:  "AppName",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
 
-	// no validation rules for StartTime
+	// no validation rules for Version
 
-	// no validation rules for CompletionTime
+	// no validation rules for RootGroupId
 
 	return nil
 }
 
-// RunOnceFieldsValidationError is the validation error returned by
-// RunOnceFields.Validate if the designated constraints aren't met.
-type RunOnceFieldsValidationError struct {
+// DeleteAppMetadataRequestValidationError is the validation error returned by
+// DeleteAppMetadataRequest.Validate if the designated constraints aren't met.
+type DeleteAppMetadataRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1213,22 +1294,24 @@ type RunOnceFieldsValidationError struct {
 }
 
 // Field function returns field value.
-func (e RunOnceFieldsValidationError) Field() string { return e.field }
+func (e DeleteAppMetadataRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RunOnceFieldsValidationError) Reason() string { return e.reason }
+func (e DeleteAppMetadataRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RunOnceFieldsValidationError) Cause() error { return e.cause }
+func (e DeleteAppMetadataRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RunOnceFieldsValidationError) Key() bool { return e.key }
+func (e DeleteAppMetadataRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RunOnceFieldsValidationError) ErrorName() string { return "RunOnceFieldsValidationError" }
+func (e DeleteAppMetadataRequestValidationError) ErrorName() string {
+	return "DeleteAppMetadataRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RunOnceFieldsValidationError) Error() string {
+func (e DeleteAppMetadataRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1240,14 +1323,14 @@ func (e RunOnceFieldsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRunOnceFields.%s: %s%s",
+		"invalid %sDeleteAppMetadataRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RunOnceFieldsValidationError{}
+var _ error = DeleteAppMetadataRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1255,43 +1338,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RunOnceFieldsValidationError{}
+} = DeleteAppMetadataRequestValidationError{}
 
-// Validate checks the field values on Resources with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Resources) Validate() error {
+// Validate checks the field values on DeleteAppsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *DeleteAppsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetRequests()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResourcesValidationError{
-				field:  "Requests",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetLimits()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResourcesValidationError{
-				field:  "Limits",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Purge
 
-	// no validation rules for PersistentStorage
+	// no validation rules for Async
 
 	return nil
 }
 
-// ResourcesValidationError is the validation error returned by
-// Resources.Validate if the designated constraints aren't met.
-type ResourcesValidationError struct {
+// DeleteAppsRequestValidationError is the validation error returned by
+// DeleteAppsRequest.Validate if the designated constraints aren't met.
+type DeleteAppsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1299,22 +1365,24 @@ type ResourcesValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResourcesValidationError) Field() string { return e.field }
+func (e DeleteAppsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResourcesValidationError) Reason() string { return e.reason }
+func (e DeleteAppsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResourcesValidationError) Cause() error { return e.cause }
+func (e DeleteAppsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResourcesValidationError) Key() bool { return e.key }
+func (e DeleteAppsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResourcesValidationError) ErrorName() string { return "ResourcesValidationError" }
+func (e DeleteAppsRequestValidationError) ErrorName() string {
+	return "DeleteAppsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ResourcesValidationError) Error() string {
+func (e DeleteAppsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1326,14 +1394,14 @@ func (e ResourcesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResources.%s: %s%s",
+		"invalid %sDeleteAppsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResourcesValidationError{}
+var _ error = DeleteAppsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1341,77 +1409,212 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResourcesValidationError{}
+} = DeleteAppsRequestValidationError{}
 
-// Validate checks the field values on Instance with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Instance) Validate() error {
-	if m == nil {
+// Validate checks the field values on EnableDisableAppRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EnableDisableAppRequest) Validate() error {
+	if m == nil {
 		return nil
 	}
 
 	// no validation rules for Name
 
-	// no validation rules for Id
-
 	// no validation rules for Version
 
 	// no validation rules for RootGroupId
 
-	// no validation rules for GroupId
+	// no validation rules for Disable
 
-	// no validation rules for State
+	return nil
+}
 
-	// no validation rules for Cycle
+// EnableDisableAppRequestValidationError is the validation error returned by
+// EnableDisableAppRequest.Validate if the designated constraints aren't met.
+type EnableDisableAppRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for ImageRepo
+// Field function returns field value.
+func (e EnableDisableAppRequestValidationError) Field() string { return e.field }
 
-	// no validation rules for ImageName
+// Reason function returns reason value.
+func (e EnableDisableAppRequestValidationError) Reason() string { return e.reason }
 
-	// no validation rules for ImageTag
+// Cause function returns cause value.
+func (e EnableDisableAppRequestValidationError) Cause() error { return e.cause }
 
-	// no validation rules for CreateDate
+// Key function returns key value.
+func (e EnableDisableAppRequestValidationError) Key() bool { return e.key }
 
-	// no validation rules for UpdateDate
+// ErrorName returns error name.
+func (e EnableDisableAppRequestValidationError) ErrorName() string {
+	return "EnableDisableAppRequestValidationError"
+}
 
-	// no validation rules for ProjectId
+// Error satisfies the builtin error interface
+func (e EnableDisableAppRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
-	// no validation rules for Namespace
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for Scale
+	return fmt.Sprintf(
+		"invalid %sEnableDisableAppRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
+var _ error = EnableDisableAppRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableDisableAppRequestValidationError{}
+
+// Validate checks the field values on CyclePeriodicReqAttr with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CyclePeriodicReqAttr) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetWorkingDays()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InstanceValidationError{
-				field:  "Resources",
+			return CyclePeriodicReqAttrValidationError{
+				field:  "WorkingDays",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetContainers() {
-		_, _ = idx, item
+	if val := m.GetMinStartHour(); val < 0 || val > 23 {
+		return CyclePeriodicReqAttrValidationError{
+			field:  "MinStartHour",
+			reason: "value must be inside range [0, 23]",
+		}
+	}
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InstanceValidationError{
-					field:  fmt.Sprintf("Containers[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if val := m.GetMaxStartHour(); val < 0 || val > 23 {
+		return CyclePeriodicReqAttrValidationError{
+			field:  "MaxStartHour",
+			reason: "value must be inside range [0, 23]",
 		}
+	}
 
+	if val := m.GetIntervalMin(); val < 1 || val > 59 {
+		return CyclePeriodicReqAttrValidationError{
+			field:  "IntervalMin",
+			reason: "value must be inside range [1, 59]",
+		}
 	}
 
-	for idx, item := range m.GetPublicEndpoints() {
+	return nil
+}
+
+// CyclePeriodicReqAttrValidationError is the validation error returned by
+// CyclePeriodicReqAttr.Validate if the designated constraints aren't met.
+type CyclePeriodicReqAttrValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CyclePeriodicReqAttrValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CyclePeriodicReqAttrValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CyclePeriodicReqAttrValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CyclePeriodicReqAttrValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CyclePeriodicReqAttrValidationError) ErrorName() string {
+	return "CyclePeriodicReqAttrValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CyclePeriodicReqAttrValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCyclePeriodicReqAttr.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CyclePeriodicReqAttrValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CyclePeriodicReqAttrValidationError{}
+
+// Validate checks the field values on Spec with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Spec) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetImage() == nil {
+		return SpecValidationError{
+			field:  "Image",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetImage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SpecValidationError{
+				field:  "Image",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPorts() {
 		_, _ = idx, item
 
 		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return InstanceValidationError{
-					field:  fmt.Sprintf("PublicEndpoints[%v]", idx),
+				return SpecValidationError{
+					field:  fmt.Sprintf("Ports[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1420,50 +1623,1169 @@ func (m *Instance) Validate() error {
 
 	}
 
-	if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+	if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InstanceValidationError{
-				field:  "Template",
+			return SpecValidationError{
+				field:  "Resources",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	switch m.CycleFields.(type) {
-
-	case *Instance_PeriodicFields:
+	if m.GetReplicas() > 100 {
+		return SpecValidationError{
+			field:  "Replicas",
+			reason: "value must be less than or equal to 100",
+		}
+	}
 
-		if v, ok := interface{}(m.GetPeriodicFields()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InstanceValidationError{
-					field:  "PeriodicFields",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+	if v, ok := interface{}(m.GetAutoscaling()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SpecValidationError{
+				field:  "Autoscaling",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
-	case *Instance_RunOnceFields:
+	return nil
+}
+
+// SpecValidationError is the validation error returned by Spec.Validate if the
+// designated constraints aren't met.
+type SpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpecValidationError) ErrorName() string { return "SpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpecValidationError{}
+
+// Validate checks the field values on CyclePeriodicRespAttr with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CyclePeriodicRespAttr) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MinStartHour
+
+	// no validation rules for MaxStartHour
+
+	// no validation rules for IntervalMin
+
+	return nil
+}
+
+// CyclePeriodicRespAttrValidationError is the validation error returned by
+// CyclePeriodicRespAttr.Validate if the designated constraints aren't met.
+type CyclePeriodicRespAttrValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CyclePeriodicRespAttrValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CyclePeriodicRespAttrValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CyclePeriodicRespAttrValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CyclePeriodicRespAttrValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CyclePeriodicRespAttrValidationError) ErrorName() string {
+	return "CyclePeriodicRespAttrValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CyclePeriodicRespAttrValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCyclePeriodicRespAttr.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CyclePeriodicRespAttrValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CyclePeriodicRespAttrValidationError{}
+
+// Validate checks the field values on PeriodicFields with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *PeriodicFields) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Schedule
+
+	// no validation rules for LastScheduleTime
+
+	// no validation rules for FailedJobsHistoryLimit
+
+	// no validation rules for SuccessfulJobsHistoryLimit
+
+	return nil
+}
+
+// PeriodicFieldsValidationError is the validation error returned by
+// PeriodicFields.Validate if the designated constraints aren't met.
+type PeriodicFieldsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeriodicFieldsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeriodicFieldsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeriodicFieldsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeriodicFieldsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeriodicFieldsValidationError) ErrorName() string { return "PeriodicFieldsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeriodicFieldsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeriodicFields.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeriodicFieldsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeriodicFieldsValidationError{}
+
+// Validate checks the field values on RunOnceFields with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *RunOnceFields) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Active
+
+	// no validation rules for Completions
+
+	// no validation rules for Failed
+
+	// no validation rules for Succeeded
+
+	// no validation rules for StartTime
+
+	// no validation rules for CompletionTime
+
+	return nil
+}
+
+// RunOnceFieldsValidationError is the validation error returned by
+// RunOnceFields.Validate if the designated constraints aren't met.
+type RunOnceFieldsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunOnceFieldsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunOnceFieldsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunOnceFieldsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunOnceFieldsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunOnceFieldsValidationError) ErrorName() string { return "RunOnceFieldsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RunOnceFieldsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunOnceFields.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunOnceFieldsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunOnceFieldsValidationError{}
+
+// Validate checks the field values on Resources with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Resources) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetRequests()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourcesValidationError{
+				field:  "Requests",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLimits()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourcesValidationError{
+				field:  "Limits",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PersistentStorage
+
+	return nil
+}
+
+// ResourcesValidationError is the validation error returned by
+// Resources.Validate if the designated constraints aren't met.
+type ResourcesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourcesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourcesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourcesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourcesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourcesValidationError) ErrorName() string { return "ResourcesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourcesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResources.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourcesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourcesValidationError{}
+
+// Validate checks the field values on Instance with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Instance) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Id
+
+	// no validation rules for Version
+
+	// no validation rules for RootGroupId
+
+	// no validation rules for GroupId
+
+	// no validation rules for State
+
+	// no validation rules for Cycle
+
+	// no validation rules for ImageRepo
+
+	// no validation rules for ImageName
+
+	// no validation rules for ImageTag
+
+	// no validation rules for CreateDate
+
+	// no validation rules for UpdateDate
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Namespace
+
+	// no validation rules for Scale
+
+	if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceValidationError{
+				field:  "Resources",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetContainers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InstanceValidationError{
+					field:  fmt.Sprintf("Containers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPublicEndpoints() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InstanceValidationError{
+					field:  fmt.Sprintf("PublicEndpoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DesiredReplicas
+
+	// no validation rules for ReadyReplicas
+
+	// no validation rules for AvailableReplicas
+
+	switch m.CycleFields.(type) {
+
+	case *Instance_PeriodicFields:
+
+		if v, ok := interface{}(m.GetPeriodicFields()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InstanceValidationError{
+					field:  "PeriodicFields",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Instance_RunOnceFields:
+
+		if v, ok := interface{}(m.GetRunOnceFields()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InstanceValidationError{
+					field:  "RunOnceFields",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// InstanceValidationError is the validation error returned by
+// Instance.Validate if the designated constraints aren't met.
+type InstanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InstanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InstanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InstanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InstanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InstanceValidationError) ErrorName() string { return "InstanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InstanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInstance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InstanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InstanceValidationError{}
+
+// Validate checks the field values on Template with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Template) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for CatalogId
+
+	return nil
+}
+
+// TemplateValidationError is the validation error returned by
+// Template.Validate if the designated constraints aren't met.
+type TemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateValidationError) ErrorName() string { return "TemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateValidationError{}
+
+// Validate checks the field values on AppInstance with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AppInstance) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Id
+
+	// no validation rules for Version
+
+	// no validation rules for RootGroupId
+
+	// no validation rules for GroupId
+
+	if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppInstanceValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// AppInstanceValidationError is the validation error returned by
+// AppInstance.Validate if the designated constraints aren't met.
+type AppInstanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppInstanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppInstanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppInstanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppInstanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppInstanceValidationError) ErrorName() string { return "AppInstanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppInstanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppInstance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppInstanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppInstanceValidationError{}
+
+// Validate checks the field values on App with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *App) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Cycle
+
+	for idx, item := range m.GetInstances() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppValidationError{
+					field:  fmt.Sprintf("Instances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// AppValidationError is the validation error returned by App.Validate if the
+// designated constraints aren't met.
+type AppValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppValidationError) ErrorName() string { return "AppValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppValidationError{}
+
+// Validate checks the field values on Apps with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Apps) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetApps() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppsValidationError{
+					field:  fmt.Sprintf("Apps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// AppsValidationError is the validation error returned by Apps.Validate if the
+// designated constraints aren't met.
+type AppsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppsValidationError) ErrorName() string { return "AppsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApps.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppsValidationError{}
+
+// Validate checks the field values on AppInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *AppInfo) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MonitorUrl
+
+	// no validation rules for LogsUrl
+
+	if v, ok := interface{}(m.GetTotalResources()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppInfoValidationError{
+				field:  "TotalResources",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SharedStorage
+
+	if v, ok := interface{}(m.GetCyclePeriodicFields()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppInfoValidationError{
+				field:  "CyclePeriodicFields",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetInstances() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppInfoValidationError{
+					field:  fmt.Sprintf("Instances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// AppInfoValidationError is the validation error returned by AppInfo.Validate
+// if the designated constraints aren't met.
+type AppInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppInfoValidationError) ErrorName() string { return "AppInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppInfoValidationError{}
+
+// Validate checks the field values on AppsInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *AppsInfo) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Apps
+
+	return nil
+}
+
+// AppsInfoValidationError is the validation error returned by
+// AppsInfo.Validate if the designated constraints aren't met.
+type AppsInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppsInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppsInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppsInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppsInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppsInfoValidationError) ErrorName() string { return "AppsInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppsInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppsInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppsInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppsInfoValidationError{}
+
+// Validate checks the field values on AppTemplates with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AppTemplates) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for AppName
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppTemplatesValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// AppTemplatesValidationError is the validation error returned by
+// AppTemplates.Validate if the designated constraints aren't met.
+type AppTemplatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppTemplatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppTemplatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppTemplatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppTemplatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppTemplatesValidationError) ErrorName() string { return "AppTemplatesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppTemplatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppTemplates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppTemplatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppTemplatesValidationError{}
+
+// Validate checks the field values on AffectedAppInstance with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AffectedAppInstance) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Id
+
+	// no validation rules for Version
 
-		if v, ok := interface{}(m.GetRunOnceFields()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InstanceValidationError{
-					field:  "RunOnceFields",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for RootGroupId
 
-	}
+	// no validation rules for GroupId
 
 	return nil
 }
 
-// InstanceValidationError is the validation error returned by
-// Instance.Validate if the designated constraints aren't met.
-type InstanceValidationError struct {
+// AffectedAppInstanceValidationError is the validation error returned by
+// AffectedAppInstance.Validate if the designated constraints aren't met.
+type AffectedAppInstanceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1471,22 +2793,24 @@ type InstanceValidationError struct {
 }
 
 // Field function returns field value.
-func (e InstanceValidationError) Field() string { return e.field }
+func (e AffectedAppInstanceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InstanceValidationError) Reason() string { return e.reason }
+func (e AffectedAppInstanceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InstanceValidationError) Cause() error { return e.cause }
+func (e AffectedAppInstanceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InstanceValidationError) Key() bool { return e.key }
+func (e AffectedAppInstanceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InstanceValidationError) ErrorName() string { return "InstanceValidationError" }
+func (e AffectedAppInstanceValidationError) ErrorName() string {
+	return "AffectedAppInstanceValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e InstanceValidationError) Error() string {
+func (e AffectedAppInstanceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1498,14 +2822,14 @@ func (e InstanceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInstance.%s: %s%s",
+		"invalid %sAffectedAppInstance.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InstanceValidationError{}
+var _ error = AffectedAppInstanceValidationError{}
 
 var _ interface {
 	Field() string
@@ -1513,25 +2837,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InstanceValidationError{}
+} = AffectedAppInstanceValidationError{}
 
-// Validate checks the field values on Template with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Template) Validate() error {
+// Validate checks the field values on AffectedAppInstances with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AffectedAppInstances) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Name
+	for idx, item := range m.GetInstances() {
+		_, _ = idx, item
 
-	// no validation rules for CatalogId
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AffectedAppInstancesValidationError{
+					field:  fmt.Sprintf("Instances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// TemplateValidationError is the validation error returned by
-// Template.Validate if the designated constraints aren't met.
-type TemplateValidationError struct {
+// AffectedAppInstancesValidationError is the validation error returned by
+// AffectedAppInstances.Validate if the designated constraints aren't met.
+type AffectedAppInstancesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1539,22 +2875,24 @@ type TemplateValidationError struct {
 }
 
 // Field function returns field value.
-func (e TemplateValidationError) Field() string { return e.field }
+func (e AffectedAppInstancesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TemplateValidationError) Reason() string { return e.reason }
+func (e AffectedAppInstancesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TemplateValidationError) Cause() error { return e.cause }
+func (e AffectedAppInstancesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TemplateValidationError) Key() bool { return e.key }
+func (e AffectedAppInstancesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TemplateValidationError) ErrorName() string { return "TemplateValidationError" }
+func (e AffectedAppInstancesValidationError) ErrorName() string {
+	return "AffectedAppInstancesValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TemplateValidationError) Error() string {
+func (e AffectedAppInstancesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1566,14 +2904,14 @@ func (e TemplateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTemplate.%s: %s%s",
+		"invalid %sAffectedAppInstances.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TemplateValidationError{}
+var _ error = AffectedAppInstancesValidationError{}
 
 var _ interface {
 	Field() string
@@ -1581,42 +2919,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TemplateValidationError{}
+} = AffectedAppInstancesValidationError{}
 
-// Validate checks the field values on AppInstance with the rules defined in
+// Validate checks the field values on AppsActivation with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
-func (m *AppInstance) Validate() error {
+func (m *AppsActivation) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Name
-
-	// no validation rules for Id
-
-	// no validation rules for Version
-
-	// no validation rules for RootGroupId
-
-	// no validation rules for GroupId
-
-	if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AppInstanceValidationError{
-				field:  "Template",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Apps
 
 	return nil
 }
 
-// AppInstanceValidationError is the validation error returned by
-// AppInstance.Validate if the designated constraints aren't met.
-type AppInstanceValidationError struct {
+// AppsActivationValidationError is the validation error returned by
+// AppsActivation.Validate if the designated constraints aren't met.
+type AppsActivationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1624,22 +2944,22 @@ type AppInstanceValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppInstanceValidationError) Field() string { return e.field }
+func (e AppsActivationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppInstanceValidationError) Reason() string { return e.reason }
+func (e AppsActivationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppInstanceValidationError) Cause() error { return e.cause }
+func (e AppsActivationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppInstanceValidationError) Key() bool { return e.key }
+func (e AppsActivationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppInstanceValidationError) ErrorName() string { return "AppInstanceValidationError" }
+func (e AppsActivationValidationError) ErrorName() string { return "AppsActivationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AppInstanceValidationError) Error() string {
+func (e AppsActivationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1651,14 +2971,14 @@ func (e AppInstanceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppInstance.%s: %s%s",
+		"invalid %sAppsActivation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppInstanceValidationError{}
+var _ error = AppsActivationValidationError{}
 
 var _ interface {
 	Field() string
@@ -1666,40 +2986,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppInstanceValidationError{}
+} = AppsActivationValidationError{}
 
-// Validate checks the field values on App with the rules defined in the proto
-// definition for this message. If any rules are violated, an error is returned.
-func (m *App) Validate() error {
+// Validate checks the field values on InstancePlan with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *InstancePlan) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for Name
 
-	// no validation rules for Cycle
+	// no validation rules for Action
 
-	for idx, item := range m.GetInstances() {
-		_, _ = idx, item
+	// no validation rules for CurrentVersion
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AppValidationError{
-					field:  fmt.Sprintf("Instances[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Version
 
-	}
+	// no validation rules for RootGroupId
+
+	// no validation rules for GroupId
+
+	// no validation rules for Values
+
+	// no validation rules for Diff
 
 	return nil
 }
 
-// AppValidationError is the validation error returned by App.Validate if the
-// designated constraints aren't met.
-type AppValidationError struct {
+// InstancePlanValidationError is the validation error returned by
+// InstancePlan.Validate if the designated constraints aren't met.
+type InstancePlanValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1707,22 +3025,22 @@ type AppValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppValidationError) Field() string { return e.field }
+func (e InstancePlanValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppValidationError) Reason() string { return e.reason }
+func (e InstancePlanValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppValidationError) Cause() error { return e.cause }
+func (e InstancePlanValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppValidationError) Key() bool { return e.key }
+func (e InstancePlanValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppValidationError) ErrorName() string { return "AppValidationError" }
+func (e InstancePlanValidationError) ErrorName() string { return "InstancePlanValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AppValidationError) Error() string {
+func (e InstancePlanValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1734,14 +3052,14 @@ func (e AppValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApp.%s: %s%s",
+		"invalid %sInstancePlan.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppValidationError{}
+var _ error = InstancePlanValidationError{}
 
 var _ interface {
 	Field() string
@@ -1749,22 +3067,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppValidationError{}
+} = InstancePlanValidationError{}
 
-// Validate checks the field values on Apps with the rules defined in the proto
+// Validate checks the field values on Plan with the rules defined in the proto
 // definition for this message. If any rules are violated, an error is returned.
-func (m *Apps) Validate() error {
+func (m *Plan) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetApps() {
+	// no validation rules for Name
+
+	// no validation rules for Cycle
+
+	for idx, item := range m.GetInstances() {
 		_, _ = idx, item
 
 		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AppsValidationError{
-					field:  fmt.Sprintf("Apps[%v]", idx),
+				return PlanValidationError{
+					field:  fmt.Sprintf("Instances[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1776,9 +3098,9 @@ func (m *Apps) Validate() error {
 	return nil
 }
 
-// AppsValidationError is the validation error returned by Apps.Validate if the
+// PlanValidationError is the validation error returned by Plan.Validate if the
 // designated constraints aren't met.
-type AppsValidationError struct {
+type PlanValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1786,22 +3108,22 @@ type AppsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppsValidationError) Field() string { return e.field }
+func (e PlanValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppsValidationError) Reason() string { return e.reason }
+func (e PlanValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppsValidationError) Cause() error { return e.cause }
+func (e PlanValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppsValidationError) Key() bool { return e.key }
+func (e PlanValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppsValidationError) ErrorName() string { return "AppsValidationError" }
+func (e PlanValidationError) ErrorName() string { return "PlanValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AppsValidationError) Error() string {
+func (e PlanValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1813,14 +3135,14 @@ func (e AppsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApps.%s: %s%s",
+		"invalid %sPlan.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppsValidationError{}
+var _ error = PlanValidationError{}
 
 var _ interface {
 	Field() string
@@ -1828,62 +3150,40 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppsValidationError{}
+} = PlanValidationError{}
 
-// Validate checks the field values on AppInfo with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *AppInfo) Validate() error {
+// Validate checks the field values on AppRevision with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AppRevision) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for MonitorUrl
-
-	// no validation rules for LogsUrl
-
-	if v, ok := interface{}(m.GetTotalResources()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AppInfoValidationError{
-				field:  "TotalResources",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for SharedStorage
+	// no validation rules for Revision
 
-	if v, ok := interface{}(m.GetCyclePeriodicFields()).(interface{ Validate() error }); ok {
+	if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AppInfoValidationError{
-				field:  "CyclePeriodicFields",
+			return AppRevisionValidationError{
+				field:  "Timestamp",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetInstances() {
-		_, _ = idx, item
+	// no validation rules for Description
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AppInfoValidationError{
-					field:  fmt.Sprintf("Instances[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Template
 
-	}
+	// no validation rules for Version
 
 	return nil
 }
 
-// AppInfoValidationError is the validation error returned by AppInfo.Validate
-// if the designated constraints aren't met.
-type AppInfoValidationError struct {
+// AppRevisionValidationError is the validation error returned by
+// AppRevision.Validate if the designated constraints aren't met.
+type AppRevisionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1891,22 +3191,22 @@ type AppInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppInfoValidationError) Field() string { return e.field }
+func (e AppRevisionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppInfoValidationError) Reason() string { return e.reason }
+func (e AppRevisionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppInfoValidationError) Cause() error { return e.cause }
+func (e AppRevisionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppInfoValidationError) Key() bool { return e.key }
+func (e AppRevisionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppInfoValidationError) ErrorName() string { return "AppInfoValidationError" }
+func (e AppRevisionValidationError) ErrorName() string { return "AppRevisionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AppInfoValidationError) Error() string {
+func (e AppRevisionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1918,14 +3218,14 @@ func (e AppInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppInfo.%s: %s%s",
+		"invalid %sAppRevision.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppInfoValidationError{}
+var _ error = AppRevisionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1933,23 +3233,39 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppInfoValidationError{}
+} = AppRevisionValidationError{}
 
-// Validate checks the field values on AppsInfo with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *AppsInfo) Validate() error {
+// Validate checks the field values on AppRevisions with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AppRevisions) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Apps
+	// no validation rules for Name
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppRevisionsValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// AppsInfoValidationError is the validation error returned by
-// AppsInfo.Validate if the designated constraints aren't met.
-type AppsInfoValidationError struct {
+// AppRevisionsValidationError is the validation error returned by
+// AppRevisions.Validate if the designated constraints aren't met.
+type AppRevisionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1957,22 +3273,22 @@ type AppsInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppsInfoValidationError) Field() string { return e.field }
+func (e AppRevisionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppsInfoValidationError) Reason() string { return e.reason }
+func (e AppRevisionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppsInfoValidationError) Cause() error { return e.cause }
+func (e AppRevisionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppsInfoValidationError) Key() bool { return e.key }
+func (e AppRevisionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppsInfoValidationError) ErrorName() string { return "AppsInfoValidationError" }
+func (e AppRevisionsValidationError) ErrorName() string { return "AppRevisionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AppsInfoValidationError) Error() string {
+func (e AppRevisionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1984,14 +3300,14 @@ func (e AppsInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppsInfo.%s: %s%s",
+		"invalid %sAppRevisions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppsInfoValidationError{}
+var _ error = AppRevisionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1999,39 +3315,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppsInfoValidationError{}
+} = AppRevisionsValidationError{}
 
-// Validate checks the field values on AppTemplates with the rules defined in
+// Validate checks the field values on ValueChange with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
-func (m *AppTemplates) Validate() error {
+func (m *ValueChange) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for AppName
+	// no validation rules for Key
 
-	for idx, item := range m.GetTemplates() {
-		_, _ = idx, item
+	// no validation rules for Type
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AppTemplatesValidationError{
-					field:  fmt.Sprintf("Templates[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for From
 
-	}
+	// no validation rules for To
 
 	return nil
 }
 
-// AppTemplatesValidationError is the validation error returned by
-// AppTemplates.Validate if the designated constraints aren't met.
-type AppTemplatesValidationError struct {
+// ValueChangeValidationError is the validation error returned by
+// ValueChange.Validate if the designated constraints aren't met.
+type ValueChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2039,22 +3346,22 @@ type AppTemplatesValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppTemplatesValidationError) Field() string { return e.field }
+func (e ValueChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppTemplatesValidationError) Reason() string { return e.reason }
+func (e ValueChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppTemplatesValidationError) Cause() error { return e.cause }
+func (e ValueChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppTemplatesValidationError) Key() bool { return e.key }
+func (e ValueChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppTemplatesValidationError) ErrorName() string { return "AppTemplatesValidationError" }
+func (e ValueChangeValidationError) ErrorName() string { return "ValueChangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AppTemplatesValidationError) Error() string {
+func (e ValueChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2066,14 +3373,14 @@ func (e AppTemplatesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppTemplates.%s: %s%s",
+		"invalid %sValueChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppTemplatesValidationError{}
+var _ error = ValueChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -2081,32 +3388,97 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppTemplatesValidationError{}
+} = ValueChangeValidationError{}
 
-// Validate checks the field values on AffectedAppInstance with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *AffectedAppInstance) Validate() error {
+// Validate checks the field values on AppRevisionDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *AppRevisionDiff) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Name
+	if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppRevisionDiffValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Id
+	if v, ok := interface{}(m.GetBase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppRevisionDiffValidationError{
+				field:  "Base",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Version
+	if v, ok := interface{}(m.GetImage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppRevisionDiffValidationError{
+				field:  "Image",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for RootGroupId
+	for idx, item := range m.GetEnvVars() {
+		_, _ = idx, item
 
-	// no validation rules for GroupId
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppRevisionDiffValidationError{
+					field:  fmt.Sprintf("EnvVars[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetConfigs() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppRevisionDiffValidationError{
+					field:  fmt.Sprintf("Configs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppRevisionDiffValidationError{
+					field:  fmt.Sprintf("Values[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// AffectedAppInstanceValidationError is the validation error returned by
-// AffectedAppInstance.Validate if the designated constraints aren't met.
-type AffectedAppInstanceValidationError struct {
+// AppRevisionDiffValidationError is the validation error returned by
+// AppRevisionDiff.Validate if the designated constraints aren't met.
+type AppRevisionDiffValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2114,24 +3486,22 @@ type AffectedAppInstanceValidationError struct {
 }
 
 // Field function returns field value.
-func (e AffectedAppInstanceValidationError) Field() string { return e.field }
+func (e AppRevisionDiffValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AffectedAppInstanceValidationError) Reason() string { return e.reason }
+func (e AppRevisionDiffValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AffectedAppInstanceValidationError) Cause() error { return e.cause }
+func (e AppRevisionDiffValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AffectedAppInstanceValidationError) Key() bool { return e.key }
+func (e AppRevisionDiffValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AffectedAppInstanceValidationError) ErrorName() string {
-	return "AffectedAppInstanceValidationError"
-}
+func (e AppRevisionDiffValidationError) ErrorName() string { return "AppRevisionDiffValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AffectedAppInstanceValidationError) Error() string {
+func (e AppRevisionDiffValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2143,14 +3513,14 @@ func (e AffectedAppInstanceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAffectedAppInstance.%s: %s%s",
+		"invalid %sAppRevisionDiff.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AffectedAppInstanceValidationError{}
+var _ error = AppRevisionDiffValidationError{}
 
 var _ interface {
 	Field() string
@@ -2158,37 +3528,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AffectedAppInstanceValidationError{}
+} = AppRevisionDiffValidationError{}
 
-// Validate checks the field values on AffectedAppInstances with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *AffectedAppInstances) Validate() error {
+// Validate checks the field values on WatchAppsEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *WatchAppsEvent) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetInstances() {
-		_, _ = idx, item
+	// no validation rules for Type
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AffectedAppInstancesValidationError{
-					field:  fmt.Sprintf("Instances[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+	// no validation rules for AppName
+
+	if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchAppsEventValidationError{
+				field:  "Instance",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchAppsEventValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	return nil
 }
 
-// AffectedAppInstancesValidationError is the validation error returned by
-// AffectedAppInstances.Validate if the designated constraints aren't met.
-type AffectedAppInstancesValidationError struct {
+// WatchAppsEventValidationError is the validation error returned by
+// WatchAppsEvent.Validate if the designated constraints aren't met.
+type WatchAppsEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2196,24 +3575,22 @@ type AffectedAppInstancesValidationError struct {
 }
 
 // Field function returns field value.
-func (e AffectedAppInstancesValidationError) Field() string { return e.field }
+func (e WatchAppsEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AffectedAppInstancesValidationError) Reason() string { return e.reason }
+func (e WatchAppsEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AffectedAppInstancesValidationError) Cause() error { return e.cause }
+func (e WatchAppsEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AffectedAppInstancesValidationError) Key() bool { return e.key }
+func (e WatchAppsEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AffectedAppInstancesValidationError) ErrorName() string {
-	return "AffectedAppInstancesValidationError"
-}
+func (e WatchAppsEventValidationError) ErrorName() string { return "WatchAppsEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AffectedAppInstancesValidationError) Error() string {
+func (e WatchAppsEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2225,14 +3602,14 @@ func (e AffectedAppInstancesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAffectedAppInstances.%s: %s%s",
+		"invalid %sWatchAppsEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AffectedAppInstancesValidationError{}
+var _ error = WatchAppsEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -2240,24 +3617,39 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AffectedAppInstancesValidationError{}
+} = WatchAppsEventValidationError{}
 
-// Validate checks the field values on AppsActivation with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *AppsActivation) Validate() error {
+// Validate checks the field values on LogLine with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *LogLine) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Apps
+	// no validation rules for Instance
+
+	// no validation rules for Pod
+
+	// no validation rules for Container
+
+	if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LogLineValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Line
 
 	return nil
 }
 
-// AppsActivationValidationError is the validation error returned by
-// AppsActivation.Validate if the designated constraints aren't met.
-type AppsActivationValidationError struct {
+// LogLineValidationError is the validation error returned by LogLine.Validate
+// if the designated constraints aren't met.
+type LogLineValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2265,22 +3657,22 @@ type AppsActivationValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppsActivationValidationError) Field() string { return e.field }
+func (e LogLineValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppsActivationValidationError) Reason() string { return e.reason }
+func (e LogLineValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppsActivationValidationError) Cause() error { return e.cause }
+func (e LogLineValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppsActivationValidationError) Key() bool { return e.key }
+func (e LogLineValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppsActivationValidationError) ErrorName() string { return "AppsActivationValidationError" }
+func (e LogLineValidationError) ErrorName() string { return "LogLineValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AppsActivationValidationError) Error() string {
+func (e LogLineValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2292,14 +3684,14 @@ func (e AppsActivationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppsActivation.%s: %s%s",
+		"invalid %sLogLine.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppsActivationValidationError{}
+var _ error = LogLineValidationError{}
 
 var _ interface {
 	Field() string
@@ -2307,7 +3699,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppsActivationValidationError{}
+} = LogLineValidationError{}
 
 // Validate checks the field values on Response with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
@@ -2337,6 +3729,8 @@ func (m *Response) Validate() error {
 		}
 	}
 
+	// no validation rules for RequestId
+
 	return nil
 }
 
@@ -2712,6 +4106,99 @@ var _ interface {
 	ErrorName() string
 } = Spec_ResourcesValidationError{}
 
+// Validate checks the field values on Spec_Autoscaling with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *Spec_Autoscaling) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetMinReplicas() > 100 {
+		return Spec_AutoscalingValidationError{
+			field:  "MinReplicas",
+			reason: "value must be less than or equal to 100",
+		}
+	}
+
+	if m.GetMaxReplicas() > 100 {
+		return Spec_AutoscalingValidationError{
+			field:  "MaxReplicas",
+			reason: "value must be less than or equal to 100",
+		}
+	}
+
+	if m.GetTargetCpuUtilization() > 100 {
+		return Spec_AutoscalingValidationError{
+			field:  "TargetCpuUtilization",
+			reason: "value must be less than or equal to 100",
+		}
+	}
+
+	if m.GetTargetMemoryUtilization() > 100 {
+		return Spec_AutoscalingValidationError{
+			field:  "TargetMemoryUtilization",
+			reason: "value must be less than or equal to 100",
+		}
+	}
+
+	return nil
+}
+
+// Spec_AutoscalingValidationError is the validation error returned by
+// Spec_Autoscaling.Validate if the designated constraints aren't met.
+type Spec_AutoscalingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Spec_AutoscalingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Spec_AutoscalingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Spec_AutoscalingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Spec_AutoscalingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Spec_AutoscalingValidationError) ErrorName() string { return "Spec_AutoscalingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Spec_AutoscalingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpec_Autoscaling.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Spec_AutoscalingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Spec_AutoscalingValidationError{}
+
 // Validate checks the field values on Spec_Resources_Limits with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
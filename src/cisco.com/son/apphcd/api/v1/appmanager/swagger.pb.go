@@ -101,6 +101,14 @@ swagger = `{
             "required": false,
             "type": "boolean",
             "format": "boolean"
+          },
+          {
+            "name": "async",
+            "description": "Return right away with the operation running in background instead of waiting for the result.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
           }
         ],
         "tags": [
@@ -194,6 +202,56 @@ swagger = `{
         ]
       }
     },
+    "/api/v1/apps/watch": {
+      "get": {
+        "summary": "WatchApps streams changes of application instances. Every existing instance is sent as ADDED\nonce the watch is started. Over HTTP the events are streamed as newline-delimited JSON",
+        "operationId": "WatchApps",
+        "responses": {
+          "200": {
+            "description": "A successful response.(streaming responses)",
+            "schema": {
+              "$ref": "#/definitions/appmanagerWatchAppsEvent"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "Application name.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "root_group_id",
+            "description": "Root Group ID.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "group_ids",
+            "description": "A list of group IDs.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          },
+          {
+            "name": "cycle",
+            "description": "Application type.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
     "/api/v1/apps/{name}": {
       "delete": {
         "summary": "DeleteApp deletes instances of a particular running application.\nIt's possible to customize the request by providing appropriate body",
@@ -291,26 +349,285 @@ swagger = `{
           "AppManager"
         ]
       }
+    },
+    "/api/v1/apps/{name}/logs": {
+      "get": {
+        "summary": "StreamAppLogs streams logs of application instances through the controller, so they are available\neven if the logging stack isn't. Over HTTP the lines are streamed as newline-delimited JSON",
+        "operationId": "StreamAppLogs",
+        "responses": {
+          "200": {
+            "description": "A successful response.(streaming responses)",
+            "schema": {
+              "$ref": "#/definitions/appmanagerLogLine"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "Application name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "group_id",
+            "description": "Group ID. If omitted, logs of all application instances are streamed.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "container",
+            "description": "Container name. If omitted, logs of all containers are streamed.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "tail_lines",
+            "description": "Number of lines from the end of the logs to show. If omitted, all lines are shown.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "int64"
+          },
+          {
+            "name": "since_time",
+            "description": "Show only the lines written since the time.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "follow",
+            "description": "Keep streaming the new lines.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
+    "/api/v1/apps/{name}/revisions": {
+      "get": {
+        "summary": "ListAppRevisions lists the revisions of the application charts kept in the applications catalog, the latest first.\nEvery change of a chart is a commit of the catalog described by the request that made it",
+        "operationId": "ListAppRevisions",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/appmanagerResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "Application name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "template",
+            "description": "Template (application instance) name. Revisions of all templates are listed if omitted.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "version",
+            "description": "Chart version. Revisions of all versions are listed if omitted.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "description": "Maximum number of revisions, the latest first. All revisions are listed if omitted.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
+    "/api/v1/apps/{name}/revisions/{revision}": {
+      "get": {
+        "summary": "GetAppRevision gets a revision of an application chart with the changes of the image, the environment variables,\nthe configuration files and the other values against the base revision",
+        "operationId": "GetAppRevision",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/appmanagerResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "Application name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "revision",
+            "description": "Revision (commit hash or its prefix) of the applications catalog",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "template",
+            "description": "Template (application instance) name. Required if the revision changed several charts of the application.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "version",
+            "description": "Chart version. Required if the revision changed several charts of the application.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "base_revision",
+            "description": "Revision (commit hash, branch or tag) the chart is compared with.\nIf omitted, the previous revision of the template is used.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "base_version",
+            "description": "Chart version the chart is compared with. The version of the chart if omitted.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
+    "/api/v1/apps/{name}/rollback": {
+      "post": {
+        "summary": "RollbackApp rolls back application instances to the chart of a previous version or a previous revision\nof the applications catalog. The chart is restored from the catalog history if it was removed or replaced since.\nInstances already running the requested chart are left as they are",
+        "operationId": "RollbackApp",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/appmanagerResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "Application name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/appmanagerRollbackAppRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
+    "/api/v1/apps/{name}/scale": {
+      "post": {
+        "summary": "ScaleApp changes the number of replicas of daemon application instances without recreating them.\nInstances scaled by the horizontal pod autoscaler are not supported",
+        "operationId": "ScaleApp",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/appmanagerResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "Application name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/appmanagerScaleAppRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
     }
   },
   "definitions": {
-    "CyclePeriodicReqAttrSched": {
+    "ContainerVolumeMount": {
       "type": "object",
       "properties": {
-        "Saturday": {
-          "type": "boolean",
-          "format": "boolean"
+        "name": {
+          "type": "string"
         },
-        "Sunday": {
-          "type": "boolean",
-          "format": "boolean"
+        "mount_path": {
+          "type": "string"
         },
-        "Monday": {
+        "read_only": {
           "type": "boolean",
           "format": "boolean"
         },
-        "Tuesday": {
-          "type": "boolean",
+        "sub_path": {
+          "type": "string"
+        }
+      }
+    },
+    "CyclePeriodicReqAttrSched": {
+      "type": "object",
+      "properties": {
+        "Saturday": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "Sunday": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "Monday": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "Tuesday": {
+          "type": "boolean",
           "format": "boolean"
         },
         "Wednesday": {
@@ -327,6 +644,100 @@ swagger = `{
         }
       }
     },
+    "InstanceContainer": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "image": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "ports": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InstanceContainerPort"
+          }
+        },
+        "vol_mounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ContainerVolumeMount"
+          }
+        }
+      }
+    },
+    "InstanceContainerPort": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "port": {
+          "type": "string",
+          "format": "int64"
+        },
+        "dns_name": {
+          "type": "string"
+        },
+        "host_ip": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string"
+        },
+        "proto": {
+          "type": "string"
+        },
+        "src_port": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "InstancePublicEndpoint": {
+      "type": "object",
+      "properties": {
+        "addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "all_nodes": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "ingress_id": {
+          "type": "string"
+        },
+        "node_id": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "pod_id": {
+          "type": "string"
+        },
+        "port": {
+          "type": "string",
+          "format": "int64"
+        },
+        "proto": {
+          "type": "string"
+        },
+        "service_id": {
+          "type": "string"
+        }
+      }
+    },
     "PortProto": {
       "type": "string",
       "enum": [
@@ -336,6 +747,47 @@ swagger = `{
       "default": "TCP",
       "description": "Protocol (\"TCP\" or \"UDP\")."
     },
+    "ResourcesRequests": {
+      "type": "object",
+      "properties": {
+        "memory": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Memory in MiB"
+        },
+        "cpu": {
+          "type": "number",
+          "format": "double",
+          "title": "CPU in millicores"
+        }
+      }
+    },
+    "SpecAutoscaling": {
+      "type": "object",
+      "properties": {
+        "min_replicas": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Minimum number of replicas. Defaults to 1"
+        },
+        "max_replicas": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Maximum number of replicas. The autoscaler is removed if set to 0"
+        },
+        "target_cpu_utilization": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Target average CPU utilization in percent of the requested CPU"
+        },
+        "target_memory_utilization": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Target average memory utilization in percent of the requested memory"
+        }
+      },
+      "title": "Horizontal pod autoscaler settings. Supported by daemon applications only"
+    },
     "SpecImage": {
       "type": "object",
       "properties": {
@@ -396,116 +848,362 @@ swagger = `{
           "type": "string",
           "title": "Root Group ID"
         },
-        "group_ids": {
+        "group_ids": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "A list of group ID"
+        },
+        "app_configs": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Application configurations"
+        },
+        "env_vars": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Application environment variables"
+        },
+        "secrets": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Secret objects"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Labels"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Annotations"
+        },
+        "shared_storage": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Dependencies\nrepeated Dependency deps = 12;\nShared storage size"
+        },
+        "spec": {
+          "$ref": "#/definitions/appmanagerSpec",
+          "title": "Instance specifications"
+        },
+        "async": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Return right away with the operation running in background instead of waiting for the result"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Plan the changes without applying them. The response body holds the plan"
+        }
+      },
+      "title": "CreateAppRequest holds appropriate properties required for a particular request\nrelated to a new application instance creation"
+    },
+    "appmanagerCyclePeriodicReqAttr": {
+      "type": "object",
+      "properties": {
+        "working_days": {
+          "$ref": "#/definitions/CyclePeriodicReqAttrSched"
+        },
+        "min_start_hour": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "max_start_hour": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "interval_min": {
+          "type": "integer",
+          "format": "int64"
+        }
+      },
+      "title": "CyclePeriodicReqAttr message holds information for an application of type periodic"
+    },
+    "appmanagerDeleteAppMetadataRequest": {
+      "type": "object",
+      "properties": {
+        "app_name": {
+          "type": "string",
+          "title": "Application name"
+        },
+        "version": {
+          "type": "string",
+          "title": "Deletes only a particular version of application instance"
+        },
+        "root_group_id": {
+          "type": "string",
+          "title": "Root Group ID"
+        },
+        "group_ids": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "A list of group IDs"
+        }
+      },
+      "title": "DeleteAppMetadataRequest holds attributes required for deleting\nmetadata for appropriate application and related instances"
+    },
+    "appmanagerDeleteAppRequest": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "description": "Application name."
+        },
+        "version": {
+          "type": "string",
+          "description": "Application version."
+        },
+        "root_group_id": {
+          "type": "string",
+          "title": "Root Group ID"
+        },
+        "group_ids": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "A list of group IDs"
+        },
+        "purge": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Indicates whether the app should be also removed from catalog"
+        },
+        "async": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Return right away with the operation running in background instead of waiting for the result"
+        }
+      },
+      "title": "DeleteAppRequest holds attributes required for deleting\nappropriate application and its related instances"
+    },
+    "appmanagerEnableDisableAppRequest": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "Application name"
+        },
+        "version": {
+          "type": "string",
+          "title": "Application version"
+        },
+        "root_group_id": {
+          "type": "string",
+          "title": "Root Group ID"
+        },
+        "group_ids": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "A list of group IDs"
+        },
+        "disable": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Enable or disable application instances"
+        }
+      },
+      "title": "EnableDisableAppRequest holds attributes required for disabling or enabling appropriate application instance"
+    },
+    "appmanagerEventType": {
+      "type": "string",
+      "enum": [
+        "ADDED",
+        "MODIFIED",
+        "DELETED"
+      ],
+      "default": "ADDED",
+      "description": "- ADDED: Application instance appeared. Sent for every existing instance once the watch is started\n - MODIFIED: Application instance changed, e.g. its state or version\n - DELETED: Application instance was removed",
+      "title": "Type of application instance change"
+    },
+    "appmanagerInstance": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        },
+        "root_group_id": {
+          "type": "string"
+        },
+        "group_id": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "cycle": {
+          "type": "string"
+        },
+        "image_repo": {
+          "type": "string"
+        },
+        "image_name": {
+          "type": "string"
+        },
+        "image_tag": {
+          "type": "string"
+        },
+        "periodic_fields": {
+          "$ref": "#/definitions/appmanagerPeriodicFields"
+        },
+        "run_once_fields": {
+          "$ref": "#/definitions/appmanagerRunOnceFields"
+        },
+        "create_date": {
+          "type": "string"
+        },
+        "update_date": {
+          "type": "string"
+        },
+        "project_id": {
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "scale": {
+          "type": "string"
+        },
+        "resources": {
+          "$ref": "#/definitions/v1appmanagerResources"
+        },
+        "containers": {
           "type": "array",
           "items": {
-            "type": "string"
-          },
-          "title": "A list of group ID"
-        },
-        "app_configs": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          },
-          "title": "Application configurations"
+            "$ref": "#/definitions/InstanceContainer"
+          }
         },
-        "env_vars": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          },
-          "title": "Application environment variables"
+        "public_endpoints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InstancePublicEndpoint"
+          }
         },
-        "secrets": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          },
-          "title": "Secret objects"
+        "template": {
+          "$ref": "#/definitions/appmanagerTemplate"
         },
-        "labels": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          },
-          "title": "Labels"
+        "desired_replicas": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Replicas of the workload of a daemon application instance"
         },
-        "annotations": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          },
-          "title": "Annotations"
+        "ready_replicas": {
+          "type": "integer",
+          "format": "int64"
         },
-        "shared_storage": {
+        "available_replicas": {
           "type": "integer",
-          "format": "int64",
-          "title": "Dependencies\nrepeated Dependency deps = 12;\nShared storage size"
+          "format": "int64"
+        }
+      },
+      "title": "Instance message holds information about a particular workload"
+    },
+    "appmanagerLogLine": {
+      "type": "object",
+      "properties": {
+        "instance": {
+          "type": "string",
+          "title": "Application instance name"
         },
-        "spec": {
-          "$ref": "#/definitions/appmanagerSpec",
-          "title": "Instance specifications"
+        "pod": {
+          "type": "string",
+          "title": "Pod name"
+        },
+        "container": {
+          "type": "string",
+          "title": "Container name"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time",
+          "title": "Time the line was written"
+        },
+        "line": {
+          "type": "string"
         }
       },
-      "title": "CreateAppRequest holds appropriate properties required for a particular request\nrelated to a new application instance creation"
+      "title": "LogLine is a line of application instance logs"
     },
-    "appmanagerCyclePeriodicReqAttr": {
+    "appmanagerPeriodicFields": {
       "type": "object",
       "properties": {
-        "working_days": {
-          "$ref": "#/definitions/CyclePeriodicReqAttrSched"
+        "schedule": {
+          "type": "string"
         },
-        "min_start_hour": {
-          "type": "integer",
-          "format": "int64"
+        "last_schedule_time": {
+          "type": "string"
         },
-        "max_start_hour": {
-          "type": "integer",
+        "failed_jobs_history_limit": {
+          "type": "string",
           "format": "int64"
         },
-        "interval_min": {
-          "type": "integer",
+        "successful_jobs_history_limit": {
+          "type": "string",
           "format": "int64"
         }
-      },
-      "title": "CyclePeriodicReqAttr message holds information for an application of type periodic"
+      }
     },
-    "appmanagerDeleteAppMetadataRequest": {
+    "appmanagerResponse": {
       "type": "object",
       "properties": {
-        "app_name": {
+        "timestamp": {
           "type": "string",
-          "title": "Application name"
+          "format": "date-time"
         },
-        "version": {
-          "type": "string",
-          "title": "Deletes only a particular version of application instance"
+        "status": {
+          "$ref": "#/definitions/appmanagerStatus"
         },
-        "root_group_id": {
-          "type": "string",
-          "title": "Root Group ID"
+        "message": {
+          "type": "string"
         },
-        "group_ids": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "title": "A list of group IDs"
+        "body": {
+          "$ref": "#/definitions/protobufAny"
+        },
+        "request_id": {
+          "type": "string",
+          "title": "Request ID the response correlates with in the controller logs"
         }
       },
-      "title": "DeleteAppMetadataRequest holds attributes required for deleting\nmetadata for appropriate application and related instances"
+      "title": "Response holds information related to a response message that is sent on appropriate request"
     },
-    "appmanagerDeleteAppRequest": {
+    "appmanagerRollbackAppRequest": {
       "type": "object",
       "properties": {
         "name": {
           "type": "string",
-          "description": "Application name."
+          "title": "Application name"
         },
         "version": {
           "type": "string",
-          "description": "Application version."
+          "title": "Version to roll back to. The running version if omitted"
+        },
+        "revision": {
+          "type": "string",
+          "title": "Revision (commit hash, branch or tag) of the applications catalog the chart is restored from.\nIf omitted, the chart is taken from the catalog or from the latest revision holding it if it was removed"
         },
         "root_group_id": {
           "type": "string",
@@ -516,26 +1214,58 @@ swagger = `{
           "items": {
             "type": "string"
           },
-          "title": "A list of group IDs"
+          "title": "A list of group IDs. All application instances are rolled back if omitted"
         },
-        "purge": {
+        "async": {
           "type": "boolean",
           "format": "boolean",
-          "title": "Indicates whether the app should be also removed from catalog"
+          "title": "Return right away with the operation running in background instead of waiting for the result"
         }
       },
-      "title": "DeleteAppRequest holds attributes required for deleting\nappropriate application and its related instances"
+      "title": "RollbackAppRequest holds the attributes required for rolling back application instances\nto the chart of a previous version or a previous revision of the applications catalog"
     },
-    "appmanagerEnableDisableAppRequest": {
+    "appmanagerRunOnceFields": {
+      "type": "object",
+      "properties": {
+        "active": {
+          "type": "string",
+          "format": "int64"
+        },
+        "completions": {
+          "type": "string",
+          "format": "int64"
+        },
+        "failed": {
+          "type": "string",
+          "format": "int64"
+        },
+        "succeeded": {
+          "type": "string",
+          "format": "int64"
+        },
+        "start_time": {
+          "type": "string"
+        },
+        "completion_time": {
+          "type": "string"
+        }
+      }
+    },
+    "appmanagerScaleAppRequest": {
       "type": "object",
       "properties": {
         "name": {
           "type": "string",
           "title": "Application name"
         },
+        "replicas": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Number of replicas of every selected application instance"
+        },
         "version": {
           "type": "string",
-          "title": "Application version"
+          "title": "Scale only the application instances running the version"
         },
         "root_group_id": {
           "type": "string",
@@ -546,34 +1276,15 @@ swagger = `{
           "items": {
             "type": "string"
           },
-          "title": "A list of group IDs"
+          "title": "A list of group IDs. All application instances are scaled if omitted"
         },
-        "disable": {
+        "async": {
           "type": "boolean",
           "format": "boolean",
-          "title": "Enable or disable application instances"
-        }
-      },
-      "title": "EnableDisableAppRequest holds attributes required for disabling or enabling appropriate application instance"
-    },
-    "appmanagerResponse": {
-      "type": "object",
-      "properties": {
-        "timestamp": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "status": {
-          "$ref": "#/definitions/appmanagerStatus"
-        },
-        "message": {
-          "type": "string"
-        },
-        "body": {
-          "$ref": "#/definitions/protobufAny"
+          "title": "Return right away with the operation running in background instead of waiting for the result"
         }
       },
-      "title": "Response holds information related to a response message that is sent on appropriate request"
+      "title": "ScaleAppRequest holds the attributes required for changing the number of replicas of daemon application instances"
     },
     "appmanagerSpec": {
       "type": "object",
@@ -589,6 +1300,14 @@ swagger = `{
         },
         "resources": {
           "$ref": "#/definitions/appmanagerSpecResources"
+        },
+        "replicas": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Number of replicas of every application instance. Defaults to 1"
+        },
+        "autoscaling": {
+          "$ref": "#/definitions/SpecAutoscaling"
         }
       },
       "title": "Specification message"
@@ -642,10 +1361,29 @@ swagger = `{
         "SUCCESS",
         "ERROR",
         "NOT_FOUND",
-        "UNCHANGED"
+        "UNCHANGED",
+        "IN_PROGRESS"
       ],
       "default": "SUCCESS",
-      "description": "Status represents operation status.\n\n - SUCCESS: Operation was successful\n - ERROR: Operation failed\n - NOT_FOUND: Resource not found\n - UNCHANGED: Operation had no results (e.g. upgrade identical, rollback to same, delete non-existent)"
+      "description": "Status represents operation status.\n\n - SUCCESS: Operation was successful\n - ERROR: Operation failed\n - NOT_FOUND: Resource not found\n - UNCHANGED: Operation had no results (e.g. upgrade identical, rollback to same, delete non-existent)\n - IN_PROGRESS: Operation started and is running in background. The response body holds the operation"
+    },
+    "appmanagerTemplate": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "catalog_id": {
+          "type": "string"
+        }
+      },
+      "title": "Template message holds information about metadata for a particular application instance"
     },
     "appmanagerUpdateAppRequest": {
       "type": "object",
@@ -733,6 +1471,20 @@ swagger = `{
         "spec": {
           "$ref": "#/definitions/appmanagerSpec",
           "description": "Instance specifications."
+        },
+        "async": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Return right away with the operation running in background instead of waiting for the result"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Plan the changes without applying them. The response body holds the plan"
+        },
+        "strategy": {
+          "$ref": "#/definitions/appmanagerUpgradeStrategy",
+          "title": "Order in which the running instances are upgraded. By default all of them are upgraded at once"
         }
       },
       "title": "UpdadeApp implements the logic of UpgradeApp but allows also to update currently running application\nWith the new configuration and if appropriate information is missed in the request, the information will\nbe obtained from one of running instances of a particular application"
@@ -823,10 +1575,82 @@ swagger = `{
         "spec": {
           "$ref": "#/definitions/appmanagerSpec",
           "title": "Instance specifications"
+        },
+        "async": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Return right away with the operation running in background instead of waiting for the result"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Plan the changes without applying them. The response body holds the plan"
+        },
+        "strategy": {
+          "$ref": "#/definitions/appmanagerUpgradeStrategy",
+          "title": "Order in which the running instances are upgraded. By default all of them are upgraded at once"
         }
       },
       "title": "UpgradeAppRequest holds the attributes required for creating a new or upgrading existing application instance"
     },
+    "appmanagerUpgradeStrategy": {
+      "type": "object",
+      "properties": {
+        "type": {
+          "$ref": "#/definitions/appmanagerUpgradeStrategyType"
+        },
+        "max_unavailable": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Maximum number of application instances upgraded at once by the rolling strategy. Defaults to 1"
+        },
+        "canary_group_ids": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Group IDs of the application instances upgraded first by the canary strategy"
+        },
+        "health_check_delay": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Seconds to wait after each batch before checking the application instances are still healthy"
+        }
+      },
+      "title": "UpgradeStrategy tells how the running application instances are upgraded. The instances upgraded\nby the batches done so far are rolled back as soon as a batch fails"
+    },
+    "appmanagerUpgradeStrategyType": {
+      "type": "string",
+      "enum": [
+        "ALL_AT_ONCE",
+        "ROLLING",
+        "CANARY"
+      ],
+      "default": "ALL_AT_ONCE",
+      "description": "- ALL_AT_ONCE: All the application instances are upgraded in parallel\n - ROLLING: Application instances are upgraded in batches of max_unavailable instances\n - CANARY: Application instances of the canary groups are upgraded first and the rest once they are healthy",
+      "title": "Upgrade strategy modes"
+    },
+    "appmanagerWatchAppsEvent": {
+      "type": "object",
+      "properties": {
+        "type": {
+          "$ref": "#/definitions/appmanagerEventType"
+        },
+        "app_name": {
+          "type": "string",
+          "title": "Application name"
+        },
+        "instance": {
+          "$ref": "#/definitions/appmanagerInstance",
+          "title": "Latest application instance. The last known one for DELETED events"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      },
+      "title": "WatchAppsEvent describes a change of an application instance"
+    },
     "protobufAny": {
       "type": "object",
       "properties": {
@@ -841,6 +1665,36 @@ swagger = `{
         }
       },
       "description": "\"Any\" contains an arbitrary serialized protocol buffer message along with a\nURL that describes the type of the serialized message.\n\nProtobuf library provides support to pack/unpack Any values in the form\nof utility functions or additional generated methods of the Any type.\n\nExample 1: Pack and unpack a message in C++.\n\n    Foo foo = ...;\n    Any any;\n    any.PackFrom(foo);\n    ...\n    if (any.UnpackTo(\u0026foo)) {\n      ...\n    }\n\nExample 2: Pack and unpack a message in Java.\n\n    Foo foo = ...;\n    Any any = Any.pack(foo);\n    ...\n    if (any.is(Foo.class)) {\n      foo = any.unpack(Foo.class);\n    }\n\n Example 3: Pack and unpack a message in Python.\n\n    foo = Foo(...)\n    any = Any()\n    any.Pack(foo)\n    ...\n    if any.Is(Foo.DESCRIPTOR):\n      any.Unpack(foo)\n      ...\n\n Example 4: Pack and unpack a message in Go\n\n     foo := \u0026pb.Foo{...}\n     any, err := ptypes.MarshalAny(foo)\n     ...\n     foo := \u0026pb.Foo{}\n     if err := ptypes.UnmarshalAny(any, foo); err != nil {\n       ...\n     }\n\nThe pack methods provided by protobuf library will by default use\n'type.googleapis.com/full.type.name' as the type URL and the unpack\nmethods only use the fully qualified type name after the last '/'\nin the type URL, for example \"foo.bar.com/x/y.z\" will yield type\nname \"y.z\".\n\n\nJSON\n====\nThe JSON representation of an \"Any\" value uses the regular\nrepresentation of the deserialized, embedded message, with an\nadditional field \"@type\" which contains the type URL. Example:\n\n    package google.profile;\n    message Person {\n      string first_name = 1;\n      string last_name = 2;\n    }\n\n    {\n      \"@type\": \"type.googleapis.com/google.profile.Person\",\n      \"firstName\": \u003cstring\u003e,\n      \"lastName\": \u003cstring\u003e\n    }\n\nIf the embedded message type is well-known and has a custom JSON\nrepresentation, that representation will be embedded adding a field\n\"value\" which holds the custom JSON in addition to the \"@type\"\nfield. Example (for message [google.protobuf.Duration][]):\n\n    {\n      \"@type\": \"type.googleapis.com/google.protobuf.Duration\",\n      \"value\": \"1.212s\"\n    }"
+    },
+    "v1appmanagerResources": {
+      "type": "object",
+      "properties": {
+        "requests": {
+          "$ref": "#/definitions/ResourcesRequests"
+        },
+        "limits": {
+          "$ref": "#/definitions/v1appmanagerResourcesLimits"
+        },
+        "persistent_storage": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "v1appmanagerResourcesLimits": {
+      "type": "object",
+      "properties": {
+        "memory": {
+          "type": "integer",
+          "format": "int64",
+          "title": "Memory in MiB"
+        },
+        "cpu": {
+          "type": "number",
+          "format": "double",
+          "title": "CPU in milli cores"
+        }
+      }
     }
   }
 }
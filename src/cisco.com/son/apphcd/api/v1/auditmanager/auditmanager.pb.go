@@ -0,0 +1,413 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: auditmanager.proto
+
+package auditmanager
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/envoyproxy/protoc-gen-validate/validate"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Record represents a mutating request handled by the controller
+type Record struct {
+	// Record identifier
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Time the request was received
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Identity of the caller
+	Identity string `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Method handled the request, e.g. AppManager/CreateApp
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// Request in JSON format. Values of the secrets and application configurations are redacted
+	Request string `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	// Applications affected by the request
+	Apps []string `protobuf:"bytes,6,rep,name=apps,proto3" json:"apps,omitempty"`
+	// Application instances affected by the request
+	Instances []string `protobuf:"bytes,7,rep,name=instances,proto3" json:"instances,omitempty"`
+	// gRPC status code, e.g. OK or PermissionDenied
+	Code string `protobuf:"bytes,8,opt,name=code,proto3" json:"code,omitempty"`
+	// Status of the response, e.g. SUCCESS or ERROR
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// Message of the response or the error
+	Message string `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`
+	// Long-running operation the request was handled by
+	OperationId string `protobuf:"bytes,11,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// Time taken to handle the request including the long-running operation
+	Duration             *duration.Duration `protobuf:"bytes,12,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Record) Reset()         { *m = Record{} }
+func (m *Record) String() string { return proto.CompactTextString(m) }
+func (*Record) ProtoMessage()    {}
+func (*Record) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auditmanager_ad3ce54625c8632f, []int{0}
+}
+func (m *Record) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Record.Unmarshal(m, b)
+}
+func (m *Record) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Record.Marshal(b, m, deterministic)
+}
+func (dst *Record) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record.Merge(dst, src)
+}
+func (m *Record) XXX_Size() int {
+	return xxx_messageInfo_Record.Size(m)
+}
+func (m *Record) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record proto.InternalMessageInfo
+
+func (m *Record) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Record) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Record) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *Record) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *Record) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *Record) GetApps() []string {
+	if m != nil {
+		return m.Apps
+	}
+	return nil
+}
+
+func (m *Record) GetInstances() []string {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+func (m *Record) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Record) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Record) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Record) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *Record) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+// ListAuditRecordsRequest holds attributes required for querying audit records.
+// Empty attributes match any record
+type ListAuditRecordsRequest struct {
+	// Records of the requests received at the time or later
+	StartTime *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Records of the requests received before the time
+	EndTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Identity of the caller
+	Identity string `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Application name
+	AppName string `protobuf:"bytes,4,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	// Method handled the requests, e.g. AppManager/CreateApp
+	Action string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	// Maximum number of records. Defaults to 1000
+	Limit                uint32   `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditRecordsRequest) Reset()         { *m = ListAuditRecordsRequest{} }
+func (m *ListAuditRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditRecordsRequest) ProtoMessage()    {}
+func (*ListAuditRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auditmanager_ad3ce54625c8632f, []int{1}
+}
+func (m *ListAuditRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditRecordsRequest.Unmarshal(m, b)
+}
+func (m *ListAuditRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditRecordsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAuditRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditRecordsRequest.Merge(dst, src)
+}
+func (m *ListAuditRecordsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditRecordsRequest.Size(m)
+}
+func (m *ListAuditRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditRecordsRequest proto.InternalMessageInfo
+
+func (m *ListAuditRecordsRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ListAuditRecordsRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *ListAuditRecordsRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *ListAuditRecordsRequest) GetAppName() string {
+	if m != nil {
+		return m.AppName
+	}
+	return ""
+}
+
+func (m *ListAuditRecordsRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ListAuditRecordsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// ListAuditRecordsResponse holds the records sorted by time
+type ListAuditRecordsResponse struct {
+	Records              []*Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListAuditRecordsResponse) Reset()         { *m = ListAuditRecordsResponse{} }
+func (m *ListAuditRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditRecordsResponse) ProtoMessage()    {}
+func (*ListAuditRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_auditmanager_ad3ce54625c8632f, []int{2}
+}
+func (m *ListAuditRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditRecordsResponse.Unmarshal(m, b)
+}
+func (m *ListAuditRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditRecordsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListAuditRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditRecordsResponse.Merge(dst, src)
+}
+func (m *ListAuditRecordsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuditRecordsResponse.Size(m)
+}
+func (m *ListAuditRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditRecordsResponse proto.InternalMessageInfo
+
+func (m *ListAuditRecordsResponse) GetRecords() []*Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Record)(nil), "com.cisco.son.apphcd.api.v1.auditmanager.Record")
+	proto.RegisterType((*ListAuditRecordsRequest)(nil), "com.cisco.son.apphcd.api.v1.auditmanager.ListAuditRecordsRequest")
+	proto.RegisterType((*ListAuditRecordsResponse)(nil), "com.cisco.son.apphcd.api.v1.auditmanager.ListAuditRecordsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AuditManagerClient is the client API for AuditManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditManagerClient interface {
+	// ListAuditRecords shows the records of the mutating requests
+	ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error)
+}
+
+type auditManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditManagerClient(cc *grpc.ClientConn) AuditManagerClient {
+	return &auditManagerClient{cc}
+}
+
+func (c *auditManagerClient) ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error) {
+	out := new(ListAuditRecordsResponse)
+	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.auditmanager.AuditManager/ListAuditRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditManagerServer is the server API for AuditManager service.
+type AuditManagerServer interface {
+	// ListAuditRecords shows the records of the mutating requests
+	ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error)
+}
+
+func RegisterAuditManagerServer(s *grpc.Server, srv AuditManagerServer) {
+	s.RegisterService(&_AuditManager_serviceDesc, srv)
+}
+
+func _AuditManager_ListAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditManagerServer).ListAuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.cisco.son.apphcd.api.v1.auditmanager.AuditManager/ListAuditRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditManagerServer).ListAuditRecords(ctx, req.(*ListAuditRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AuditManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "com.cisco.son.apphcd.api.v1.auditmanager.AuditManager",
+	HandlerType: (*AuditManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditRecords",
+			Handler:    _AuditManager_ListAuditRecords_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auditmanager.proto",
+}
+
+func init() { proto.RegisterFile("auditmanager.proto", fileDescriptor_auditmanager_ad3ce54625c8632f) }
+
+var fileDescriptor_auditmanager_ad3ce54625c8632f = []byte{
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3d, 0x8f, 0x13, 0x31,
+	0x10, 0xd5, 0x26, 0x97, 0x2f, 0x27, 0x9c, 0x90, 0x25, 0x38, 0x5f, 0x74, 0xc0, 0x92, 0x6a, 0x85,
+	0x74, 0x5e, 0x2e, 0x08, 0x09, 0xca, 0x44, 0x34, 0x20, 0xb8, 0x62, 0x45, 0x45, 0x13, 0x4d, 0xd6,
+	0xbe, 0x3d, 0x4b, 0x59, 0xdb, 0xac, 0xbd, 0x11, 0x69, 0xf9, 0x05, 0x48, 0xfc, 0x19, 0x44, 0x45,
+	0x4b, 0xcd, 0x5f, 0xa0, 0xa1, 0xa5, 0xa6, 0x40, 0x6b, 0x6f, 0x72, 0xe1, 0x22, 0xb8, 0x93, 0xe8,
+	0x3c, 0x9e, 0x37, 0xef, 0xcd, 0xcc, 0xf3, 0x2e, 0xc2, 0x50, 0x32, 0x61, 0x73, 0x90, 0x90, 0xf1,
+	0x82, 0xea, 0x42, 0x59, 0x85, 0xa3, 0x54, 0xe5, 0x34, 0x15, 0x26, 0x55, 0xd4, 0x28, 0x49, 0x41,
+	0xeb, 0xf3, 0x94, 0x51, 0xd0, 0x82, 0x2e, 0x4f, 0xe8, 0x36, 0x7e, 0x78, 0x94, 0x29, 0x95, 0x2d,
+	0x78, 0x0c, 0x5a, 0xc4, 0x20, 0xa5, 0xb2, 0x60, 0x85, 0x92, 0xc6, 0xf3, 0x0c, 0xef, 0xd5, 0x59,
+	0x17, 0xcd, 0xcb, 0xb3, 0xd8, 0x8a, 0x9c, 0x1b, 0x0b, 0xb9, 0xae, 0x01, 0x77, 0x2f, 0x03, 0x58,
+	0x59, 0x38, 0x86, 0x3a, 0x3f, 0xc9, 0x84, 0x3d, 0x2f, 0xe7, 0x34, 0x55, 0x79, 0xcc, 0xe5, 0x52,
+	0xad, 0x74, 0xa1, 0xde, 0xad, 0x3c, 0x3c, 0x3d, 0xce, 0xb8, 0x3c, 0x5e, 0xc2, 0x42, 0x30, 0xb0,
+	0x3c, 0xde, 0x39, 0x78, 0x8a, 0xd1, 0xcf, 0x06, 0x6a, 0x27, 0x3c, 0x55, 0x05, 0xc3, 0xfb, 0xa8,
+	0x21, 0x18, 0x09, 0xc2, 0x20, 0xea, 0x25, 0x0d, 0xc1, 0xf0, 0x13, 0xd4, 0xdb, 0x34, 0x44, 0x1a,
+	0x61, 0x10, 0xf5, 0xc7, 0x43, 0xea, 0x3b, 0xa2, 0xeb, 0x8e, 0xe8, 0xeb, 0x35, 0x22, 0xb9, 0x00,
+	0xe3, 0x21, 0xea, 0x0a, 0xc6, 0xa5, 0x15, 0x76, 0x45, 0x9a, 0x8e, 0x6f, 0x13, 0xe3, 0xdb, 0xa8,
+	0x0d, 0x69, 0x35, 0x03, 0xd9, 0x73, 0x99, 0x3a, 0xc2, 0x04, 0x75, 0x0a, 0xfe, 0xb6, 0xe4, 0xc6,
+	0x92, 0x96, 0x4b, 0xac, 0x43, 0x8c, 0xd1, 0x1e, 0x68, 0x6d, 0x48, 0x3b, 0x6c, 0x46, 0xbd, 0xc4,
+	0x9d, 0xf1, 0x11, 0xea, 0x09, 0x69, 0x2c, 0xc8, 0x94, 0x1b, 0xd2, 0x71, 0x89, 0x8b, 0x8b, 0xaa,
+	0x22, 0x55, 0x8c, 0x93, 0xae, 0x23, 0x72, 0xe7, 0x4a, 0xd7, 0x58, 0xb0, 0xa5, 0x21, 0x3d, 0xaf,
+	0xeb, 0xa3, 0x4a, 0x37, 0xe7, 0xc6, 0x40, 0xc6, 0x09, 0xf2, 0xba, 0x75, 0x88, 0xef, 0xa3, 0x81,
+	0xd2, 0xdc, 0x2f, 0x7c, 0x26, 0x18, 0xe9, 0xbb, 0x74, 0x7f, 0x73, 0xf7, 0x9c, 0xe1, 0xc7, 0xa8,
+	0xbb, 0xb6, 0x84, 0x0c, 0xdc, 0x86, 0x0e, 0x77, 0x36, 0xf4, 0xac, 0x06, 0x24, 0x1b, 0xe8, 0xe8,
+	0x57, 0x80, 0x0e, 0x5e, 0x0a, 0x63, 0x27, 0xd5, 0x5b, 0xf1, 0xdb, 0x37, 0x49, 0x3d, 0xed, 0x53,
+	0x84, 0x8c, 0x85, 0xc2, 0xce, 0xaa, 0x75, 0x92, 0xe0, 0xea, 0xb5, 0x3b, 0x74, 0x15, 0x57, 0xdd,
+	0x70, 0xc9, 0x7c, 0xe1, 0xd5, 0x7e, 0x75, 0xb8, 0x64, 0xae, 0xec, 0x5f, 0x6e, 0x1d, 0xa2, 0x2e,
+	0x68, 0x3d, 0x93, 0x90, 0xf3, 0xda, 0xaf, 0x0e, 0x68, 0x7d, 0x0a, 0x39, 0xdf, 0x32, 0xb2, 0xf5,
+	0x87, 0x91, 0x21, 0x6a, 0x2d, 0x44, 0x2e, 0x2c, 0x69, 0x87, 0x41, 0x74, 0x63, 0x8a, 0x3e, 0xff,
+	0xf8, 0xd2, 0x6c, 0x3d, 0x68, 0x92, 0x0f, 0xa7, 0x89, 0x4f, 0x8c, 0xce, 0x10, 0xd9, 0x9d, 0xde,
+	0x68, 0x25, 0x0d, 0xc7, 0x2f, 0xaa, 0x67, 0xe0, 0xae, 0x48, 0x10, 0x36, 0xa3, 0xfe, 0xf8, 0x21,
+	0xbd, 0xee, 0xd7, 0x46, 0x3d, 0x57, 0xb2, 0x26, 0x18, 0x7f, 0x0d, 0xd0, 0xc0, 0x89, 0xbc, 0xf2,
+	0x00, 0xfc, 0x29, 0x40, 0x37, 0x2f, 0x2b, 0xe3, 0xc9, 0xf5, 0x05, 0xfe, 0xe2, 0xd9, 0x70, 0xfa,
+	0x3f, 0x14, 0x7e, 0xf0, 0xd1, 0x9d, 0xf7, 0xdf, 0xbe, 0x7f, 0x6c, 0x1c, 0xe0, 0x5b, 0xee, 0x67,
+	0xb1, 0x3c, 0x89, 0x5d, 0x59, 0x5c, 0xcf, 0x32, 0xdd, 0x7f, 0x33, 0xd8, 0xe6, 0x99, 0xb7, 0x9d,
+	0xa3, 0x8f, 0x7e, 0x0f, 0x00, 0xab, 0x68, 0x22, 0xa1, 0xa0, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: auditmanager.proto
+
+package auditmanager
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = ptypes.DynamicAny{}
+)
+
+// Validate checks the field values on Record with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Record) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Identity
+
+	// no validation rules for Action
+
+	// no validation rules for Request
+
+	// no validation rules for Code
+
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	// no validation rules for OperationId
+
+	if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// RecordValidationError is the validation error returned by Record.Validate if
+// the designated constraints aren't met.
+type RecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordValidationError) ErrorName() string { return "RecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordValidationError{}
+
+// Validate checks the field values on ListAuditRecordsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAuditRecordsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditRecordsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditRecordsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Identity
+
+	// no validation rules for AppName
+
+	// no validation rules for Action
+
+	if m.GetLimit() > 10000 {
+		return ListAuditRecordsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 10000",
+		}
+	}
+
+	return nil
+}
+
+// ListAuditRecordsRequestValidationError is the validation error returned by
+// ListAuditRecordsRequest.Validate if the designated constraints aren't met.
+type ListAuditRecordsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditRecordsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditRecordsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditRecordsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditRecordsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditRecordsRequestValidationError) ErrorName() string {
+	return "ListAuditRecordsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditRecordsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditRecordsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditRecordsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditRecordsRequestValidationError{}
+
+// Validate checks the field values on ListAuditRecordsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAuditRecordsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditRecordsResponseValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListAuditRecordsResponseValidationError is the validation error returned by
+// ListAuditRecordsResponse.Validate if the designated constraints aren't met.
+type ListAuditRecordsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditRecordsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditRecordsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditRecordsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditRecordsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditRecordsResponseValidationError) ErrorName() string {
+	return "ListAuditRecordsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditRecordsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditRecordsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditRecordsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditRecordsResponseValidationError{}
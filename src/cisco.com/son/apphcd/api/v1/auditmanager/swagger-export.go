@@ -0,0 +1,7 @@
+// Author  <dorzheho@cisco.com>
+
+package auditmanager
+
+const (
+	Swagger = swagger
+)
@@ -0,0 +1,163 @@
+package auditmanager 
+
+const (
+swagger = `{
+  "swagger": "2.0",
+  "info": {
+    "title": "auditmanager.proto",
+    "version": "version not set"
+  },
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/api/v1/audit/records": {
+      "get": {
+        "summary": "ListAuditRecords shows the records of the mutating requests",
+        "operationId": "ListAuditRecords",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/auditmanagerListAuditRecordsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "start_time",
+            "description": "Records of the requests received at the time or later.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "end_time",
+            "description": "Records of the requests received before the time.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "identity",
+            "description": "Identity of the caller.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "app_name",
+            "description": "Application name.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "action",
+            "description": "Method handled the requests, e.g. AppManager/CreateApp.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "description": "Maximum number of records. Defaults to 1000.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "AuditManager"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "auditmanagerListAuditRecordsResponse": {
+      "type": "object",
+      "properties": {
+        "records": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/auditmanagerRecord"
+          }
+        }
+      },
+      "title": "ListAuditRecordsResponse holds the records sorted by time"
+    },
+    "auditmanagerRecord": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "title": "Record identifier"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time",
+          "title": "Time the request was received"
+        },
+        "identity": {
+          "type": "string",
+          "title": "Identity of the caller"
+        },
+        "action": {
+          "type": "string",
+          "title": "Method handled the request, e.g. AppManager/CreateApp"
+        },
+        "request": {
+          "type": "string",
+          "title": "Request in JSON format. Values of the secrets and application configurations are redacted"
+        },
+        "apps": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Applications affected by the request"
+        },
+        "instances": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Application instances affected by the request"
+        },
+        "code": {
+          "type": "string",
+          "title": "gRPC status code, e.g. OK or PermissionDenied"
+        },
+        "status": {
+          "type": "string",
+          "title": "Status of the response, e.g. SUCCESS or ERROR"
+        },
+        "message": {
+          "type": "string",
+          "title": "Message of the response or the error"
+        },
+        "operation_id": {
+          "type": "string",
+          "title": "Long-running operation the request was handled by"
+        },
+        "duration": {
+          "type": "string",
+          "title": "Time taken to handle the request including the long-running operation"
+        }
+      },
+      "title": "Record represents a mutating request handled by the controller"
+    }
+  }
+}
+`
+)
@@ -54,7 +54,7 @@ func (x Status) String() string {
 	return proto.EnumName(Status_name, int32(x))
 }
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{0}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{0}
 }
 
 // Node state. We use lower case properties since
@@ -85,7 +85,7 @@ func (x State) String() string {
 	return proto.EnumName(State_name, int32(x))
 }
 func (State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{1}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{1}
 }
 
 type Condition_Status int32
@@ -118,7 +118,7 @@ func (x Condition_Status) String() string {
 	return proto.EnumName(Condition_Status_name, int32(x))
 }
 func (Condition_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{0, 0}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{0, 0}
 }
 
 type Condition struct {
@@ -134,7 +134,7 @@ func (m *Condition) Reset()         { *m = Condition{} }
 func (m *Condition) String() string { return proto.CompactTextString(m) }
 func (*Condition) ProtoMessage()    {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{0}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{0}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Condition.Unmarshal(m, b)
@@ -187,7 +187,7 @@ func (m *Cpu) Reset()         { *m = Cpu{} }
 func (m *Cpu) String() string { return proto.CompactTextString(m) }
 func (*Cpu) ProtoMessage()    {}
 func (*Cpu) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{1}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{1}
 }
 func (m *Cpu) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Cpu.Unmarshal(m, b)
@@ -234,7 +234,7 @@ func (m *Memory) Reset()         { *m = Memory{} }
 func (m *Memory) String() string { return proto.CompactTextString(m) }
 func (*Memory) ProtoMessage()    {}
 func (*Memory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{2}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{2}
 }
 func (m *Memory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Memory.Unmarshal(m, b)
@@ -296,7 +296,7 @@ func (m *GetClusterInfoResponseBody) Reset()         { *m = GetClusterInfoRespon
 func (m *GetClusterInfoResponseBody) String() string { return proto.CompactTextString(m) }
 func (*GetClusterInfoResponseBody) ProtoMessage()    {}
 func (*GetClusterInfoResponseBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{3}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{3}
 }
 func (m *GetClusterInfoResponseBody) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetClusterInfoResponseBody.Unmarshal(m, b)
@@ -409,7 +409,7 @@ func (m *GetClusterInfoResponseBody_UpgradeStatus) Reset() {
 func (m *GetClusterInfoResponseBody_UpgradeStatus) String() string { return proto.CompactTextString(m) }
 func (*GetClusterInfoResponseBody_UpgradeStatus) ProtoMessage()    {}
 func (*GetClusterInfoResponseBody_UpgradeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{3, 0}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{3, 0}
 }
 func (m *GetClusterInfoResponseBody_UpgradeStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetClusterInfoResponseBody_UpgradeStatus.Unmarshal(m, b)
@@ -474,7 +474,7 @@ func (m *GetClusterInfoResponseBody_UpgradeStatus_Component) String() string {
 }
 func (*GetClusterInfoResponseBody_UpgradeStatus_Component) ProtoMessage() {}
 func (*GetClusterInfoResponseBody_UpgradeStatus_Component) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{3, 0, 0}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{3, 0, 0}
 }
 func (m *GetClusterInfoResponseBody_UpgradeStatus_Component) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetClusterInfoResponseBody_UpgradeStatus_Component.Unmarshal(m, b)
@@ -528,7 +528,7 @@ func (m *GetClusterInfoResponseBody_App) Reset()         { *m = GetClusterInfoRe
 func (m *GetClusterInfoResponseBody_App) String() string { return proto.CompactTextString(m) }
 func (*GetClusterInfoResponseBody_App) ProtoMessage()    {}
 func (*GetClusterInfoResponseBody_App) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{3, 1}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{3, 1}
 }
 func (m *GetClusterInfoResponseBody_App) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetClusterInfoResponseBody_App.Unmarshal(m, b)
@@ -584,7 +584,7 @@ func (m *GetClusterInfoResponseBody_Workloads) Reset()         { *m = GetCluster
 func (m *GetClusterInfoResponseBody_Workloads) String() string { return proto.CompactTextString(m) }
 func (*GetClusterInfoResponseBody_Workloads) ProtoMessage()    {}
 func (*GetClusterInfoResponseBody_Workloads) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{3, 2}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{3, 2}
 }
 func (m *GetClusterInfoResponseBody_Workloads) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetClusterInfoResponseBody_Workloads.Unmarshal(m, b)
@@ -660,7 +660,7 @@ func (m *GetClusterInfoResponseBody_Node) Reset()         { *m = GetClusterInfoR
 func (m *GetClusterInfoResponseBody_Node) String() string { return proto.CompactTextString(m) }
 func (*GetClusterInfoResponseBody_Node) ProtoMessage()    {}
 func (*GetClusterInfoResponseBody_Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{3, 3}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{3, 3}
 }
 func (m *GetClusterInfoResponseBody_Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetClusterInfoResponseBody_Node.Unmarshal(m, b)
@@ -772,7 +772,7 @@ func (m *GetClusterInfoResponseBody_Node_Storage) Reset() {
 func (m *GetClusterInfoResponseBody_Node_Storage) String() string { return proto.CompactTextString(m) }
 func (*GetClusterInfoResponseBody_Node_Storage) ProtoMessage()    {}
 func (*GetClusterInfoResponseBody_Node_Storage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{3, 3, 0}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{3, 3, 0}
 }
 func (m *GetClusterInfoResponseBody_Node_Storage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetClusterInfoResponseBody_Node_Storage.Unmarshal(m, b)
@@ -823,7 +823,7 @@ func (m *GetKubeConfigRequest) Reset()         { *m = GetKubeConfigRequest{} }
 func (m *GetKubeConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*GetKubeConfigRequest) ProtoMessage()    {}
 func (*GetKubeConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{4}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{4}
 }
 func (m *GetKubeConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetKubeConfigRequest.Unmarshal(m, b)
@@ -854,7 +854,7 @@ func (m *GetKubeConfigResponse) Reset()         { *m = GetKubeConfigResponse{} }
 func (m *GetKubeConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*GetKubeConfigResponse) ProtoMessage()    {}
 func (*GetKubeConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{5}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{5}
 }
 func (m *GetKubeConfigResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetKubeConfigResponse.Unmarshal(m, b)
@@ -892,7 +892,7 @@ func (m *GetClusterInfoRequest) Reset()         { *m = GetClusterInfoRequest{} }
 func (m *GetClusterInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetClusterInfoRequest) ProtoMessage()    {}
 func (*GetClusterInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{6}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{6}
 }
 func (m *GetClusterInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetClusterInfoRequest.Unmarshal(m, b)
@@ -929,7 +929,7 @@ func (m *UpgradeClusterRequest) Reset()         { *m = UpgradeClusterRequest{} }
 func (m *UpgradeClusterRequest) String() string { return proto.CompactTextString(m) }
 func (*UpgradeClusterRequest) ProtoMessage()    {}
 func (*UpgradeClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{7}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{7}
 }
 func (m *UpgradeClusterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeClusterRequest.Unmarshal(m, b)
@@ -962,7 +962,7 @@ func (m *Quota) Reset()         { *m = Quota{} }
 func (m *Quota) String() string { return proto.CompactTextString(m) }
 func (*Quota) ProtoMessage()    {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{8}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{8}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Quota.Unmarshal(m, b)
@@ -1014,7 +1014,7 @@ func (m *SetClusterResourceQuotasRequest) Reset()         { *m = SetClusterResou
 func (m *SetClusterResourceQuotasRequest) String() string { return proto.CompactTextString(m) }
 func (*SetClusterResourceQuotasRequest) ProtoMessage()    {}
 func (*SetClusterResourceQuotasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{9}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{9}
 }
 func (m *SetClusterResourceQuotasRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetClusterResourceQuotasRequest.Unmarshal(m, b)
@@ -1052,7 +1052,7 @@ func (m *GetClusterResourceQuotasRequest) Reset()         { *m = GetClusterResou
 func (m *GetClusterResourceQuotasRequest) String() string { return proto.CompactTextString(m) }
 func (*GetClusterResourceQuotasRequest) ProtoMessage()    {}
 func (*GetClusterResourceQuotasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{10}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{10}
 }
 func (m *GetClusterResourceQuotasRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetClusterResourceQuotasRequest.Unmarshal(m, b)
@@ -1090,7 +1090,7 @@ func (m *DeleteClusterResourceQuotasRequest) Reset()         { *m = DeleteCluste
 func (m *DeleteClusterResourceQuotasRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteClusterResourceQuotasRequest) ProtoMessage()    {}
 func (*DeleteClusterResourceQuotasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{11}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{11}
 }
 func (m *DeleteClusterResourceQuotasRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteClusterResourceQuotasRequest.Unmarshal(m, b)
@@ -1130,7 +1130,7 @@ func (m *SetGetClusterResourceQuotasResponseBody) Reset() {
 func (m *SetGetClusterResourceQuotasResponseBody) String() string { return proto.CompactTextString(m) }
 func (*SetGetClusterResourceQuotasResponseBody) ProtoMessage()    {}
 func (*SetGetClusterResourceQuotasResponseBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{12}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{12}
 }
 func (m *SetGetClusterResourceQuotasResponseBody) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetGetClusterResourceQuotasResponseBody.Unmarshal(m, b)
@@ -1169,7 +1169,7 @@ func (m *CreateNodeRequest) Reset()         { *m = CreateNodeRequest{} }
 func (m *CreateNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateNodeRequest) ProtoMessage()    {}
 func (*CreateNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{13}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{13}
 }
 func (m *CreateNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateNodeRequest.Unmarshal(m, b)
@@ -1216,7 +1216,7 @@ func (m *CreateNodeResponseBody) Reset()         { *m = CreateNodeResponseBody{}
 func (m *CreateNodeResponseBody) String() string { return proto.CompactTextString(m) }
 func (*CreateNodeResponseBody) ProtoMessage()    {}
 func (*CreateNodeResponseBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{14}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{14}
 }
 func (m *CreateNodeResponseBody) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateNodeResponseBody.Unmarshal(m, b)
@@ -1269,7 +1269,7 @@ func (m *UpdateNodeStateRequest) Reset()         { *m = UpdateNodeStateRequest{}
 func (m *UpdateNodeStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodeStateRequest) ProtoMessage()    {}
 func (*UpdateNodeStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{15}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{15}
 }
 func (m *UpdateNodeStateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodeStateRequest.Unmarshal(m, b)
@@ -1316,7 +1316,7 @@ func (m *UpdateNodesStateResponseBody) Reset()         { *m = UpdateNodesStateRe
 func (m *UpdateNodesStateResponseBody) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodesStateResponseBody) ProtoMessage()    {}
 func (*UpdateNodesStateResponseBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{16}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{16}
 }
 func (m *UpdateNodesStateResponseBody) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodesStateResponseBody.Unmarshal(m, b)
@@ -1368,7 +1368,7 @@ func (m *DeleteNodeRequest) Reset()         { *m = DeleteNodeRequest{} }
 func (m *DeleteNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteNodeRequest) ProtoMessage()    {}
 func (*DeleteNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{17}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{17}
 }
 func (m *DeleteNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteNodeRequest.Unmarshal(m, b)
@@ -1407,7 +1407,7 @@ func (m *DeleteNodeResponseBody) Reset()         { *m = DeleteNodeResponseBody{}
 func (m *DeleteNodeResponseBody) String() string { return proto.CompactTextString(m) }
 func (*DeleteNodeResponseBody) ProtoMessage()    {}
 func (*DeleteNodeResponseBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{18}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{18}
 }
 func (m *DeleteNodeResponseBody) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteNodeResponseBody.Unmarshal(m, b)
@@ -1443,20 +1443,22 @@ func (m *DeleteNodeResponseBody) GetHostname() string {
 
 // Response holds information related to a response message that is sent on appropriate request
 type Response struct {
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Status               Status               `protobuf:"varint,2,opt,name=status,proto3,enum=com.cisco.son.apphcd.api.v1.clustermanager.Status" json:"status,omitempty"`
-	Message              string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Body                 *any.Any             `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Status    Status               `protobuf:"varint,2,opt,name=status,proto3,enum=com.cisco.son.apphcd.api.v1.clustermanager.Status" json:"status,omitempty"`
+	Message   string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Body      *any.Any             `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// Request ID the response correlates with in the controller logs
+	RequestId            string   `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clustermanager_034f4aca859d6bc9, []int{19}
+	return fileDescriptor_clustermanager_4a15065ccbea5d99, []int{19}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -1504,6 +1506,13 @@ func (m *Response) GetBody() *any.Any {
 	return nil
 }
 
+func (m *Response) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Condition)(nil), "com.cisco.son.apphcd.api.v1.clustermanager.Condition")
 	proto.RegisterType((*Cpu)(nil), "com.cisco.son.apphcd.api.v1.clustermanager.Cpu")
@@ -1891,113 +1900,114 @@ var _ClusterManager_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("clustermanager.proto", fileDescriptor_clustermanager_034f4aca859d6bc9)
-}
-
-var fileDescriptor_clustermanager_034f4aca859d6bc9 = []byte{
-	// 1656 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0xf5, 0xcf, 0xe2, 0x93, 0xe5, 0xd8, 0x13, 0xc7, 0xe1, 0xaa, 0x6e, 0xad, 0x30, 0xc1,
-	0xae, 0x20, 0x34, 0x14, 0xec, 0xb4, 0x58, 0xf4, 0x1f, 0x50, 0x59, 0x5e, 0x08, 0xde, 0xd4, 0x76,
-	0x3a, 0x8a, 0xb1, 0x40, 0xb1, 0x58, 0x61, 0x44, 0x8d, 0x65, 0x62, 0x45, 0x0e, 0xc3, 0x3f, 0x8e,
-	0x8d, 0x20, 0x3d, 0x14, 0xbd, 0xf4, 0xda, 0x00, 0xfd, 0x04, 0xed, 0xb1, 0x87, 0xf6, 0xd8, 0x4b,
-	0x83, 0x7e, 0x83, 0xa2, 0x5f, 0xa0, 0x87, 0x5e, 0x5a, 0xb4, 0xc7, 0xde, 0x8b, 0x99, 0x21, 0x29,
-	0xca, 0xb2, 0xe3, 0x50, 0x76, 0x4e, 0xd5, 0x89, 0xf3, 0x86, 0xf3, 0x7b, 0xbf, 0x37, 0x6f, 0xe6,
-	0xf7, 0x1e, 0x05, 0x6b, 0xe6, 0x38, 0xf4, 0x03, 0xea, 0xd9, 0xc4, 0x21, 0x23, 0xea, 0x19, 0xae,
-	0xc7, 0x02, 0x86, 0x9a, 0x26, 0xb3, 0x0d, 0xd3, 0xf2, 0x4d, 0x66, 0xf8, 0xcc, 0x31, 0x88, 0xeb,
-	0x9e, 0x98, 0x43, 0x83, 0xb8, 0x96, 0x71, 0xba, 0x65, 0x4c, 0xaf, 0xa8, 0x6d, 0x8c, 0x18, 0x1b,
-	0x8d, 0x69, 0x8b, 0xb8, 0x56, 0x8b, 0x38, 0x0e, 0x0b, 0x48, 0x60, 0x31, 0xc7, 0x97, 0x48, 0xb5,
-	0xcd, 0x68, 0x56, 0x8c, 0x06, 0xe1, 0x71, 0x2b, 0xb0, 0x6c, 0xea, 0x07, 0xc4, 0x76, 0xa3, 0x17,
-	0xda, 0x23, 0x2b, 0x38, 0x09, 0x07, 0x86, 0xc9, 0xec, 0x16, 0x75, 0x4e, 0xd9, 0xb9, 0xeb, 0xb1,
-	0xb3, 0x73, 0xf9, 0xbe, 0xf9, 0x78, 0x44, 0x9d, 0xc7, 0xa7, 0x64, 0x6c, 0x0d, 0x49, 0x40, 0x5b,
-	0x33, 0x0f, 0x11, 0xc4, 0x47, 0x17, 0x7d, 0x10, 0xe7, 0x5c, 0x4e, 0xe9, 0xff, 0x56, 0x40, 0xed,
-	0x30, 0x67, 0x68, 0x71, 0x4e, 0xe8, 0x39, 0x94, 0xfc, 0x80, 0x04, 0xa1, 0xaf, 0x29, 0x75, 0xa5,
-	0xb1, 0xbc, 0xfd, 0x43, 0xe3, 0xfd, 0xe3, 0x34, 0x12, 0x18, 0xa3, 0x27, 0x30, 0x70, 0x84, 0x85,
-	0xba, 0x50, 0xe4, 0x4f, 0x54, 0xcb, 0x09, 0xd0, 0xad, 0x2c, 0xa0, 0x1c, 0x8a, 0x62, 0xb9, 0x1e,
-	0xad, 0x43, 0x89, 0x7a, 0x1e, 0xf3, 0x7c, 0x2d, 0x5f, 0xcf, 0x37, 0x54, 0x1c, 0x8d, 0xf4, 0x06,
-	0x94, 0xa4, 0x4b, 0x54, 0x82, 0xdc, 0xe1, 0xd3, 0x95, 0x05, 0xa4, 0x42, 0xf1, 0x33, 0x8c, 0x0f,
-	0xf1, 0x8a, 0x82, 0x2a, 0xb0, 0xf8, 0x45, 0x1b, 0x1f, 0xec, 0x1d, 0x74, 0x57, 0x72, 0xfa, 0xf7,
-	0x20, 0xdf, 0x71, 0x43, 0xb4, 0x06, 0xc5, 0x80, 0x05, 0x64, 0x2c, 0xc2, 0x54, 0xb1, 0x1c, 0xa0,
-	0x0d, 0x50, 0xc9, 0x78, 0xcc, 0x4c, 0x12, 0xd0, 0xa1, 0xe0, 0xaa, 0xe2, 0x89, 0x41, 0x7f, 0x06,
-	0xa5, 0x7d, 0x6a, 0x33, 0xef, 0x7c, 0x9e, 0xd5, 0x08, 0x41, 0xe1, 0xd8, 0xa3, 0x54, 0xcb, 0x8b,
-	0x09, 0xf1, 0xac, 0xff, 0x6a, 0x15, 0x6a, 0x5d, 0x1a, 0x74, 0x64, 0xc4, 0x7b, 0xce, 0x31, 0xc3,
-	0xd4, 0x77, 0x99, 0xe3, 0xd3, 0x1d, 0x36, 0x3c, 0x47, 0x0f, 0x60, 0x29, 0xda, 0x8c, 0xbe, 0x43,
-	0x6c, 0x1a, 0x79, 0xab, 0x44, 0xb6, 0x03, 0x62, 0x53, 0xb4, 0x0c, 0x39, 0x2b, 0x76, 0x96, 0xb3,
-	0x86, 0xe8, 0x21, 0x54, 0x4d, 0x8f, 0x8a, 0xf3, 0xd5, 0xe7, 0xf9, 0x8f, 0xdc, 0x2d, 0xc5, 0xc6,
-	0x5d, 0xbe, 0x8b, 0x3d, 0x50, 0xcd, 0x38, 0x55, 0x5a, 0xa1, 0xae, 0x34, 0x2a, 0xdb, 0xdf, 0x9d,
-	0x2b, 0xcf, 0x78, 0x82, 0x83, 0x7e, 0x02, 0xaa, 0xe9, 0x86, 0x7d, 0x93, 0x79, 0xd4, 0xd7, 0x8a,
-	0x02, 0xb4, 0x95, 0x09, 0xd4, 0x0d, 0x71, 0xd9, 0x74, 0xc3, 0x0e, 0x07, 0x40, 0x9f, 0x43, 0xc9,
-	0x16, 0x7b, 0xad, 0x95, 0x04, 0xd4, 0x76, 0x16, 0x28, 0x99, 0x25, 0x1c, 0x21, 0xa0, 0x5f, 0x2a,
-	0x70, 0x77, 0x4c, 0xfc, 0xa0, 0x1f, 0xba, 0x23, 0x8f, 0x0c, 0x69, 0x3f, 0x3a, 0xe1, 0x8b, 0x02,
-	0xf9, 0x79, 0x16, 0xe4, 0xab, 0x93, 0x65, 0x1c, 0x49, 0xf0, 0xe8, 0xe4, 0xaf, 0x72, 0x87, 0x53,
-	0x26, 0xf4, 0x31, 0xdc, 0xf1, 0x4f, 0xcd, 0xbe, 0xcd, 0x1c, 0x2b, 0x60, 0x5e, 0x3f, 0xf4, 0xc6,
-	0x5a, 0x59, 0x24, 0xa7, 0xea, 0x9f, 0x9a, 0xfb, 0xd2, 0x7a, 0xe4, 0x8d, 0x91, 0x03, 0xea, 0x4b,
-	0xe6, 0x7d, 0x3d, 0x66, 0x64, 0xe8, 0x6b, 0xaa, 0xe0, 0xf8, 0xec, 0x96, 0x38, 0x7e, 0x11, 0xe3,
-	0xe2, 0x89, 0x0b, 0xce, 0xcb, 0x09, 0xed, 0x01, 0xf5, 0xfa, 0xec, 0xb8, 0xef, 0xb0, 0x21, 0xf5,
-	0x35, 0xa8, 0x2b, 0x8d, 0x2a, 0xae, 0x4a, 0xf3, 0xe1, 0xf1, 0x01, 0x37, 0x22, 0x02, 0x45, 0x39,
-	0x5b, 0xa9, 0xe7, 0x1b, 0x95, 0xed, 0xa7, 0xb7, 0xc4, 0x89, 0x83, 0x63, 0x89, 0x5c, 0xfb, 0x6f,
-	0x0e, 0xaa, 0xd3, 0x9b, 0xb6, 0x07, 0x4b, 0xe9, 0xd4, 0x89, 0x2b, 0x50, 0xd9, 0xae, 0x19, 0x52,
-	0xcf, 0x8c, 0x58, 0xcf, 0x8c, 0xe7, 0xb1, 0x66, 0xee, 0xc0, 0x9f, 0xfe, 0xf9, 0x36, 0x5f, 0xfc,
-	0xa3, 0x92, 0x2b, 0x2b, 0xb8, 0x92, 0xca, 0x02, 0xd7, 0x8e, 0x28, 0xf1, 0xf2, 0xba, 0xc4, 0xe2,
-	0xf4, 0x10, 0xaa, 0x42, 0x45, 0xfa, 0x36, 0xf5, 0x7d, 0x32, 0x4a, 0xae, 0x8c, 0x30, 0xee, 0x4b,
-	0x1b, 0xfa, 0x39, 0x80, 0xc9, 0x6c, 0x97, 0x39, 0xd4, 0x09, 0x7c, 0xad, 0x20, 0x76, 0xe0, 0xab,
-	0x0f, 0x71, 0x72, 0x8c, 0x4e, 0xec, 0x06, 0xa7, 0x3c, 0xd6, 0xbe, 0xe4, 0x22, 0x1d, 0x8d, 0xb8,
-	0x94, 0xa4, 0xf4, 0x40, 0x3c, 0xdf, 0x28, 0xba, 0x1a, 0x81, 0x7c, 0xdb, 0x75, 0x2f, 0xc5, 0x35,
-	0xe0, 0xee, 0xe4, 0x74, 0x58, 0x8e, 0x1f, 0x10, 0xc7, 0xa4, 0xd2, 0x49, 0x15, 0xaf, 0xc6, 0x27,
-	0x64, 0x2f, 0x9e, 0xe0, 0x3c, 0x88, 0x19, 0x58, 0xa7, 0xd2, 0x51, 0x19, 0x47, 0xa3, 0xda, 0x1f,
-	0x72, 0xa0, 0x26, 0xc7, 0x0f, 0x3d, 0x82, 0xe5, 0x09, 0x2a, 0x71, 0x5d, 0x59, 0x6e, 0xaa, 0x78,
-	0x29, 0x06, 0x6c, 0xbb, 0xae, 0x9f, 0xd9, 0xf7, 0x16, 0xdc, 0x4b, 0xa1, 0x0a, 0xbf, 0x12, 0x3c,
-	0x2f, 0x56, 0xa0, 0x04, 0x5c, 0x4c, 0x09, 0x17, 0x3f, 0x80, 0xda, 0xcc, 0x92, 0x89, 0xa7, 0x82,
-	0x58, 0x77, 0x7f, 0x7a, 0xdd, 0xc4, 0xdf, 0x57, 0x50, 0x10, 0xf0, 0x45, 0x71, 0x1c, 0x3e, 0xbf,
-	0xa5, 0xe3, 0xd0, 0x76, 0x5d, 0x2c, 0x70, 0x6b, 0xff, 0x2a, 0x40, 0x81, 0x5f, 0x0f, 0x54, 0x83,
-	0xf2, 0x09, 0xf3, 0x83, 0x54, 0x72, 0x92, 0xf1, 0x4c, 0x05, 0xd8, 0x84, 0x0a, 0x3d, 0x0b, 0xa8,
-	0xe7, 0x90, 0x71, 0xdf, 0x72, 0xa3, 0x74, 0x43, 0x6c, 0xda, 0x73, 0xff, 0xdf, 0xd4, 0xff, 0x0c,
-	0xaa, 0xbc, 0x04, 0x8f, 0xfb, 0x7e, 0xc0, 0x3c, 0x7e, 0x01, 0xa4, 0xec, 0xf7, 0x6e, 0x51, 0xbe,
-	0x8c, 0x9e, 0x84, 0xc6, 0x4b, 0xc2, 0x53, 0x34, 0xe2, 0x99, 0x98, 0x15, 0x7b, 0xb0, 0x27, 0x4a,
-	0xbf, 0x0e, 0x25, 0x9b, 0x70, 0x54, 0x21, 0xf3, 0x65, 0x1c, 0x8d, 0xb8, 0x9d, 0xcb, 0x33, 0xf5,
-	0x84, 0x10, 0x97, 0x71, 0x34, 0xe2, 0xf7, 0x93, 0x06, 0xe6, 0x50, 0xab, 0x08, 0xab, 0x78, 0xae,
-	0x75, 0x61, 0x31, 0xf6, 0x77, 0x79, 0x57, 0x82, 0xa0, 0x10, 0xfa, 0x49, 0x43, 0x22, 0x9e, 0x2f,
-	0xed, 0x45, 0xd6, 0x61, 0xad, 0x4b, 0x83, 0xa7, 0xe1, 0x80, 0x76, 0x98, 0x73, 0x6c, 0x8d, 0x30,
-	0x7d, 0x11, 0x52, 0x3f, 0xd0, 0x3f, 0x85, 0x7b, 0x17, 0xec, 0x32, 0x7a, 0xf4, 0x2d, 0x80, 0xaf,
-	0xc3, 0x01, 0x35, 0x85, 0x35, 0xf2, 0x99, 0xb2, 0xe8, 0x4f, 0xc4, 0xc2, 0xa9, 0x7d, 0x13, 0x88,
-	0xef, 0x3a, 0xcd, 0xfa, 0x7d, 0xb8, 0x17, 0xc9, 0x61, 0xb4, 0x30, 0xa6, 0xf1, 0x12, 0x8a, 0x3f,
-	0x0d, 0x59, 0x40, 0xd0, 0x27, 0xa0, 0xf2, 0x37, 0x7d, 0x97, 0x98, 0xd1, 0xf2, 0x1d, 0x95, 0x4b,
-	0x7e, 0xc1, 0xcb, 0xd5, 0x15, 0x3c, 0x99, 0x43, 0x0f, 0x92, 0x43, 0x24, 0x04, 0x23, 0x7a, 0xab,
-	0x99, 0xab, 0x2f, 0x24, 0x67, 0x43, 0x87, 0xbc, 0xe9, 0x86, 0x62, 0x1b, 0x94, 0x9d, 0x15, 0x3e,
-	0x5f, 0x41, 0xea, 0x83, 0x85, 0xe8, 0x87, 0xf9, 0xa4, 0x7e, 0x06, 0x9b, 0xbd, 0x24, 0x0c, 0x4c,
-	0x7d, 0x16, 0x7a, 0x26, 0x15, 0x54, 0xfc, 0x38, 0xa0, 0x23, 0x28, 0xbd, 0x10, 0x06, 0x4d, 0x11,
-	0x4a, 0x90, 0xa9, 0xbf, 0x15, 0x50, 0x51, 0xd5, 0xfa, 0xb5, 0xa8, 0x5a, 0x11, 0x98, 0xbe, 0x0f,
-	0x9b, 0xdd, 0x6b, 0x3c, 0x37, 0x01, 0x92, 0x80, 0xa5, 0x77, 0x75, 0x0a, 0x2a, 0x35, 0xab, 0xef,
-	0x80, 0xbe, 0x4b, 0xc7, 0x34, 0xa0, 0xef, 0x44, 0xdc, 0x98, 0xd9, 0xde, 0xd4, 0x9e, 0xea, 0x01,
-	0x7c, 0xd2, 0xa3, 0xc1, 0xd5, 0xac, 0x52, 0xcd, 0xeb, 0xde, 0x8d, 0x37, 0x25, 0xd9, 0x88, 0x2e,
-	0xac, 0x76, 0x78, 0xff, 0x4a, 0x45, 0xaf, 0x70, 0xfd, 0x29, 0x4a, 0x5d, 0xac, 0x5c, 0xfa, 0x62,
-	0xe9, 0x5f, 0xc2, 0x7a, 0x1a, 0x28, 0xc5, 0x56, 0xaa, 0xa8, 0x92, 0xa8, 0x68, 0x1a, 0x3d, 0x77,
-	0x25, 0x7a, 0x7e, 0x0a, 0xfd, 0x35, 0xac, 0x1f, 0xb9, 0xc3, 0x08, 0x5d, 0x7e, 0xb6, 0xbc, 0x07,
-	0xd7, 0xdb, 0xfa, 0x36, 0xd2, 0xdf, 0x28, 0xb0, 0x31, 0xf1, 0xef, 0x47, 0x04, 0xe6, 0x8c, 0x31,
-	0x61, 0x95, 0xbf, 0x21, 0xab, 0x16, 0xac, 0xca, 0x53, 0xf7, 0x9e, 0xb9, 0xd3, 0x77, 0x61, 0x3d,
-	0xbd, 0x60, 0x3e, 0xfe, 0xfa, 0xdf, 0x15, 0x28, 0x27, 0x4a, 0xb5, 0x0b, 0x6a, 0xf2, 0x4d, 0x9d,
-	0xb1, 0x83, 0x9c, 0x2c, 0xe4, 0x45, 0x29, 0xd5, 0x61, 0x2d, 0x67, 0x2b, 0x4a, 0x17, 0x3e, 0x88,
-	0x35, 0x58, 0x9c, 0xee, 0xc7, 0xe2, 0x21, 0x6a, 0x40, 0x61, 0xc0, 0x86, 0xe7, 0x51, 0x61, 0x5e,
-	0x9b, 0xa1, 0xd9, 0x76, 0xce, 0xb1, 0x78, 0xa3, 0xb9, 0x95, 0x7c, 0xf3, 0x56, 0x60, 0xb1, 0x77,
-	0xd4, 0xe9, 0x7c, 0xd6, 0xeb, 0x4d, 0x7f, 0xf8, 0xde, 0x81, 0xca, 0xde, 0x41, 0xff, 0x19, 0x3e,
-	0xec, 0x62, 0x3e, 0x97, 0x6b, 0x7e, 0x0a, 0x45, 0x91, 0x1c, 0x04, 0x71, 0x97, 0xb6, 0xb2, 0x80,
-	0x56, 0xa1, 0x1a, 0x3a, 0xbe, 0x79, 0x42, 0x87, 0xe1, 0x98, 0x0c, 0xc6, 0x54, 0x2e, 0xb4, 0x89,
-	0xe5, 0x04, 0xd4, 0xe1, 0x8d, 0xce, 0x4a, 0x6e, 0xfb, 0x3f, 0x4b, 0xb0, 0x1c, 0x5d, 0xf9, 0x7d,
-	0x19, 0x11, 0xfa, 0x8d, 0x02, 0xd5, 0xa9, 0xc2, 0x80, 0x7e, 0x9c, 0xb1, 0xa4, 0xce, 0xd4, 0x9a,
-	0x5a, 0xfb, 0x06, 0x08, 0x32, 0xd7, 0xfa, 0x02, 0xfa, 0xad, 0x02, 0xcb, 0xd3, 0x85, 0x07, 0xb5,
-	0x6f, 0x52, 0xec, 0x25, 0xb5, 0xef, 0x64, 0x81, 0x48, 0xd8, 0x7c, 0xf4, 0x8b, 0xbf, 0xfd, 0xe3,
-	0x4d, 0xee, 0x2e, 0x5a, 0x15, 0xff, 0xfd, 0x9c, 0x6e, 0xb5, 0xf8, 0x32, 0x26, 0x8a, 0x3c, 0xa7,
-	0x39, 0x5d, 0xea, 0xb2, 0xd1, 0xbc, 0xb4, 0x4c, 0xde, 0x8c, 0x66, 0xed, 0x12, 0x9a, 0xbf, 0x53,
-	0x00, 0x26, 0x9a, 0x89, 0x7e, 0x94, 0xa9, 0xa9, 0xbb, 0x28, 0xda, 0x73, 0xd2, 0xd3, 0x05, 0xbd,
-	0x0d, 0xfd, 0xfe, 0x0c, 0xbd, 0x96, 0xf8, 0x6e, 0xfc, 0xbe, 0xd2, 0x44, 0xbf, 0x57, 0x00, 0x26,
-	0xba, 0x91, 0x8d, 0xe7, 0x8c, 0x40, 0xcd, 0xc9, 0xb3, 0x29, 0x78, 0x3e, 0x6a, 0xea, 0x57, 0xf0,
-	0x6c, 0xbd, 0x8a, 0xf5, 0xe9, 0x35, 0x7a, 0xab, 0xc0, 0x9d, 0x0b, 0xd5, 0x02, 0xed, 0x64, 0xcb,
-	0xff, 0x65, 0xa5, 0x66, 0x4e, 0xe6, 0x4f, 0x04, 0xf3, 0xc7, 0x7a, 0xe3, 0x7a, 0xe6, 0x2d, 0xa1,
-	0xeb, 0x7c, 0xcb, 0xff, 0xa2, 0x80, 0x76, 0x55, 0x6b, 0x84, 0x32, 0xfd, 0x3d, 0x70, 0x4d, 0x83,
-	0x35, 0x67, 0x50, 0x0f, 0x45, 0x50, 0xdf, 0xd4, 0xb5, 0xd9, 0xa0, 0x64, 0x63, 0xc1, 0x83, 0xf8,
-	0xb3, 0x02, 0x5a, 0xf7, 0x56, 0x82, 0xe8, 0x7e, 0x90, 0x20, 0xea, 0x22, 0x88, 0x1a, 0xba, 0x32,
-	0x08, 0xf4, 0x57, 0x05, 0xbe, 0xf1, 0x8e, 0xc6, 0x0e, 0x1d, 0x64, 0xbf, 0x0a, 0x1f, 0x20, 0x8e,
-	0x6f, 0x8b, 0x38, 0x3e, 0x6e, 0x3e, 0xba, 0x2a, 0x8e, 0xd6, 0xab, 0xa4, 0xcd, 0x7c, 0xbd, 0xb3,
-	0xf2, 0xb3, 0xe5, 0x69, 0xa0, 0x41, 0x49, 0x54, 0xc0, 0x27, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0xac, 0x86, 0x68, 0xe3, 0x8e, 0x17, 0x00, 0x00,
+	proto.RegisterFile("clustermanager.proto", fileDescriptor_clustermanager_4a15065ccbea5d99)
+}
+
+var fileDescriptor_clustermanager_4a15065ccbea5d99 = []byte{
+	// 1670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0xf5, 0x65, 0xf3, 0xc9, 0x72, 0xec, 0x49, 0xe2, 0x70, 0x55, 0x6f, 0xe3, 0x70, 0x83,
+	0x5d, 0x41, 0x68, 0x28, 0x58, 0x69, 0xb1, 0xe8, 0x17, 0x50, 0x59, 0x59, 0x08, 0xda, 0xd4, 0x4e,
+	0x3a, 0x8a, 0xb1, 0x40, 0xb1, 0x58, 0x61, 0x44, 0x8d, 0x15, 0x62, 0x45, 0x0e, 0x97, 0x1f, 0xde,
+	0x18, 0x8b, 0xf4, 0x50, 0xf4, 0xd2, 0x6b, 0x17, 0xe8, 0x5f, 0xd0, 0x1e, 0x7b, 0x68, 0x8f, 0xbd,
+	0x34, 0xe8, 0x7f, 0x50, 0xf4, 0x5f, 0xe8, 0xa5, 0x45, 0x7b, 0xec, 0xb9, 0xc5, 0x7c, 0x90, 0xa2,
+	0x2c, 0x3b, 0x0e, 0x65, 0xe7, 0xb4, 0x3a, 0x71, 0xde, 0x70, 0x7e, 0xef, 0xf7, 0x66, 0xde, 0xfc,
+	0xde, 0xa3, 0xe0, 0x96, 0x3d, 0x8d, 0xc3, 0x88, 0x06, 0x2e, 0xf1, 0xc8, 0x84, 0x06, 0x96, 0x1f,
+	0xb0, 0x88, 0xa1, 0xa6, 0xcd, 0x5c, 0xcb, 0x76, 0x42, 0x9b, 0x59, 0x21, 0xf3, 0x2c, 0xe2, 0xfb,
+	0xcf, 0xed, 0xb1, 0x45, 0x7c, 0xc7, 0x3a, 0xd9, 0xb3, 0xe6, 0x57, 0xd4, 0x77, 0x26, 0x8c, 0x4d,
+	0xa6, 0xb4, 0x45, 0x7c, 0xa7, 0x45, 0x3c, 0x8f, 0x45, 0x24, 0x72, 0x98, 0x17, 0x4a, 0xa4, 0xfa,
+	0x5d, 0x35, 0x2b, 0x46, 0xa3, 0xf8, 0xb8, 0x15, 0x39, 0x2e, 0x0d, 0x23, 0xe2, 0xfa, 0xea, 0x85,
+	0xce, 0xc4, 0x89, 0x9e, 0xc7, 0x23, 0xcb, 0x66, 0x6e, 0x8b, 0x7a, 0x27, 0xec, 0xd4, 0x0f, 0xd8,
+	0x8b, 0x53, 0xf9, 0xbe, 0xfd, 0x60, 0x42, 0xbd, 0x07, 0x27, 0x64, 0xea, 0x8c, 0x49, 0x44, 0x5b,
+	0x0b, 0x0f, 0x0a, 0xe2, 0x9d, 0xb3, 0x3e, 0x88, 0x77, 0x2a, 0xa7, 0xcc, 0x7f, 0x6b, 0xa0, 0x77,
+	0x99, 0x37, 0x76, 0x38, 0x27, 0xf4, 0x0c, 0x2a, 0x61, 0x44, 0xa2, 0x38, 0x34, 0xb4, 0x5d, 0xad,
+	0xb1, 0xd1, 0xfe, 0x91, 0xf5, 0xe6, 0x71, 0x5a, 0x29, 0x8c, 0x35, 0x10, 0x18, 0x58, 0x61, 0xa1,
+	0x1e, 0x94, 0xf9, 0x13, 0x35, 0x0a, 0x02, 0x74, 0x2f, 0x0f, 0x28, 0x87, 0xa2, 0x58, 0xae, 0x47,
+	0xdb, 0x50, 0xa1, 0x41, 0xc0, 0x82, 0xd0, 0x28, 0xee, 0x16, 0x1b, 0x3a, 0x56, 0x23, 0xb3, 0x01,
+	0x15, 0xe9, 0x12, 0x55, 0xa0, 0xf0, 0xe4, 0xf1, 0xe6, 0x0a, 0xd2, 0xa1, 0xfc, 0x11, 0xc6, 0x4f,
+	0xf0, 0xa6, 0x86, 0xaa, 0xb0, 0xfa, 0x49, 0x07, 0x1f, 0xf6, 0x0f, 0x7b, 0x9b, 0x05, 0xf3, 0xfb,
+	0x50, 0xec, 0xfa, 0x31, 0xba, 0x05, 0xe5, 0x88, 0x45, 0x64, 0x2a, 0xc2, 0xd4, 0xb1, 0x1c, 0xa0,
+	0x1d, 0xd0, 0xc9, 0x74, 0xca, 0x6c, 0x12, 0xd1, 0xb1, 0xe0, 0xaa, 0xe3, 0x99, 0xc1, 0x7c, 0x0a,
+	0x95, 0x03, 0xea, 0xb2, 0xe0, 0x74, 0x99, 0xd5, 0x08, 0x41, 0xe9, 0x38, 0xa0, 0xd4, 0x28, 0x8a,
+	0x09, 0xf1, 0x6c, 0xfe, 0x7a, 0x0b, 0xea, 0x3d, 0x1a, 0x75, 0x65, 0xc4, 0x7d, 0xef, 0x98, 0x61,
+	0x1a, 0xfa, 0xcc, 0x0b, 0xe9, 0x3e, 0x1b, 0x9f, 0xa2, 0x7b, 0xb0, 0xae, 0x36, 0x63, 0xe8, 0x11,
+	0x97, 0x2a, 0x6f, 0x55, 0x65, 0x3b, 0x24, 0x2e, 0x45, 0x1b, 0x50, 0x70, 0x12, 0x67, 0x05, 0x67,
+	0x8c, 0xde, 0x83, 0x9a, 0x1d, 0x50, 0x91, 0x5f, 0x43, 0x7e, 0xfe, 0xca, 0xdd, 0x7a, 0x62, 0x7c,
+	0xc4, 0x77, 0x71, 0x00, 0xba, 0x9d, 0x1c, 0x95, 0x51, 0xda, 0xd5, 0x1a, 0xd5, 0xf6, 0xf7, 0x96,
+	0x3a, 0x67, 0x3c, 0xc3, 0x41, 0x3f, 0x05, 0xdd, 0xf6, 0xe3, 0xa1, 0xcd, 0x02, 0x1a, 0x1a, 0x65,
+	0x01, 0xda, 0xca, 0x05, 0xea, 0xc7, 0x78, 0xcd, 0xf6, 0xe3, 0x2e, 0x07, 0x40, 0x1f, 0x43, 0xc5,
+	0x15, 0x7b, 0x6d, 0x54, 0x04, 0x54, 0x3b, 0x0f, 0x94, 0x3c, 0x25, 0xac, 0x10, 0xd0, 0xaf, 0x34,
+	0xb8, 0x39, 0x25, 0x61, 0x34, 0x8c, 0xfd, 0x49, 0x40, 0xc6, 0x74, 0xa8, 0x32, 0x7c, 0x55, 0x20,
+	0x3f, 0xcb, 0x83, 0x7c, 0xf1, 0x61, 0x59, 0x47, 0x12, 0x5c, 0x65, 0xfe, 0x16, 0x77, 0x38, 0x67,
+	0x42, 0xef, 0xc3, 0x8d, 0xf0, 0xc4, 0x1e, 0xba, 0xcc, 0x73, 0x22, 0x16, 0x0c, 0xe3, 0x60, 0x6a,
+	0xac, 0x89, 0xc3, 0xa9, 0x85, 0x27, 0xf6, 0x81, 0xb4, 0x1e, 0x05, 0x53, 0xe4, 0x81, 0xfe, 0x25,
+	0x0b, 0x3e, 0x9f, 0x32, 0x32, 0x0e, 0x0d, 0x5d, 0x70, 0x7c, 0x7a, 0x4d, 0x1c, 0x3f, 0x49, 0x70,
+	0xf1, 0xcc, 0x05, 0xe7, 0xe5, 0xc5, 0xee, 0x88, 0x06, 0x43, 0x76, 0x3c, 0xf4, 0xd8, 0x98, 0x86,
+	0x06, 0xec, 0x6a, 0x8d, 0x1a, 0xae, 0x49, 0xf3, 0x93, 0xe3, 0x43, 0x6e, 0x44, 0x04, 0xca, 0x72,
+	0xb6, 0xba, 0x5b, 0x6c, 0x54, 0xdb, 0x8f, 0xaf, 0x89, 0x13, 0x07, 0xc7, 0x12, 0xb9, 0xfe, 0xdf,
+	0x02, 0xd4, 0xe6, 0x37, 0xad, 0x0f, 0xeb, 0xd9, 0xa3, 0x13, 0x57, 0xa0, 0xda, 0xae, 0x5b, 0x52,
+	0xcf, 0xac, 0x44, 0xcf, 0xac, 0x67, 0x89, 0x66, 0xee, 0xc3, 0x9f, 0xff, 0xf9, 0xaa, 0x58, 0xfe,
+	0x93, 0x56, 0x58, 0xd3, 0x70, 0x35, 0x73, 0x0a, 0x5c, 0x3b, 0xd4, 0xc1, 0xcb, 0xeb, 0xa2, 0x46,
+	0xfc, 0xca, 0x08, 0x15, 0x19, 0xba, 0x34, 0x0c, 0xc9, 0x24, 0xbd, 0x32, 0xc2, 0x78, 0x20, 0x6d,
+	0xe8, 0x17, 0x00, 0x36, 0x73, 0x7d, 0xe6, 0x51, 0x2f, 0x0a, 0x8d, 0x92, 0xd8, 0x81, 0xcf, 0xde,
+	0x46, 0xe6, 0x58, 0xdd, 0xc4, 0x0d, 0xce, 0x78, 0xac, 0x7f, 0xca, 0x45, 0x5a, 0x8d, 0xb8, 0x94,
+	0x64, 0xf4, 0x40, 0x3c, 0x5f, 0x29, 0xba, 0x3a, 0x81, 0x62, 0xc7, 0xf7, 0xcf, 0xc5, 0xb5, 0xe0,
+	0xe6, 0x2c, 0x3b, 0x1c, 0x2f, 0x8c, 0x88, 0x67, 0x53, 0xe9, 0xa4, 0x86, 0xb7, 0x92, 0x0c, 0xe9,
+	0x27, 0x13, 0x9c, 0x07, 0xb1, 0x23, 0xe7, 0x44, 0x3a, 0x5a, 0xc3, 0x6a, 0x54, 0xff, 0x63, 0x01,
+	0xf4, 0x34, 0xfd, 0xd0, 0x7d, 0xd8, 0x98, 0xa1, 0x12, 0xdf, 0x97, 0xe5, 0xa6, 0x86, 0xd7, 0x13,
+	0xc0, 0x8e, 0xef, 0x87, 0xb9, 0x7d, 0xef, 0xc1, 0xed, 0x0c, 0xaa, 0xf0, 0x2b, 0xc1, 0x8b, 0x62,
+	0x05, 0x4a, 0xc1, 0xc5, 0x94, 0x70, 0xf1, 0x43, 0xa8, 0x2f, 0x2c, 0x99, 0x79, 0x2a, 0x89, 0x75,
+	0x77, 0xe6, 0xd7, 0xcd, 0xfc, 0x7d, 0x06, 0x25, 0x01, 0x5f, 0x16, 0xe9, 0xf0, 0xf1, 0x35, 0xa5,
+	0x43, 0xc7, 0xf7, 0xb1, 0xc0, 0xad, 0xff, 0xab, 0x04, 0x25, 0x7e, 0x3d, 0x50, 0x1d, 0xd6, 0x9e,
+	0xb3, 0x30, 0xca, 0x1c, 0x4e, 0x3a, 0x5e, 0xa8, 0x00, 0x77, 0xa1, 0x4a, 0x5f, 0x44, 0x34, 0xf0,
+	0xc8, 0x74, 0xe8, 0xf8, 0xea, 0xb8, 0x21, 0x31, 0xf5, 0xfd, 0x6f, 0x9a, 0xfa, 0xbf, 0x80, 0x1a,
+	0x2f, 0xc1, 0xd3, 0x61, 0x18, 0xb1, 0x80, 0x5f, 0x00, 0x29, 0xfb, 0x83, 0x6b, 0x94, 0x2f, 0x6b,
+	0x20, 0xa1, 0xf1, 0xba, 0xf0, 0xa4, 0x46, 0xfc, 0x24, 0x16, 0xc5, 0x1e, 0xdc, 0x99, 0xd2, 0x6f,
+	0x43, 0xc5, 0x25, 0x1c, 0x55, 0xc8, 0xfc, 0x1a, 0x56, 0x23, 0x6e, 0xe7, 0xf2, 0x4c, 0x03, 0x21,
+	0xc4, 0x6b, 0x58, 0x8d, 0xf8, 0xfd, 0xa4, 0x91, 0x3d, 0x36, 0xaa, 0xc2, 0x2a, 0x9e, 0xeb, 0x3d,
+	0x58, 0x4d, 0xfc, 0x9d, 0xdf, 0x95, 0x20, 0x28, 0xc5, 0x61, 0xda, 0x90, 0x88, 0xe7, 0x73, 0x7b,
+	0x91, 0x6d, 0xb8, 0xd5, 0xa3, 0xd1, 0xe3, 0x78, 0x44, 0xbb, 0xcc, 0x3b, 0x76, 0x26, 0x98, 0x7e,
+	0x11, 0xd3, 0x30, 0x32, 0x3f, 0x84, 0xdb, 0x67, 0xec, 0x32, 0x7a, 0xf4, 0x6d, 0x80, 0xcf, 0xe3,
+	0x11, 0xb5, 0x85, 0x55, 0xf9, 0xcc, 0x58, 0xcc, 0x87, 0x70, 0xfb, 0xec, 0xbe, 0x09, 0xc4, 0xd7,
+	0x65, 0xb3, 0x79, 0x07, 0x6e, 0x2b, 0x39, 0x54, 0x0b, 0x13, 0x1a, 0x5f, 0x42, 0xf9, 0x67, 0x31,
+	0x8b, 0x08, 0xfa, 0x00, 0x74, 0xfe, 0x66, 0xe8, 0x13, 0x5b, 0x2d, 0xdf, 0xd7, 0xb9, 0xe4, 0x97,
+	0x82, 0xc2, 0xae, 0x86, 0x67, 0x73, 0xe8, 0x5e, 0x9a, 0x44, 0x42, 0x30, 0xd4, 0x5b, 0xcd, 0xc2,
+	0xee, 0x4a, 0x9a, 0x1b, 0x26, 0x14, 0x6d, 0x3f, 0x16, 0xdb, 0xa0, 0xed, 0x6f, 0xf2, 0xf9, 0x2a,
+	0xd2, 0xef, 0xad, 0xa8, 0x1f, 0xe6, 0x93, 0xe6, 0x0b, 0xb8, 0x3b, 0x48, 0xc3, 0xc0, 0x34, 0x64,
+	0x71, 0x60, 0x53, 0x41, 0x25, 0x4c, 0x02, 0x3a, 0x82, 0xca, 0x17, 0xc2, 0x60, 0x68, 0x42, 0x09,
+	0x72, 0xf5, 0xb7, 0x02, 0x4a, 0x55, 0xad, 0xdf, 0x88, 0xaa, 0xa5, 0xc0, 0xcc, 0x03, 0xb8, 0xdb,
+	0xbb, 0xc4, 0x73, 0x13, 0x20, 0x0d, 0x58, 0x7a, 0xd7, 0xe7, 0xa0, 0x32, 0xb3, 0xe6, 0x3e, 0x98,
+	0x8f, 0xe8, 0x94, 0x46, 0xf4, 0xb5, 0x88, 0x3b, 0x0b, 0xdb, 0x9b, 0xd9, 0x53, 0x33, 0x82, 0x0f,
+	0x06, 0x34, 0xba, 0x98, 0x55, 0xa6, 0x79, 0xed, 0x5f, 0x79, 0x53, 0xd2, 0x8d, 0xe8, 0xc1, 0x56,
+	0x37, 0xa0, 0x24, 0xa2, 0xa2, 0x57, 0xb8, 0x3c, 0x8b, 0x32, 0x17, 0xab, 0x90, 0xbd, 0x58, 0xe6,
+	0xa7, 0xb0, 0x9d, 0x05, 0xca, 0xb0, 0x95, 0x2a, 0xaa, 0xa5, 0x2a, 0x9a, 0x45, 0x2f, 0x5c, 0x88,
+	0x5e, 0x9c, 0x43, 0x7f, 0x09, 0xdb, 0x47, 0xfe, 0x58, 0xa1, 0xcb, 0xcf, 0x96, 0x37, 0xe0, 0x7a,
+	0x5d, 0xdf, 0x46, 0xe6, 0xd7, 0x1a, 0xec, 0xcc, 0xfc, 0x87, 0x8a, 0xc0, 0x92, 0x31, 0xa6, 0xac,
+	0x8a, 0x57, 0x64, 0xd5, 0x82, 0x2d, 0x99, 0x75, 0x6f, 0x78, 0x76, 0xe6, 0x23, 0xd8, 0xce, 0x2e,
+	0x58, 0x8e, 0xbf, 0xf9, 0x3f, 0x0d, 0xd6, 0x52, 0xa5, 0x7a, 0x04, 0x7a, 0xfa, 0x4d, 0x9d, 0xb3,
+	0x83, 0x9c, 0x2d, 0xe4, 0x45, 0x29, 0xd3, 0x61, 0x6d, 0xb4, 0xdb, 0x79, 0xf7, 0x24, 0xf3, 0x41,
+	0x6c, 0xc0, 0xea, 0x7c, 0x3f, 0x96, 0x0c, 0x51, 0x03, 0x4a, 0x23, 0x36, 0x3e, 0x55, 0x85, 0xf9,
+	0xd6, 0x02, 0xcd, 0x8e, 0x77, 0x8a, 0xc5, 0x1b, 0xe8, 0x5d, 0x80, 0x40, 0xee, 0xe7, 0xd0, 0x19,
+	0x8b, 0x9a, 0xab, 0x63, 0x5d, 0x59, 0xfa, 0xe3, 0xe6, 0x5e, 0xfa, 0x49, 0x5c, 0x85, 0xd5, 0xc1,
+	0x51, 0xb7, 0xfb, 0xd1, 0x60, 0x30, 0xff, 0x5d, 0x7c, 0x03, 0xaa, 0xfd, 0xc3, 0xe1, 0x53, 0xfc,
+	0xa4, 0x87, 0xf9, 0x5c, 0xa1, 0xf9, 0x21, 0x94, 0xc5, 0xd9, 0x21, 0x48, 0x9a, 0xb8, 0xcd, 0x15,
+	0xb4, 0x05, 0xb5, 0xd8, 0x0b, 0xed, 0xe7, 0x74, 0x1c, 0x4f, 0xc9, 0x68, 0x4a, 0xe5, 0x42, 0x97,
+	0x38, 0x5e, 0x44, 0x3d, 0xde, 0x07, 0x6d, 0x16, 0xda, 0xff, 0x59, 0x87, 0x0d, 0xa5, 0x08, 0x07,
+	0x32, 0x60, 0xf4, 0x5b, 0x0d, 0x6a, 0x73, 0x75, 0x03, 0xfd, 0x24, 0x67, 0xc5, 0x5d, 0x28, 0x45,
+	0xf5, 0xce, 0x15, 0x10, 0x64, 0x2a, 0x98, 0x2b, 0xe8, 0x77, 0x1a, 0x6c, 0xcc, 0xd7, 0x25, 0xd4,
+	0xb9, 0x4a, 0x2f, 0x20, 0xa9, 0x7d, 0x37, 0x0f, 0x44, 0xca, 0xe6, 0x9d, 0x5f, 0xfe, 0xfd, 0x1f,
+	0x5f, 0x17, 0x6e, 0xa2, 0x2d, 0xf1, 0xd7, 0xd0, 0xc9, 0x5e, 0x8b, 0x2f, 0x63, 0xfc, 0x55, 0x41,
+	0x73, 0xbe, 0x12, 0xe6, 0xa3, 0x79, 0x6e, 0x15, 0xbd, 0x1a, 0xcd, 0xfa, 0x39, 0x34, 0x7f, 0xaf,
+	0x01, 0xcc, 0x24, 0x15, 0xfd, 0x38, 0x57, 0xcf, 0x77, 0x56, 0xd3, 0x97, 0xa4, 0x67, 0x0a, 0x7a,
+	0x3b, 0xe6, 0x9d, 0x05, 0x7a, 0x2d, 0xf1, 0x59, 0xf9, 0x03, 0xad, 0x89, 0xfe, 0xa0, 0x01, 0xcc,
+	0x64, 0x25, 0x1f, 0xcf, 0x05, 0xfd, 0x5a, 0x92, 0x67, 0x53, 0xf0, 0xbc, 0xdf, 0x34, 0x2f, 0xe0,
+	0xd9, 0xfa, 0x2a, 0x91, 0xaf, 0x97, 0xe8, 0x95, 0x06, 0x37, 0xce, 0x14, 0x13, 0xb4, 0x9f, 0xef,
+	0xfc, 0xcf, 0xab, 0x44, 0x4b, 0x32, 0x7f, 0x28, 0x98, 0x3f, 0x30, 0x1b, 0x97, 0x33, 0x6f, 0x09,
+	0xd9, 0xe7, 0x5b, 0xfe, 0x57, 0x0d, 0x8c, 0x8b, 0x3a, 0x27, 0x94, 0xeb, 0xdf, 0x83, 0x4b, 0xfa,
+	0xaf, 0x25, 0x83, 0x7a, 0x4f, 0x04, 0xf5, 0xae, 0x69, 0x2c, 0x06, 0x25, 0xfb, 0x0e, 0x1e, 0xc4,
+	0x5f, 0x34, 0x30, 0x7a, 0xd7, 0x12, 0x44, 0xef, 0xad, 0x04, 0xb1, 0x2b, 0x82, 0xa8, 0xa3, 0x0b,
+	0x83, 0x40, 0x7f, 0xd3, 0xe0, 0x5b, 0xaf, 0xe9, 0xfb, 0xd0, 0x61, 0xfe, 0xab, 0xf0, 0x16, 0xe2,
+	0xf8, 0x8e, 0x88, 0xe3, 0xfd, 0xe6, 0xfd, 0x8b, 0xe2, 0x68, 0x7d, 0x95, 0x76, 0xa1, 0x2f, 0xf7,
+	0x37, 0x7f, 0xbe, 0x31, 0x0f, 0x34, 0xaa, 0x88, 0x02, 0xf9, 0xf0, 0xff, 0x03, 0x00, 0xe9, 0xce,
+	0xe4, 0x11, 0xad, 0x17, 0x00, 0x00,
 }
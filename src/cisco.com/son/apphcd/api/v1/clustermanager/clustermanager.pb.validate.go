@@ -1513,6 +1513,8 @@ func (m *Response) Validate() error {
 		}
 	}
 
+	// no validation rules for RequestId
+
 	return nil
 }
 
@@ -274,6 +274,10 @@ swagger = `{
         },
         "body": {
           "$ref": "#/definitions/protobufAny"
+        },
+        "request_id": {
+          "type": "string",
+          "title": "Request ID the response correlates with in the controller logs"
         }
       },
       "title": "Response holds information related to a response message that is sent on appropriate request"
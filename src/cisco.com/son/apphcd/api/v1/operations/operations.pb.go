@@ -0,0 +1,715 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: operations.proto
+
+package operations
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/envoyproxy/protoc-gen-validate/validate"
+import any "github.com/golang/protobuf/ptypes/any"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Operation state
+type State int32
+
+const (
+	// Operation is running
+	State_RUNNING State = 0
+	// Operation finished, the response holds the result
+	State_SUCCEEDED State = 1
+	// Operation failed, the error holds the reason
+	State_FAILED State = 2
+	// Operation was cancelled by the caller
+	State_CANCELLED State = 3
+)
+
+var State_name = map[int32]string{
+	0: "RUNNING",
+	1: "SUCCEEDED",
+	2: "FAILED",
+	3: "CANCELLED",
+}
+var State_value = map[string]int32{
+	"RUNNING":   0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+	"CANCELLED": 3,
+}
+
+func (x State) String() string {
+	return proto.EnumName(State_name, int32(x))
+}
+func (State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_operations_91d4139ac07f6cee, []int{0}
+}
+
+// Step of a running operation, e.g. chart created, catalog synced or instance ready
+type Step struct {
+	// Time the step was reached
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Step description
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Step) Reset()         { *m = Step{} }
+func (m *Step) String() string { return proto.CompactTextString(m) }
+func (*Step) ProtoMessage()    {}
+func (*Step) Descriptor() ([]byte, []int) {
+	return fileDescriptor_operations_91d4139ac07f6cee, []int{0}
+}
+func (m *Step) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Step.Unmarshal(m, b)
+}
+func (m *Step) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Step.Marshal(b, m, deterministic)
+}
+func (dst *Step) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Step.Merge(dst, src)
+}
+func (m *Step) XXX_Size() int {
+	return xxx_messageInfo_Step.Size(m)
+}
+func (m *Step) XXX_DiscardUnknown() {
+	xxx_messageInfo_Step.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Step proto.InternalMessageInfo
+
+func (m *Step) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Step) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// Operation represents a long-running mutation of applications or the cluster
+type Operation struct {
+	// Operation identifier
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Method started the operation, e.g. AppManager/CreateApp
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Application name or cluster the operation is applied to
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Identity of the caller started the operation
+	Identity string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Operation state
+	State State `protobuf:"varint,5,opt,name=state,proto3,enum=com.cisco.son.apphcd.api.v1.operations.State" json:"state,omitempty"`
+	// Indicates whether the operation is finished
+	Done bool `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+	// Time the operation was started
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Time the operation was last updated
+	UpdateTime *timestamp.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Steps reached by the operation
+	Steps []*Step `protobuf:"bytes,9,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Response of the method once the operation is finished. Holds per-instance results
+	Response *any.Any `protobuf:"bytes,10,opt,name=response,proto3" json:"response,omitempty"`
+	// Error message if the operation failed
+	Error                string   `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Operation) Reset()         { *m = Operation{} }
+func (m *Operation) String() string { return proto.CompactTextString(m) }
+func (*Operation) ProtoMessage()    {}
+func (*Operation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_operations_91d4139ac07f6cee, []int{1}
+}
+func (m *Operation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Operation.Unmarshal(m, b)
+}
+func (m *Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Operation.Marshal(b, m, deterministic)
+}
+func (dst *Operation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation.Merge(dst, src)
+}
+func (m *Operation) XXX_Size() int {
+	return xxx_messageInfo_Operation.Size(m)
+}
+func (m *Operation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation proto.InternalMessageInfo
+
+func (m *Operation) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Operation) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Operation) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Operation) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *Operation) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return State_RUNNING
+}
+
+func (m *Operation) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *Operation) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Operation) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+func (m *Operation) GetSteps() []*Step {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *Operation) GetResponse() *any.Any {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *Operation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// GetOperationRequest holds attributes required for obtaining an operation
+type GetOperationRequest struct {
+	// Operation identifier
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOperationRequest) Reset()         { *m = GetOperationRequest{} }
+func (m *GetOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOperationRequest) ProtoMessage()    {}
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_operations_91d4139ac07f6cee, []int{2}
+}
+func (m *GetOperationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOperationRequest.Unmarshal(m, b)
+}
+func (m *GetOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOperationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperationRequest.Merge(dst, src)
+}
+func (m *GetOperationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOperationRequest.Size(m)
+}
+func (m *GetOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOperationRequest proto.InternalMessageInfo
+
+func (m *GetOperationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// ListOperationsRequest holds attributes required for listing operations
+type ListOperationsRequest struct {
+	// Method started the operations, e.g. AppManager/CreateApp
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Application name or cluster the operations are applied to
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// List only the running operations
+	Running              bool     `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOperationsRequest) Reset()         { *m = ListOperationsRequest{} }
+func (m *ListOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOperationsRequest) ProtoMessage()    {}
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_operations_91d4139ac07f6cee, []int{3}
+}
+func (m *ListOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOperationsRequest.Unmarshal(m, b)
+}
+func (m *ListOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOperationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOperationsRequest.Merge(dst, src)
+}
+func (m *ListOperationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOperationsRequest.Size(m)
+}
+func (m *ListOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOperationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOperationsRequest proto.InternalMessageInfo
+
+func (m *ListOperationsRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *ListOperationsRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ListOperationsRequest) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+// ListOperationsResponse holds the operations sorted by start time
+type ListOperationsResponse struct {
+	Operations           []*Operation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListOperationsResponse) Reset()         { *m = ListOperationsResponse{} }
+func (m *ListOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOperationsResponse) ProtoMessage()    {}
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_operations_91d4139ac07f6cee, []int{4}
+}
+func (m *ListOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOperationsResponse.Unmarshal(m, b)
+}
+func (m *ListOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOperationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOperationsResponse.Merge(dst, src)
+}
+func (m *ListOperationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOperationsResponse.Size(m)
+}
+func (m *ListOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOperationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOperationsResponse proto.InternalMessageInfo
+
+func (m *ListOperationsResponse) GetOperations() []*Operation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+// CancelOperationRequest holds attributes required for cancelling an operation
+type CancelOperationRequest struct {
+	// Operation identifier
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelOperationRequest) Reset()         { *m = CancelOperationRequest{} }
+func (m *CancelOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelOperationRequest) ProtoMessage()    {}
+func (*CancelOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_operations_91d4139ac07f6cee, []int{5}
+}
+func (m *CancelOperationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOperationRequest.Unmarshal(m, b)
+}
+func (m *CancelOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOperationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CancelOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOperationRequest.Merge(dst, src)
+}
+func (m *CancelOperationRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelOperationRequest.Size(m)
+}
+func (m *CancelOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOperationRequest proto.InternalMessageInfo
+
+func (m *CancelOperationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// WaitOperationRequest holds attributes required for waiting for an operation
+type WaitOperationRequest struct {
+	// Operation identifier
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Maximum time to wait. The operation is returned as is once the timeout is reached
+	Timeout              *duration.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *WaitOperationRequest) Reset()         { *m = WaitOperationRequest{} }
+func (m *WaitOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitOperationRequest) ProtoMessage()    {}
+func (*WaitOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_operations_91d4139ac07f6cee, []int{6}
+}
+func (m *WaitOperationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitOperationRequest.Unmarshal(m, b)
+}
+func (m *WaitOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitOperationRequest.Marshal(b, m, deterministic)
+}
+func (dst *WaitOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitOperationRequest.Merge(dst, src)
+}
+func (m *WaitOperationRequest) XXX_Size() int {
+	return xxx_messageInfo_WaitOperationRequest.Size(m)
+}
+func (m *WaitOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitOperationRequest proto.InternalMessageInfo
+
+func (m *WaitOperationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WaitOperationRequest) GetTimeout() *duration.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Step)(nil), "com.cisco.son.apphcd.api.v1.operations.Step")
+	proto.RegisterType((*Operation)(nil), "com.cisco.son.apphcd.api.v1.operations.Operation")
+	proto.RegisterType((*GetOperationRequest)(nil), "com.cisco.son.apphcd.api.v1.operations.GetOperationRequest")
+	proto.RegisterType((*ListOperationsRequest)(nil), "com.cisco.son.apphcd.api.v1.operations.ListOperationsRequest")
+	proto.RegisterType((*ListOperationsResponse)(nil), "com.cisco.son.apphcd.api.v1.operations.ListOperationsResponse")
+	proto.RegisterType((*CancelOperationRequest)(nil), "com.cisco.son.apphcd.api.v1.operations.CancelOperationRequest")
+	proto.RegisterType((*WaitOperationRequest)(nil), "com.cisco.son.apphcd.api.v1.operations.WaitOperationRequest")
+	proto.RegisterEnum("com.cisco.son.apphcd.api.v1.operations.State", State_name, State_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// OperationsClient is the client API for Operations service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OperationsClient interface {
+	// GetOperation shows the latest state of an operation
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// ListOperations shows the operations kept by the controller.
+	// Finished operations are kept for a limited period of time
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	// CancelOperation requests cancellation of a running operation. The cancellation is best effort:
+	// the steps already started are finished, the remaining steps are skipped
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// WaitOperation waits until the operation is finished or the timeout is reached
+	WaitOperation(ctx context.Context, in *WaitOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+}
+
+type operationsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOperationsClient(cc *grpc.ClientConn) OperationsClient {
+	return &operationsClient{cc}
+}
+
+func (c *operationsClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.operations.Operations/GetOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationsClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.operations.Operations/ListOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationsClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.operations.Operations/CancelOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationsClient) WaitOperation(ctx context.Context, in *WaitOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, "/com.cisco.son.apphcd.api.v1.operations.Operations/WaitOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OperationsServer is the server API for Operations service.
+type OperationsServer interface {
+	// GetOperation shows the latest state of an operation
+	GetOperation(context.Context, *GetOperationRequest) (*Operation, error)
+	// ListOperations shows the operations kept by the controller.
+	// Finished operations are kept for a limited period of time
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	// CancelOperation requests cancellation of a running operation. The cancellation is best effort:
+	// the steps already started are finished, the remaining steps are skipped
+	CancelOperation(context.Context, *CancelOperationRequest) (*Operation, error)
+	// WaitOperation waits until the operation is finished or the timeout is reached
+	WaitOperation(context.Context, *WaitOperationRequest) (*Operation, error)
+}
+
+func RegisterOperationsServer(s *grpc.Server, srv OperationsServer) {
+	s.RegisterService(&_Operations_serviceDesc, srv)
+}
+
+func _Operations_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationsServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.cisco.son.apphcd.api.v1.operations.Operations/GetOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationsServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operations_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationsServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.cisco.son.apphcd.api.v1.operations.Operations/ListOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationsServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operations_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationsServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.cisco.son.apphcd.api.v1.operations.Operations/CancelOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationsServer).CancelOperation(ctx, req.(*CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operations_WaitOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationsServer).WaitOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.cisco.son.apphcd.api.v1.operations.Operations/WaitOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationsServer).WaitOperation(ctx, req.(*WaitOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Operations_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "com.cisco.son.apphcd.api.v1.operations.Operations",
+	HandlerType: (*OperationsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOperation",
+			Handler:    _Operations_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _Operations_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _Operations_CancelOperation_Handler,
+		},
+		{
+			MethodName: "WaitOperation",
+			Handler:    _Operations_WaitOperation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "operations.proto",
+}
+
+func init() { proto.RegisterFile("operations.proto", fileDescriptor_operations_91d4139ac07f6cee) }
+
+var fileDescriptor_operations_91d4139ac07f6cee = []byte{
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4e, 0xdb, 0x48,
+	0x1c, 0xde, 0xc9, 0xff, 0xfc, 0x02, 0x6c, 0x34, 0x9b, 0x65, 0x9d, 0x08, 0x41, 0xd6, 0x2b, 0xed,
+	0x66, 0xd1, 0xe2, 0x40, 0xb8, 0xac, 0x60, 0x17, 0x29, 0x24, 0x29, 0x42, 0x42, 0xa9, 0x6a, 0x8a,
+	0x2a, 0xf5, 0x52, 0x4d, 0xec, 0x21, 0x8c, 0x4a, 0x66, 0x5c, 0x7b, 0x92, 0x36, 0xaa, 0x7a, 0xe9,
+	0x2b, 0xf4, 0x01, 0x7a, 0xae, 0x7a, 0xe8, 0x9d, 0x53, 0x4f, 0x7d, 0x81, 0xbe, 0x42, 0x2f, 0x7d,
+	0x8b, 0xca, 0x63, 0x27, 0x0e, 0x49, 0x10, 0x81, 0x9b, 0x7f, 0x33, 0xdf, 0x37, 0xbf, 0xdf, 0x7c,
+	0xdf, 0xe7, 0x81, 0xbc, 0x70, 0xa8, 0x4b, 0x24, 0x13, 0xdc, 0x33, 0x1c, 0x57, 0x48, 0x81, 0xff,
+	0xb4, 0x44, 0xcf, 0xb0, 0x98, 0x67, 0x09, 0xc3, 0x13, 0xdc, 0x20, 0x8e, 0x73, 0x61, 0xd9, 0x06,
+	0x71, 0x98, 0x31, 0xd8, 0x31, 0x22, 0x74, 0x69, 0xad, 0x2b, 0x44, 0xf7, 0x92, 0x56, 0x89, 0xc3,
+	0xaa, 0x84, 0x73, 0x21, 0x27, 0x4f, 0x29, 0x6d, 0x84, 0xbb, 0xaa, 0xea, 0xf4, 0xcf, 0xab, 0x92,
+	0xf5, 0xa8, 0x27, 0x49, 0xcf, 0x09, 0x01, 0xeb, 0xd3, 0x00, 0xbb, 0x1f, 0x9c, 0x1c, 0xee, 0xd7,
+	0xbb, 0x4c, 0x5e, 0xf4, 0x3b, 0x86, 0x25, 0x7a, 0x55, 0xca, 0x07, 0x62, 0xe8, 0xb8, 0xe2, 0xd5,
+	0x30, 0x80, 0x5b, 0x5b, 0x5d, 0xca, 0xb7, 0x06, 0xe4, 0x92, 0xd9, 0x44, 0xd2, 0xea, 0xcc, 0x47,
+	0x78, 0x44, 0x71, 0xba, 0x05, 0xe1, 0xc3, 0x60, 0x4b, 0xef, 0x40, 0xe2, 0x54, 0x52, 0x07, 0xff,
+	0x0b, 0xd9, 0xf1, 0x60, 0x1a, 0x2a, 0xa3, 0x4a, 0xae, 0x56, 0x32, 0x02, 0x9a, 0x31, 0xa2, 0x19,
+	0x8f, 0x47, 0x08, 0x33, 0x02, 0xe3, 0x32, 0xe4, 0x6c, 0xea, 0x59, 0x2e, 0x73, 0xfc, 0xa1, 0xb5,
+	0x58, 0x19, 0x55, 0xb2, 0xe6, 0xe4, 0x92, 0xfe, 0x25, 0x0e, 0xd9, 0x87, 0x23, 0xbd, 0xf0, 0x0a,
+	0xc4, 0x98, 0xad, 0x5a, 0x64, 0xcd, 0x18, 0xb3, 0xf1, 0x2a, 0xa4, 0x7a, 0x54, 0x5e, 0x08, 0x3b,
+	0xa4, 0x86, 0x95, 0xbf, 0x2e, 0x89, 0xdb, 0xa5, 0x52, 0x8b, 0x07, 0xeb, 0x41, 0x85, 0x4b, 0x90,
+	0x61, 0x36, 0xe5, 0x92, 0xc9, 0xa1, 0x96, 0x50, 0x3b, 0xe3, 0x1a, 0x37, 0x20, 0xe9, 0x49, 0x22,
+	0xa9, 0x96, 0x2c, 0xa3, 0xca, 0x4a, 0x6d, 0xcb, 0x58, 0xcc, 0x42, 0xe3, 0xd4, 0x27, 0x99, 0x01,
+	0x17, 0x63, 0x48, 0xd8, 0x82, 0x53, 0x2d, 0x55, 0x46, 0x95, 0x8c, 0xa9, 0xbe, 0xf1, 0x3e, 0xe4,
+	0x2c, 0x97, 0x12, 0x49, 0x9f, 0xf9, 0x17, 0xd7, 0xd2, 0xb7, 0x0a, 0x04, 0x01, 0xdc, 0x5f, 0xf0,
+	0xc9, 0x7d, 0xc7, 0x1e, 0x93, 0x33, 0xb7, 0x93, 0x03, 0xb8, 0x22, 0x1f, 0xfa, 0x57, 0xa2, 0x8e,
+	0xa7, 0x65, 0xcb, 0xf1, 0x4a, 0xae, 0xf6, 0xcf, 0xe2, 0x57, 0xa2, 0x8e, 0x19, 0x50, 0xf1, 0x36,
+	0x64, 0x5c, 0xea, 0x39, 0x82, 0x7b, 0x54, 0x03, 0xd5, 0xbd, 0x30, 0xd3, 0xbd, 0xce, 0x87, 0xe6,
+	0x18, 0x85, 0x0b, 0x90, 0xa4, 0xae, 0x2b, 0x5c, 0x2d, 0xa7, 0x14, 0x0e, 0x0a, 0x7d, 0x1b, 0x7e,
+	0x39, 0xa2, 0x72, 0x6c, 0xa5, 0x49, 0x5f, 0xf4, 0xa9, 0x27, 0x71, 0x31, 0x72, 0xf4, 0x30, 0x7b,
+	0xf5, 0xfd, 0x73, 0x3c, 0xe1, 0xc6, 0xca, 0xc8, 0x37, 0x57, 0x27, 0xf0, 0xeb, 0x09, 0xf3, 0x22,
+	0x8a, 0x37, 0xe2, 0x44, 0xae, 0xa3, 0x1b, 0x5c, 0x8f, 0x5d, 0x73, 0x5d, 0x83, 0xb4, 0xdb, 0xe7,
+	0x9c, 0xf1, 0xae, 0x8a, 0x43, 0xc6, 0x1c, 0x95, 0xfa, 0x73, 0x58, 0x9d, 0x6e, 0x11, 0x5e, 0xe2,
+	0x11, 0x40, 0x24, 0x88, 0x86, 0x94, 0x7e, 0x3b, 0x8b, 0xea, 0x17, 0xdd, 0x72, 0xe2, 0x10, 0x7d,
+	0x17, 0x56, 0x1b, 0x84, 0x5b, 0xf4, 0xf2, 0x2e, 0x22, 0x9c, 0x43, 0xe1, 0x09, 0x61, 0x77, 0xd1,
+	0x0d, 0xef, 0x42, 0xda, 0xcf, 0x8a, 0xe8, 0x07, 0x3a, 0xe4, 0x6a, 0xc5, 0x19, 0xc3, 0x9a, 0xe1,
+	0x33, 0x61, 0x8e, 0x90, 0x9b, 0x07, 0x90, 0x54, 0x41, 0xc6, 0x39, 0x48, 0x9b, 0x67, 0xed, 0xf6,
+	0x71, 0xfb, 0x28, 0xff, 0x13, 0x5e, 0x86, 0xec, 0xe9, 0x59, 0xa3, 0xd1, 0x6a, 0x35, 0x5b, 0xcd,
+	0x3c, 0xc2, 0x00, 0xa9, 0x07, 0xf5, 0xe3, 0x93, 0x56, 0x33, 0x1f, 0xf3, 0xb7, 0x1a, 0xf5, 0x76,
+	0xa3, 0x75, 0xe2, 0x97, 0xf1, 0xda, 0x55, 0x12, 0x20, 0x92, 0x11, 0xbf, 0x47, 0xb0, 0x34, 0x69,
+	0x37, 0xde, 0x5f, 0x54, 0xbb, 0x39, 0x21, 0x29, 0xdd, 0x5d, 0x78, 0x7d, 0xe3, 0xed, 0xd7, 0x6f,
+	0xef, 0x62, 0x45, 0xfc, 0x9b, 0x7a, 0x5a, 0x07, 0x3b, 0xd5, 0x08, 0x55, 0x7d, 0xcd, 0xec, 0x37,
+	0xf8, 0x23, 0x82, 0x95, 0xeb, 0xde, 0xe3, 0xff, 0x17, 0x6d, 0x33, 0x37, 0x96, 0xa5, 0x83, 0xfb,
+	0xd2, 0x83, 0xc8, 0xe9, 0x25, 0x35, 0x72, 0x01, 0xe3, 0xd9, 0x91, 0xf1, 0x27, 0x04, 0x3f, 0x4f,
+	0x85, 0x07, 0x2f, 0xdc, 0x6f, 0x7e, 0xea, 0xee, 0xa3, 0xea, 0xdf, 0x6a, 0xc4, 0x3f, 0xf4, 0xf5,
+	0x1b, 0x54, 0xdd, 0xb3, 0x54, 0xab, 0x3d, 0xb4, 0x89, 0x3f, 0x20, 0x58, 0xbe, 0x96, 0x5c, 0xfc,
+	0xdf, 0xa2, 0xfd, 0xe6, 0x05, 0xfe, 0x3e, 0xd3, 0xfe, 0xa5, 0xa6, 0xfd, 0x5d, 0x5f, 0xbb, 0x69,
+	0xda, 0x97, 0x84, 0xc9, 0x3d, 0xb4, 0x79, 0xb8, 0xf4, 0x74, 0xe2, 0x3f, 0xed, 0xa4, 0xd4, 0x6f,
+	0xb2, 0xfb, 0x63, 0x00, 0xc7, 0x49, 0x9b, 0x25, 0xd6, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,7 @@
+// Author  <dorzheho@cisco.com>
+
+package operations
+
+const (
+	Swagger = swagger
+)
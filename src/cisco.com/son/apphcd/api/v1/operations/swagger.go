@@ -0,0 +1,5 @@
+// Author  <dorzheho@cisco.com>
+
+package operations
+
+//go:generate go run scripts/includetxt.go
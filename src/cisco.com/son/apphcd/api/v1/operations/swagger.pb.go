@@ -0,0 +1,295 @@
+package operations 
+
+const (
+swagger = `{
+  "swagger": "2.0",
+  "info": {
+    "title": "operations.proto",
+    "version": "version not set"
+  },
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/api/v1/operations": {
+      "get": {
+        "summary": "ListOperations shows the operations kept by the controller.\nFinished operations are kept for a limited period of time",
+        "operationId": "ListOperations",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/operationsListOperationsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "method",
+            "description": "Method started the operations, e.g. AppManager/CreateApp.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "target",
+            "description": "Application name or cluster the operations are applied to.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "running",
+            "description": "List only the running operations.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          }
+        ],
+        "tags": [
+          "Operations"
+        ]
+      }
+    },
+    "/api/v1/operations/{id}": {
+      "get": {
+        "summary": "GetOperation shows the latest state of an operation",
+        "operationId": "GetOperation",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/operationsOperation"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "description": "Operation identifier",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "Operations"
+        ]
+      }
+    },
+    "/api/v1/operations/{id}:cancel": {
+      "post": {
+        "summary": "CancelOperation requests cancellation of a running operation. The cancellation is best effort:\nthe steps already started are finished, the remaining steps are skipped",
+        "operationId": "CancelOperation",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/operationsOperation"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "description": "Operation identifier",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operationsCancelOperationRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Operations"
+        ]
+      }
+    },
+    "/api/v1/operations/{id}:wait": {
+      "post": {
+        "summary": "WaitOperation waits until the operation is finished or the timeout is reached",
+        "operationId": "WaitOperation",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/operationsOperation"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "description": "Operation identifier",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operationsWaitOperationRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Operations"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "operationsCancelOperationRequest": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "title": "Operation identifier"
+        }
+      },
+      "title": "CancelOperationRequest holds attributes required for cancelling an operation"
+    },
+    "operationsListOperationsResponse": {
+      "type": "object",
+      "properties": {
+        "operations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operationsOperation"
+          }
+        }
+      },
+      "title": "ListOperationsResponse holds the operations sorted by start time"
+    },
+    "operationsOperation": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "title": "Operation identifier"
+        },
+        "method": {
+          "type": "string",
+          "title": "Method started the operation, e.g. AppManager/CreateApp"
+        },
+        "target": {
+          "type": "string",
+          "title": "Application name or cluster the operation is applied to"
+        },
+        "identity": {
+          "type": "string",
+          "title": "Identity of the caller started the operation"
+        },
+        "state": {
+          "$ref": "#/definitions/operationsState",
+          "title": "Operation state"
+        },
+        "done": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Indicates whether the operation is finished"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "Time the operation was started"
+        },
+        "update_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "Time the operation was last updated"
+        },
+        "steps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operationsStep"
+          },
+          "title": "Steps reached by the operation"
+        },
+        "response": {
+          "$ref": "#/definitions/protobufAny",
+          "title": "Response of the method once the operation is finished. Holds per-instance results"
+        },
+        "error": {
+          "type": "string",
+          "title": "Error message if the operation failed"
+        }
+      },
+      "title": "Operation represents a long-running mutation of applications or the cluster"
+    },
+    "operationsState": {
+      "type": "string",
+      "enum": [
+        "RUNNING",
+        "SUCCEEDED",
+        "FAILED",
+        "CANCELLED"
+      ],
+      "default": "RUNNING",
+      "description": "- RUNNING: Operation is running\n - SUCCEEDED: Operation finished, the response holds the result\n - FAILED: Operation failed, the error holds the reason\n - CANCELLED: Operation was cancelled by the caller",
+      "title": "Operation state"
+    },
+    "operationsStep": {
+      "type": "object",
+      "properties": {
+        "timestamp": {
+          "type": "string",
+          "format": "date-time",
+          "title": "Time the step was reached"
+        },
+        "description": {
+          "type": "string",
+          "title": "Step description"
+        }
+      },
+      "title": "Step of a running operation, e.g. chart created, catalog synced or instance ready"
+    },
+    "operationsWaitOperationRequest": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "title": "Operation identifier"
+        },
+        "timeout": {
+          "type": "string",
+          "title": "Maximum time to wait. The operation is returned as is once the timeout is reached"
+        }
+      },
+      "title": "WaitOperationRequest holds attributes required for waiting for an operation"
+    },
+    "protobufAny": {
+      "type": "object",
+      "properties": {
+        "type_url": {
+          "type": "string",
+          "description": "A URL/resource name that uniquely identifies the type of the serialized\nprotocol buffer message. This string must contain at least\none \"/\" character. The last segment of the URL's path must represent\nthe fully qualified name of the type (as in\n\"path/google.protobuf.Duration\"). The name should be in a canonical form\n(e.g., leading \".\" is not accepted).\n\nIn practice, teams usually precompile into the binary all types that they\nexpect it to use in the context of Any. However, for URLs which use the\nscheme \"http\", \"https\", or no scheme, one can optionally set up a type\nserver that maps type URLs to message definitions as follows:\n\n* If no scheme is provided, \"https\" is assumed.\n* An HTTP GET on the URL must yield a [google.protobuf.Type][]\n  value in binary format, or produce an error.\n* Applications are allowed to cache lookup results based on the\n  URL, or have them precompiled into a binary to avoid any\n  lookup. Therefore, binary compatibility needs to be preserved\n  on changes to types. (Use versioned type names to manage\n  breaking changes.)\n\nNote: this functionality is not currently available in the official\nprotobuf release, and it is not used for type URLs beginning with\ntype.googleapis.com.\n\nSchemes other than \"http\", \"https\" (or the empty scheme) might be\nused with implementation specific semantics."
+        },
+        "value": {
+          "type": "string",
+          "format": "byte",
+          "description": "Must be a valid serialized protocol buffer of the above specified type."
+        }
+      },
+      "description": "\"Any\" contains an arbitrary serialized protocol buffer message along with a\nURL that describes the type of the serialized message.\n\nProtobuf library provides support to pack/unpack Any values in the form\nof utility functions or additional generated methods of the Any type.\n\nExample 1: Pack and unpack a message in C++.\n\n    Foo foo = ...;\n    Any any;\n    any.PackFrom(foo);\n    ...\n    if (any.UnpackTo(\u0026foo)) {\n      ...\n    }\n\nExample 2: Pack and unpack a message in Java.\n\n    Foo foo = ...;\n    Any any = Any.pack(foo);\n    ...\n    if (any.is(Foo.class)) {\n      foo = any.unpack(Foo.class);\n    }\n\n Example 3: Pack and unpack a message in Python.\n\n    foo = Foo(...)\n    any = Any()\n    any.Pack(foo)\n    ...\n    if any.Is(Foo.DESCRIPTOR):\n      any.Unpack(foo)\n      ...\n\n Example 4: Pack and unpack a message in Go\n\n     foo := \u0026pb.Foo{...}\n     any, err := ptypes.MarshalAny(foo)\n     ...\n     foo := \u0026pb.Foo{}\n     if err := ptypes.UnmarshalAny(any, foo); err != nil {\n       ...\n     }\n\nThe pack methods provided by protobuf library will by default use\n'type.googleapis.com/full.type.name' as the type URL and the unpack\nmethods only use the fully qualified type name after the last '/'\nin the type URL, for example \"foo.bar.com/x/y.z\" will yield type\nname \"y.z\".\n\n\nJSON\n====\nThe JSON representation of an \"Any\" value uses the regular\nrepresentation of the deserialized, embedded message, with an\nadditional field \"@type\" which contains the type URL. Example:\n\n    package google.profile;\n    message Person {\n      string first_name = 1;\n      string last_name = 2;\n    }\n\n    {\n      \"@type\": \"type.googleapis.com/google.profile.Person\",\n      \"firstName\": \u003cstring\u003e,\n      \"lastName\": \u003cstring\u003e\n    }\n\nIf the embedded message type is well-known and has a custom JSON\nrepresentation, that representation will be embedded adding a field\n\"value\" which holds the custom JSON in addition to the \"@type\"\nfield. Example (for message [google.protobuf.Duration][]):\n\n    {\n      \"@type\": \"type.googleapis.com/google.protobuf.Duration\",\n      \"value\": \"1.212s\"\n    }"
+    }
+  }
+}
+`
+)
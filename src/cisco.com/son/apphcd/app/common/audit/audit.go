@@ -0,0 +1,290 @@
+// Author  <dorzheho@cisco.com>
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	pbappmgr "cisco.com/son/apphcd/api/v1/appmanager"
+	pb "cisco.com/son/apphcd/api/v1/auditmanager"
+	pbclumgr "cisco.com/son/apphcd/api/v1/clustermanager"
+	pbops "cisco.com/son/apphcd/api/v1/operations"
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/inflight"
+	"cisco.com/son/apphcd/app/common/longrunning"
+)
+
+// Value the redacted request values are replaced with
+const redacted = "REDACTED"
+
+// Request fields holding sensitive values. The keys are kept, the values are redacted
+var redactedFields = []string{"secrets", "app_configs"}
+
+// Filter selects the audit records. Empty attributes match any record
+type Filter struct {
+	Start    time.Time // Records at the time or later
+	End      time.Time // Records before the time
+	Identity string    // Caller identity
+	App      string    // Affected application
+	Action   string    // Method, e.g. AppManager/CreateApp
+	Limit    int       // Maximum number of the newest records
+}
+
+// Match tells whether the record matches the filter
+func (f *Filter) Match(r *pb.Record) bool {
+	t, err := ptypes.Timestamp(r.Timestamp)
+	if err != nil {
+		return false
+	}
+
+	if (!f.Start.IsZero() && t.Before(f.Start)) || (!f.End.IsZero() && !t.Before(f.End)) {
+		return false
+	}
+
+	if (f.Identity != "" && r.Identity != f.Identity) || (f.Action != "" && r.Action != f.Action) {
+		return false
+	}
+
+	if f.App == "" {
+		return true
+	}
+
+	for _, app := range r.Apps {
+		if app == f.App {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sink keeps the audit records
+type Sink interface {
+	// Append appends the record. The records are never modified
+	Append(r *pb.Record) error
+	// List gives back the records matching the filter sorted by time
+	List(f *Filter) ([]*pb.Record, error)
+}
+
+// Logger records the mutating requests
+type Logger struct {
+	sink     Sink                  // Records storage
+	registry *longrunning.Registry // Long-running operations the requests are handled by
+}
+
+// NewLogger creates a new audit logger
+func NewLogger(sink Sink, registry *longrunning.Registry) *Logger {
+	return &Logger{sink: sink, registry: registry}
+}
+
+// List gives back the records matching the filter sorted by time
+func (l *Logger) List(f *Filter) ([]*pb.Record, error) {
+	return l.sink.List(f)
+}
+
+// UnaryServerInterceptor records the mutating requests. Requests handled by long-running operations
+// are recorded once the operation is finished
+func (l *Logger) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if inflight.IsReadOnly(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		r := newRecord(start, auth.IdentityName(ctx), auth.ShortMethod(info.FullMethod), req)
+		r.Code = status.Code(err).String()
+		if err != nil {
+			r.Message = status.Convert(err).Message()
+		}
+
+		// Wait for the operation in background, so the response is not delayed
+		if op := l.operation(resp); op != nil {
+			r.OperationId = op.Id()
+			go func() {
+				<-op.Done()
+				setOperationOutcome(r, op.Proto())
+				l.append(r, start)
+			}()
+			return resp, err
+		}
+
+		setResponseOutcome(r, resp)
+		l.append(r, start)
+
+		return resp, err
+	}
+}
+
+// operation gives back the running operation the response refers to. Nil if there is none
+func (l *Logger) operation(resp interface{}) *longrunning.Operation {
+	if l.registry == nil {
+		return nil
+	}
+
+	return l.registry.Referred(resp)
+}
+
+// append sets duration of the record and appends it to the sink
+func (l *Logger) append(r *pb.Record, start time.Time) {
+	r.Duration = ptypes.DurationProto(time.Since(start))
+
+	if err := l.sink.Append(r); err != nil {
+		logrus.WithFields(logrus.Fields{"action": r.Action, "identity": r.Identity, "error": err}).Error("Unable to write audit record")
+	}
+}
+
+// newRecord creates a new record of the request
+func newRecord(start time.Time, identity, action string, req interface{}) *pb.Record {
+	ts, _ := ptypes.TimestampProto(start)
+	r := &pb.Record{Id: uuid.New().String(), Timestamp: ts, Identity: identity, Action: action}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return r
+	}
+
+	r.Request = redact(msg)
+
+	// Requests of particular applications name them either way
+	if named, ok := msg.(interface{ GetName() string }); ok && named.GetName() != "" {
+		r.Apps = append(r.Apps, named.GetName())
+	} else if named, ok := msg.(interface{ GetAppName() string }); ok && named.GetAppName() != "" {
+		r.Apps = append(r.Apps, named.GetAppName())
+	}
+
+	return r
+}
+
+// redact gives back the request in JSON format with the sensitive values redacted
+func redact(msg proto.Message) string {
+	s, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(msg)
+	if err != nil {
+		return ""
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &fields); err != nil {
+		return s
+	}
+
+	for _, name := range redactedFields {
+		values, ok := fields[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for k := range values {
+			values[k] = redacted
+		}
+	}
+
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+// setResponseOutcome sets the outcome and the affected applications of the record according to the response
+func setResponseOutcome(r *pb.Record, resp interface{}) {
+	switch resp := resp.(type) {
+	case *pbappmgr.Response:
+		r.Status = resp.GetStatus().String()
+		r.Message = resp.GetMessage()
+		addAffected(r, resp.GetBody())
+	case *pbclumgr.Response:
+		r.Status = resp.GetStatus().String()
+		r.Message = resp.GetMessage()
+	case *pbops.Operation:
+		r.Status = resp.GetState().String()
+	}
+}
+
+// setOperationOutcome sets the outcome of the record according to the finished operation
+func setOperationOutcome(r *pb.Record, op *pbops.Operation) {
+	r.Status = op.State.String()
+	r.Message = op.Error
+
+	if op.Response == nil {
+		return
+	}
+
+	var resp ptypes.DynamicAny
+	if err := ptypes.UnmarshalAny(op.Response, &resp); err != nil {
+		return
+	}
+
+	setResponseOutcome(r, resp.Message)
+	if op.Error != "" {
+		r.Message = op.Error
+	}
+}
+
+// addAffected adds the applications and instances the response body refers to
+func addAffected(r *pb.Record, body *any.Any) {
+	if body == nil {
+		return
+	}
+
+	var msg ptypes.DynamicAny
+	if err := ptypes.UnmarshalAny(body, &msg); err != nil {
+		return
+	}
+
+	apps := make(map[string]bool)
+	for _, app := range r.Apps {
+		apps[app] = true
+	}
+
+	addApp := func(name string) {
+		if name != "" && !apps[name] {
+			apps[name] = true
+			r.Apps = append(r.Apps, name)
+		}
+	}
+
+	switch m := msg.Message.(type) {
+	case *pbappmgr.App:
+		addApp(m.Name)
+		for _, i := range m.Instances {
+			r.Instances = append(r.Instances, i.Name)
+		}
+	case *pbappmgr.Apps:
+		for _, app := range m.Apps {
+			addApp(app.Name)
+			for _, i := range app.Instances {
+				r.Instances = append(r.Instances, i.Name)
+			}
+		}
+	case *pbappmgr.AppsActivation:
+		var names []string
+		for name := range m.Apps {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			addApp(name)
+			for _, i := range m.Apps[name].Instances {
+				r.Instances = append(r.Instances, i.Name)
+			}
+		}
+	case *pbappmgr.AppTemplates:
+		addApp(m.AppName)
+	}
+}
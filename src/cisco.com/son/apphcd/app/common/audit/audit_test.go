@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	pbappmgr "cisco.com/son/apphcd/api/v1/appmanager"
+	pb "cisco.com/son/apphcd/api/v1/auditmanager"
+)
+
+func TestNewRecord(t *testing.T) {
+	req := &pbappmgr.CreateAppRequest{
+		Name:       "demo",
+		GroupIds:   []string{"g1"},
+		Secrets:    map[string]string{"password": "secret"},
+		AppConfigs: map[string]string{"config.yaml": "token: secret"},
+		EnvVars:    map[string]string{"LEVEL": "debug"},
+	}
+
+	r := newRecord(time.Now(), "admin", "AppManager/CreateApp", req)
+	if strings.Contains(r.Request, `"secret"`) || strings.Contains(r.Request, "token") {
+		t.Fatalf("request not redacted: %s", r.Request)
+	}
+
+	for _, s := range []string{`"password":"REDACTED"`, `"config.yaml":"REDACTED"`, `"LEVEL":"debug"`} {
+		if !strings.Contains(r.Request, s) {
+			t.Fatalf("%s not found in %s", s, r.Request)
+		}
+	}
+
+	if len(r.Apps) != 1 || r.Apps[0] != "demo" {
+		t.Fatalf("unexpected apps %v", r.Apps)
+	}
+
+	body, _ := ptypes.MarshalAny(&pbappmgr.Apps{Apps: []*pbappmgr.App{
+		{Name: "demo", Instances: []*pbappmgr.AppInstance{{Name: "demo-g1"}}},
+		{Name: "other", Instances: []*pbappmgr.AppInstance{{Name: "other-g1"}}},
+	}})
+	setResponseOutcome(r, &pbappmgr.Response{Status: pbappmgr.Status_SUCCESS, Message: "done", Body: body})
+
+	if r.Status != "SUCCESS" || r.Message != "done" {
+		t.Fatalf("unexpected outcome %s: %s", r.Status, r.Message)
+	}
+
+	if strings.Join(r.Apps, ",") != "demo,other" || strings.Join(r.Instances, ",") != "demo-g1,other-g1" {
+		t.Fatalf("unexpected affected apps %v and instances %v", r.Apps, r.Instances)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.jsonl")
+
+	// Every record exceeds the maximal size, hence the file is rotated on each append
+	sink, err := NewFileSink(path, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	for i, action := range []string{"AppManager/CreateApp", "AppManager/UpgradeApp", "AppManager/DeleteApp", "ClusterManager/DeleteNode"} {
+		ts, _ := ptypes.TimestampProto(start.Add(time.Duration(i) * time.Second))
+		if err := sink.Append(&pb.Record{Timestamp: ts, Identity: "admin", Action: action, Apps: []string{"demo"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Fatal("only 2 rotated files expected")
+	}
+
+	records, err := sink.List(&Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 3 || records[0].Action != "AppManager/UpgradeApp" || records[2].Action != "ClusterManager/DeleteNode" {
+		t.Fatalf("unexpected records %v", records)
+	}
+
+	records, _ = sink.List(&Filter{Start: start.Add(3 * time.Second), App: "demo"})
+	if len(records) != 1 || records[0].Action != "ClusterManager/DeleteNode" {
+		t.Fatalf("unexpected records %v", records)
+	}
+
+	records, _ = sink.List(&Filter{Action: "AppManager/DeleteApp", Identity: "admin", Limit: 1})
+	if len(records) != 1 {
+		t.Fatalf("unexpected records %v", records)
+	}
+
+	// The newest records are kept
+	records, _ = sink.List(&Filter{Limit: 2})
+	if len(records) != 2 || records[0].Action != "AppManager/DeleteApp" || records[1].Action != "ClusterManager/DeleteNode" {
+		t.Fatalf("unexpected records %v", records)
+	}
+
+	if records, _ = sink.List(&Filter{App: "other"}); len(records) != 0 {
+		t.Fatalf("unexpected records %v", records)
+	}
+}
@@ -0,0 +1,232 @@
+// Author  <dorzheho@cisco.com>
+
+package audit
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/sirupsen/logrus"
+
+	pb "cisco.com/son/apphcd/api/v1/auditmanager"
+)
+
+// Maximal size of a record line read back from the files
+const maxRecordSize = 4 * 1024 * 1024
+
+// FileSink keeps the records in JSON Lines files. The file is rotated once it exceeds the maximal size,
+// e.g. audit.jsonl is renamed to audit.jsonl.1, audit.jsonl.1 to audit.jsonl.2 and so on.
+// The oldest file is removed once the number of rotated files exceeds the maximal number
+type FileSink struct {
+	path       string     // Current file
+	maxSize    int64      // Size in bytes the file is rotated after
+	maxBackups int        // Number of rotated files kept
+	mu         sync.Mutex // Protects the fields below
+	file       *os.File   // Current file opened for appending
+	size       int64      // Size of the current file
+}
+
+// NewFileSink creates a new file sink. The directory of the file is created unless exists
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, err
+	}
+
+	s := &FileSink{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// open opens the current file for appending. Must be called with the sink locked unless created
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+// Append appends the record as a line to the current file
+func (s *FileSink) Append(r *pb.Record) error {
+	line, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(r)
+	if err != nil {
+		return err
+	}
+	line += "\n"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return fmt.Errorf("unable to rotate %s: %v", s.path, err)
+		}
+	}
+
+	n, err := s.file.WriteString(line)
+	s.size += int64(n)
+	return err
+}
+
+// rotate renames the files and opens the new current one. Must be called with the sink locked
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+
+	if s.maxBackups < 1 {
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return s.open()
+	}
+
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(s.backup(i), s.backup(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if err := os.Rename(s.path, s.backup(1)); err != nil {
+		return err
+	}
+
+	return s.open()
+}
+
+// backup gives back path of the rotated file
+func (s *FileSink) backup(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+// List reads the records from the rotated files and the current one. The files are opened with the sink locked
+// and read without it, so the appends are not blocked meanwhile
+func (s *FileSink) List(f *Filter) ([]*pb.Record, error) {
+	files, err := s.snapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, file := range files {
+			_ = file.Close()
+		}
+	}()
+
+	var records []*pb.Record
+	for _, file := range files {
+		matched, err := readRecords(file, f)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, matched...)
+	}
+
+	// Records of the long-running operations are appended once the operations are finished
+	sort.SliceStable(records, func(i, j int) bool {
+		ti, _ := ptypes.Timestamp(records[i].Timestamp)
+		tj, _ := ptypes.Timestamp(records[j].Timestamp)
+		return ti.Before(tj)
+	})
+
+	// The newest records are kept
+	if f.Limit > 0 && len(records) > f.Limit {
+		records = records[len(records)-f.Limit:]
+	}
+
+	return records, nil
+}
+
+// snapshotFile is a file opened for reading. Only the records written before it was opened are read
+type snapshotFile struct {
+	*os.File
+	size int64 // Size of the file once opened
+}
+
+// snapshot opens the rotated files from the oldest one and the current one. The opened files
+// are not affected by the rotations. Missing files are skipped
+func (s *FileSink) snapshot() ([]*snapshotFile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var paths []string
+	for i := s.maxBackups; i >= 1; i-- {
+		paths = append(paths, s.backup(i))
+	}
+	paths = append(paths, s.path)
+
+	var files []*snapshotFile
+	for _, path := range paths {
+		file, err := openSnapshot(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		if err != nil {
+			for _, file := range files {
+				_ = file.Close()
+			}
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// openSnapshot opens the file for reading
+func openSnapshot(path string) (*snapshotFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return &snapshotFile{File: file, size: info.Size()}, nil
+}
+
+// readRecords reads the records matching the filter from the file
+func readRecords(file *snapshotFile, f *Filter) ([]*pb.Record, error) {
+	var records []*pb.Record
+	scanner := bufio.NewScanner(io.LimitReader(file, file.size))
+	scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+	for scanner.Scan() {
+		r := &pb.Record{}
+		// A record might be truncated if the controller was killed while writing it
+		if err := jsonpb.UnmarshalString(scanner.Text(), r); err != nil {
+			logrus.WithFields(logrus.Fields{"file": file.Name(), "error": err}).Warn("Skipping malformed audit record")
+			continue
+		}
+
+		if f.Match(r) {
+			records = append(records, r)
+		}
+	}
+
+	return records, scanner.Err()
+}
@@ -0,0 +1,151 @@
+// Author  <dorzheho@cisco.com>
+
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authentication methods
+const (
+	MethodToken = "token" // Static bearer token
+	MethodJwt   = "jwt"   // JSON Web Token
+	MethodMtls  = "mtls"  // Client certificate
+)
+
+// Methods of the standard gRPC health service
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// Identity of the authenticated caller
+type Identity struct {
+	Name   string   // Identity name
+	Groups []string // Groups the identity belongs to
+	Method string   // Authentication method
+}
+
+// Authenticator authenticates callers of gRPC requests
+type Authenticator interface {
+	// Authenticate gives back the caller identity.
+	// Gives back nil if the request doesn't carry credentials supported by the authenticator
+	Authenticate(ctx context.Context) (*Identity, error)
+}
+
+// Context key of the caller identity
+type identityKey struct{}
+
+// NewContext gives back a copy of the context carrying the caller identity
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext gives back the caller identity. Nil if the request was not authenticated
+func FromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
+
+// IdentityName gives back name of the caller identity for logging
+func IdentityName(ctx context.Context) string {
+	if id := FromContext(ctx); id != nil {
+		return id.Name
+	}
+
+	return "anonymous"
+}
+
+// Authorizer authenticates callers of gRPC requests and authorizes them according to the policy
+type Authorizer struct {
+	authenticators []Authenticator // Authenticators in order of precedence
+	policy         *Policy         // Nil allows any method to any authenticated identity
+}
+
+// NewAuthorizer creates a new authorizer
+func NewAuthorizer(policy *Policy, authenticators ...Authenticator) *Authorizer {
+	return &Authorizer{authenticators: authenticators, policy: policy}
+}
+
+// authorize authenticates the caller and checks whether the identity is allowed to call the method
+func (a *Authorizer) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	// Health is checked by the probes having no credentials
+	if strings.HasPrefix(fullMethod, healthServicePrefix) {
+		return ctx, nil
+	}
+
+	var id *Identity
+	for _, authenticator := range a.authenticators {
+		var err error
+		id, err = authenticator.Authenticate(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+
+		if id != nil {
+			break
+		}
+	}
+
+	if id == nil {
+		return nil, status.Error(codes.Unauthenticated, "no valid credentials provided")
+	}
+
+	// Bearer token takes precedence over client certificate
+	if id.Method == MethodMtls && bearerToken(ctx) != "" {
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+
+	if a.policy != nil && !a.policy.Allowed(id, fullMethod) {
+		logrus.WithFields(logrus.Fields{
+			"identity": id.Name,
+			"method":   fullMethod,
+		}).Warn("Permission denied")
+
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", id.Name, ShortMethod(fullMethod))
+	}
+
+	return NewContext(ctx, id), nil
+}
+
+// UnaryServerInterceptor authorizes unary requests
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		newCtx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(newCtx, req)
+	}
+}
+
+// StreamServerInterceptor authorizes streaming requests
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		newCtx, err := a.authorize(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = newCtx
+		return handler(srv, wrapped)
+	}
+}
+
+// ShortMethod gives back gRPC method name without the package, e.g. AppManager/GetApps
+func ShortMethod(fullMethod string) string {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		if j := strings.LastIndex(fullMethod[:i], "."); j >= 0 {
+			return fullMethod[j+1:]
+		}
+	}
+
+	return fullMethod
+}
@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// withToken gives back incoming context carrying the bearer token
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+// b64 encodes the data in base64url
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// sign creates JSON Web Token signed by the key
+func sign(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := b64(header) + "." + b64(payload)
+
+	hash := map[string]crypto.Hash{"256": crypto.SHA256, "384": crypto.SHA384, "512": crypto.SHA512}[alg[len(alg)-3:]]
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		if signature, err = rsa.SignPKCS1v15(rand.Reader, k, hash, digest); err != nil {
+			t.Fatal(err)
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		signature = append(r.FillBytes(make([]byte, size)), s.FillBytes(make([]byte, size))...)
+	}
+
+	return signed + "." + b64(signature)
+}
+
+func TestJwtAuthenticator(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	jwks, _ := json.Marshal(map[string]interface{}{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": b64(ecKey.X.Bytes()), "y": b64(ecKey.Y.Bytes())},
+	}})
+
+	keys, err := ParseJwks(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewJwtAuthenticator(keys, JwtOptions{Issuer: "https://idp", Audience: "apphc"})
+	exp := float64(time.Now().Add(time.Hour).Unix())
+	claims := map[string]interface{}{"iss": "https://idp", "aud": []string{"apphc"}, "sub": "alice", "groups": []string{"admins"}, "exp": exp}
+
+	for _, token := range []string{sign(t, "RS256", "rsa", rsaKey, claims), sign(t, "ES256", "ec", ecKey, claims)} {
+		id, err := a.Authenticate(withToken(token))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if id.Name != "alice" || len(id.Groups) != 1 || id.Groups[0] != "admins" || id.Method != MethodJwt {
+			t.Fatalf("unexpected identity %v", id)
+		}
+	}
+
+	invalid := map[string]string{
+		"wrong key":      sign(t, "RS256", "ec", rsaKey, claims),
+		"unknown key":    sign(t, "RS256", "other", rsaKey, claims),
+		"expired":        sign(t, "RS256", "rsa", rsaKey, map[string]interface{}{"iss": "https://idp", "aud": "apphc", "sub": "alice", "exp": 1}),
+		"wrong audience": sign(t, "RS256", "rsa", rsaKey, map[string]interface{}{"iss": "https://idp", "aud": "other", "sub": "alice", "exp": exp}),
+		"wrong issuer":   sign(t, "RS256", "rsa", rsaKey, map[string]interface{}{"iss": "https://other", "aud": "apphc", "sub": "alice", "exp": exp}),
+		"none algorithm": b64([]byte(`{"alg":"none"}`)) + "." + b64([]byte(`{"sub":"alice"}`)) + ".",
+	}
+
+	for name, token := range invalid {
+		if _, err := a.Authenticate(withToken(token)); err == nil {
+			t.Fatalf("%s: token accepted", name)
+		}
+	}
+
+	// Opaque tokens are left to the other authenticators
+	if id, err := a.Authenticate(withToken("opaque")); id != nil || err != nil {
+		t.Fatalf("unexpected result for opaque token: %v %v", id, err)
+	}
+}
+
+func TestJwtAlgorithms(t *testing.T) {
+	ecKeys := map[string]*ecdsa.PrivateKey{}
+	var jwk []map[string]string
+	for _, curve := range []elliptic.Curve{elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+		key, _ := ecdsa.GenerateKey(curve, rand.Reader)
+		size := (curve.Params().BitSize + 7) / 8
+		name := curve.Params().Name
+		ecKeys[name] = key
+		jwk = append(jwk, map[string]string{"kty": "EC", "kid": name, "crv": name,
+			"x": b64(key.X.FillBytes(make([]byte, size))), "y": b64(key.Y.FillBytes(make([]byte, size)))})
+	}
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	jwk = append(jwk, map[string]string{"kty": "RSA", "kid": "rsa", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())})
+
+	jwks, _ := json.Marshal(map[string]interface{}{"keys": jwk})
+	keys, err := ParseJwks(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewJwtAuthenticator(keys, JwtOptions{})
+	claims := map[string]interface{}{"sub": "alice", "exp": float64(time.Now().Add(time.Hour).Unix())}
+	payload, _ := json.Marshal(claims)
+
+	// Each curve is accepted with its own algorithm only
+	for curve, alg := range map[string]string{"P-256": "ES256", "P-384": "ES384", "P-521": "ES512"} {
+		for _, other := range []string{"ES256", "ES384", "ES512"} {
+			_, err := a.Authenticate(withToken(sign(t, other, curve, ecKeys[curve], claims)))
+			if other == alg && err != nil {
+				t.Fatalf("%s signed by %s key rejected: %v", other, curve, err)
+			}
+
+			if other != alg && err == nil {
+				t.Fatalf("%s signed by %s key accepted", other, curve)
+			}
+		}
+	}
+
+	// Unsigned tokens are rejected whatever the key is
+	for _, kid := range []string{"P-256", "rsa", ""} {
+		for _, alg := range []string{"none", "None", "NONE"} {
+			header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+			if _, err := a.Authenticate(withToken(b64(header) + "." + b64(payload) + ".")); err == nil {
+				t.Fatalf("unsigned token with algorithm %s and key %q accepted", alg, kid)
+			}
+		}
+	}
+
+	// RSA algorithms are not accepted for ECDSA keys and the other way around
+	if _, err := a.Authenticate(withToken(sign(t, "RS256", "P-256", ecKeys["P-256"], claims))); err == nil {
+		t.Fatal("RS256 signed by ECDSA key accepted")
+	}
+
+	if _, err := a.Authenticate(withToken(sign(t, "ES256", "rsa", rsaKey, claims))); err == nil {
+		t.Fatal("ES256 signed by RSA key accepted")
+	}
+}
+
+func TestAuthorizer(t *testing.T) {
+	tokens := NewStaticTokens()
+	tokens.Add("admin-token", "admin", "admins")
+	tokens.Add("viewer-token", "viewer")
+
+	policy := &Policy{Rules: []Rule{
+		{Identities: []string{"group:admins"}, Methods: []string{"*"}},
+		{Identities: []string{"*"}, Methods: []string{"AppManager/Get*", "ClusterManager/GetClusterInfo"}},
+	}}
+
+	if err := policy.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewAuthorizer(policy, tokens)
+
+	cases := []struct {
+		token  string
+		method string
+		code   codes.Code
+	}{
+		{"admin-token", "/com.cisco.son.apphcd.api.v1.clustermanager.ClusterManager/UpgradeCluster", codes.OK},
+		{"viewer-token", "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/GetApps", codes.OK},
+		{"viewer-token", "/com.cisco.son.apphcd.api.v1.clustermanager.ClusterManager/GetClusterInfo", codes.OK},
+		{"viewer-token", "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/DeleteApps", codes.PermissionDenied},
+		{"unknown-token", "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/GetApps", codes.Unauthenticated},
+	}
+
+	for _, c := range cases {
+		ctx, err := a.authorize(withToken(c.token), c.method)
+		if status.Code(err) != c.code {
+			t.Fatalf("%s %s: expected %s, got %v", c.token, c.method, c.code, err)
+		}
+
+		if err == nil && FromContext(ctx) == nil {
+			t.Fatalf("%s %s: identity is missing in the context", c.token, c.method)
+		}
+	}
+}
+
+func TestStaticTokensReplace(t *testing.T) {
+	tokens := NewStaticTokens()
+	tokens.Add("old-token", "default")
+	tokens.Add("viewer-token", "viewer")
+
+	tokens.Replace("new-token", "default")
+	if id, _ := tokens.Authenticate(withToken("old-token")); id != nil {
+		t.Fatal("replaced token expected to be rejected")
+	}
+
+	if id, _ := tokens.Authenticate(withToken("new-token")); id == nil || id.Name != "default" {
+		t.Fatalf("unexpected identity %v", id)
+	}
+
+	tokens.Replace("", "default")
+	if tokens.Len() != 1 {
+		t.Fatalf("expected 1 token, got %d", tokens.Len())
+	}
+}
@@ -0,0 +1,42 @@
+// Author  <dorzheho@cisco.com>
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// GatewayTokenKey is the metadata key of the token the in-process gRPC Gateway sends along with the requests
+const GatewayTokenKey = "x-apphc-gateway-token"
+
+// gatewayToken is generated at start, so only the gRPC Gateway of the same process knows it
+var gatewayToken = newGatewayToken()
+
+// newGatewayToken generates a random token
+func newGatewayToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// GatewayToken gives back the token of the in-process gRPC Gateway
+func GatewayToken() string {
+	return gatewayToken
+}
+
+// FromGateway tells whether the request was forwarded by the in-process gRPC Gateway.
+// Only then the metadata set by the gRPC Gateway on behalf of HTTP clients can be trusted, e.g. x-forwarded-for
+func FromGateway(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(GatewayTokenKey)
+
+	return len(tokens) == 1 && subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(gatewayToken)) == 1
+}
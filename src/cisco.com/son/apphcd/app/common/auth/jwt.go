@@ -0,0 +1,239 @@
+// Author  <dorzheho@cisco.com>
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Allowed clock skew between the token issuer and the Controller
+const jwtLeeway = time.Minute
+
+// JwtOptions configures validation of the token claims
+type JwtOptions struct {
+	Issuer        string // Expected issuer. Not checked if empty
+	Audience      string // Expected audience. Not checked if empty
+	IdentityClaim string // Claim containing the identity name
+	GroupsClaim   string // Claim containing the identity groups
+}
+
+// JwtAuthenticator validates bearer tokens being JSON Web Tokens signed by the keys of the key set
+type JwtAuthenticator struct {
+	keys KeySet           // Signing keys
+	opts JwtOptions       // Claims validation options
+	now  func() time.Time // Current time
+}
+
+// jwtHeader is the JOSE header of the token
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// NewJwtAuthenticator creates a new JWT authenticator
+func NewJwtAuthenticator(keys KeySet, opts JwtOptions) *JwtAuthenticator {
+	if opts.IdentityClaim == "" {
+		opts.IdentityClaim = "sub"
+	}
+
+	if opts.GroupsClaim == "" {
+		opts.GroupsClaim = "groups"
+	}
+
+	return &JwtAuthenticator{keys: keys, opts: opts, now: time.Now}
+}
+
+// Authenticate validates the bearer token of the request.
+// Tokens which are not JSON Web Tokens are left to the other authenticators
+func (a *JwtAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	token := bearerToken(ctx)
+	if strings.Count(token, ".") != 2 {
+		return nil, nil
+	}
+
+	claims, err := a.verify(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %v", err)
+	}
+
+	if err := a.validateClaims(claims); err != nil {
+		return nil, fmt.Errorf("invalid token: %v", err)
+	}
+
+	name, _ := claims[a.opts.IdentityClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("invalid token: claim %s is missing", a.opts.IdentityClaim)
+	}
+
+	return &Identity{Name: name, Groups: stringList(claims[a.opts.GroupsClaim]), Method: MethodJwt}, nil
+}
+
+// verify checks the token signature and gives back the token claims
+func (a *JwtAuthenticator) verify(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := a.keys.Key(header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifySignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// validateClaims checks the token validity period, issuer and audience
+func (a *JwtAuthenticator) validateClaims(claims map[string]interface{}) error {
+	now := a.now()
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return errors.New("claim exp is missing")
+	}
+
+	if now.After(time.Unix(int64(exp), 0).Add(jwtLeeway)) {
+		return errors.New("token is expired")
+	}
+
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(jwtLeeway).Before(time.Unix(int64(nbf), 0)) {
+		return errors.New("token is not valid yet")
+	}
+
+	if a.opts.Issuer != "" && claims["iss"] != a.opts.Issuer {
+		return fmt.Errorf("unexpected issuer %v", claims["iss"])
+	}
+
+	if a.opts.Audience != "" {
+		found := false
+		for _, aud := range stringList(claims["aud"]) {
+			if aud == a.opts.Audience {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("token is not issued for audience %s", a.opts.Audience)
+		}
+	}
+
+	return nil
+}
+
+// ecdsaAlgorithms are the algorithms by the curves of the ECDSA keys
+var ecdsaAlgorithms = map[string]string{
+	"P-256": "ES256",
+	"P-384": "ES384",
+	"P-521": "ES512",
+}
+
+// verifySignature verifies the signature according to the algorithm
+func verifySignature(alg string, key crypto.PublicKey, signed, signature []byte) error {
+	// Algorithms are named as RS256, PS384, ES512 etc
+	if len(alg) != 5 {
+		return fmt.Errorf("unsupported algorithm %s", alg)
+	}
+
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported algorithm %s", alg)
+	}
+
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		switch alg[:2] {
+		case "RS":
+			return rsa.VerifyPKCS1v15(k, hash, digest, signature)
+		case "PS":
+			return rsa.VerifyPSS(k, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		}
+	case *ecdsa.PublicKey:
+		// The curve is bound to the algorithm, e.g. ES256 is only valid for P-256 keys
+		if alg[:2] != "ES" || ecdsaAlgorithms[k.Curve.Params().Name] != alg {
+			break
+		}
+
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid signature")
+		}
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("algorithm %s doesn't match the key", alg)
+}
+
+// decodeSegment decodes base64url encoded JSON segment of the token
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// stringList converts claim being either a string or a list of strings to a list
+func stringList(claim interface{}) []string {
+	switch c := claim.(type) {
+	case string:
+		return []string{c}
+	case []interface{}:
+		var list []string
+		for _, v := range c {
+			if s, ok := v.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+
+	return nil
+}
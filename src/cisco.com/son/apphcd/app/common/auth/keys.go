@@ -0,0 +1,266 @@
+// Author  <dorzheho@cisco.com>
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	oidcKeysTtl            = time.Hour        // Time the keys of OIDC issuer are cached for
+	oidcKeysRefreshBackoff = time.Minute      // Minimal interval between fetches of the keys of OIDC issuer
+	oidcHttpTimeout        = 10 * time.Second // Timeout of the requests to OIDC issuer
+)
+
+// KeySet gives back keys verifying signatures of the tokens
+type KeySet interface {
+	// Key gives back the key by its identifier.
+	// The only key of the set is given back if the identifier is empty
+	Key(kid string) (crypto.PublicKey, error)
+}
+
+// jsonWebKey is a public key in JWK format
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// staticKeySet keeps the keys in memory
+type staticKeySet map[string]crypto.PublicKey
+
+// Key gives back the key by its identifier
+func (s staticKeySet) Key(kid string) (crypto.PublicKey, error) {
+	if kid == "" && len(s) == 1 {
+		for _, k := range s {
+			return k, nil
+		}
+	}
+
+	if k, ok := s[kid]; ok {
+		return k, nil
+	}
+
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// LoadJwks reads the keys from JWKS file
+func LoadJwks(file string) (KeySet, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := ParseJwks(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWKS file %s: %v", file, err)
+	}
+
+	return keys, nil
+}
+
+// ParseJwks parses the keys in JWKS format. Keys not used for signatures are skipped
+func ParseJwks(data []byte) (KeySet, error) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(staticKeySet)
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %v", jwk.Kid, err)
+		}
+
+		keys[jwk.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no signing keys found")
+	}
+
+	return keys, nil
+}
+
+// publicKey converts the JWK to public key
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+}
+
+// decodeBigInt decodes base64url encoded big-endian integer
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
+
+// oidcKeySet fetches the keys of OIDC issuer discovered by its configuration document
+type oidcKeySet struct {
+	issuer  string       // Issuer URL
+	client  *http.Client // HTTP client
+	mu      sync.Mutex   // Protects the fields below
+	keys    staticKeySet // Cached keys
+	fetched time.Time    // Time the keys were fetched
+}
+
+// NewOidcKeySet creates a new key set of OIDC issuer. The keys are fetched on demand
+func NewOidcKeySet(issuer string) KeySet {
+	return &oidcKeySet{
+		issuer: strings.TrimSuffix(issuer, "/"),
+		client: &http.Client{Timeout: oidcHttpTimeout},
+	}
+}
+
+// Key gives back the key by its identifier. The keys are fetched again if they are expired
+// or the key is unknown, since the issuer might have rotated them
+func (s *oidcKeySet) Key(kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.keys != nil {
+		key, err := s.keys.Key(kid)
+		if err == nil && time.Since(s.fetched) < oidcKeysTtl {
+			return key, nil
+		}
+
+		if time.Since(s.fetched) < oidcKeysRefreshBackoff {
+			return key, err
+		}
+	} else if time.Since(s.fetched) < oidcKeysRefreshBackoff {
+		return nil, fmt.Errorf("signing keys of %s are not available", s.issuer)
+	}
+
+	s.fetched = time.Now()
+	keys, err := s.fetch()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"issuer": s.issuer, "error": err}).Error("Unable to fetch signing keys")
+		if s.keys == nil {
+			return nil, fmt.Errorf("signing keys of %s are not available", s.issuer)
+		}
+	} else {
+		s.keys = keys
+	}
+
+	return s.keys.Key(kid)
+}
+
+// fetch discovers the JWKS endpoint and fetches the keys
+func (s *oidcKeySet) fetch() (staticKeySet, error) {
+	data, err := s.get(s.issuer + "/.well-known/openid-configuration")
+	if err != nil {
+		return nil, err
+	}
+
+	var config struct {
+		Issuer  string `json:"issuer"`
+		JwksUri string `json:"jwks_uri"`
+	}
+
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSuffix(config.Issuer, "/") != s.issuer {
+		return nil, fmt.Errorf("issuer %s doesn't match the discovery document", config.Issuer)
+	}
+
+	if data, err = s.get(config.JwksUri); err != nil {
+		return nil, err
+	}
+
+	keys, err := ParseJwks(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys.(staticKeySet), nil
+}
+
+// get fetches the document
+func (s *oidcKeySet) get(url string) ([]byte, error) {
+	resp, err := s.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s from %s", resp.Status, url)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
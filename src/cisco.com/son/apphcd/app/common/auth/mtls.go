@@ -0,0 +1,66 @@
+// Author  <dorzheho@cisco.com>
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ForwardedClientCertKey is the metadata key of the client certificate forwarded by the gRPC Gateway.
+// The certificate is DER encoded in base64
+const ForwardedClientCertKey = "x-apphc-forwarded-client-cert"
+
+// MtlsAuthenticator takes the identity from the client certificate verified during TLS handshake.
+// The identity name is the certificate common name and the groups are the certificate organizations
+type MtlsAuthenticator struct {
+	// Tells whether the certificate belongs to the gRPC Gateway, which forwards the certificates of HTTP clients
+	isGateway func(cert *x509.Certificate) bool
+}
+
+// NewMtlsAuthenticator creates a new mTLS authenticator
+func NewMtlsAuthenticator(isGateway func(cert *x509.Certificate) bool) *MtlsAuthenticator {
+	return &MtlsAuthenticator{isGateway: isGateway}
+}
+
+// Authenticate gives back identity of the client certificate
+func (a *MtlsAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil, nil
+	}
+
+	cert := tlsInfo.State.PeerCertificates[0]
+	if a.isGateway(cert) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		forwarded := md.Get(ForwardedClientCertKey)
+		if len(forwarded) != 1 {
+			return nil, nil
+		}
+
+		raw, err := base64.StdEncoding.DecodeString(forwarded[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if cert, err = x509.ParseCertificate(raw); err != nil {
+			return nil, err
+		}
+	}
+
+	if cert.Subject.CommonName == "" {
+		return nil, nil
+	}
+
+	return &Identity{Name: cert.Subject.CommonName, Groups: cert.Subject.Organization, Method: MethodMtls}, nil
+}
@@ -0,0 +1,108 @@
+// Author  <dorzheho@cisco.com>
+
+package auth
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Prefix of the group identities in the policy rules
+const groupPrefix = "group:"
+
+// Rule allows the identities to call the methods
+type Rule struct {
+	Identities []string `yaml:"identities"` // Identity names, group:<name> for the group members or * for any identity
+	Methods    []string `yaml:"methods"`    // Method patterns, e.g. AppManager/Get*, ClusterManager/* or * for any method
+}
+
+// Policy maps identities to the allowed gRPC methods. The methods are not allowed unless a rule allows them
+type Policy struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// LoadPolicy reads the policy from YAML file
+func LoadPolicy(file string) (*Policy, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Policy{}
+	if err := yaml.UnmarshalStrict(data, p); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %v", file, err)
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %v", file, err)
+	}
+
+	return p, nil
+}
+
+// Validate checks the method patterns of the rules
+func (p *Policy) Validate() error {
+	for i, r := range p.Rules {
+		if len(r.Identities) == 0 || len(r.Methods) == 0 {
+			return fmt.Errorf("rule %d misses identities or methods", i)
+		}
+
+		for _, m := range r.Methods {
+			if _, err := path.Match(m, ""); err != nil {
+				return fmt.Errorf("rule %d: invalid method pattern %q", i, m)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Allowed tells whether the identity is allowed to call the gRPC method
+func (p *Policy) Allowed(id *Identity, fullMethod string) bool {
+	method := ShortMethod(fullMethod)
+	for _, r := range p.Rules {
+		if r.matchIdentity(id) && r.matchMethod(method) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchIdentity tells whether the rule applies to the identity
+func (r *Rule) matchIdentity(id *Identity) bool {
+	for _, i := range r.Identities {
+		if i == "*" || i == id.Name {
+			return true
+		}
+
+		if strings.HasPrefix(i, groupPrefix) {
+			for _, g := range id.Groups {
+				if g == strings.TrimPrefix(i, groupPrefix) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// matchMethod tells whether the rule applies to the method
+func (r *Rule) matchMethod(method string) bool {
+	for _, m := range r.Methods {
+		if m == "*" {
+			return true
+		}
+
+		if ok, _ := path.Match(m, method); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,123 @@
+// Author  <dorzheho@cisco.com>
+
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"gopkg.in/yaml.v2"
+)
+
+// staticToken is a preconfigured bearer token
+type staticToken struct {
+	Token    string   `yaml:"token"`    // Bearer token
+	Identity string   `yaml:"identity"` // Identity name
+	Groups   []string `yaml:"groups"`   // Groups the identity belongs to
+}
+
+// StaticTokens authenticates bearer tokens against the preconfigured ones
+type StaticTokens struct {
+	mu     sync.RWMutex // Protects the tokens
+	tokens []staticToken
+}
+
+// NewStaticTokens creates a new empty set of static tokens
+func NewStaticTokens() *StaticTokens {
+	return &StaticTokens{}
+}
+
+// LoadStaticTokens reads the static tokens from YAML file containing a list of token, identity and groups
+func LoadStaticTokens(path string) (*StaticTokens, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &StaticTokens{}
+	if err := yaml.UnmarshalStrict(data, &s.tokens); err != nil {
+		return nil, fmt.Errorf("invalid tokens file %s: %v", path, err)
+	}
+
+	for i, t := range s.tokens {
+		if t.Token == "" || t.Identity == "" {
+			return nil, fmt.Errorf("invalid tokens file %s: entry %d misses token or identity", path, i)
+		}
+	}
+
+	return s, nil
+}
+
+// Add adds a new token
+func (s *StaticTokens) Add(token, identity string, groups ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = append(s.tokens, staticToken{Token: token, Identity: identity, Groups: groups})
+}
+
+// Replace replaces the tokens of the identity with the token. Empty token removes them
+func (s *StaticTokens) Replace(token, identity string, groups ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens := make([]staticToken, 0, len(s.tokens)+1)
+	for _, t := range s.tokens {
+		if t.Identity != identity {
+			tokens = append(tokens, t)
+		}
+	}
+
+	if token != "" {
+		tokens = append(tokens, staticToken{Token: token, Identity: identity, Groups: groups})
+	}
+
+	s.tokens = tokens
+}
+
+// Len gives back number of the tokens
+func (s *StaticTokens) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.tokens)
+}
+
+// Authenticate looks up the bearer token of the request
+func (s *StaticTokens) Authenticate(ctx context.Context) (*Identity, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		return nil, nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Compare every token in constant time
+	var found *staticToken
+	for i := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.tokens[i].Token)) == 1 && found == nil {
+			found = &s.tokens[i]
+		}
+	}
+
+	if found == nil {
+		return nil, nil
+	}
+
+	return &Identity{Name: found.Identity, Groups: found.Groups, Method: MethodToken}, nil
+}
+
+// bearerToken gives back the bearer token of the request. Empty if not provided
+func bearerToken(ctx context.Context) string {
+	token, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return ""
+	}
+
+	// Remove leading/trailing spaces
+	return strings.TrimSpace(token)
+}
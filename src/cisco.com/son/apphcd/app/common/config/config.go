@@ -0,0 +1,198 @@
+// Author  <dorzheho@cisco.com>
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/template"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/metrics"
+	"cisco.com/son/apphcd/app/grpc/common/rancher"
+)
+
+// Prefix of the keys of the monitor URL templates
+const monitorTemplatesPrefix = "templates.url.monitor."
+
+// Settings that can be changed without restarting the controller
+type Settings struct {
+	LogLevel              logrus.Level        // Level of the logs
+	LogFormat             appcommon.LogFormat // Format of the logs
+	BearerToken           string              // Preconfigured bearer token
+	MonitorTemplates      map[string]string   // Monitor URL templates by the configuration key
+	UpgradePolicyRecreate bool                // Whether the applications are re-created on upgrade
+	RancherEndpoint       string              // Rancher server endpoint
+	RancherToken          string              // Rancher server token
+	StatusCodes           bool                // Whether the failed responses are reported by the status codes
+}
+
+// MonitorTemplate gives back the monitor URL template of the configuration key
+func (s *Settings) MonitorTemplate(key string) string {
+	return s.MonitorTemplates[key]
+}
+
+// Hook applies the reloaded settings. A failed hook must leave the applied settings unchanged,
+// the hooks succeeded before it are called again with the current settings
+type Hook func(s *Settings) error
+
+var (
+	current atomic.Value // *Settings
+
+	hooksMu sync.Mutex
+	hooks   []Hook
+)
+
+// Load reads the settings from the configuration and validates them
+func Load() (*Settings, error) {
+	s := &Settings{
+		LogFormat:             appcommon.LogFormat(viper.GetString(appcommon.EnvApphcLogFormat)),
+		BearerToken:           viper.GetString(appcommon.EnvApphcBearerToken),
+		MonitorTemplates:      make(map[string]string),
+		UpgradePolicyRecreate: viper.GetBool(appcommon.EnvApphcAppsUpgradePolicyRecreate),
+		RancherEndpoint:       viper.GetString(rancher.EnvApphcAdaptersRancherServerEndpoint),
+		RancherToken:          viper.GetString(rancher.EnvApphcAdaptersRancherServerCredsToken),
+		StatusCodes:           viper.GetBool(appcommon.EnvApphcStatusCodesEnabled),
+	}
+
+	level, err := logrus.ParseLevel(viper.GetString(appcommon.EnvApphcLogLevel))
+	if err != nil {
+		return nil, fmt.Errorf("invalid APPHC_LOG_LEVEL: %v", err)
+	}
+	s.LogLevel = level
+
+	if s.LogFormat != appcommon.LogFormatText && s.LogFormat != appcommon.LogFormatJson {
+		return nil, fmt.Errorf("invalid APPHC_LOG_FORMAT: %q is neither %s nor %s",
+			s.LogFormat, appcommon.LogFormatText, appcommon.LogFormatJson)
+	}
+
+	for _, key := range viper.AllKeys() {
+		if !strings.HasPrefix(key, monitorTemplatesPrefix) {
+			continue
+		}
+
+		tmplt := viper.GetString(key)
+		if _, err := template.New(key).Parse(tmplt); err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", key, err)
+		}
+
+		s.MonitorTemplates[key] = tmplt
+	}
+
+	// At least one authentication method is required
+	if viper.GetBool(appcommon.EnvApphcInternalAuthorizationEnabled) &&
+		s.BearerToken == "" &&
+		viper.GetString(appcommon.EnvApphcAuthTokensFile) == "" &&
+		viper.GetString(appcommon.EnvApphcAuthJwtJwksFile) == "" &&
+		viper.GetString(appcommon.EnvApphcAuthJwtIssuer) == "" &&
+		!(viper.GetBool(appcommon.EnvApphcTlsEnabled) && viper.GetString(appcommon.EnvApphcTlsClientCaFile) != "") {
+		return nil, fmt.Errorf("invalid APPHC_BEARER_TOKEN: no authentication method configured")
+	}
+
+	if viper.GetString(appcommon.EnvApphcAdapter) == appcommon.AdapterRancher {
+		if s.RancherEndpoint == "" {
+			return nil, fmt.Errorf("invalid APPHC_ADAPTERS_RANCHER_SERVER_ENDPOINT: empty endpoint")
+		}
+
+		if s.RancherToken == "" {
+			return nil, fmt.Errorf("invalid APPHC_ADAPTERS_RANCHER_SERVER_CREDS_TOKEN: empty token")
+		}
+	}
+
+	return s, nil
+}
+
+// Current gives back the current settings
+func Current() *Settings {
+	if s, ok := current.Load().(*Settings); ok {
+		return s
+	}
+
+	return &Settings{MonitorTemplates: map[string]string{}}
+}
+
+// Store makes the settings current
+func Store(s *Settings) {
+	current.Store(s)
+}
+
+// OnReload registers a hook applying the reloaded settings
+func OnReload(hook Hook) {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+	hooks = append(hooks, hook)
+}
+
+// Reload re-reads the settings and applies them by the hooks.
+// The current settings are kept if the configuration is invalid or any hook fails
+func Reload() error {
+	// Viper keeps the previous configuration if the file is malformed
+	if f := viper.ConfigFileUsed(); f != "" {
+		v := viper.New()
+		v.SetConfigFile(f)
+		if err := v.ReadInConfig(); err != nil {
+			metrics.ConfigReloads.WithLabelValues("error").Inc()
+			return fmt.Errorf("invalid config file %s: %v", f, err)
+		}
+	}
+
+	s, err := Load()
+	if err != nil {
+		metrics.ConfigReloads.WithLabelValues("error").Inc()
+		return err
+	}
+
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+
+	// The settings are made current once applied by all the hooks
+	previous := Current()
+	for i, hook := range hooks {
+		if err := hook(s); err != nil {
+			rollback(hooks[:i], previous)
+			metrics.ConfigReloads.WithLabelValues("error").Inc()
+			return fmt.Errorf("failed to apply configuration: %v", err)
+		}
+	}
+
+	Store(s)
+
+	metrics.ConfigReloads.WithLabelValues("ok").Inc()
+	return nil
+}
+
+// rollback applies the previous settings by the hooks in reverse order
+func rollback(applied []Hook, previous *Settings) {
+	for i := len(applied) - 1; i >= 0; i-- {
+		if err := applied[i](previous); err != nil {
+			logrus.WithError(err).Error("Rolling back configuration")
+		}
+	}
+}
+
+// Watch reloads the settings whenever the config file changes.
+// The reload failures are logged, the controller keeps running with the current settings
+func Watch() {
+	if viper.ConfigFileUsed() == "" {
+		return
+	}
+
+	viper.OnConfigChange(func(e fsnotify.Event) {
+		logrus.WithFields(logrus.Fields{"file": e.Name}).Info("Reloading configuration")
+
+		if err := Reload(); err != nil {
+			logrus.WithFields(logrus.Fields{"file": e.Name}).WithError(err).Error("Reloading configuration")
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{"file": e.Name, "status": "OK"}).Info("Reloading configuration")
+	})
+
+	viper.WatchConfig()
+}
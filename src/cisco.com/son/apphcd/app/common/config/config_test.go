@@ -0,0 +1,128 @@
+package config
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	appcommon "cisco.com/son/apphcd/app/common"
+)
+
+func TestLoad(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set(appcommon.EnvApphcLogFormat, "json")
+	viper.Set(appcommon.EnvApphcLogLevel, "warning")
+	viper.Set(appcommon.EnvApphcBearerToken, "token")
+	viper.Set("templates.url.monitor.apph.nodes", "http://{{ .MonitorEndpoint }}/nodes")
+
+	s, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.LogLevel != logrus.WarnLevel || s.LogFormat != appcommon.LogFormatJson ||
+		s.MonitorTemplate("templates.url.monitor.apph.nodes") != "http://{{ .MonitorEndpoint }}/nodes" {
+		t.Fatalf("unexpected settings %+v", s)
+	}
+
+	invalid := map[string]string{
+		appcommon.EnvApphcLogLevel:         "verbose",
+		appcommon.EnvApphcLogFormat:        "xml",
+		"templates.url.monitor.apph.nodes": "http://{{ .MonitorEndpoint",
+	}
+
+	for key, value := range invalid {
+		previous := viper.Get(key)
+		viper.Set(key, value)
+		if _, err := Load(); err == nil {
+			t.Fatalf("%s=%s expected to be rejected", key, value)
+		}
+		viper.Set(key, previous)
+	}
+
+	viper.Set(appcommon.EnvApphcInternalAuthorizationEnabled, true)
+	viper.Set(appcommon.EnvApphcBearerToken, "")
+	if _, err := Load(); err == nil {
+		t.Fatal("configuration without authentication method expected to be rejected")
+	}
+}
+
+func TestReload(t *testing.T) {
+	defer viper.Reset()
+	defer func() { hooks = nil }()
+
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "apphc.yaml")
+	write := func(data string) {
+		if err := ioutil.WriteFile(file, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("log_format: text\nlog_level: info\nbearer_token: old\n")
+	viper.SetConfigFile(file)
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	var applied string
+	OnReload(func(s *Settings) error {
+		applied = s.BearerToken
+		return nil
+	})
+
+	write("log_format: text\nlog_level: debug\nbearer_token: new\n")
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Reload(); err != nil {
+		t.Fatal(err)
+	}
+
+	if applied != "new" || Current().LogLevel != logrus.DebugLevel {
+		t.Fatalf("reloaded settings expected to be applied, got %+v", Current())
+	}
+
+	// Malformed file keeps the current settings
+	write("log_level: [debug\n")
+	if err := Reload(); err == nil {
+		t.Fatal("malformed config file expected to be rejected")
+	}
+
+	if Current().BearerToken != "new" {
+		t.Fatalf("current settings expected to be kept, got %+v", Current())
+	}
+
+	// Failed hook keeps the current settings and rolls back the applied ones
+	OnReload(func(s *Settings) error {
+		if s.BearerToken == "newer" {
+			return errors.New("unable to log in")
+		}
+		return nil
+	})
+
+	write("log_format: text\nlog_level: debug\nbearer_token: newer\n")
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Reload(); err == nil {
+		t.Fatal("failed hook expected to fail the reload")
+	}
+
+	if applied != "new" || Current().BearerToken != "new" {
+		t.Fatalf("current settings expected to be kept, got %+v", Current())
+	}
+}
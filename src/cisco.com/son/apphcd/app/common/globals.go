@@ -14,6 +14,7 @@ const (
 	EnvApphMasterNodeIp                  = "master_node_ip"
 	EnvApphMasterNodeUser                = "master_node_user"
 	EnvApphcLogFormat                    = "log_format"
+	EnvApphcLogLevel                     = "log_level" // Level of the logs: panic, fatal, error, warning, info, debug or trace
 	EnvApphcNetworkPort                  = "network_port"
 	EnvApphcCachePath                    = "cache_path"
 	EnvApphcInternalAuthorizationEnabled = "internal_authorization_enabled"
@@ -26,6 +27,45 @@ const (
 	EnvApphcGitServerEndpoint            = "git_server_endpoint"
 	EnvApphcAdaptersRancherEnabled       = "adapters_rancher_enabled"
 	EnvApphcPurgeAppMetadata             = "purge_application_metadata"
+	EnvApphcAppTemplatesPath             = "app_templates_path" // Application chart templates used by the native adapter
+	EnvApphcAdapter                      = "adapter"            // Adapters implementing the Application and Cluster managers
+	EnvApphcAppsImageValidationEnabled   = "apps_image_validation_enabled"
+	EnvApphcTlsEnabled                   = "tls_enabled"
+	EnvApphcTlsCertFile                  = "tls_cert_file"           // Server certificate (PEM)
+	EnvApphcTlsKeyFile                   = "tls_key_file"            // Server private key (PEM)
+	EnvApphcTlsClientCaFile              = "tls_client_ca_file"      // CA bundle verifying client certificates. Enables mutual TLS
+	EnvApphcShutdownTimeout              = "shutdown_timeout"        // Seconds to wait for the running operations on shutdown. The servers are given 5 more seconds to stop
+	EnvApphcAuthTokensFile               = "auth_tokens_file"        // Static bearer tokens (YAML)
+	EnvApphcAuthJwtJwksFile              = "auth_jwt_jwks_file"      // Keys verifying JSON Web Tokens (JWKS)
+	EnvApphcAuthJwtIssuer                = "auth_jwt_issuer"         // Issuer of JSON Web Tokens. Keys are discovered from it unless JWKS file is set
+	EnvApphcAuthJwtAudience              = "auth_jwt_audience"       // Audience of JSON Web Tokens
+	EnvApphcAuthJwtIdentityClaim         = "auth_jwt_identity_claim" // Claim containing the identity name
+	EnvApphcAuthJwtGroupsClaim           = "auth_jwt_groups_claim"   // Claim containing the identity groups
+	EnvApphcAuthPolicyFile               = "auth_policy_file"        // Policy mapping identities to the allowed methods (YAML)
+	EnvApphcLocksTtl                     = "locks_ttl"               // Seconds an operation lock is kept unless renewed
+	EnvApphcLocksNamespace               = "locks_namespace"         // Namespace of the Lease objects keeping the operation locks
+	EnvApphcOperationsRetention          = "operations_retention"    // Seconds a finished long-running operation is kept for
+	EnvApphcTracingExporter              = "tracing_exporter"        // Exporter of the trace spans: otlp, stdout or file. Tracing is disabled if empty
+	EnvApphcTracingOtlpEndpoint          = "tracing_otlp_endpoint"   // OTLP/HTTP traces URL of OpenTelemetry collector
+	EnvApphcTracingFile                  = "tracing_file"            // File the trace spans are appended to
+	EnvApphcAuditEnabled                 = "audit_enabled"           // Record the mutating requests
+	EnvApphcAuditFile                    = "audit_file"              // File the audit records are appended to (JSON Lines)
+	EnvApphcAuditMaxSize                 = "audit_max_size"          // Megabytes the audit file is rotated after
+	EnvApphcAuditMaxBackups              = "audit_max_backups"       // Number of rotated audit files kept
+	EnvApphcHealthCheckTimeout           = "health_check_timeout"    // Seconds a readiness check of a dependency is given up after
+	EnvApphcRateLimitRps                 = "rate_limit_rps"          // Requests per second allowed to every client. Disabled if 0, the default
+	EnvApphcRateLimitBurst               = "rate_limit_burst"        // Requests allowed to every client at once
+	EnvApphcRateLimitMethods             = "rate_limit_methods"      // Limits of the methods of every client, e.g. AppManager/Get*=2:5,AppManager/UpgradeApp=0.1:1
+	EnvApphcOperationsMaxRunning         = "operations_max_running"  // Mutating operations allowed to run at once. Unlimited if 0
+	EnvApphcStatusCodesEnabled           = "status_codes_enabled"    // Report the failed responses by gRPC and HTTP status codes
+	EnvApphcIdempotencyWindow            = "idempotency_window"      // Seconds the responses of the requests with an idempotency key are kept for. Disabled if 0
+)
+
+// Adapters
+const (
+	AdapterRancher = "rancher" // Rancher server
+	AdapterNative  = "native"  // Kubernetes API server
+	AdapterMemory  = "memory"  // Process memory, no external dependencies
 )
 
 type LogFormat string
@@ -0,0 +1,54 @@
+// Author  <dorzheho@cisco.com>
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+)
+
+// HTTPCheck requests the URL. The check fails unless the response status is one of the accepted ones
+func HTTPCheck(url string, accepted ...int) CheckFunc {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+		for _, code := range accepted {
+			if resp.StatusCode == code {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+}
+
+// DirCheck checks that the directory exists and is readable
+func DirCheck(path string) CheckFunc {
+	return func(ctx context.Context) error {
+		d, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer d.Close()
+
+		if _, err := d.Readdirnames(1); err != nil && err != io.EOF {
+			return err
+		}
+
+		return nil
+	}
+}
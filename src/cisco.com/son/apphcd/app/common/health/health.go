@@ -0,0 +1,133 @@
+// Author  <dorzheho@cisco.com>
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status of a dependency or of the controller
+type Status string
+
+const (
+	StatusOk     Status = "ok"
+	StatusFailed Status = "failed"
+)
+
+// CheckFunc checks a dependency. Must give up once the context is done
+type CheckFunc func(ctx context.Context) error
+
+// Result is the outcome of a dependency check
+type Result struct {
+	Name      string  `json:"name"`
+	Status    Status  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Report is the outcome of the readiness check
+type Report struct {
+	Status       Status    `json:"status"`
+	Timestamp    time.Time `json:"timestamp"`
+	Dependencies []*Result `json:"dependencies"`
+}
+
+// Checker checks the dependencies the controller needs to serve the requests
+type Checker struct {
+	timeout  time.Duration
+	mu       sync.RWMutex // Protects the fields below
+	checks   map[string]CheckFunc
+	draining bool
+}
+
+// NewChecker creates a new checker. Every dependency check is given up after the timeout
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout, checks: map[string]CheckFunc{}}
+}
+
+// Register adds the dependency check. The check with the same name is replaced
+func (c *Checker) Register(name string, fn CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks[name] = fn
+}
+
+// Drain marks the controller as not ready, so no new requests are routed to it while shutting down
+func (c *Checker) Drain() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.draining = true
+}
+
+// Ready runs the dependency checks concurrently. The controller is ready if all of them succeed
+func (c *Checker) Ready(ctx context.Context) *Report {
+	c.mu.RLock()
+	draining := c.draining
+	checks := make(map[string]CheckFunc, len(c.checks))
+	for name, fn := range c.checks {
+		checks[name] = fn
+	}
+	c.mu.RUnlock()
+
+	report := &Report{Status: StatusOk, Timestamp: time.Now().UTC(), Dependencies: []*Result{}}
+
+	var wg sync.WaitGroup
+	results := make(chan *Result, len(checks))
+	for name, fn := range checks {
+		wg.Add(1)
+		go func(name string, fn CheckFunc) {
+			defer wg.Done()
+			results <- c.run(ctx, name, fn)
+		}(name, fn)
+	}
+	wg.Wait()
+	close(results)
+
+	for r := range results {
+		if r.Status != StatusOk {
+			report.Status = StatusFailed
+		}
+		report.Dependencies = append(report.Dependencies, r)
+	}
+
+	sort.Slice(report.Dependencies, func(i, j int) bool {
+		return report.Dependencies[i].Name < report.Dependencies[j].Name
+	})
+
+	if draining {
+		report.Status = StatusFailed
+	}
+
+	return report
+}
+
+// run runs the check. Checks not aware of the context are abandoned once the timeout expires
+func (c *Checker) run(ctx context.Context, name string, fn CheckFunc) *Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	errc := make(chan error, 1)
+	go func() {
+		errc <- fn(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errc:
+	case <-ctx.Done():
+		err = fmt.Errorf("check timed out after %v", c.timeout)
+	}
+
+	r := &Result{Name: name, Status: StatusOk, LatencyMs: float64(time.Since(start)) / float64(time.Millisecond)}
+	if err != nil {
+		r.Status = StatusFailed
+		r.Error = err.Error()
+	}
+
+	return r
+}
@@ -0,0 +1,77 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestReady(t *testing.T) {
+	c := NewChecker(100 * time.Millisecond)
+	if r := c.Ready(context.Background()); r.Status != StatusOk || len(r.Dependencies) != 0 {
+		t.Fatalf("unexpected report %+v", r)
+	}
+
+	c.Register("kubernetes", func(ctx context.Context) error { return nil })
+	c.Register("git", func(ctx context.Context) error { return nil })
+	if r := c.Ready(context.Background()); r.Status != StatusOk || len(r.Dependencies) != 2 || r.Dependencies[0].Name != "git" {
+		t.Fatalf("unexpected report %+v", r)
+	}
+
+	// Check ignoring the context is abandoned
+	c.Register("rancher", func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+	c.Register("git", func(ctx context.Context) error { return errors.New("connection refused") })
+
+	start := time.Now()
+	r := c.Ready(context.Background())
+	if time.Since(start) > 500*time.Millisecond {
+		t.Fatal("timed out check expected to be abandoned")
+	}
+
+	if r.Status != StatusFailed || r.Dependencies[0].Error != "connection refused" || r.Dependencies[2].Status != StatusFailed {
+		t.Fatalf("unexpected report %+v", r)
+	}
+
+	c = NewChecker(time.Second)
+	c.Drain()
+	if r := c.Ready(context.Background()); r.Status != StatusFailed {
+		t.Fatal("draining checker expected to be not ready")
+	}
+}
+
+func TestChecks(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	if err := HTTPCheck(srv.URL, http.StatusOK, http.StatusUnauthorized)(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := HTTPCheck(srv.URL, http.StatusOK)(context.Background()); err == nil {
+		t.Fatal("unauthorized status expected to fail")
+	}
+
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := DirCheck(dir)(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := DirCheck(dir + "/missing")(context.Background()); err == nil {
+		t.Fatal("missing directory expected to fail")
+	}
+}
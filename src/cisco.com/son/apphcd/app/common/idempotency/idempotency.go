@@ -0,0 +1,283 @@
+// Author  <dorzheho@cisco.com>
+
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pbappmgr "cisco.com/son/apphcd/api/v1/appmanager"
+	pbclumgr "cisco.com/son/apphcd/api/v1/clustermanager"
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/inflight"
+	"cisco.com/son/apphcd/app/common/longrunning"
+	"cisco.com/son/apphcd/app/common/metrics"
+	"cisco.com/son/apphcd/app/common/requestid"
+)
+
+// HeaderKey is the HTTP header and the gRPC metadata key carrying the idempotency key
+const HeaderKey = "idempotency-key"
+
+// Maximum length of the idempotency key
+const maxKeyLength = 255
+
+// entry is the outcome of the request sent with the idempotency key
+type entry struct {
+	method      string                 // Full gRPC method of the request
+	fingerprint string                 // Digest of the request
+	returned    chan struct{}          // Closed once the handler of the request returned
+	resp        proto.Message          // Response of the request. Nil if the request failed
+	err         error                  // Error of the request
+	op          *longrunning.Operation // Operation the response refers to. Nil if the response is final
+	expires     time.Time              // Time the entry is removed after
+}
+
+// Cache remembers the responses of the mutating requests by the idempotency keys of the callers,
+// so a retried request gives back the response of the original one instead of running again
+type Cache struct {
+	registry *longrunning.Registry // Operations the responses refer to
+	window   time.Duration         // Period the responses are remembered for
+	now      func() time.Time
+	mu       sync.Mutex        // Protects the fields below
+	entries  map[string]*entry // Entries by the caller identity and the key
+	swept    time.Time         // Time the expired entries were last removed
+}
+
+// NewCache creates a new cache remembering the responses for the window
+func NewCache(registry *longrunning.Registry, window time.Duration) *Cache {
+	return &Cache{
+		registry: registry,
+		window:   window,
+		now:      time.Now,
+		entries:  make(map[string]*entry),
+	}
+}
+
+// begin gives back the entry of the key. The entry is created unless found
+func (c *Cache) begin(key, method, fingerprint string) (*entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.sweep(now)
+
+	if e, ok := c.entries[key]; ok {
+		return e, false
+	}
+
+	e := &entry{
+		method:      method,
+		fingerprint: fingerprint,
+		returned:    make(chan struct{}),
+		expires:     now.Add(c.window),
+	}
+
+	c.entries[key] = e
+	return e, true
+}
+
+// forget removes the entry of the key, so the request is run again once retried
+func (c *Cache) forget(key string, e *entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries[key] == e {
+		delete(c.entries, key)
+	}
+}
+
+// sweep removes the expired entries. The entries of the requests not returned yet are kept
+func (c *Cache) sweep(now time.Time) {
+	if now.Sub(c.swept) < c.window/10 {
+		return
+	}
+
+	for key, e := range c.entries {
+		select {
+		case <-e.returned:
+			if now.After(e.expires) {
+				delete(c.entries, key)
+			}
+		default:
+		}
+	}
+
+	c.swept = now
+}
+
+// UnaryServerInterceptor runs the mutating requests carrying an idempotency key once within the window.
+// Retries give back the response of the original request or attach to the operation it started
+func (c *Cache) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := incomingKey(ctx)
+		if key == "" || inflight.IsReadOnly(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		if len(key) > maxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key exceeds %d characters", maxKeyLength)
+		}
+
+		fingerprint, err := digest(req)
+		if err != nil {
+			return handler(ctx, req)
+		}
+
+		// Keys of different callers never clash
+		cacheKey := auth.IdentityName(ctx) + " " + key
+		e, created := c.begin(cacheKey, info.FullMethod, fingerprint)
+		if created {
+			return c.run(ctx, req, handler, cacheKey, e)
+		}
+
+		if e.method != info.FullMethod || e.fingerprint != fingerprint {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key %s was used by a different request", key)
+		}
+
+		return c.replay(ctx, req, info.FullMethod, key, e)
+	}
+}
+
+// run runs the request and remembers its outcome. Requests rejected by an error are forgotten, so they can be retried
+func (c *Cache) run(ctx context.Context, req interface{}, handler grpc.UnaryHandler, cacheKey string, e *entry) (interface{}, error) {
+	// The retries waiting for the request are released and the request is forgotten even if the handler panics
+	remembered := false
+	e.err = status.Error(codes.Aborted, "request with the same idempotency key failed, retry later")
+	defer func() {
+		if !remembered {
+			c.forget(cacheKey, e)
+		}
+
+		close(e.returned)
+	}()
+
+	resp, err := handler(ctx, req)
+
+	m, ok := resp.(proto.Message)
+	if err != nil || !ok {
+		e.err = err
+		return resp, err
+	}
+
+	// The response returned to the caller is changed by the interceptors, so a copy is kept
+	e.resp = proto.Clone(m)
+	e.op = c.registry.Referred(resp)
+	e.err = nil
+	remembered = true
+
+	return resp, err
+}
+
+// replay gives back the outcome of the original request. A synchronous retry of a request which started
+// an operation waits until the operation is finished
+func (c *Cache) replay(ctx context.Context, req interface{}, method, key string, e *entry) (interface{}, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"method":          method,
+		"idempotency_key": key,
+	}).Info("Replaying request")
+
+	metrics.IdempotentReplays.WithLabelValues(auth.ShortMethod(method)).Inc()
+
+	select {
+	case <-e.returned:
+	case <-ctx.Done():
+		return nil, status.Errorf(codes.Aborted, "request with idempotency key %s is still running, retry later", key)
+	}
+
+	if e.resp == nil {
+		return nil, e.err
+	}
+
+	if e.op == nil {
+		return proto.Clone(e.resp), nil
+	}
+
+	if r, ok := req.(interface{ GetAsync() bool }); ok && !r.GetAsync() {
+		select {
+		case <-e.op.Done():
+			return final(e.op)
+		case <-ctx.Done():
+		}
+	}
+
+	return refresh(e.resp, e.op), nil
+}
+
+// final gives back the response of the finished operation
+func final(op *longrunning.Operation) (interface{}, error) {
+	snapshot := op.Proto()
+	if snapshot.Response == nil {
+		return nil, status.Error(codes.Internal, snapshot.Error)
+	}
+
+	var resp ptypes.DynamicAny
+	if err := ptypes.UnmarshalAny(snapshot.Response, &resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp.Message, nil
+}
+
+// refresh gives back a copy of the response carrying the current state of the operation
+func refresh(resp proto.Message, op *longrunning.Operation) proto.Message {
+	resp = proto.Clone(resp)
+
+	body, err := ptypes.MarshalAny(op.Proto())
+	if err != nil {
+		return resp
+	}
+
+	switch r := resp.(type) {
+	case *pbappmgr.Response:
+		r.Body = body
+		r.Timestamp = ptypes.TimestampNow()
+	case *pbclumgr.Response:
+		r.Body = body
+		r.Timestamp = ptypes.TimestampNow()
+	}
+
+	return resp
+}
+
+// digest gives back the fingerprint of the request
+func digest(req interface{}) (string, error) {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return "", status.Error(codes.Internal, "request is not a protobuf message")
+	}
+
+	// Maps are marshaled in the same order every time
+	b := proto.NewBuffer(nil)
+	b.SetDeterministic(true)
+	if err := b.Marshal(m); err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b.Bytes())
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// incomingKey gives back the idempotency key passed by the caller. Empty if there is none
+func incomingKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(HeaderKey); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
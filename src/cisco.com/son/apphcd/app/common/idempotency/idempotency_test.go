@@ -0,0 +1,150 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "cisco.com/son/apphcd/api/v1/appmanager"
+	"cisco.com/son/apphcd/app/common/inflight"
+	"cisco.com/son/apphcd/app/common/longrunning"
+)
+
+var createApp = &grpc.UnaryServerInfo{FullMethod: "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/CreateApp"}
+
+func withKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(HeaderKey, key))
+}
+
+func TestReplay(t *testing.T) {
+	interceptor := NewCache(longrunning.NewRegistry(inflight.NewTracker(), time.Hour), time.Hour).UnaryServerInterceptor()
+
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return &pb.Response{Status: pb.Status_SUCCESS, Message: "created"}, nil
+	}
+
+	req := &pb.CreateAppRequest{Name: "nginx"}
+	for i := 0; i < 2; i++ {
+		resp, err := interceptor(withKey("k1"), req, createApp, handler)
+		if err != nil || resp.(*pb.Response).Message != "created" {
+			t.Fatalf("unexpected response %v: %v", resp, err)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected the request to run once, ran %d times", calls)
+	}
+
+	// The key is bound to the request
+	_, err := interceptor(withKey("k1"), &pb.CreateAppRequest{Name: "redis"}, createApp, handler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected %s, got %v", codes.InvalidArgument, err)
+	}
+
+	// Requests without a key are never replayed
+	if _, err := interceptor(context.Background(), req, createApp, handler); err != nil || calls != 2 {
+		t.Fatalf("expected the request to run, ran %d times: %v", calls, err)
+	}
+}
+
+func TestRejectedRequestRunsAgain(t *testing.T) {
+	interceptor := NewCache(longrunning.NewRegistry(inflight.NewTracker(), time.Hour), time.Hour).UnaryServerInterceptor()
+
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, status.Error(codes.ResourceExhausted, "too many operations")
+		}
+
+		return &pb.Response{Status: pb.Status_SUCCESS}, nil
+	}
+
+	req := &pb.CreateAppRequest{Name: "nginx"}
+	if _, err := interceptor(withKey("k1"), req, createApp, handler); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected %s, got %v", codes.ResourceExhausted, err)
+	}
+
+	if _, err := interceptor(withKey("k1"), req, createApp, handler); err != nil || calls != 2 {
+		t.Fatalf("expected the request to run again, ran %d times: %v", calls, err)
+	}
+}
+
+func TestPanickedRequestRunsAgain(t *testing.T) {
+	interceptor := NewCache(longrunning.NewRegistry(inflight.NewTracker(), time.Hour), time.Hour).UnaryServerInterceptor()
+
+	started := make(chan struct{})
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if calls == 1 {
+			close(started)
+			time.Sleep(10 * time.Millisecond)
+			panic("handler failed")
+		}
+
+		return &pb.Response{Status: pb.Status_SUCCESS}, nil
+	}
+
+	req := &pb.CreateAppRequest{Name: "nginx"}
+	go func() {
+		defer func() { recover() }()
+		interceptor(withKey("k1"), req, createApp, handler)
+	}()
+
+	// The retry waiting for the panicked request is released
+	<-started
+	ctx, cancel := context.WithTimeout(withKey("k1"), time.Second)
+	defer cancel()
+	if _, err := interceptor(ctx, req, createApp, handler); status.Code(err) != codes.Aborted || ctx.Err() != nil {
+		t.Fatalf("expected the waiting retry to be released with %s, got %v", codes.Aborted, err)
+	}
+
+	if _, err := interceptor(withKey("k1"), req, createApp, handler); err != nil || calls != 2 {
+		t.Fatalf("expected the request to run again, ran %d times: %v", calls, err)
+	}
+}
+
+func TestAttachToOperation(t *testing.T) {
+	registry := longrunning.NewRegistry(inflight.NewTracker(), time.Hour)
+	interceptor := NewCache(registry, time.Hour).UnaryServerInterceptor()
+
+	finish := make(chan struct{})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		op, err := registry.Run(ctx, "AppManager/CreateApp", "nginx", "admin", func(ctx context.Context) (proto.Message, error) {
+			<-finish
+			return &pb.Response{Status: pb.Status_SUCCESS, Message: "created"}, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// The caller has gone before the operation was finished
+		body, _ := ptypes.MarshalAny(op.Proto())
+		return &pb.Response{Status: pb.Status_IN_PROGRESS, Body: body}, nil
+	}
+
+	req := &pb.CreateAppRequest{Name: "nginx"}
+	if resp, err := interceptor(withKey("k1"), req, createApp, handler); err != nil || resp.(*pb.Response).Status != pb.Status_IN_PROGRESS {
+		t.Fatalf("unexpected response %v: %v", resp, err)
+	}
+
+	// The synchronous retry waits for the operation started by the original request
+	close(finish)
+	resp, err := interceptor(withKey("k1"), req, createApp, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("the request is not expected to run again")
+	})
+	if err != nil || resp.(*pb.Response).Message != "created" {
+		t.Fatalf("unexpected response %v: %v", resp, err)
+	}
+}
@@ -0,0 +1,141 @@
+// Author  <dorzheho@cisco.com>
+
+package inflight
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cisco.com/son/apphcd/app/common/auth"
+)
+
+// Operation is a running mutating request
+type Operation struct {
+	Method   string    // Full gRPC method name
+	Target   string    // Name of the application the operation runs on, if any
+	Identity string    // Caller identity
+	Started  time.Time // Time the operation was started
+}
+
+// Tracker keeps the running mutating requests and rejects the new ones while draining
+type Tracker struct {
+	mu       sync.Mutex            // Protects the fields below
+	draining bool                  // Whether new operations are rejected
+	nextId   uint64                // Identifier of the next operation
+	ops      map[uint64]*Operation // Running operations
+	idle     chan struct{}         // Closed once there are no running operations while draining
+}
+
+// NewTracker creates a new operations tracker
+func NewTracker() *Tracker {
+	return &Tracker{ops: make(map[uint64]*Operation)}
+}
+
+// Begin registers a new operation. Returns false if the tracker is draining
+func (t *Tracker) Begin(method, target, identity string) (uint64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.draining {
+		return 0, false
+	}
+
+	t.nextId++
+	t.ops[t.nextId] = &Operation{Method: method, Target: target, Identity: identity, Started: time.Now()}
+	return t.nextId, true
+}
+
+// End unregisters the operation
+func (t *Tracker) End(id uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.ops, id)
+
+	if t.draining && len(t.ops) == 0 && t.idle != nil {
+		close(t.idle)
+		t.idle = nil
+	}
+}
+
+// Drain rejects new operations and waits for the running ones until the context is done.
+// Returns operations that are still running, sorted by start time
+func (t *Tracker) Drain(ctx context.Context) []Operation {
+	t.mu.Lock()
+	t.draining = true
+	idle := make(chan struct{})
+	if len(t.ops) == 0 {
+		close(idle)
+	} else {
+		t.idle = idle
+	}
+	t.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var ops []Operation
+	for _, op := range t.ops {
+		ops = append(ops, *op)
+	}
+
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Started.Before(ops[j].Started) })
+	return ops
+}
+
+// UnaryServerInterceptor tracks mutating requests. Requests of the methods named Get*, List*, Wait* and Check*
+// are read-only and served while draining
+func (t *Tracker) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if IsReadOnly(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		id, ok := t.Begin(info.FullMethod, target(req), auth.IdentityName(ctx))
+		if !ok {
+			return nil, status.Errorf(codes.Unavailable, "the controller is shutting down")
+		}
+		defer t.End(id)
+
+		return handler(ctx, req)
+	}
+}
+
+// target gives back the name of the application the request runs on. The request itself is not kept
+// since it may carry secrets, e.g. the application configs
+func target(req interface{}) string {
+	if named, ok := req.(interface{ GetName() string }); ok && named.GetName() != "" {
+		return named.GetName()
+	}
+
+	if named, ok := req.(interface{ GetAppName() string }); ok {
+		return named.GetAppName()
+	}
+
+	return ""
+}
+
+// IsReadOnly tells whether the gRPC method doesn't change anything
+func IsReadOnly(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range []string{"Get", "List", "Wait", "Check"} {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
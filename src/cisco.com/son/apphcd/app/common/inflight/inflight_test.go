@@ -0,0 +1,81 @@
+package inflight
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "cisco.com/son/apphcd/api/v1/appmanager"
+)
+
+func TestDrain(t *testing.T) {
+	tracker := NewTracker()
+
+	first, _ := tracker.Begin("/appmanager.AppManager/UpgradeApp", "first", "default")
+	second, _ := tracker.Begin("/appmanager.AppManager/DeleteApp", "second", "default")
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		tracker.End(first)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	ops := tracker.Drain(ctx)
+	if len(ops) != 1 || ops[0].Target != "second" {
+		t.Fatalf("unexpected interrupted operations: %v", ops)
+	}
+
+	if _, ok := tracker.Begin("/appmanager.AppManager/CreateApp", "third", "default"); ok {
+		t.Fatal("operation accepted while draining")
+	}
+
+	tracker.End(second)
+	if ops := tracker.Drain(context.Background()); len(ops) != 0 {
+		t.Fatalf("unexpected interrupted operations: %v", ops)
+	}
+}
+
+func TestInterceptorTarget(t *testing.T) {
+	tracker := NewTracker()
+	interceptor := tracker.UnaryServerInterceptor()
+
+	req := &pb.UpdateAppRequest{Name: "first", Secrets: map[string]string{"password": "secret"}}
+	info := &grpc.UnaryServerInfo{FullMethod: "/appmanager.AppManager/UpdateApp"}
+
+	var ops []Operation
+	_, err := interceptor(context.Background(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ops = tracker.Drain(ctx)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ops) != 1 || ops[0].Target != "first" {
+		t.Fatalf("unexpected running operations: %v", ops)
+	}
+}
+
+func TestIsReadOnly(t *testing.T) {
+	if !IsReadOnly("/appmanager.AppManager/GetApps") {
+		t.Fatal("GetApps is read-only")
+	}
+
+	if !IsReadOnly("/operations.Operations/WaitOperation") {
+		t.Fatal("WaitOperation is read-only")
+	}
+
+	if !IsReadOnly("/grpc.health.v1.Health/Check") {
+		t.Fatal("Check is read-only")
+	}
+
+	if IsReadOnly("/clustermanager.ClusterManager/SetQuotas") {
+		t.Fatal("SetQuotas is mutating")
+	}
+}
@@ -0,0 +1,331 @@
+// Author  <dorzheho@cisco.com>
+
+package longrunning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+
+	pbappmgr "cisco.com/son/apphcd/api/v1/appmanager"
+	pbclumgr "cisco.com/son/apphcd/api/v1/clustermanager"
+	pb "cisco.com/son/apphcd/api/v1/operations"
+	"cisco.com/son/apphcd/app/common/inflight"
+	"cisco.com/son/apphcd/app/common/ratelimit"
+	"cisco.com/son/apphcd/app/common/requestid"
+	"cisco.com/son/apphcd/app/common/tracing"
+)
+
+// ErrNotFound is returned for operations that don't exist or already expired
+var ErrNotFound = errors.New("operation not found")
+
+// Func implements the operation. Gives back the response of the method started the operation
+type Func func(ctx context.Context) (proto.Message, error)
+
+// Operation is a long-running mutation of applications or the cluster
+type Operation struct {
+	mu       sync.Mutex         // Protects the fields below
+	op       *pb.Operation      // Operation state
+	finished time.Time          // Time the operation was finished
+	cancel   context.CancelFunc // Cancels the operation context
+	done     chan struct{}      // Closed once the operation is finished
+}
+
+// Id gives back the operation identifier
+func (o *Operation) Id() string {
+	return o.op.Id
+}
+
+// Done gives back a channel closed once the operation is finished
+func (o *Operation) Done() <-chan struct{} {
+	return o.done
+}
+
+// Proto gives back a snapshot of the operation
+func (o *Operation) Proto() *pb.Operation {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return proto.Clone(o.op).(*pb.Operation)
+}
+
+// step records a step reached by the operation
+func (o *Operation) step(description string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := ptypes.TimestampNow()
+	o.op.Steps = append(o.op.Steps, &pb.Step{Timestamp: now, Description: description})
+	o.op.UpdateTime = now
+}
+
+// finish records result of the operation
+func (o *Operation) finish(ctx context.Context, resp proto.Message, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if resp != nil {
+		if body, merr := ptypes.MarshalAny(resp); merr == nil {
+			o.op.Response = body
+		}
+	}
+
+	switch {
+	case err != nil && ctx.Err() == context.Canceled:
+		o.op.State = pb.State_CANCELLED
+		o.op.Error = err.Error()
+	case err != nil:
+		o.op.State = pb.State_FAILED
+		o.op.Error = err.Error()
+	default:
+		o.op.State = pb.State_SUCCEEDED
+	}
+
+	o.op.Done = true
+	o.op.UpdateTime = ptypes.TimestampNow()
+	o.finished = time.Now()
+	close(o.done)
+}
+
+// Registry runs the operations and keeps them until the retention period after they are finished
+type Registry struct {
+	tracker   *inflight.Tracker     // Drains the running operations on shutdown
+	retention time.Duration         // Period the finished operations are kept for
+	mu        sync.Mutex            // Protects the operations
+	ops       map[string]*Operation // Operations by identifier
+}
+
+// NewRegistry creates a new operations registry
+func NewRegistry(tracker *inflight.Tracker, retention time.Duration) *Registry {
+	return &Registry{tracker: tracker, retention: retention, ops: make(map[string]*Operation)}
+}
+
+// Run starts the operation in background. The operation continues the trace and keeps the request ID of the caller context
+func (r *Registry) Run(caller context.Context, method, target, identity string, fn Func) (*Operation, error) {
+	// The operation keeps the concurrency slot of the request until it is finished
+	release := ratelimit.Detach(caller)
+
+	trackId, ok := r.tracker.Begin(method, target, identity)
+	if !ok {
+		release()
+		return nil, errors.New("the controller is shutting down")
+	}
+
+	now := ptypes.TimestampNow()
+	ctx, cancel := context.WithCancel(requestid.NewContext(tracing.Detach(caller), requestid.FromContext(caller)))
+	o := &Operation{
+		op: &pb.Operation{
+			Id:         uuid.New().String(),
+			Method:     method,
+			Target:     target,
+			Identity:   identity,
+			State:      pb.State_RUNNING,
+			CreateTime: now,
+			UpdateTime: now,
+		},
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	r.mu.Lock()
+	r.expire()
+	r.ops[o.op.Id] = o
+	r.mu.Unlock()
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"operation": o.op.Id,
+		"method":    method,
+		"target":    target,
+		"identity":  identity,
+	}).Info("Operation started")
+
+	go func() {
+		defer release()
+		defer r.tracker.End(trackId)
+		defer cancel()
+
+		spanCtx, span := tracing.Start(ctx, "Operation "+method)
+		span.SetAttributes(attribute.String("apphc.operation", o.op.Id))
+
+		resp, err := fn(newContext(spanCtx, o))
+		tracing.Finish(span, &err)
+		o.finish(ctx, resp, err)
+
+		snapshot := o.Proto()
+		requestid.Logger(ctx).WithFields(logrus.Fields{
+			"operation": snapshot.Id,
+			"method":    snapshot.Method,
+			"state":     snapshot.State,
+		}).Info("Operation finished")
+	}()
+
+	return o, nil
+}
+
+// Get gives back the operation
+func (r *Registry) Get(id string) (*Operation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire()
+
+	o, ok := r.ops[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return o, nil
+}
+
+// List gives back the operations matching the method and target sorted by start time.
+// Empty method or target matches any
+func (r *Registry) List(method, target string, running bool) []*pb.Operation {
+	r.mu.Lock()
+	r.expire()
+	var ops []*pb.Operation
+	for _, o := range r.ops {
+		ops = append(ops, o.Proto())
+	}
+	r.mu.Unlock()
+
+	var matched []*pb.Operation
+	for _, op := range ops {
+		if (method == "" || op.Method == method) && (target == "" || op.Target == target) && (!running || !op.Done) {
+			matched = append(matched, op)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		ti, _ := ptypes.Timestamp(matched[i].CreateTime)
+		tj, _ := ptypes.Timestamp(matched[j].CreateTime)
+		return ti.Before(tj)
+	})
+
+	return matched
+}
+
+// Cancel requests cancellation of the operation. The operation is finished once the current step is done
+func (r *Registry) Cancel(id string) (*Operation, error) {
+	o, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-o.done:
+		return nil, fmt.Errorf("operation %s is already finished", id)
+	default:
+	}
+
+	logrus.WithFields(logrus.Fields{"operation": id}).Info("Cancelling operation")
+	o.cancel()
+	return o, nil
+}
+
+// Wait waits until the operation is finished or the context is done
+func (r *Registry) Wait(ctx context.Context, id string) (*Operation, error) {
+	o, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-o.done:
+	case <-ctx.Done():
+	}
+
+	return o, nil
+}
+
+// Referred gives back the running operation the response in progress refers to. Nil if there is none
+func (r *Registry) Referred(resp interface{}) *Operation {
+	var body *any.Any
+	switch rs := resp.(type) {
+	case *pbappmgr.Response:
+		if rs.GetStatus() == pbappmgr.Status_IN_PROGRESS {
+			body = rs.Body
+		}
+	case *pbclumgr.Response:
+		if rs.GetStatus() == pbclumgr.Status_IN_PROGRESS {
+			body = rs.Body
+		}
+	}
+
+	op := &pb.Operation{}
+	if body == nil || !ptypes.Is(body, op) || ptypes.UnmarshalAny(body, op) != nil || op.Done {
+		return nil
+	}
+
+	o, err := r.Get(op.Id)
+	if err != nil {
+		return nil
+	}
+
+	return o
+}
+
+// expire removes the operations finished before the retention period. Must be called with the registry locked
+func (r *Registry) expire() {
+	deadline := time.Now().Add(-r.retention)
+	for id, o := range r.ops {
+		o.mu.Lock()
+		expired := o.op.Done && o.finished.Before(deadline)
+		o.mu.Unlock()
+
+		if expired {
+			delete(r.ops, id)
+		}
+	}
+}
+
+// Context key of the running operation
+type operationKey struct{}
+
+// newContext gives back a copy of the context carrying the operation
+func newContext(ctx context.Context, o *Operation) context.Context {
+	return context.WithValue(ctx, operationKey{}, o)
+}
+
+// FromContext gives back the operation running in the context. Nil if there is no operation
+func FromContext(ctx context.Context) *Operation {
+	o, _ := ctx.Value(operationKey{}).(*Operation)
+	return o
+}
+
+// Detach gives back a context carrying the operation running in the context which is never cancelled.
+// The context keeps the request ID and continues the trace of the operation.
+// Used for the steps that must not be interrupted, e.g. rollback
+func Detach(ctx context.Context) context.Context {
+	detached := requestid.NewContext(tracing.Detach(ctx), requestid.FromContext(ctx))
+	if o := FromContext(ctx); o != nil {
+		return newContext(detached, o)
+	}
+
+	return detached
+}
+
+// Step records a step reached by the operation running in the context
+func Step(ctx context.Context, format string, args ...interface{}) {
+	o := FromContext(ctx)
+	if o == nil {
+		return
+	}
+
+	description := fmt.Sprintf(format, args...)
+	o.step(description)
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"operation": o.Id(),
+		"step":      description,
+	}).Debug("Operation step reached")
+}
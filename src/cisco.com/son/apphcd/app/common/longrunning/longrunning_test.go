@@ -0,0 +1,121 @@
+package longrunning
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "cisco.com/son/apphcd/api/v1/operations"
+	"cisco.com/son/apphcd/app/common/inflight"
+	"cisco.com/son/apphcd/app/common/requestid"
+)
+
+func TestRun(t *testing.T) {
+	registry := NewRegistry(inflight.NewTracker(), time.Hour)
+
+	o, err := registry.Run(context.Background(), "AppManager/CreateApp", "first", "default", func(ctx context.Context) (proto.Message, error) {
+		Step(ctx, "instance %s ready", "first")
+		return &pb.Step{Description: "result"}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o, err = registry.Wait(context.Background(), o.Id())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	op := o.Proto()
+	if op.State != pb.State_SUCCEEDED || !op.Done || op.Response == nil {
+		t.Fatalf("unexpected operation: %v", op)
+	}
+
+	if len(op.Steps) != 1 || op.Steps[0].Description != "instance first ready" {
+		t.Fatalf("unexpected steps: %v", op.Steps)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	registry := NewRegistry(inflight.NewTracker(), time.Hour)
+
+	o, _ := registry.Run(context.Background(), "AppManager/DeleteApps", "", "default", func(ctx context.Context) (proto.Message, error) {
+		<-ctx.Done()
+		return nil, errors.New("operation cancelled")
+	})
+
+	if ops := registry.List("", "", true); len(ops) != 1 {
+		t.Fatalf("unexpected running operations: %v", ops)
+	}
+
+	if _, err := registry.Cancel(o.Id()); err != nil {
+		t.Fatal(err)
+	}
+
+	<-o.Done()
+	if op := o.Proto(); op.State != pb.State_CANCELLED {
+		t.Fatalf("unexpected state: %v", op.State)
+	}
+
+	if _, err := registry.Cancel(o.Id()); err == nil {
+		t.Fatal("finished operation cancelled")
+	}
+}
+
+func TestExpire(t *testing.T) {
+	registry := NewRegistry(inflight.NewTracker(), 0)
+
+	o, _ := registry.Run(context.Background(), "AppManager/UpdateApp", "first", "default", func(ctx context.Context) (proto.Message, error) {
+		return nil, nil
+	})
+
+	<-o.Done()
+	time.Sleep(time.Millisecond)
+
+	if _, err := registry.Get(o.Id()); err != ErrNotFound {
+		t.Fatalf("expired operation found: %v", err)
+	}
+}
+
+func TestDetach(t *testing.T) {
+	registry := NewRegistry(inflight.NewTracker(), time.Hour)
+
+	span := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa},
+		TraceFlags: trace.FlagsSampled,
+	})
+	caller := requestid.NewContext(trace.ContextWithSpanContext(context.Background(), span), "req-1")
+
+	detached := make(chan context.Context, 1)
+	o, _ := registry.Run(caller, "AppManager/UpgradeApps", "first", "default", func(ctx context.Context) (proto.Message, error) {
+		<-ctx.Done()
+		detached <- Detach(ctx)
+		return nil, ctx.Err()
+	})
+
+	if _, err := registry.Cancel(o.Id()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := <-detached
+	if ctx.Err() != nil {
+		t.Fatal("detached context cancelled together with the operation")
+	}
+
+	if FromContext(ctx) != o {
+		t.Fatal("operation not carried by the detached context")
+	}
+
+	if id := requestid.FromContext(ctx); id != "req-1" {
+		t.Fatalf("unexpected request ID %q", id)
+	}
+
+	if sc := trace.SpanContextFromContext(ctx); !sc.Equal(span) {
+		t.Fatalf("unexpected span %v", sc)
+	}
+}
@@ -0,0 +1,85 @@
+// Author  <dorzheho@cisco.com>
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Adapter steps timed by StepDuration
+const (
+	StepGitClone             = "git_clone"
+	StepGitPull              = "git_pull"
+	StepGitPush              = "git_push"
+	StepGitRestore           = "git_restore"
+	StepGitLog               = "git_log"
+	StepRefreshCatalog       = "refresh_catalog"
+	StepWaitForCatalogEntry  = "wait_for_catalog_entry"
+	StepWaitForPodsReadiness = "wait_for_pods_readiness"
+)
+
+// Controller metrics. The gRPC requests are recorded by GrpcServerMetrics
+var (
+	// ResponseStatuses counts the Status values of the responses by method
+	ResponseStatuses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "apphc_response_status_total",
+		Help: "Number of responses by the Status returned to the caller",
+	}, []string{"method", "status"})
+
+	// StepDuration times the adapter steps by step and result
+	StepDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "apphc_step_duration_seconds",
+		Help:    "Time taken by the internal steps of the adapters",
+		Buckets: DefaultBuckets,
+	}, []string{"step", "result"})
+
+	// RancherRequestDuration times the Rancher API requests by HTTP method and status code
+	RancherRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "apphc_rancher_api_request_duration_seconds",
+		Help:    "Time taken by the Rancher API requests",
+		Buckets: DefaultBuckets,
+	}, []string{"method", "code"})
+
+	// LockContentions counts the operations rejected because the lock was held by another operation
+	LockContentions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "apphc_lock_contentions_total",
+		Help: "Number of operations rejected because of a held lock",
+	}, []string{"lock"})
+
+	// ClusterUpgradesInProgress is the number of cluster upgrades in progress
+	ClusterUpgradesInProgress = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "apphc_cluster_upgrades_in_progress",
+		Help: "Number of AppHoster cluster upgrades in progress",
+	})
+
+	// RateLimited counts the requests rejected by the rate limits or the number of the running operations
+	RateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "apphc_rate_limited_total",
+		Help: "Number of requests rejected by the rate or the concurrency limits",
+	}, []string{"method", "limit"})
+
+	// IdempotentReplays counts the retried requests given back the outcome of the original ones by method
+	IdempotentReplays = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "apphc_idempotent_replays_total",
+		Help: "Number of retried requests given back the outcome of the original request",
+	}, []string{"method"})
+
+	// ConfigReloads counts the configuration reloads by result
+	ConfigReloads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "apphc_config_reloads_total",
+		Help: "Number of configuration reloads by result",
+	}, []string{"result"})
+)
+
+// ObserveStep records time taken by the step started at the start time. The error tells the step result.
+// Intended to be deferred with a pointer to the named error result of the function
+func ObserveStep(step string, start time.Time, err *error) {
+	result := "ok"
+	if err != nil && *err != nil {
+		result = "error"
+	}
+
+	StepDuration.WithLabelValues(step, result).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,45 @@
+// Author  <dorzheho@cisco.com>
+
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// DefaultBuckets are the histogram buckets in seconds. The adapter steps may take minutes
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600}
+
+// Registry holds the metrics served by the Controller
+var Registry = prometheus.NewRegistry()
+
+// GrpcServerMetrics records the gRPC requests by service, method and gRPC status code.
+// The gRPC server is initialized with the interceptors of the metrics
+var GrpcServerMetrics = grpc_prometheus.NewServerMetrics()
+
+func init() {
+	GrpcServerMetrics.EnableHandlingTimeHistogram(grpc_prometheus.WithHistogramBuckets(DefaultBuckets))
+
+	Registry.MustRegister(
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewGoCollector(),
+		GrpcServerMetrics,
+		ResponseStatuses,
+		StepDuration,
+		RancherRequestDuration,
+		LockContentions,
+		ClusterUpgradesInProgress,
+		RateLimited,
+		IdempotentReplays,
+		ConfigReloads,
+	)
+}
+
+// Handler serves the registered metrics in the Prometheus format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
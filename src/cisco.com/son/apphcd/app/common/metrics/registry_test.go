@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler(t *testing.T) {
+	err := errors.New("unable to pull")
+	ObserveStep(StepGitPull, time.Now().Add(-42*time.Second), &err)
+	ObserveStep(StepGitPull, time.Now(), nil)
+	LockContentions.WithLabelValues("app").Inc()
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	body, _ := ioutil.ReadAll(rec.Body)
+	out := string(body)
+	for _, line := range []string{
+		"# TYPE apphc_step_duration_seconds histogram",
+		`apphc_step_duration_seconds_bucket{result="error",step="git_pull",le="30"} 0`,
+		`apphc_step_duration_seconds_bucket{result="error",step="git_pull",le="60"} 1`,
+		`apphc_step_duration_seconds_count{result="ok",step="git_pull"} 1`,
+		`apphc_lock_contentions_total{lock="app"} 1`,
+		"# TYPE go_goroutines gauge",
+		"# TYPE process_start_time_seconds gauge",
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Fatalf("line %q not found in:\n%s", line, out)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Author <dorzheho@cisco.com>
+
+package mutex
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Lease annotations
+const (
+	leaseNamePrefix         = "apphc-lock-"
+	leaseAnnotationKey      = "apphc.cisco.com/lock"
+	leaseAnnotationOp       = "apphc.cisco.com/operation"
+	leaseAnnotationIdentity = "apphc.cisco.com/identity"
+)
+
+// leaseBackend keeps the locks in Kubernetes Lease objects, so they survive restarts of the Controller
+// and are shared by its replicas
+type leaseBackend struct {
+	kubeClient kubernetes.Interface // Kubernetes client
+	namespace  string               // Namespace of the leases
+}
+
+// NewLeaseBackend creates a new locks backend keeping the locks in Kubernetes Lease objects
+func NewLeaseBackend(kubeClient kubernetes.Interface, namespace string) Backend {
+	return &leaseBackend{kubeClient: kubeClient, namespace: namespace}
+}
+
+// Create acquires the lock. An expired lease is taken over
+func (b *leaseBackend) Create(key string, lock *Lock) (bool, error) {
+	leases := b.kubeClient.CoordinationV1().Leases(b.namespace)
+
+	lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: leaseName(key)}}
+	setLease(lease, key, lock)
+
+	_, err := leases.Create(lease)
+	if err == nil {
+		return true, nil
+	}
+
+	if !apierrors.IsAlreadyExists(err) {
+		return false, err
+	}
+
+	current, err := leases.Get(lease.Name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	if l := leaseLock(current); l != nil && !l.expired(time.Now()) {
+		return false, nil
+	}
+
+	// Take over the expired lease. The resource version guarantees a single winner
+	setLease(current, key, lock)
+	if _, err := leases.Update(current); err != nil {
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Get gives back the lock
+func (b *leaseBackend) Get(key string) (*Lock, error) {
+	lease, err := b.kubeClient.CoordinationV1().Leases(b.namespace).Get(leaseName(key), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if l := leaseLock(lease); l != nil && !l.expired(time.Now()) {
+		return l, nil
+	}
+
+	return nil, nil
+}
+
+// Renew extends the lock
+func (b *leaseBackend) Renew(key string, lock *Lock) error {
+	leases := b.kubeClient.CoordinationV1().Leases(b.namespace)
+
+	lease, err := leases.Get(leaseName(key), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if l := leaseLock(lease); l == nil || !l.owned(lock) {
+		return nil
+	}
+
+	renewTime := metav1.NewMicroTime(lock.Renewed)
+	lease.Spec.RenewTime = &renewTime
+	_, err = leases.Update(lease)
+	return err
+}
+
+// Delete releases the lock
+func (b *leaseBackend) Delete(key string, lock *Lock) error {
+	leases := b.kubeClient.CoordinationV1().Leases(b.namespace)
+
+	lease, err := leases.Get(leaseName(key), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if l := leaseLock(lease); l == nil || !l.owned(lock) {
+		return nil
+	}
+
+	err = leases.Delete(lease.Name, &metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{ResourceVersion: &lease.ResourceVersion},
+	})
+	if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+		return err
+	}
+
+	return nil
+}
+
+// leaseName gives back name of the lease keeping the lock. Lock keys are not valid object names, hence hashed
+func leaseName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return leaseNamePrefix + hex.EncodeToString(sum[:])[:32]
+}
+
+// setLease stores the lock in the lease
+func setLease(lease *coordinationv1.Lease, key string, lock *Lock) {
+	if lease.Annotations == nil {
+		lease.Annotations = make(map[string]string)
+	}
+
+	lease.Annotations[leaseAnnotationKey] = key
+	lease.Annotations[leaseAnnotationOp] = lock.Operation
+	lease.Annotations[leaseAnnotationIdentity] = lock.Identity
+
+	holder := lock.Holder
+	duration := int32(lock.Ttl / time.Second)
+	acquireTime := metav1.NewMicroTime(lock.Acquired)
+	renewTime := metav1.NewMicroTime(lock.Renewed)
+
+	lease.Spec.HolderIdentity = &holder
+	lease.Spec.LeaseDurationSeconds = &duration
+	lease.Spec.AcquireTime = &acquireTime
+	lease.Spec.RenewTime = &renewTime
+}
+
+// leaseLock gives back the lock stored in the lease. Nil if the lease is not held
+func leaseLock(lease *coordinationv1.Lease) *Lock {
+	spec := lease.Spec
+	if spec.HolderIdentity == nil || spec.LeaseDurationSeconds == nil || spec.AcquireTime == nil || spec.RenewTime == nil {
+		return nil
+	}
+
+	return &Lock{
+		Holder:    *spec.HolderIdentity,
+		Identity:  lease.Annotations[leaseAnnotationIdentity],
+		Operation: lease.Annotations[leaseAnnotationOp],
+		Acquired:  spec.AcquireTime.Time,
+		Renewed:   spec.RenewTime.Time,
+		Ttl:       time.Duration(*spec.LeaseDurationSeconds) * time.Second,
+	}
+}
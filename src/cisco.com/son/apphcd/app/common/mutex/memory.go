@@ -0,0 +1,71 @@
+// Author <dorzheho@cisco.com>
+
+package mutex
+
+import (
+	"sync"
+	"time"
+)
+
+// memoryBackend keeps the locks in the Controller memory
+type memoryBackend struct {
+	mu    sync.Mutex       // Protects the locks
+	locks map[string]*Lock // Locks by key
+}
+
+// NewMemoryBackend creates a new in-memory locks backend. The locks don't survive restarts of the Controller
+func NewMemoryBackend() Backend {
+	return &memoryBackend{locks: make(map[string]*Lock)}
+}
+
+// Create acquires the lock
+func (b *memoryBackend) Create(key string, lock *Lock) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if l, ok := b.locks[key]; ok && !l.expired(time.Now()) {
+		return false, nil
+	}
+
+	l := *lock
+	b.locks[key] = &l
+	return true, nil
+}
+
+// Get gives back the lock
+func (b *memoryBackend) Get(key string) (*Lock, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	l, ok := b.locks[key]
+	if !ok || l.expired(time.Now()) {
+		return nil, nil
+	}
+
+	lock := *l
+	return &lock, nil
+}
+
+// Renew extends the lock
+func (b *memoryBackend) Renew(key string, lock *Lock) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if l, ok := b.locks[key]; ok && l.owned(lock) {
+		l.Renewed = lock.Renewed
+	}
+
+	return nil
+}
+
+// Delete releases the lock
+func (b *memoryBackend) Delete(key string, lock *Lock) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if l, ok := b.locks[key]; ok && l.owned(lock) {
+		delete(b.locks, key)
+	}
+
+	return nil
+}
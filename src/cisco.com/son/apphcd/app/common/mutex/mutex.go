@@ -2,7 +2,14 @@
 
 package mutex
 
-import "sync"
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"cisco.com/son/apphcd/app/common/metrics"
+)
 
 const (
 	LockActionUpgradeCluster    = "UpgradeCluster"
@@ -14,38 +21,219 @@ const (
 	LockActionAny               = "AnyAction"
 )
 
-type mutex map[string]interface{}
+// Actions locking the whole cluster. Every operation is mutually exclusive with them
+var clusterActions = []string{LockActionEnableDisableApps, LockActionDeleteApps, LockActionUpgradeCluster,
+	LockActionCreateNode, LockActionDeleteNode, LockActionUpdateNodeState}
 
-var (
-	once     sync.Once
-	instance mutex
-)
+// Lock is a held operation lock
+type Lock struct {
+	Holder    string        // Controller instance holding the lock
+	Identity  string        // Caller identity which requested the operation
+	Operation string        // Operation description
+	Acquired  time.Time     // Time the lock was acquired
+	Renewed   time.Time     // Time the lock was renewed
+	Ttl       time.Duration // Time the lock expires after unless renewed
+}
 
-func New() mutex {
-	once.Do(func() {
-		instance = make(map[string]interface{})
-	})
+// expired tells whether the lock is expired
+func (l *Lock) expired(now time.Time) bool {
+	return now.After(l.Renewed.Add(l.Ttl))
+}
 
-	return instance
+// owned tells whether the lock is the same acquisition as the other one
+func (l *Lock) owned(other *Lock) bool {
+	return l.Holder == other.Holder && l.Acquired.Equal(other.Acquired)
 }
 
-func Lock(key string, value interface{}) {
-	instance[key] = value
+// Backend keeps the locks
+type Backend interface {
+	// Create acquires the lock. Gives back false if the lock is held by someone else and not expired
+	Create(key string, lock *Lock) (bool, error)
+	// Get gives back the lock. Nil if the lock is not held or expired
+	Get(key string) (*Lock, error)
+	// Renew extends the lock
+	Renew(key string, lock *Lock) error
+	// Delete releases the lock
+	Delete(key string, lock *Lock) error
 }
 
-func Unlock(key string) {
-	if _, ok := instance[key]; ok {
-		delete(instance, key)
+// Manager acquires, renews and releases the operation locks
+type Manager struct {
+	backend Backend              // Locks storage
+	holder  string               // Controller instance
+	ttl     time.Duration        // Locks TTL
+	mu      sync.Mutex           // Protects the field below
+	held    map[string]*heldLock // Locks held by the Controller instance
+}
+
+// heldLock is a lock held by the Controller instance
+type heldLock struct {
+	lock *Lock         // The lock
+	stop chan struct{} // Stops the lock renewal
+}
+
+// NewManager creates a new lock manager
+func NewManager(backend Backend, holder string, ttl time.Duration) *Manager {
+	return &Manager{backend: backend, holder: holder, ttl: ttl, held: make(map[string]*heldLock)}
+}
+
+// TryLock acquires the lock unless the key or any of the cluster actions is locked.
+// The lock is renewed until released. Gives back false if the lock was not acquired
+func (m *Manager) TryLock(key, operation, identity string) bool {
+	conflicts := conflicts(key)
+	if m.anyLocked(conflicts) {
+		metrics.LockContentions.WithLabelValues(lockKind(key)).Inc()
+		return false
+	}
+
+	now := time.Now().Truncate(time.Microsecond)
+	lock := &Lock{
+		Holder:    m.holder,
+		Identity:  identity,
+		Operation: operation,
+		Acquired:  now,
+		Renewed:   now,
+		Ttl:       m.ttl,
+	}
+
+	ok, err := m.backend.Create(key, lock)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"lock": key, "error": err}).Error("Unable to acquire lock")
+		return false
 	}
+
+	if !ok {
+		metrics.LockContentions.WithLabelValues(lockKind(key)).Inc()
+		return false
+	}
+
+	// A conflicting lock might have been acquired concurrently, hence check again and back off
+	if m.anyLocked(conflicts) {
+		if err := m.backend.Delete(key, lock); err != nil {
+			logrus.WithFields(logrus.Fields{"lock": key, "error": err}).Error("Unable to release lock")
+		}
+		metrics.LockContentions.WithLabelValues(lockKind(key)).Inc()
+		return false
+	}
+
+	h := &heldLock{lock: lock, stop: make(chan struct{})}
+	m.mu.Lock()
+	m.held[key] = h
+	m.mu.Unlock()
+
+	go m.renew(key, h)
+
+	logrus.WithFields(logrus.Fields{
+		"lock":      key,
+		"operation": operation,
+		"identity":  identity,
+	}).Debug("Lock acquired")
+
+	return true
 }
 
-func IsLocked(key string) bool {
-	for _, k := range []string{key, LockActionEnableDisableApps, LockActionDeleteApps,
-		LockActionUpgradeCluster, LockActionCreateNode, LockActionDeleteNode} {
-		if _, ok := instance[k]; ok {
+// Unlock releases the lock
+func (m *Manager) Unlock(key string) {
+	m.mu.Lock()
+	h, ok := m.held[key]
+	delete(m.held, key)
+	m.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	close(h.stop)
+
+	if err := m.backend.Delete(key, h.lock); err != nil {
+		logrus.WithFields(logrus.Fields{"lock": key, "error": err}).Error("Unable to release lock")
+	}
+}
+
+// IsLocked tells whether the key or any of the cluster actions is locked
+func (m *Manager) IsLocked(key string) bool {
+	return m.anyLocked(append(conflicts(key), key))
+}
+
+// renew extends the lock periodically until stopped
+func (m *Manager) renew(key string, h *heldLock) {
+	ticker := time.NewTicker(m.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stop:
+			return
+		case <-ticker.C:
+		}
+
+		renewed := *h.lock
+		renewed.Renewed = time.Now()
+		if err := m.backend.Renew(key, &renewed); err != nil {
+			logrus.WithFields(logrus.Fields{"lock": key, "error": err}).Error("Unable to renew lock")
+		}
+	}
+}
+
+// anyLocked tells whether any of the keys is locked. Keys that can't be checked are considered locked
+func (m *Manager) anyLocked(keys []string) bool {
+	for _, k := range keys {
+		lock, err := m.backend.Get(k)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"lock": k, "error": err}).Error("Unable to check lock")
+			return true
+		}
+
+		if lock != nil {
 			return true
 		}
 	}
 
 	return false
 }
+
+// conflicts gives back the keys mutually exclusive with the key
+func conflicts(key string) []string {
+	var keys []string
+	for _, k := range clusterActions {
+		if k != key {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
+// lockKind gives back the cluster action of the lock. The application locks are reported as "app"
+// since their keys are made of the application names
+func lockKind(key string) string {
+	for _, k := range clusterActions {
+		if k == key {
+			return key
+		}
+	}
+
+	return "app"
+}
+
+var instance *Manager
+
+// Init sets up the lock manager of the Controller
+func Init(backend Backend, holder string, ttl time.Duration) {
+	instance = NewManager(backend, holder, ttl)
+}
+
+// TryLock acquires the lock unless the key or any of the cluster actions is locked
+func TryLock(key, operation, identity string) bool {
+	return instance.TryLock(key, operation, identity)
+}
+
+// Unlock releases the lock
+func Unlock(key string) {
+	instance.Unlock(key)
+}
+
+// IsLocked tells whether the key or any of the cluster actions is locked
+func IsLocked(key string) bool {
+	return instance.IsLocked(key)
+}
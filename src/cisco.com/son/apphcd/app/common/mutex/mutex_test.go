@@ -0,0 +1,88 @@
+package mutex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTryLock(t *testing.T) {
+	backend := NewMemoryBackend()
+	first := NewManager(backend, "first", time.Minute)
+	second := NewManager(backend, "second", time.Minute)
+
+	if !first.TryLock("app", "CreateApp app", "alice") {
+		t.Fatal("unable to lock a free key")
+	}
+
+	if second.TryLock("app", "UpdateApp app", "bob") {
+		t.Fatal("locked a held key")
+	}
+
+	lock, _ := backend.Get("app")
+	if lock == nil || lock.Holder != "first" || lock.Identity != "alice" || lock.Operation != "CreateApp app" {
+		t.Fatalf("unexpected lock %v", lock)
+	}
+
+	if !second.TryLock("other", "CreateApp other", "bob") {
+		t.Fatal("unable to lock an independent key")
+	}
+
+	// Unlocking a key held by another instance has no effect
+	second.Unlock("app")
+	if !first.IsLocked("app") {
+		t.Fatal("lock released by another instance")
+	}
+
+	first.Unlock("app")
+	second.Unlock("other")
+	if first.IsLocked("app") || first.IsLocked(LockActionAny) {
+		t.Fatal("lock is not released")
+	}
+
+	if !first.TryLock(LockActionDeleteNode, "RemoveNode", "alice") {
+		t.Fatal("unable to lock a cluster action")
+	}
+
+	if !second.IsLocked(LockActionAny) || second.TryLock("app", "CreateApp app", "bob") {
+		t.Fatal("cluster action doesn't lock the cluster")
+	}
+
+	first.Unlock(LockActionDeleteNode)
+}
+
+func TestLockExpiry(t *testing.T) {
+	backend := NewMemoryBackend()
+
+	now := time.Now()
+	expired := &Lock{Holder: "crashed", Acquired: now.Add(-time.Hour), Renewed: now.Add(-time.Hour), Ttl: time.Minute}
+	if ok, _ := backend.Create("app", expired); !ok {
+		t.Fatal("unable to create a lock")
+	}
+
+	m := NewManager(backend, "second", time.Minute)
+	if m.IsLocked("app") {
+		t.Fatal("expired lock is considered held")
+	}
+
+	if !m.TryLock("app", "CreateApp app", "bob") {
+		t.Fatal("unable to take over an expired lock")
+	}
+
+	m.Unlock("app")
+}
+
+func TestLockRenewal(t *testing.T) {
+	backend := NewMemoryBackend()
+	m := NewManager(backend, "first", 150*time.Millisecond)
+
+	if !m.TryLock("app", "CreateApp app", "alice") {
+		t.Fatal("unable to lock a free key")
+	}
+
+	time.Sleep(400 * time.Millisecond)
+	if !m.IsLocked("app") {
+		t.Fatal("lock is not renewed")
+	}
+
+	m.Unlock("app")
+}
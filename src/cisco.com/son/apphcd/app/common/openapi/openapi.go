@@ -0,0 +1,266 @@
+// Author  <dorzheho@cisco.com>
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// BearerScheme is the name of the security scheme the callers are authenticated by
+const BearerScheme = "bearer"
+
+// Document is a decoded OpenAPI document
+type Document map[string]interface{}
+
+// Info describes the merged document
+type Info struct {
+	Title       string
+	Description string
+	Version     string
+}
+
+// Merge merges the OpenAPI 2 (Swagger) specifications generated for the services into a single document.
+// The paths and the definitions must be unique unless they are identical
+func Merge(info Info, specs ...string) (Document, error) {
+	paths := map[string]interface{}{}
+	definitions := map[string]interface{}{}
+	tags := map[string]bool{}
+
+	for _, spec := range specs {
+		var doc Document
+		if err := json.Unmarshal([]byte(spec), &doc); err != nil {
+			return nil, fmt.Errorf("unable to decode specification: %v", err)
+		}
+
+		for path, item := range object(doc["paths"]) {
+			merged := object(paths[path])
+			for method, op := range object(item) {
+				if _, ok := merged[method]; ok {
+					return nil, fmt.Errorf("duplicated operation %s %s", strings.ToUpper(method), path)
+				}
+				merged[method] = op
+
+				for _, tag := range array(object(op)["tags"]) {
+					if name, ok := tag.(string); ok {
+						tags[name] = true
+					}
+				}
+			}
+			paths[path] = merged
+		}
+
+		for name, def := range object(doc["definitions"]) {
+			if existing, ok := definitions[name]; ok && !reflect.DeepEqual(existing, def) {
+				return nil, fmt.Errorf("conflicting definition %s", name)
+			}
+			definitions[name] = def
+		}
+	}
+
+	var names []string
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var tagList []interface{}
+	for _, name := range names {
+		tagList = append(tagList, map[string]interface{}{"name": name})
+	}
+
+	return Document{
+		"swagger": "2.0",
+		"info": map[string]interface{}{
+			"title":       info.Title,
+			"description": info.Description,
+			"version":     info.Version,
+		},
+		"schemes":     []interface{}{"http", "https"},
+		"consumes":    []interface{}{"application/json"},
+		"produces":    []interface{}{"application/json"},
+		"tags":        tagList,
+		"paths":       paths,
+		"definitions": definitions,
+		"securityDefinitions": map[string]interface{}{
+			BearerScheme: map[string]interface{}{
+				"type":        "apiKey",
+				"in":          "header",
+				"name":        "Authorization",
+				"description": "Bearer token in the form \"Bearer <token>\"",
+			},
+		},
+		"security": []interface{}{map[string]interface{}{BearerScheme: []interface{}{}}},
+	}, nil
+}
+
+// ConvertV3 converts the OpenAPI 2 document into OpenAPI 3
+func ConvertV3(doc Document) Document {
+	paths := map[string]interface{}{}
+	for path, item := range object(doc["paths"]) {
+		ops := map[string]interface{}{}
+		for method, op := range object(item) {
+			ops[method] = convertOperation(object(op))
+		}
+		paths[path] = ops
+	}
+
+	schemes := map[string]interface{}{}
+	for name, scheme := range object(doc["securityDefinitions"]) {
+		schemes[name] = convertSecurityScheme(object(scheme))
+	}
+
+	v3 := Document{
+		"openapi": "3.0.3",
+		"info":    doc["info"],
+		"servers": []interface{}{map[string]interface{}{"url": "/"}},
+		"paths":   paths,
+		"components": map[string]interface{}{
+			"schemas":         doc["definitions"],
+			"securitySchemes": schemes,
+		},
+	}
+
+	for _, key := range []string{"tags", "security"} {
+		if v, ok := doc[key]; ok {
+			v3[key] = v
+		}
+	}
+
+	return rewriteRefs(v3).(Document)
+}
+
+// Parameter fields moved to the schema in OpenAPI 3
+var schemaFields = []string{"type", "format", "items", "enum", "default", "pattern",
+	"minimum", "maximum", "minLength", "maxLength", "minItems", "maxItems"}
+
+// convertOperation moves the body parameter to the request body and the response schemas to the content
+func convertOperation(op map[string]interface{}) map[string]interface{} {
+	res := map[string]interface{}{}
+	for _, key := range []string{"summary", "description", "operationId", "tags", "deprecated", "security"} {
+		if v, ok := op[key]; ok {
+			res[key] = v
+		}
+	}
+
+	var params []interface{}
+	for _, p := range array(op["parameters"]) {
+		param := object(p)
+		if param["in"] == "body" {
+			body := map[string]interface{}{
+				"required": param["required"] == true,
+				"content":  jsonContent(param["schema"]),
+			}
+			if d, ok := param["description"]; ok {
+				body["description"] = d
+			}
+			res["requestBody"] = body
+			continue
+		}
+
+		schema := map[string]interface{}{}
+		converted := map[string]interface{}{"schema": schema}
+		for key, v := range param {
+			switch {
+			case contains(schemaFields, key):
+				schema[key] = v
+			case key == "collectionFormat":
+				// Repeated query parameters are the default of OpenAPI 3
+			default:
+				converted[key] = v
+			}
+		}
+		params = append(params, converted)
+	}
+
+	if len(params) > 0 {
+		res["parameters"] = params
+	}
+
+	responses := map[string]interface{}{}
+	for code, r := range object(op["responses"]) {
+		resp := object(r)
+		converted := map[string]interface{}{"description": resp["description"]}
+		if schema, ok := resp["schema"]; ok {
+			converted["content"] = jsonContent(schema)
+		}
+		responses[code] = converted
+	}
+	res["responses"] = responses
+
+	return res
+}
+
+// convertSecurityScheme converts the security definition. Authorization header is declared as the bearer scheme
+func convertSecurityScheme(scheme map[string]interface{}) map[string]interface{} {
+	switch scheme["type"] {
+	case "basic":
+		return map[string]interface{}{"type": "http", "scheme": "basic", "description": scheme["description"]}
+	case "apiKey":
+		if scheme["in"] == "header" && strings.EqualFold(fmt.Sprint(scheme["name"]), "Authorization") {
+			return map[string]interface{}{"type": "http", "scheme": "bearer", "description": scheme["description"]}
+		}
+	}
+
+	return scheme
+}
+
+// jsonContent gives back the content of the JSON media type
+func jsonContent(schema interface{}) map[string]interface{} {
+	return map[string]interface{}{"application/json": map[string]interface{}{"schema": schema}}
+}
+
+// rewriteRefs points the references to the definitions at the components
+func rewriteRefs(v interface{}) interface{} {
+	switch t := v.(type) {
+	case Document:
+		return Document(rewriteRefs(map[string]interface{}(t)).(map[string]interface{}))
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(t))
+		for key, val := range t {
+			if ref, ok := val.(string); ok && key == "$ref" {
+				res[key] = strings.Replace(ref, "#/definitions/", "#/components/schemas/", 1)
+				continue
+			}
+			res[key] = rewriteRefs(val)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(t))
+		for i, val := range t {
+			res[i] = rewriteRefs(val)
+		}
+		return res
+	}
+
+	return v
+}
+
+// object gives back the value as JSON object. Empty if the value is not an object
+func object(v interface{}) map[string]interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		return m
+	}
+
+	return map[string]interface{}{}
+}
+
+// array gives back the value as JSON array. Nil if the value is not an array
+func array(v interface{}) []interface{} {
+	a, _ := v.([]interface{})
+	return a
+}
+
+// contains checks whether the list contains the string
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const appsSpec = `{
+  "swagger": "2.0",
+  "paths": {
+    "/api/v1/apps": {
+      "post": {
+        "operationId": "CreateApp",
+        "tags": ["AppManager"],
+        "parameters": [{"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/appmanagerCreateAppRequest"}}],
+        "responses": {"200": {"description": "A successful response.", "schema": {"$ref": "#/definitions/appmanagerResponse"}}}
+      }
+    }
+  },
+  "definitions": {
+    "appmanagerCreateAppRequest": {"type": "object"},
+    "appmanagerResponse": {"type": "object", "properties": {"body": {"$ref": "#/definitions/protobufAny"}}},
+    "protobufAny": {"type": "object"}
+  }
+}`
+
+const clusterSpec = `{
+  "swagger": "2.0",
+  "paths": {
+    "/api/v1/apps": {
+      "get": {
+        "operationId": "GetApps",
+        "tags": ["AppManager"],
+        "parameters": [{"name": "group_ids", "in": "query", "type": "array", "items": {"type": "string"}, "collectionFormat": "multi"}],
+        "responses": {"200": {"description": "A successful response."}}
+      }
+    },
+    "/api/v1/apphoster": {
+      "get": {"operationId": "GetClusterInfo", "tags": ["ClusterManager"], "responses": {}}
+    }
+  },
+  "definitions": {
+    "protobufAny": {"type": "object"}
+  }
+}`
+
+func TestMerge(t *testing.T) {
+	doc, err := Merge(Info{Title: "AppHoster Controller", Version: "canary"}, appsSpec, clusterSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(object(doc["paths"])) != 2 || len(object(object(doc["paths"])["/api/v1/apps"])) != 2 {
+		t.Fatalf("unexpected paths %v", doc["paths"])
+	}
+
+	if len(object(doc["definitions"])) != 3 || len(array(doc["tags"])) != 2 {
+		t.Fatalf("unexpected definitions %v or tags %v", doc["definitions"], doc["tags"])
+	}
+
+	if _, ok := object(doc["securityDefinitions"])[BearerScheme]; !ok {
+		t.Fatal("bearer security scheme expected")
+	}
+
+	if _, err := Merge(Info{}, appsSpec, appsSpec); err == nil || !strings.Contains(err.Error(), "duplicated operation POST /api/v1/apps") {
+		t.Fatalf("duplicated operation expected, got %v", err)
+	}
+
+	conflicting := strings.Replace(clusterSpec, `"protobufAny": {"type": "object"}`, `"protobufAny": {"type": "string"}`, 1)
+	if _, err := Merge(Info{}, appsSpec, conflicting); err == nil || !strings.Contains(err.Error(), "conflicting definition protobufAny") {
+		t.Fatalf("conflicting definition expected, got %v", err)
+	}
+}
+
+func TestConvertV3(t *testing.T) {
+	doc, err := Merge(Info{Title: "AppHoster Controller", Version: "canary"}, appsSpec, clusterSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(ConvertV3(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v3 := string(data)
+	if strings.Contains(v3, "#/definitions/") {
+		t.Fatalf("references not rewritten: %s", v3)
+	}
+
+	for _, s := range []string{
+		`"openapi":"3.0.3"`,
+		`"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/appmanagerCreateAppRequest"}}},"required":true}`,
+		`"parameters":[{"in":"query","name":"group_ids","schema":{"items":{"type":"string"},"type":"array"}}]`,
+		`"scheme":"bearer","type":"http"`,
+		`"security":[{"bearer":[]}]`,
+	} {
+		if !strings.Contains(v3, s) {
+			t.Fatalf("%s not found in %s", s, v3)
+		}
+	}
+}
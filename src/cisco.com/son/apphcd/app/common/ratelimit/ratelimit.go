@@ -0,0 +1,357 @@
+// Author  <dorzheho@cisco.com>
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/inflight"
+	"cisco.com/son/apphcd/app/common/metrics"
+	"cisco.com/son/apphcd/app/common/requestid"
+)
+
+// RetryAfterKey is the response header suggesting the number of seconds to wait before retrying
+const RetryAfterKey = "retry-after"
+
+// Time a client is suggested to wait for a running operation to finish
+const operationsRetryAfter = 5 * time.Second
+
+// Buckets untouched for the period are full and are removed
+const idleBucketsPeriod = time.Minute
+
+// Methods of the standard gRPC health service
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// Limit is a token bucket refilled at the rate up to the burst
+type Limit struct {
+	Rate  float64 // Requests per second
+	Burst int     // Requests allowed at once
+}
+
+// MethodLimit is the limit of the methods matching the pattern, e.g. AppManager/Get*
+type MethodLimit struct {
+	Pattern string
+	Limit
+}
+
+// ParseMethodLimits parses comma separated list of pattern=rate:burst, e.g. AppManager/GetApps=2:5
+func ParseMethodLimits(s string) ([]MethodLimit, error) {
+	var limits []MethodLimit
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		i := strings.LastIndex(item, "=")
+		j := strings.LastIndex(item, ":")
+		if i <= 0 || j < i {
+			return nil, fmt.Errorf("invalid method limit %q, expected pattern=rate:burst", item)
+		}
+
+		pattern := item[:i]
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid method limit %q: %v", item, err)
+		}
+
+		rate, err := strconv.ParseFloat(item[i+1:j], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid method limit %q: rate must be a positive number", item)
+		}
+
+		burst, err := strconv.Atoi(item[j+1:])
+		if err != nil || burst <= 0 {
+			return nil, fmt.Errorf("invalid method limit %q: burst must be a positive integer", item)
+		}
+
+		limits = append(limits, MethodLimit{Pattern: pattern, Limit: Limit{Rate: rate, Burst: burst}})
+	}
+
+	return limits, nil
+}
+
+// bucket is a token bucket
+type bucket struct {
+	limit   Limit
+	tokens  float64
+	updated time.Time
+}
+
+// refill adds the tokens accumulated since the last update
+func (b *bucket) refill(now time.Time) {
+	b.tokens = math.Min(float64(b.limit.Burst), b.tokens+now.Sub(b.updated).Seconds()*b.limit.Rate)
+	b.updated = now
+}
+
+// wait gives back time to wait for the next token. Zero if the bucket has a token
+func (b *bucket) wait() time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.limit.Rate * float64(time.Second))
+}
+
+// Limiter limits the rate of the requests of every client and the number of the running mutating operations
+type Limiter struct {
+	client  Limit         // Limit of all the requests of a client. Disabled if the rate is 0
+	methods []MethodLimit // Limits of the methods of a client, first match applies
+	slots   chan struct{} // Running mutating operations. Nil if unlimited
+	now     func() time.Time
+	mu      sync.Mutex         // Protects the fields below
+	buckets map[string]*bucket // Buckets by client and method pattern
+	swept   time.Time          // Time the idle buckets were last removed
+}
+
+// NewLimiter creates a new limiter. Zero maximum of the operations makes them unlimited
+func NewLimiter(client Limit, methods []MethodLimit, maxOperations int) *Limiter {
+	l := &Limiter{
+		client:  client,
+		methods: methods,
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+
+	if maxOperations > 0 {
+		l.slots = make(chan struct{}, maxOperations)
+	}
+
+	return l
+}
+
+// Allow takes a token of the client and the method buckets. The tokens are taken only if both buckets have one.
+// Gives back time to wait before retrying if the request is not allowed
+func (l *Limiter) Allow(client, method string) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	var buckets []*bucket
+	if l.client.Rate > 0 {
+		buckets = append(buckets, l.bucket(client, l.client, now))
+	}
+
+	for _, m := range l.methods {
+		if ok, _ := path.Match(m.Pattern, method); ok {
+			buckets = append(buckets, l.bucket(client+" "+m.Pattern, m.Limit, now))
+			break
+		}
+	}
+
+	// The rejected request doesn't consume the token of the other bucket
+	var wait time.Duration
+	for _, b := range buckets {
+		if w := b.wait(); w > wait {
+			wait = w
+		}
+	}
+
+	if wait > 0 {
+		return wait, false
+	}
+
+	for _, b := range buckets {
+		b.tokens--
+	}
+
+	return 0, true
+}
+
+// bucket gives back the refilled bucket. New bucket is full
+func (l *Limiter) bucket(key string, limit Limit, now time.Time) *bucket {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), updated: now}
+		l.buckets[key] = b
+	}
+
+	b.refill(now)
+	return b
+}
+
+// sweep removes the buckets that got full
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < idleBucketsPeriod {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.updated).Seconds()*b.limit.Rate >= float64(b.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.swept = now
+}
+
+// acquire takes a slot of a running operation
+func (l *Limiter) acquire() (*slot, bool) {
+	if l.slots == nil {
+		return &slot{}, true
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return &slot{release: func() { <-l.slots }}, true
+	default:
+		return nil, false
+	}
+}
+
+// slot is held by a running mutating operation
+type slot struct {
+	once     sync.Once
+	release  func() // Frees the slot. Nil if the operations are unlimited
+	detached int32  // Whether the slot was taken over by a background operation
+}
+
+// free frees the slot once
+func (s *slot) free() {
+	s.once.Do(func() {
+		if s.release != nil {
+			s.release()
+		}
+	})
+}
+
+// Context key of the operation slot
+type slotKey struct{}
+
+// Detach takes over the operation slot of the request, so the slot is held until the background operation
+// started by the request is finished. Gives back the function freeing the slot
+func Detach(ctx context.Context) func() {
+	s, ok := ctx.Value(slotKey{}).(*slot)
+	if !ok {
+		return func() {}
+	}
+
+	atomic.StoreInt32(&s.detached, 1)
+	return s.free
+}
+
+// UnaryServerInterceptor rejects the requests exceeding the rate limits and the mutating requests
+// exceeding the number of the running operations
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		if inflight.IsReadOnly(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		s, ok := l.acquire()
+		if !ok {
+			return nil, l.reject(ctx, info.FullMethod, "operations", operationsRetryAfter,
+				"too many operations are running, retry after %s", operationsRetryAfter)
+		}
+
+		defer func() {
+			if atomic.LoadInt32(&s.detached) == 0 {
+				s.free()
+			}
+		}()
+
+		return handler(context.WithValue(ctx, slotKey{}, s), req)
+	}
+}
+
+// StreamServerInterceptor rejects the streaming requests exceeding the rate limits
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.allow(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// allow checks the rate limits of the caller
+func (l *Limiter) allow(ctx context.Context, fullMethod string) error {
+	// Health is checked by the probes
+	if strings.HasPrefix(fullMethod, healthServicePrefix) {
+		return nil
+	}
+
+	method := auth.ShortMethod(fullMethod)
+	client := clientName(ctx)
+	if wait, ok := l.Allow(client, method); !ok {
+		return l.reject(ctx, fullMethod, "rate", wait, "rate limit of %s exceeded, retry after %s", client, roundUp(wait))
+	}
+
+	return nil
+}
+
+// reject sets the retry hint and gives back the error rejecting the request
+func (l *Limiter) reject(ctx context.Context, fullMethod, limit string, wait time.Duration, format string, args ...interface{}) error {
+	seconds := int(roundUp(wait).Seconds())
+	if err := grpc.SetHeader(ctx, metadata.Pairs(RetryAfterKey, strconv.Itoa(seconds))); err != nil {
+		requestid.Logger(ctx).WithFields(logrus.Fields{"error": err}).Debug("Unable to set retry hint")
+	}
+
+	metrics.RateLimited.WithLabelValues(auth.ShortMethod(fullMethod), limit).Inc()
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"client": clientName(ctx),
+		"method": fullMethod,
+		"limit":  limit,
+	}).Warn("Request rejected")
+
+	return status.Errorf(codes.ResourceExhausted, format, args...)
+}
+
+// roundUp rounds the duration up to whole seconds, at least one
+func roundUp(d time.Duration) time.Duration {
+	if d < time.Second {
+		return time.Second
+	}
+
+	return (d + time.Second - 1).Truncate(time.Second)
+}
+
+// clientName gives back the caller identity. The address of the caller identifies the anonymous ones
+func clientName(ctx context.Context) string {
+	if id := auth.FromContext(ctx); id != nil {
+		return id.Name
+	}
+
+	addr := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+	}
+
+	// The gRPC Gateway forwards the address of the HTTP client. It's appended to the addresses sent by the client,
+	// so only the last one is trusted
+	if auth.FromGateway(ctx) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			hops := strings.Split(forwarded[len(forwarded)-1], ",")
+			addr = strings.TrimSpace(hops[len(hops)-1])
+		}
+	}
+
+	return "anonymous@" + addr
+}
@@ -0,0 +1,166 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"cisco.com/son/apphcd/app/common/auth"
+)
+
+func TestParseMethodLimits(t *testing.T) {
+	limits, err := ParseMethodLimits("AppManager/Get*=2:5, AppManager/UpgradeApp=0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(limits) != 2 || limits[0].Pattern != "AppManager/Get*" || limits[1].Rate != 0.1 || limits[1].Burst != 1 {
+		t.Fatalf("unexpected limits %+v", limits)
+	}
+
+	for _, s := range []string{"AppManager/GetApps", "AppManager/GetApps=2", "AppManager/GetApps=0:1", "AppManager/[=1:1"} {
+		if _, err := ParseMethodLimits(s); err == nil {
+			t.Fatalf("%q expected to be rejected", s)
+		}
+	}
+}
+
+func TestAllow(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewLimiter(Limit{Rate: 1, Burst: 2}, []MethodLimit{{Pattern: "AppManager/Upgrade*", Limit: Limit{Rate: 0.1, Burst: 1}}}, 0)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if _, ok := l.Allow("admin", "AppManager/GetApps"); !ok {
+			t.Fatalf("request %d expected to be allowed", i)
+		}
+	}
+
+	if wait, ok := l.Allow("admin", "AppManager/GetApps"); ok || wait != time.Second {
+		t.Fatalf("exceeding request expected to wait 1s, got %v %v", wait, ok)
+	}
+
+	// Clients are limited separately
+	if _, ok := l.Allow("viewer", "AppManager/GetApps"); !ok {
+		t.Fatal("request of another client expected to be allowed")
+	}
+
+	now = now.Add(2 * time.Second)
+	if _, ok := l.Allow("admin", "AppManager/UpgradeApp"); !ok {
+		t.Fatal("refilled bucket expected to allow the request")
+	}
+
+	if wait, ok := l.Allow("admin", "AppManager/UpgradeApp"); ok || wait != 10*time.Second {
+		t.Fatalf("exceeding method request expected to wait 10s, got %v %v", wait, ok)
+	}
+
+	// Full buckets are removed
+	now = now.Add(time.Hour)
+	l.Allow("admin", "AppManager/GetApps")
+	if len(l.buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(l.buckets))
+	}
+}
+
+func TestAllowBothBuckets(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewLimiter(Limit{Rate: 1, Burst: 2}, []MethodLimit{{Pattern: "AppManager/Upgrade*", Limit: Limit{Rate: 0.1, Burst: 1}}}, 0)
+	l.now = func() time.Time { return now }
+
+	if _, ok := l.Allow("admin", "AppManager/UpgradeApp"); !ok {
+		t.Fatal("first request expected to be allowed")
+	}
+
+	// The requests rejected by the method limit don't drain the client bucket
+	for i := 0; i < 3; i++ {
+		if wait, ok := l.Allow("admin", "AppManager/UpgradeApp"); ok || wait != 10*time.Second {
+			t.Fatalf("exceeding method request expected to wait 10s, got %v %v", wait, ok)
+		}
+	}
+
+	if _, ok := l.Allow("admin", "AppManager/GetApps"); !ok {
+		t.Fatal("client bucket expected to keep a token")
+	}
+
+	// The requests rejected by the client limit don't drain the method bucket
+	if _, ok := l.Allow("admin", "AppManager/GetApps"); ok {
+		t.Fatal("client bucket expected to be empty")
+	}
+
+	now = now.Add(10 * time.Second)
+	for i := 0; i < 2; i++ {
+		l.Allow("admin", "AppManager/GetApps")
+	}
+
+	if wait, ok := l.Allow("admin", "AppManager/UpgradeApp"); ok || wait != time.Second {
+		t.Fatalf("request expected to wait 1s for the client bucket, got %v %v", wait, ok)
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := l.Allow("admin", "AppManager/UpgradeApp"); !ok {
+		t.Fatal("method bucket expected to keep a token")
+	}
+}
+
+func TestOperations(t *testing.T) {
+	l := NewLimiter(Limit{}, nil, 1)
+	interceptor := l.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/UpgradeApp"}
+
+	// The background operation keeps the slot of the request
+	var release func()
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		release = Detach(ctx)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected %s, got %v", codes.ResourceExhausted, err)
+	}
+
+	// Read-only requests are not limited by the running operations
+	readOnly := &grpc.UnaryServerInfo{FullMethod: "/com.cisco.son.apphcd.api.v1.appmanager.AppManager/GetApps"}
+	if _, err := interceptor(context.Background(), nil, readOnly, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	release()
+	release()
+	if _, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientName(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 4000}})
+
+	// Direct callers can't forward an address
+	direct := metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "10.0.0.1"))
+	if name := clientName(direct); name != "anonymous@127.0.0.1" {
+		t.Fatalf("unexpected client %q", name)
+	}
+
+	// The gRPC Gateway appends the address of the HTTP client to the spoofed ones
+	gateway := metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "10.0.0.1, 10.0.0.2",
+		auth.GatewayTokenKey, auth.GatewayToken()))
+	if name := clientName(gateway); name != "anonymous@10.0.0.2" {
+		t.Fatalf("unexpected client %q", name)
+	}
+}
@@ -0,0 +1,58 @@
+// Author  <dorzheho@cisco.com>
+
+package requestid
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// HeaderKey is the HTTP header and the gRPC metadata key carrying the request ID
+const HeaderKey = "x-request-id"
+
+// LogField is the logrus field holding the request ID
+const LogField = "request_id"
+
+// Request IDs passed by the callers are accepted unless they exceed the length or contain
+// characters unsafe to be logged
+var validId = regexp.MustCompile(`^[A-Za-z0-9._:/+=-]{1,128}$`)
+
+type key struct{}
+
+// New generates a new request ID
+func New() string {
+	return uuid.New().String()
+}
+
+// Valid tells whether the request ID passed by the caller is accepted
+func Valid(id string) bool {
+	return validId.MatchString(id)
+}
+
+// NewContext gives back a copy of the context carrying the request ID
+func NewContext(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, key{}, id)
+}
+
+// FromContext gives back the request ID carried by the context. Empty if there is none
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(key{}).(string)
+	return id
+}
+
+// Logger gives back the log entry of the request carried by the context
+func Logger(ctx context.Context) *logrus.Entry {
+	entry := logrus.NewEntry(logrus.StandardLogger())
+	if id := FromContext(ctx); id != "" {
+		return entry.WithField(LogField, id)
+	}
+
+	return entry
+}
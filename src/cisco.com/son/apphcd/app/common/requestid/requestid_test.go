@@ -0,0 +1,41 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	for _, id := range []string{New(), "abc-123", "trace:1/2+3=4"} {
+		if !Valid(id) {
+			t.Fatalf("%q expected to be valid", id)
+		}
+	}
+
+	for _, id := range []string{"", "with space", "new\nline", strings.Repeat("a", 129)} {
+		if Valid(id) {
+			t.Fatalf("%q expected to be invalid", id)
+		}
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if FromContext(ctx) != "" || NewContext(ctx, "") != ctx {
+		t.Fatal("no request ID expected")
+	}
+
+	if _, ok := Logger(ctx).Data[LogField]; ok {
+		t.Fatal("no request ID field expected")
+	}
+
+	ctx = NewContext(ctx, "abc-123")
+	if FromContext(ctx) != "abc-123" {
+		t.Fatalf("unexpected request ID %q", FromContext(ctx))
+	}
+
+	if Logger(ctx).Data[LogField] != "abc-123" {
+		t.Fatalf("unexpected log fields %v", Logger(ctx).Data)
+	}
+}
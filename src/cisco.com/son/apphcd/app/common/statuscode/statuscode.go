@@ -0,0 +1,160 @@
+// Author  <dorzheho@cisco.com>
+
+package statuscode
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// HeaderKey is the HTTP header and the gRPC metadata key turning the status codes on or off for the request
+const HeaderKey = "x-apphc-status-codes"
+
+// Logical statuses of the Response reported by the status codes
+const (
+	statusError    = "ERROR"
+	statusNotFound = "NOT_FOUND"
+)
+
+// Validation error of a field, e.g. invalid CreateAppRequest.Name: value length must be at least 1 runes
+var validationError = regexp.MustCompile(`^invalid (?:key for )?[A-Za-z0-9_]+\.([^:\s]+): (.*)$`)
+
+// Separator of the validation error and the validation error of the embedded message causing it
+const validationCause = " | caused by: "
+
+// Failures of the connections to the Rancher server or the Kubernetes API server
+var upstreamFailures = []string{
+	"connection refused",
+	"connection reset",
+	"no such host",
+	"i/o timeout",
+	"Bad Gateway",
+	"Service Unavailable",
+}
+
+// Enabled tells whether the failures of the request are reported by the status codes.
+// The header of the request takes precedence over the configuration
+func Enabled(ctx context.Context, configured bool) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return configured
+	}
+
+	if values := md.Get(HeaderKey); len(values) > 0 {
+		if enabled, err := strconv.ParseBool(values[0]); err == nil {
+			return enabled
+		}
+	}
+
+	return configured
+}
+
+// Code gives back the status code of the logical status and the message of the Response
+func Code(logical, msg string) codes.Code {
+	if logical == statusNotFound {
+		return codes.NotFound
+	}
+
+	if logical != statusError {
+		return codes.OK
+	}
+
+	if validationError.MatchString(msg) {
+		return codes.InvalidArgument
+	}
+
+	if strings.HasSuffix(msg, " is locked") || strings.HasSuffix(msg, " are locked") {
+		return codes.Aborted
+	}
+
+	for _, f := range upstreamFailures {
+		if strings.Contains(msg, f) {
+			return codes.Unavailable
+		}
+	}
+
+	return codes.Internal
+}
+
+// Error gives back the error reporting the Response by the status code. The Response is attached
+// to the error details, so the clients relying on the logical status keep working.
+// Nil if the logical status is not a failure
+func Error(logical, msg string, resp proto.Message) error {
+	code := Code(logical, msg)
+	if code == codes.OK {
+		return nil
+	}
+
+	details := []proto.Message{resp}
+	if code == codes.InvalidArgument {
+		details = append(details, BadRequest(msg))
+	}
+
+	s, err := status.New(code, msg).WithDetails(details...)
+	if err != nil {
+		return status.Error(code, msg)
+	}
+
+	return s.Err()
+}
+
+// BadRequest gives back the field violation of the validation error.
+// The field of an embedded message is joined with the fields of the messages containing it
+func BadRequest(msg string) *errdetails.BadRequest {
+	var fields []string
+	description := msg
+
+	for _, e := range strings.Split(msg, validationCause) {
+		m := validationError.FindStringSubmatch(e)
+		if m == nil {
+			break
+		}
+
+		fields = append(fields, m[1])
+		description = m[2]
+	}
+
+	return &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       strings.Join(fields, "."),
+			Description: description,
+		}},
+	}
+}
+
+// Upstream tells whether the error reports a failure of the Rancher server or the Kubernetes API server
+// rather than of the controller
+func Upstream(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok || s.Code() != codes.Unavailable {
+		return false
+	}
+
+	// Unavailable errors not carrying the Response are the ones of the controller shutting down
+	return len(s.Proto().GetDetails()) > 0
+}
+
+// Response unmarshals the Response attached to the error details. Gives back false if there is none
+func Response(err error, resp proto.Message) bool {
+	s, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	for _, d := range s.Proto().GetDetails() {
+		if ptypes.Is(d, resp) {
+			return ptypes.UnmarshalAny(d, resp) == nil
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+package statuscode
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+)
+
+func TestCode(t *testing.T) {
+	for _, c := range []struct {
+		logical string
+		msg     string
+		code    codes.Code
+	}{
+		{"SUCCESS", "", codes.OK},
+		{"IN_PROGRESS", "", codes.OK},
+		{"NOT_FOUND", "Nothing to delete", codes.NotFound},
+		{"ERROR", "invalid CreateAppRequest.Name: value length must be at least 1 runes", codes.InvalidArgument},
+		{"ERROR", "application nginx is locked", codes.Aborted},
+		{"ERROR", "applications are locked", codes.Aborted},
+		{"ERROR", "Get https://rancher/v3: dial tcp 10.0.0.1:443: connect: connection refused", codes.Unavailable},
+		{"ERROR", "unable to render the chart", codes.Internal},
+	} {
+		if code := Code(c.logical, c.msg); code != c.code {
+			t.Errorf("%s %q: expected %s, got %s", c.logical, c.msg, c.code, code)
+		}
+	}
+}
+
+func TestError(t *testing.T) {
+	msg := "invalid CreateAppRequest.Spec: embedded message failed validation | caused by: invalid Image.Tag: value length must be at least 1 runes"
+	err := Error("ERROR", msg, &appmanager.Response{Status: appmanager.Status_ERROR, Message: msg})
+
+	s := status.Convert(err)
+	if s.Code() != codes.InvalidArgument || s.Message() != msg {
+		t.Fatalf("unexpected status %v", s)
+	}
+
+	var violation *errdetails.BadRequest_FieldViolation
+	for _, d := range s.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			violation = br.FieldViolations[0]
+		}
+	}
+
+	if violation == nil || violation.Field != "Spec.Tag" || violation.Description != "value length must be at least 1 runes" {
+		t.Fatalf("unexpected field violation %v", violation)
+	}
+
+	resp := &appmanager.Response{}
+	if !Response(err, resp) || resp.Status != appmanager.Status_ERROR || resp.Message != msg {
+		t.Fatalf("unexpected response %v", resp)
+	}
+
+	if Upstream(err) || Error("SUCCESS", "", resp) != nil {
+		t.Fatal("unexpected error")
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	ctx := context.Background()
+	if Enabled(ctx, false) || !Enabled(ctx, true) {
+		t.Fatal("expected the configuration to apply")
+	}
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(HeaderKey, "true"))
+	if !Enabled(ctx, false) {
+		t.Fatal("expected the header to take precedence")
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(HeaderKey, "maybe"))
+	if !Enabled(ctx, true) {
+		t.Fatal("expected invalid header to be ignored")
+	}
+}
@@ -0,0 +1,105 @@
+// Author  <dorzheho@cisco.com>
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Exporters
+const (
+	ExporterNone   = ""       // Tracing disabled
+	ExporterOtlp   = "otlp"   // OpenTelemetry collector over OTLP/HTTP
+	ExporterStdout = "stdout" // Standard output, a JSON document per span
+	ExporterFile   = "file"   // File, a JSON document per span
+)
+
+// Tracer provider state. Nil provider means tracing is disabled
+var (
+	mu       sync.Mutex
+	provider *sdktrace.TracerProvider
+	file     *os.File
+)
+
+// Init sets up the exporter of the spans. The endpoint is the OTLP/HTTP traces URL of the collector,
+// the file is the path the spans are appended to. Tracing is disabled for ExporterNone
+func Init(kind, endpoint, path, serviceName string) error {
+	var (
+		exporter sdktrace.SpanExporter
+		f        *os.File
+		err      error
+	)
+
+	switch kind {
+	case ExporterNone:
+		return nil
+	case ExporterOtlp:
+		if endpoint == "" {
+			return fmt.Errorf("OTLP endpoint is not set")
+		}
+		exporter, err = otlptracehttp.New(context.Background(), otlptracehttp.WithEndpointURL(endpoint))
+	case ExporterStdout:
+		exporter, err = stdouttrace.New()
+	case ExporterFile:
+		if f, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644); err != nil {
+			return err
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(f))
+	default:
+		return fmt.Errorf("unsupported tracing exporter %s", kind)
+	}
+
+	if err != nil {
+		if f != nil {
+			f.Close()
+		}
+		return err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(tp)
+
+	mu.Lock()
+	provider = tp
+	file = f
+	mu.Unlock()
+
+	logrus.WithFields(logrus.Fields{"exporter": kind, "endpoint": endpoint, "file": path}).Info("Tracing enabled")
+	return nil
+}
+
+// Shutdown exports the finished spans and stops the exporter
+func Shutdown(ctx context.Context) {
+	mu.Lock()
+	tp, f := provider, file
+	provider, file = nil, nil
+	mu.Unlock()
+
+	if tp == nil {
+		return
+	}
+
+	if err := tp.Shutdown(ctx); err != nil {
+		logrus.WithFields(logrus.Fields{"error": err}).Warn("Unable to shut down tracing exporter")
+	}
+
+	if f != nil {
+		if err := f.Close(); err != nil {
+			logrus.WithFields(logrus.Fields{"error": err}).Warn("Unable to close tracing file")
+		}
+	}
+}
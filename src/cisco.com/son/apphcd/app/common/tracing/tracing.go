@@ -0,0 +1,41 @@
+// Author  <dorzheho@cisco.com>
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer starting the spans of the Controller
+const instrumentationName = "cisco.com/son/apphcd"
+
+// Tracer gives back the tracer of the Controller spans. The spans aren't recorded unless tracing is initialized
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start starts a new span, a child of the span carried by the context if any
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, opts...)
+}
+
+// Finish ends the span. The error tells the span status.
+// Intended to be deferred with a pointer to the named error result of the function
+func Finish(span trace.Span, err *error) {
+	if err != nil && *err != nil {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+
+	span.End()
+}
+
+// Detach gives back a background context carrying the span of the context, so the trace continues
+// after the context is done, e.g. in long-running operations
+func Detach(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+}
@@ -0,0 +1,117 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// recordSpans makes the spans recorded by the exporter for the rest of the test
+func recordSpans(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	return exporter
+}
+
+func TestSpans(t *testing.T) {
+	exporter := recordSpans(t)
+
+	traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	remote := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceId, SpanID: spanId,
+		TraceFlags: trace.FlagsSampled, Remote: true})
+
+	ctx, parent := Start(trace.ContextWithRemoteSpanContext(context.Background(), remote), "AppManager/CreateApp",
+		trace.WithSpanKind(trace.SpanKindServer))
+
+	detached := Detach(ctx)
+	if detached.Done() != nil {
+		t.Fatal("detached context expected to be never done")
+	}
+
+	_, child := Start(detached, "syncer.Pull")
+	err := errors.New("already up-to-date")
+	Finish(child, &err)
+
+	var ok error
+	Finish(parent, &ok)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	c, s := spans[0], spans[1]
+	if s.SpanContext.TraceID() != traceId || s.Parent.SpanID() != spanId || s.SpanKind != trace.SpanKindServer {
+		t.Fatalf("unexpected server span %+v", s)
+	}
+
+	if c.SpanContext.TraceID() != traceId || c.Parent.SpanID() != s.SpanContext.SpanID() || c.Name != "syncer.Pull" {
+		t.Fatalf("unexpected child span %+v", c)
+	}
+
+	if c.Status.Code != codes.Error || c.Status.Description != "already up-to-date" || s.Status.Code != codes.Unset {
+		t.Fatalf("unexpected span statuses %+v, %+v", c.Status, s.Status)
+	}
+}
+
+func TestInitFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tracing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	path := filepath.Join(dir, "traces.json")
+	if err := Init(ExporterFile, "", path, "apphcd"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, span := Start(context.Background(), "syncer.Push")
+	Finish(span, nil)
+
+	Shutdown(context.Background())
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(b), `"Name":"syncer.Push"`) || !strings.Contains(string(b), `"apphcd"`) {
+		t.Fatalf("unexpected traces %s", b)
+	}
+}
+
+func TestInitUnsupported(t *testing.T) {
+	if err := Init("jaeger", "", "", "apphcd"); err == nil {
+		t.Fatal("unsupported exporter expected to be rejected")
+	}
+
+	if err := Init(ExporterOtlp, "", "", "apphcd"); err == nil {
+		t.Fatal("missing OTLP endpoint expected to be rejected")
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	_, span := Start(context.Background(), "syncer.Pull")
+	if span.IsRecording() {
+		t.Fatal("no span expected to be recorded while tracing is disabled")
+	}
+
+	err := errors.New("failed")
+	Finish(span, &err)
+}
@@ -0,0 +1,89 @@
+// Author  <dorzheho@cisco.com>
+
+package controller
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/config"
+)
+
+// Identity of the callers presenting the preconfigured bearer token
+const bearerTokenIdentity = "default"
+
+// newAuthorizer creates authorizer of gRPC requests according to the configuration
+func newAuthorizer(certs *certStore) (*auth.Authorizer, error) {
+	var authenticators []auth.Authenticator
+
+	// Static tokens
+	tokens := auth.NewStaticTokens()
+	if f := viper.GetString(appcommon.EnvApphcAuthTokensFile); f != "" {
+		var err error
+		if tokens, err = auth.LoadStaticTokens(f); err != nil {
+			return nil, err
+		}
+	}
+
+	if token := config.Current().BearerToken; token != "" {
+		tokens.Add(token, bearerTokenIdentity)
+	}
+
+	// The bearer token may be set or rotated by reloading the configuration
+	authenticators = append(authenticators, tokens)
+	config.OnReload(func(s *config.Settings) error {
+		tokens.Replace(s.BearerToken, bearerTokenIdentity)
+		return nil
+	})
+
+	// JSON Web Tokens. The signing keys are discovered from OIDC issuer unless JWKS file is provided
+	jwksFile := viper.GetString(appcommon.EnvApphcAuthJwtJwksFile)
+	issuer := viper.GetString(appcommon.EnvApphcAuthJwtIssuer)
+	if jwksFile != "" || issuer != "" {
+		var keys auth.KeySet
+		if jwksFile != "" {
+			var err error
+			if keys, err = auth.LoadJwks(jwksFile); err != nil {
+				return nil, err
+			}
+		} else {
+			keys = auth.NewOidcKeySet(issuer)
+		}
+
+		authenticators = append(authenticators, auth.NewJwtAuthenticator(keys, auth.JwtOptions{
+			Issuer:        issuer,
+			Audience:      viper.GetString(appcommon.EnvApphcAuthJwtAudience),
+			IdentityClaim: viper.GetString(appcommon.EnvApphcAuthJwtIdentityClaim),
+			GroupsClaim:   viper.GetString(appcommon.EnvApphcAuthJwtGroupsClaim),
+		}))
+	}
+
+	// Client certificates
+	if certs != nil && viper.GetString(appcommon.EnvApphcTlsClientCaFile) != "" {
+		authenticators = append(authenticators, auth.NewMtlsAuthenticator(certs.isServerCert))
+	}
+
+	if len(authenticators) == 1 && tokens.Len() == 0 {
+		return nil, errors.New("no authentication method configured")
+	}
+
+	// Any authenticated identity is allowed to call any method unless the policy is provided
+	var policy *auth.Policy
+	if f := viper.GetString(appcommon.EnvApphcAuthPolicyFile); f != "" {
+		var err error
+		if policy, err = auth.LoadPolicy(f); err != nil {
+			return nil, err
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"authenticators": len(authenticators),
+		"policy":         viper.GetString(appcommon.EnvApphcAuthPolicyFile),
+	}).Info("Authorization enabled")
+
+	return auth.NewAuthorizer(policy, authenticators...), nil
+}
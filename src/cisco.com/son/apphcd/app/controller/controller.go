@@ -3,23 +3,43 @@
 package controller
 
 import (
-	"cisco.com/son/apphcd/app/common/mutex"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/soheilhy/cmux"
+	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/health"
+	"cisco.com/son/apphcd/app/common/inflight"
+	"cisco.com/son/apphcd/app/common/tracing"
 )
 
+// Time the servers are given to finish the requests being served once the operations are drained
+const serversShutdownTimeout = 5 * time.Second
+
+// Time the finished spans are given to be exported on shutdown
+const tracingShutdownTimeout = 3 * time.Second
+
 // Controller structure
 type Controller struct {
-	serverPort int          // Server port number
-	listener   net.Listener // Network listener
-	httpServer *http.Server // HTTP server
-	grpcServer *grpc.Server // gRPC server
+	serverPort int                // Server port number
+	listener   net.Listener       // Network listener
+	httpServer *http.Server       // HTTP server
+	grpcServer *grpc.Server       // gRPC server
+	certs      *certStore         // TLS certificates. Nil if TLS is disabled
+	operations *inflight.Tracker  // Running mutating operations
+	streams    context.CancelFunc // Closes the streaming calls
+	health     *health.Checker    // Checks the dependencies of the Controller
 }
 
 // New returns a Controller instance
@@ -27,6 +47,8 @@ func New(serverPort int, listener net.Listener) *Controller {
 	return &Controller{
 		serverPort: serverPort,
 		listener:   listener,
+		operations: inflight.NewTracker(),
+		health:     health.NewChecker(time.Duration(viper.GetInt(appcommon.EnvApphcHealthCheckTimeout)) * time.Second),
 	}
 }
 
@@ -39,6 +61,31 @@ func (c *Controller) Start() error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	// Terminate TLS on the listener, so both gRPC and HTTP are served over it
+	if viper.GetBool(appcommon.EnvApphcTlsEnabled) {
+		var err error
+		c.certs, err = newCertStore(viper.GetString(appcommon.EnvApphcTlsCertFile),
+			viper.GetString(appcommon.EnvApphcTlsKeyFile), viper.GetString(appcommon.EnvApphcTlsClientCaFile))
+		if err != nil {
+			return fmt.Errorf("unable to load TLS certificates: %v", err)
+		}
+
+		go c.certs.watch(ctx)
+
+		c.listener = tls.NewListener(c.listener, c.certs.serverConfig())
+
+		logrus.WithFields(logrus.Fields{
+			"certificate": viper.GetString(appcommon.EnvApphcTlsCertFile),
+			"client_ca":   viper.GetString(appcommon.EnvApphcTlsClientCaFile),
+		}).Info("TLS enabled")
+	}
+
+	// Export the trace spans
+	if err := tracing.Init(viper.GetString(appcommon.EnvApphcTracingExporter), viper.GetString(appcommon.EnvApphcTracingOtlpEndpoint),
+		viper.GetString(appcommon.EnvApphcTracingFile), "apphcd"); err != nil {
+		return fmt.Errorf("unable to initialize tracing: %v", err)
+	}
+
 	// Create TCP multiplexer
 	tcpMux := cmux.New(c.listener)
 
@@ -47,36 +94,113 @@ func (c *Controller) Start() error {
 	httpL := tcpMux.Match(cmux.HTTP1Fast("PATCH"))
 
 	// Initialize gRPC server instance
+	// Streaming calls never finish on their own, so they are closed before the gRPC server is stopped
+	streamsCtx, closeStreams := context.WithCancel(ctx)
+	c.streams = closeStreams
+
 	var err error
-	c.grpcServer, err = newGrpcServer()
+	c.grpcServer, err = newGrpcServer(streamsCtx, c.certs, c.operations, c.health)
 	if err != nil {
 		return fmt.Errorf("unable to initialize gRPC server instance: %v", err)
 	}
 
 	// Initialize HTTP server instance
-	c.httpServer, err = newHttpServer(ctx, c.serverPort)
+	c.httpServer, err = newHttpServer(ctx, c.serverPort, c.certs, c.grpcServer.GetServiceInfo(), c.health)
 	if err != nil {
 		return fmt.Errorf("unable to initialize HTTP server instance: %v", err)
 	}
 
+	// Serving errors
+	errc := make(chan error, 3)
+
 	// Start gRPC server
 	go func() {
 		if err := c.grpcServer.Serve(grpcL); err != nil {
-			logrus.Fatalln("unable to start external gRPC server")
+			errc <- fmt.Errorf("gRPC server failure: %v", err)
 		}
 	}()
 
 	// Start HTTP server
 	go func() {
 		if err := c.httpServer.Serve(httpL); err != nil {
-			logrus.Fatalln("unable to start HTTP server")
+			errc <- fmt.Errorf("HTTP server failure: %v", err)
 		}
 	}()
 
-	// Create new mutex
-	mutex.New()
-
 	logrus.Info("Instantiating TCP Multiplexer")
 	logrus.Infof("AppHoster Controller is listening on port %d", c.serverPort)
-	return tcpMux.Serve()
+
+	go func() {
+		errc <- tcpMux.Serve()
+	}()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGTERM, syscall.SIGINT)
+	defer signal.Stop(sigc)
+
+	select {
+	case err := <-errc:
+		return err
+	case sig := <-sigc:
+		logrus.WithFields(logrus.Fields{"signal": sig.String()}).Info("Shutting down Controller")
+	}
+
+	c.shutdown(cancel)
+	return nil
+}
+
+// shutdown stops the Controller. Waits for the running mutating operations up to the shutdown timeout,
+// then gives the servers and the tracing exporter their own deadlines, so a long drain doesn't leave them no time
+func (c *Controller) shutdown(cancelGateway context.CancelFunc) {
+	timeout := time.Duration(viper.GetInt(appcommon.EnvApphcShutdownTimeout)) * time.Second
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), timeout)
+	defer cancelDrain()
+
+	// Stop routing new requests to the Controller
+	c.health.Drain()
+
+	// Reject new mutating requests and wait for the running ones
+	for _, op := range c.operations.Drain(drainCtx) {
+		logrus.WithFields(logrus.Fields{
+			"method":   op.Method,
+			"identity": op.Identity,
+			"target":   op.Target,
+			"duration": time.Since(op.Started).String(),
+		}).Error("Operation interrupted by shutdown")
+	}
+
+	// Close the streaming calls, e.g. WatchApps
+	c.streams()
+
+	// Close the gRPC Gateway connection. The multiplexer keeps sniffing an idle connection
+	// until its first request, which would block the servers shutdown
+	cancelGateway()
+
+	ctx, cancel := context.WithTimeout(context.Background(), serversShutdownTimeout)
+	defer cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		// Stop accepting connections and wait for the requests being served
+		if err := c.httpServer.Shutdown(ctx); err != nil {
+			logrus.WithFields(logrus.Fields{"error": err}).Warn("HTTP server was not shut down gracefully")
+		}
+
+		c.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		logrus.Warn("Controller was not stopped gracefully")
+		return
+	}
+
+	// Export the spans of the finished requests
+	tracingCtx, cancelTracing := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancelTracing()
+	tracing.Shutdown(tracingCtx)
+
+	logrus.WithFields(logrus.Fields{"status": "OK"}).Info("Controller stopped")
 }
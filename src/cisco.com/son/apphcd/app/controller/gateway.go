@@ -4,26 +4,45 @@ package controller
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/base64"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/sirupsen/logrus"
-	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 
 	"cisco.com/son/apphcd/api/v1/apphcmanager"
 	pbappmgr "cisco.com/son/apphcd/api/v1/appmanager"
+	pbaudmgr "cisco.com/son/apphcd/api/v1/auditmanager"
 	"cisco.com/son/apphcd/api/v1/clustermanager"
-	"cisco.com/son/apphcd/app/common"
+	pbops "cisco.com/son/apphcd/api/v1/operations"
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/idempotency"
+	"cisco.com/son/apphcd/app/common/requestid"
+	"cisco.com/son/apphcd/app/common/statuscode"
 )
 
 // gRPC server static IP.
 // Since the server is running locally we use localhost for the endpoint
 const grpcServerAddr = "127.0.0.1"
 
-// newGateway creates new gRPC gateway
-func newGateway(ctx context.Context, serverPort int) (http.Handler, error) {
+// Gateway endpoints registrars of the services exposed through the gRPC Gateway
+var serviceHandlers = map[string]func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+	"com.cisco.son.apphcd.api.v1.apphcmanager.ApphcManager":     apphcmanager.RegisterApphcManagerHandler,
+	"com.cisco.son.apphcd.api.v1.appmanager.AppManager":         pbappmgr.RegisterAppManagerHandler,
+	"com.cisco.son.apphcd.api.v1.clustermanager.ClusterManager": clustermanager.RegisterClusterManagerHandler,
+	"com.cisco.son.apphcd.api.v1.operations.Operations":         pbops.RegisterOperationsHandler,
+	"com.cisco.son.apphcd.api.v1.auditmanager.AuditManager":     pbaudmgr.RegisterAuditManagerHandler,
+}
+
+// newGateway creates new gRPC gateway exposing the services registered to gRPC server
+func newGateway(ctx context.Context, serverPort int, certs *certStore, services map[string]grpc.ServiceInfo) (http.Handler, error) {
 	logrus.Info("Instantiating gRPC Gateway")
 
 	// gRPC dial up options
@@ -31,37 +50,104 @@ func newGateway(ctx context.Context, serverPort int) (http.Handler, error) {
 		//grpc.WithBlock(),
 		grpc.WithInsecure(),
 	}
+
+	// Dial over TLS if the listener terminates it
+	if certs != nil {
+		opts = []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(certs.gatewayConfig())),
+		}
+	}
+
+	// Propagate the spans of the HTTP requests to the gRPC server
+	opts = append(opts,
+		grpc.WithUnaryInterceptor(tracingUnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(tracingStreamClientInterceptor()),
+	)
+
 	// Create gRPC connection
 	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", grpcServerAddr, serverPort), opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	// Close the connection once the context is done
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			logrus.WithFields(logrus.Fields{"error": err}).Warn("Unable to close gRPC Gateway connection")
+		}
+	}()
+
 	// Changes json serializer to include empty fields with default values
 	gwMux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
-		runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
+		runtime.WithProtoErrorHandler(statusCodeErrorHandler),
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithMetadata(forwardClientCert),
+		runtime.WithMetadata(forwardGatewayToken),
 	)
 
-	// Register Gateway endpoints
-	// If Rancher adapter enabled
-	if viper.GetBool(common.EnvApphcAdaptersRancherEnabled) {
-		// Register Application manager service
-		logrus.Info("Registering HTTP handlers for service AppManager")
-		if err := pbappmgr.RegisterAppManagerHandler(ctx, gwMux, conn); err != nil {
+	// Register Gateway endpoints of the services registered to gRPC server only,
+	// e.g. Cluster manager is not provided by every adapter
+	var names []string
+	for name := range services {
+		if _, ok := serviceHandlers[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		logrus.Infof("Registering HTTP handlers for service %s", name)
+		if err := serviceHandlers[name](ctx, gwMux, conn); err != nil {
 			return nil, err
 		}
 	}
 
-	// Register AppHoster Controller manager service
-	if err := apphcmanager.RegisterApphcManagerHandler(ctx, gwMux, conn); err != nil {
-		return nil, err
+	return gwMux, nil
+}
+
+// headerMatcher passes HTTP headers to gRPC metadata.
+// HTTP clients are not allowed to set the forwarded client certificate and the gRPC Gateway token
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, runtime.MetadataHeaderPrefix+auth.ForwardedClientCertKey) ||
+		strings.EqualFold(key, runtime.MetadataHeaderPrefix+auth.GatewayTokenKey) {
+		return "", false
 	}
 
-	// Register AppHoster Cluster manager service
-	if err := clustermanager.RegisterClusterManagerHandler(ctx, gwMux, conn); err != nil {
-		return nil, err
+	if strings.EqualFold(key, requestid.HeaderKey) {
+		return requestid.HeaderKey, true
 	}
 
-	return gwMux, nil
+	if strings.EqualFold(key, statuscode.HeaderKey) {
+		return statuscode.HeaderKey, true
+	}
+
+	if strings.EqualFold(key, idempotency.HeaderKey) {
+		return idempotency.HeaderKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// forwardClientCert passes the certificate of HTTP client to gRPC server, so the client is authenticated by it
+func forwardClientCert(ctx context.Context, req *http.Request) metadata.MD {
+	conn, ok := req.Context().Value(tlsConnKey{}).(*tls.Conn)
+	if !ok {
+		return nil
+	}
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil
+	}
+
+	return metadata.Pairs(auth.ForwardedClientCertKey, base64.StdEncoding.EncodeToString(certs[0].Raw))
+}
+
+// forwardGatewayToken tells gRPC server the request comes from the gRPC Gateway,
+// so the address of HTTP client it forwards is trusted
+func forwardGatewayToken(ctx context.Context, req *http.Request) metadata.MD {
+	return metadata.Pairs(auth.GatewayTokenKey, auth.GatewayToken())
 }
@@ -6,28 +6,46 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"strings"
+	"time"
 
-	"github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
+	pbhealth "google.golang.org/grpc/health/grpc_health_v1"
 	"k8s.io/client-go/kubernetes"
 
 	pbapphcmgr "cisco.com/son/apphcd/api/v1/apphcmanager"
 	pbappmgr "cisco.com/son/apphcd/api/v1/appmanager"
+	pbaudmgr "cisco.com/son/apphcd/api/v1/auditmanager"
 	pbclumgr "cisco.com/son/apphcd/api/v1/clustermanager"
+	pbops "cisco.com/son/apphcd/api/v1/operations"
 	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/audit"
+	"cisco.com/son/apphcd/app/common/config"
+	"cisco.com/son/apphcd/app/common/health"
+	"cisco.com/son/apphcd/app/common/idempotency"
+	"cisco.com/son/apphcd/app/common/inflight"
+	"cisco.com/son/apphcd/app/common/longrunning"
+	"cisco.com/son/apphcd/app/common/metrics"
+	"cisco.com/son/apphcd/app/common/mutex"
 	"cisco.com/son/apphcd/app/grpc/apphcmanager"
 	"cisco.com/son/apphcd/app/grpc/appmanager"
+	mappmgr "cisco.com/son/apphcd/app/grpc/appmanager/adapters/memory"
+	nappmgr "cisco.com/son/apphcd/app/grpc/appmanager/adapters/native"
 	rappmgr "cisco.com/son/apphcd/app/grpc/appmanager/adapters/rancher"
 	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+	audmgr "cisco.com/son/apphcd/app/grpc/auditmanager"
 	"cisco.com/son/apphcd/app/grpc/clustermanager"
+	mclumgr "cisco.com/son/apphcd/app/grpc/clustermanager/adapters/memory"
 	rclumgr "cisco.com/son/apphcd/app/grpc/clustermanager/adapters/rancher"
 	clumgrcommon "cisco.com/son/apphcd/app/grpc/clustermanager/common"
 	grpccommon "cisco.com/son/apphcd/app/grpc/common"
 	"cisco.com/son/apphcd/app/grpc/common/rancher"
+	grpchealth "cisco.com/son/apphcd/app/grpc/health"
+	opsmgr "cisco.com/son/apphcd/app/grpc/operations"
 )
 
 const (
@@ -35,41 +53,82 @@ const (
 	defaultApphDockerRegistryPort = 5000
 )
 
-// newGrpcServer creates new gRPC server
-func newGrpcServer() (*grpc.Server, error) {
+// newGrpcServer creates new gRPC server. The streaming calls are closed once the streams context is done
+func newGrpcServer(streams context.Context, certs *certStore, operations *inflight.Tracker, checker *health.Checker) (*grpc.Server, error) {
 	logrus.Info("Instantiating gRPC server")
 
-	var grpcServer *grpc.Server
+	var opts []grpc.ServerOption
 
-	// Create new gRPC server
+	// TLS is terminated by the Controller listener, the credentials only expose the peer certificates
+	if certs != nil {
+		opts = append(opts, grpc.Creds(terminatedTlsCreds{}))
+	}
+
+	// Correlate the logs and the responses of all the requests including the rejected ones
+	unaryInterceptors := []grpc.UnaryServerInterceptor{requestIdUnaryServerInterceptor()}
+	streamInterceptors := []grpc.StreamServerInterceptor{requestIdStreamServerInterceptor()}
+
+	// Report the failed responses by the status codes. The other interceptors keep getting the Response
+	unaryInterceptors = append(unaryInterceptors, statusCodeUnaryServerInterceptor())
+
+	// Record all the requests including the rejected ones
+	unaryInterceptors = append(unaryInterceptors, metrics.GrpcServerMetrics.UnaryServerInterceptor(),
+		responseStatusUnaryServerInterceptor())
+	streamInterceptors = append(streamInterceptors, metrics.GrpcServerMetrics.StreamServerInterceptor())
+
+	// Continue the traces propagated by the gRPC Gateway or the gRPC clients
+	unaryInterceptors = append(unaryInterceptors, tracingUnaryServerInterceptor())
+	streamInterceptors = append(streamInterceptors, tracingStreamServerInterceptor())
+
+	// Authenticate and authorize the callers
 	if viper.GetBool(appcommon.EnvApphcInternalAuthorizationEnabled) {
-		// Create with OAuth (bearer token) authorization support
-		authFunc := func(ctx context.Context) (context.Context, error) {
-			token, err := grpc_auth.AuthFromMD(ctx, "bearer")
-			if err != nil {
-				return nil, err
-			}
+		authorizer, err := newAuthorizer(certs)
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize authorization: %v", err)
+		}
 
-			// Remove leading/trailing spaces
-			token = strings.TrimSpace(token)
+		streamInterceptors = append(streamInterceptors, authorizer.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, authorizer.UnaryServerInterceptor())
+	}
 
-			// Compare against preconfigured token
-			if token != viper.GetString(appcommon.EnvApphcBearerToken) {
-				return nil, fmt.Errorf("%s: invalid bearer token", codes.Unauthenticated)
-			}
+	// Long-running operations are drained on shutdown together with the mutating requests
+	registry := longrunning.NewRegistry(operations, time.Duration(viper.GetInt(appcommon.EnvApphcOperationsRetention))*time.Second)
 
-			// Set the new context
-			newCtx := context.WithValue(ctx, "tokenInfo", token)
-			return newCtx, nil
+	// Record the mutating requests including the ones rejected on shutdown
+	var auditLogger *audit.Logger
+	if viper.GetBool(appcommon.EnvApphcAuditEnabled) {
+		sink, err := audit.NewFileSink(viper.GetString(appcommon.EnvApphcAuditFile),
+			int64(viper.GetInt(appcommon.EnvApphcAuditMaxSize))*1024*1024, viper.GetInt(appcommon.EnvApphcAuditMaxBackups))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize audit log: %v", err)
 		}
 
-		grpcServer = grpc.NewServer(grpc.StreamInterceptor(grpc_auth.StreamServerInterceptor(authFunc)),
-			grpc.UnaryInterceptor(grpc_auth.UnaryServerInterceptor(authFunc)))
-	} else {
-		// Create insecure server
-		grpcServer = grpc.NewServer()
+		auditLogger = audit.NewLogger(sink, registry)
+		unaryInterceptors = append(unaryInterceptors, auditLogger.UnaryServerInterceptor())
+	}
+
+	// Retried mutating requests carrying an idempotency key give back the outcome of the original ones
+	if window := viper.GetInt(appcommon.EnvApphcIdempotencyWindow); window > 0 {
+		cache := idempotency.NewCache(registry, time.Duration(window)*time.Second)
+		unaryInterceptors = append(unaryInterceptors, cache.UnaryServerInterceptor())
+	}
+
+	// Throttle the callers and the mutating requests. Rejected mutating requests are audited
+	limiter, err := newLimiter()
+	if err != nil {
+		return nil, err
 	}
 
+	streamInterceptors = append(streamInterceptors, limiter.StreamServerInterceptor())
+	unaryInterceptors = append(unaryInterceptors, limiter.UnaryServerInterceptor())
+
+	// Track mutating requests, so they are drained on shutdown
+	unaryInterceptors = append(unaryInterceptors, operations.UnaryServerInterceptor())
+	opts = append(opts, grpc_middleware.WithUnaryServerChain(unaryInterceptors...))
+	opts = append(opts, grpc_middleware.WithStreamServerChain(streamInterceptors...))
+
+	grpcServer := grpc.NewServer(opts...)
+
 	logrus.Info("Registering ApphcManager service to gRPC")
 
 	pbapphcmgr.RegisterApphcManagerServer(grpcServer, &apphcmanager.ApphcManager{})
@@ -78,23 +137,22 @@ func newGrpcServer() (*grpc.Server, error) {
 	var clumgrAdapter clumgrcommon.ClusterManagerAdapter
 	var kubeClient *kubernetes.Clientset
 
-	if viper.GetBool(appcommon.EnvApphcAdaptersRancherEnabled) {
-		if err := rancher.LoginSetup(viper.GetString(rancher.EnvApphcAdaptersRancherServerEndpoint),
-			viper.GetString(rancher.EnvApphcAdaptersRancherServerCredsToken), rancher.AppsProjectName); err != nil {
-			return nil, err
-
-		}
-		if err := rancher.LoginSetup(viper.GetString(rancher.EnvApphcAdaptersRancherServerEndpoint),
-			viper.GetString(rancher.EnvApphcAdaptersRancherServerCredsToken), rancher.SvcsProjectName); err != nil {
-			return nil, err
-
-		}
-		// Create Rancher client for Services project
-		svcsClient, err := rancher.GetClient(rancher.SvcsProjectName)
+	switch viper.GetString(appcommon.EnvApphcAdapter) {
+	case appcommon.AdapterRancher:
+		// Log in to Rancher server. The clients are re-created once the endpoint or the token is reloaded
+		clients, err := rancher.NewClients(viper.GetString(rancher.EnvApphcAdaptersRancherServerEndpoint),
+			viper.GetString(rancher.EnvApphcAdaptersRancherServerCredsToken))
 		if err != nil {
 			return nil, err
 		}
 
+		config.OnReload(func(s *config.Settings) error {
+			return clients.Login(s.RancherEndpoint, s.RancherToken)
+		})
+
+		// Rancher client for Services project
+		svcsClient := clients.Svcs()
+
 		if err := rancher.RelocateCoreServices(svcsClient); err != nil {
 			return nil, err
 		}
@@ -139,15 +197,9 @@ func newGrpcServer() (*grpc.Server, error) {
 			viper.SetDefault(appcommon.EnvApphMasterNodeIp, masterIpAddress)
 		}
 
-		// Create Rancher client for Applications project
-		appsMasterClient, err := rancher.GetClient(rancher.AppsProjectName)
-		if err != nil {
-			return nil, err
-		}
-
 		logrus.Debug("Instantiating Rancher adapters")
 
-		clumgrAdapter = rclumgr.NewAdapter(appsMasterClient, svcsClient.ProjectClient)
+		clumgrAdapter = rclumgr.NewAdapter(clients)
 		if err := createKubeConfigFile(clumgrAdapter); err != nil {
 			return nil, err
 		}
@@ -158,23 +210,96 @@ func newGrpcServer() (*grpc.Server, error) {
 		}
 
 		// Create adapter for Application manager
-		appmgrAdapter, err = rappmgr.NewAdapter(appsMasterClient, svcsClient.ProjectClient, kubeClient)
+		appmgrAdapter, err = rappmgr.NewAdapter(clients, kubeClient)
 		if err != nil {
 			return nil, err
 		}
 
+		registerRancherChecks(checker, clients)
+
 		logrus.Debugf("Server endpoint: %s", viper.GetString(rancher.EnvApphcAdaptersRancherServerEndpoint))
+
+	case appcommon.AdapterNative:
+		logrus.Debug("Instantiating native Kubernetes adapters")
+
+		var err error
+		kubeClient, err = grpccommon.KubeClientset()
+		if err != nil {
+			return nil, err
+		}
+
+		// Create adapter for Application manager
+		appmgrAdapter, err = nappmgr.NewAdapter(kubeClient)
+		if err != nil {
+			return nil, err
+		}
+
+	case appcommon.AdapterMemory:
+		logrus.Debug("Instantiating in-memory adapters")
+
+		// Applications, nodes and quotas are kept in the process memory
+		appmgrAdapter = mappmgr.NewAdapter()
+		clumgrAdapter = mclumgr.NewAdapter(appmgrAdapter)
+
+	default:
+		return nil, fmt.Errorf("unsupported adapter %s", viper.GetString(appcommon.EnvApphcAdapter))
+	}
+
+	// Adapters talking to a cluster are not ready while its API server is not
+	if kubeClient != nil {
+		registerKubeChecks(checker, kubeClient)
+	}
+
+	// Operation locks are shared by the Controller replicas through Kubernetes unless there is no cluster behind
+	holder, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	locksTtl := time.Duration(viper.GetInt(appcommon.EnvApphcLocksTtl)) * time.Second
+	if kubeClient != nil {
+		mutex.Init(mutex.NewLeaseBackend(kubeClient, viper.GetString(appcommon.EnvApphcLocksNamespace)), holder, locksTtl)
+	} else {
+		mutex.Init(mutex.NewMemoryBackend(), holder, locksTtl)
 	}
 
 	logrus.Info("Registering AppManager service to gRPC")
 
 	// Register Application manager gRPC server
-	pbappmgr.RegisterAppManagerServer(grpcServer, appmanager.New(appmgrAdapter))
+	pbappmgr.RegisterAppManagerServer(grpcServer, appmanager.New(streams, appmgrAdapter, kubeClient, registry))
+
+	// Cluster manager is not provided by every adapter
+	if clumgrAdapter != nil {
+		logrus.Info("Registering ClusterManager service to gRPC")
+
+		// Register Cluster manager gRPC server
+		pbclumgr.RegisterClusterManagerServer(grpcServer, clustermanager.New(clumgrAdapter, kubeClient, registry))
+	}
+
+	logrus.Info("Registering Operations service to gRPC")
+
+	// Register Operations gRPC server
+	pbops.RegisterOperationsServer(grpcServer, opsmgr.New(registry))
+
+	logrus.Info("Registering AuditManager service to gRPC")
+
+	// Register Audit manager gRPC server
+	pbaudmgr.RegisterAuditManagerServer(grpcServer, audmgr.New(auditLogger))
+
+	logrus.Info("Registering Health service to gRPC")
+
+	// Register standard Health gRPC server. The serving status of all the services follows the readiness
+	var services []string
+	for name := range grpcServer.GetServiceInfo() {
+		services = append(services, name)
+	}
 
-	logrus.Info("Registering ClusterManager service to gRPC")
+	healthServer := grpchealth.New(streams, checker)
+	pbhealth.RegisterHealthServer(grpcServer, healthServer)
+	go healthServer.Run(services)
 
-	// Register Cluster manager gRPC server
-	pbclumgr.RegisterClusterManagerServer(grpcServer, clustermanager.New(clumgrAdapter, kubeClient))
+	// Report the methods of all the registered services before they are called
+	metrics.GrpcServerMetrics.InitializeMetrics(grpcServer)
 
 	// Return gRPC server
 	return grpcServer, nil
@@ -183,7 +308,7 @@ func newGrpcServer() (*grpc.Server, error) {
 func createKubeConfigFile(adapter clumgrcommon.ClusterManagerAdapter) error {
 	// Fetch kubernetes configuration
 	req := &pbclumgr.GetKubeConfigRequest{}
-	cfg, err := adapter.GetKubeConfig(req)
+	cfg, err := adapter.GetKubeConfig(context.Background(), req)
 	if err != nil {
 		return err
 	}
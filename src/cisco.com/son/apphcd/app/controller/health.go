@@ -0,0 +1,85 @@
+// Author  <dorzheho@cisco.com>
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	"k8s.io/client-go/kubernetes"
+
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/health"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+	"cisco.com/son/apphcd/app/grpc/common/rancher"
+)
+
+// Names of the dependencies reported by the readiness check
+const (
+	dependencyRancher        = "rancher"
+	dependencyGit            = "git"
+	dependencyKubernetes     = "kubernetes"
+	dependencyDockerRegistry = "docker_registry"
+	dependencyCatalogCache   = "catalog_cache"
+)
+
+// serveHealth serves the liveness and the readiness of the controller
+func serveHealth(mux *http.ServeMux, checker *health.Checker) {
+	// The controller is alive as long as it serves HTTP requests
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, req *http.Request) {
+		writeHealth(w, http.StatusOK, map[string]health.Status{"status": health.StatusOk})
+	})
+
+	// The controller is ready if all the dependencies are
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, req *http.Request) {
+		report := checker.Ready(req.Context())
+
+		code := http.StatusOK
+		if report.Status != health.StatusOk {
+			code = http.StatusServiceUnavailable
+		}
+
+		writeHealth(w, code, report)
+	})
+}
+
+// writeHealth writes the status as JSON
+func writeHealth(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// registerRancherChecks registers the checks of the Rancher management API, the git server and the catalog cache
+func registerRancherChecks(checker *health.Checker, clients *rancher.Clients) {
+	// Expired token is rejected by the management API
+	checker.Register(dependencyRancher, func(ctx context.Context) error {
+		_, err := clients.Svcs().ManagementClient.Setting.ByID("server-version")
+		return err
+	})
+
+	checker.Register(dependencyGit,
+		health.HTTPCheck(fmt.Sprintf("http://%s/", viper.GetString(appcommon.EnvApphcGitServerEndpoint)), http.StatusOK))
+
+	checker.Register(dependencyCatalogCache,
+		health.DirCheck(filepath.Join(viper.GetString(appcommon.EnvApphcCachePath), appmgrcommon.CatalogAppsRepo)))
+}
+
+// registerKubeChecks registers the checks of the Kubernetes API server and the private Docker registry
+func registerKubeChecks(checker *health.Checker, kc kubernetes.Interface) {
+	checker.Register(dependencyKubernetes, func(ctx context.Context) error {
+		_, err := kc.Discovery().ServerVersion()
+		return err
+	})
+
+	// Docker registry API responds on the base endpoint unless the credentials are missing
+	if registry := viper.GetString(appcommon.EnvApphcPrivateDockerRegistry); registry != "" {
+		checker.Register(dependencyDockerRegistry,
+			health.HTTPCheck(fmt.Sprintf("http://%s/v2/", registry), http.StatusOK, http.StatusUnauthorized))
+	}
+}
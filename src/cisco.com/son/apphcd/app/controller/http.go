@@ -4,16 +4,19 @@ package controller
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
-	"io"
 	"mime"
+	"net"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/soheilhy/cmux"
+	"google.golang.org/grpc"
 
-	openapi "cisco.com/son/apphcd/api/v1/appmanager"
+	"cisco.com/son/apphcd/app/common/health"
+	"cisco.com/son/apphcd/app/common/metrics"
 	"cisco.com/son/apphcd/pkg/ui/data/swagger"
 	"github.com/philips/go-bindata-assetfs"
 )
@@ -23,34 +26,59 @@ import (
 const httpServerAddr = "127.0.0.1"
 
 // newHttpServer creates new HTTP server
-func newHttpServer(ctx context.Context, serverPort int) (*http.Server, error) {
+func newHttpServer(ctx context.Context, serverPort int, certs *certStore, services map[string]grpc.ServiceInfo,
+	checker *health.Checker) (*http.Server, error) {
 	logrus.Info("Instantiating HTTP server")
 
 	// Create HTTP router
 	router := http.NewServeMux()
-	router.HandleFunc("/swagger.json", func(w http.ResponseWriter, req *http.Request) {
-		_,_ = io.Copy(w, strings.NewReader(openapi.Swagger))
-	})
+
+	// Serve OpenAPI documents of the registered services
+	if err := serveOpenApi(router, services); err != nil {
+		return nil, fmt.Errorf("unable to merge OpenAPI documents: %v", err)
+	}
+
+	// Serve the liveness and the readiness of the controller
+	serveHealth(router, checker)
 
 	// Serve Swagger
 	serveSwagger(router)
 
+	// Serve the controller metrics in the Prometheus format
+	router.Handle("/metrics", metrics.Handler())
+
 	// Initialize gRPC Gateway
-	gw, err := newGateway(ctx, serverPort)
+	gw, err := newGateway(ctx, serverPort, certs, services)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize gRPC Gateway: %v", err)
 	}
 
-	router.Handle("/", gw)
+	router.Handle("/", tracingHandler(gw))
 
 	// Return HTTP Server instance
 	return &http.Server{
 		Addr:        fmt.Sprintf("%s:%d", httpServerAddr, serverPort),
 		Handler:     router,
 		IdleTimeout: 120 * time.Second,
+		ConnContext: connContext,
 	}, nil
 }
 
+// Context key of the TLS connection
+type tlsConnKey struct{}
+
+// connContext keeps TLS connection terminated by the Controller listener in the request context,
+// since the HTTP server is not aware of the connections wrapped by the multiplexer
+func connContext(ctx context.Context, c net.Conn) context.Context {
+	if m, ok := c.(*cmux.MuxConn); ok {
+		if conn, ok := m.Conn.(*tls.Conn); ok {
+			return context.WithValue(ctx, tlsConnKey{}, conn)
+		}
+	}
+
+	return ctx
+}
+
 // serveSwagger serves Swagger WebUI
 func serveSwagger(mux *http.ServeMux) {
 	_ = mime.AddExtensionType(".svg", "image/svg+xml")
@@ -0,0 +1,39 @@
+// Author  <dorzheho@cisco.com>
+
+package controller
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	pbappmgr "cisco.com/son/apphcd/api/v1/appmanager"
+	pbclumgr "cisco.com/son/apphcd/api/v1/clustermanager"
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/metrics"
+)
+
+// responseStatusUnaryServerInterceptor records the Status of the responses returned to the callers
+func responseStatusUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		if s := responseStatus(resp); s != "" {
+			metrics.ResponseStatuses.WithLabelValues(auth.ShortMethod(info.FullMethod), s).Inc()
+		}
+
+		return resp, err
+	}
+}
+
+// responseStatus gives back the Status of the response. Empty if the response has no Status
+func responseStatus(resp interface{}) string {
+	switch r := resp.(type) {
+	case *pbappmgr.Response:
+		return r.GetStatus().String()
+	case *pbclumgr.Response:
+		return r.GetStatus().String()
+	}
+
+	return ""
+}
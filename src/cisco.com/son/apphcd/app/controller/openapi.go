@@ -0,0 +1,89 @@
+// Author  <dorzheho@cisco.com>
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	pbapphcmgr "cisco.com/son/apphcd/api/v1/apphcmanager"
+	pbappmgr "cisco.com/son/apphcd/api/v1/appmanager"
+	pbaudmgr "cisco.com/son/apphcd/api/v1/auditmanager"
+	pbclumgr "cisco.com/son/apphcd/api/v1/clustermanager"
+	pbops "cisco.com/son/apphcd/api/v1/operations"
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/openapi"
+	"cisco.com/son/apphcd/app/grpc/apphcmanager/version"
+)
+
+// Paths the merged OpenAPI documents are served on
+const (
+	openApiV2Path = "/openapi/v2.json"
+	openApiV3Path = "/openapi/v3.json"
+)
+
+// Swagger specifications of the services exposed through the gRPC Gateway
+var serviceSpecs = map[string]string{
+	"com.cisco.son.apphcd.api.v1.apphcmanager.ApphcManager":     pbapphcmgr.Swagger,
+	"com.cisco.son.apphcd.api.v1.appmanager.AppManager":         pbappmgr.Swagger,
+	"com.cisco.son.apphcd.api.v1.clustermanager.ClusterManager": pbclumgr.Swagger,
+	"com.cisco.son.apphcd.api.v1.operations.Operations":         pbops.Swagger,
+	"com.cisco.son.apphcd.api.v1.auditmanager.AuditManager":     pbaudmgr.Swagger,
+}
+
+// serveOpenApi merges the specifications of the services registered to gRPC server and serves
+// the result as OpenAPI 2 and OpenAPI 3 documents. The Swagger WebUI reads the OpenAPI 2 one
+func serveOpenApi(mux *http.ServeMux, services map[string]grpc.ServiceInfo) error {
+	var names []string
+	for name := range services {
+		if _, ok := serviceSpecs[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var specs []string
+	for _, name := range names {
+		specs = append(specs, serviceSpecs[name])
+	}
+
+	v2, err := openapi.Merge(openapi.Info{
+		Title:       "AppHoster Controller",
+		Description: fmt.Sprintf("AppHoster Controller API served by the %s adapter", viper.GetString(appcommon.EnvApphcAdapter)),
+		Version:     version.New().Version,
+	}, specs...)
+	if err != nil {
+		return err
+	}
+
+	v2Data, err := json.MarshalIndent(v2, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	v3Data, err := json.MarshalIndent(openapi.ConvertV3(v2), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	logrus.WithFields(logrus.Fields{"services": names}).Debug("Serving OpenAPI documents")
+
+	mux.Handle("/swagger.json", openApiHandler(v2Data))
+	mux.Handle(openApiV2Path, openApiHandler(v2Data))
+	mux.Handle(openApiV3Path, openApiHandler(v3Data))
+	return nil
+}
+
+// openApiHandler writes the document
+func openApiHandler(data []byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	})
+}
@@ -0,0 +1,33 @@
+// Author  <dorzheho@cisco.com>
+
+package controller
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/ratelimit"
+)
+
+// newLimiter creates limiter of gRPC requests according to the configuration
+func newLimiter() (*ratelimit.Limiter, error) {
+	methods, err := ratelimit.ParseMethodLimits(viper.GetString(appcommon.EnvApphcRateLimitMethods))
+	if err != nil {
+		return nil, err
+	}
+
+	client := ratelimit.Limit{
+		Rate:  viper.GetFloat64(appcommon.EnvApphcRateLimitRps),
+		Burst: viper.GetInt(appcommon.EnvApphcRateLimitBurst),
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"rps":        client.Rate,
+		"burst":      client.Burst,
+		"methods":    len(methods),
+		"operations": viper.GetInt(appcommon.EnvApphcOperationsMaxRunning),
+	}).Info("Rate limiting enabled")
+
+	return ratelimit.NewLimiter(client, methods, viper.GetInt(appcommon.EnvApphcOperationsMaxRunning)), nil
+}
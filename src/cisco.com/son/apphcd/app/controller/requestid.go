@@ -0,0 +1,77 @@
+// Author  <dorzheho@cisco.com>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pbappmgr "cisco.com/son/apphcd/api/v1/appmanager"
+	pbclumgr "cisco.com/son/apphcd/api/v1/clustermanager"
+	"cisco.com/son/apphcd/app/common/ratelimit"
+	"cisco.com/son/apphcd/app/common/requestid"
+)
+
+// requestIdUnaryServerInterceptor attaches the request ID to the context, the response header and the Response
+func requestIdUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id := incomingRequestId(ctx)
+		ctx = requestid.NewContext(ctx, id)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestid.HeaderKey, id))
+
+		resp, err := handler(ctx, req)
+
+		switch r := resp.(type) {
+		case *pbappmgr.Response:
+			r.RequestId = id
+		case *pbclumgr.Response:
+			r.RequestId = id
+		}
+
+		return resp, err
+	}
+}
+
+// requestIdStreamServerInterceptor attaches the request ID to the context and the response header
+func requestIdStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		id := incomingRequestId(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(requestid.HeaderKey, id))
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = requestid.NewContext(ss.Context(), id)
+
+		return handler(srv, wrapped)
+	}
+}
+
+// incomingRequestId gives back the request ID passed by the caller. A new one is generated unless passed or valid
+func incomingRequestId(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestid.HeaderKey); len(values) > 0 && requestid.Valid(values[0]) {
+			return values[0]
+		}
+	}
+
+	return requestid.New()
+}
+
+// outgoingHeaderMatcher passes the request ID to HTTP clients as is, the other gRPC metadata is prefixed
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == requestid.HeaderKey {
+		return http.CanonicalHeaderKey(requestid.HeaderKey), true
+	}
+
+	// Throttled clients are told when to retry
+	if key == ratelimit.RetryAfterKey {
+		return http.CanonicalHeaderKey(ratelimit.RetryAfterKey), true
+	}
+
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
@@ -0,0 +1,71 @@
+// Author  <dorzheho@cisco.com>
+
+package controller
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	pbappmgr "cisco.com/son/apphcd/api/v1/appmanager"
+	pbclumgr "cisco.com/son/apphcd/api/v1/clustermanager"
+	"cisco.com/son/apphcd/app/common/config"
+	"cisco.com/son/apphcd/app/common/requestid"
+	"cisco.com/son/apphcd/app/common/statuscode"
+)
+
+// statusCodeUnaryServerInterceptor reports the failed responses by the status codes if the caller or
+// the configuration asks for it. The Response is attached to the error details
+func statusCodeUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil || !statuscode.Enabled(ctx, config.Current().StatusCodes) {
+			return resp, err
+		}
+
+		// The request ID is set once the response is returned, so it is set on the Response in advance
+		switch r := resp.(type) {
+		case *pbappmgr.Response:
+			r.RequestId = requestid.FromContext(ctx)
+			err = statuscode.Error(r.Status.String(), r.Message, r)
+		case *pbclumgr.Response:
+			r.RequestId = requestid.FromContext(ctx)
+			err = statuscode.Error(r.Status.String(), r.Message, r)
+		}
+
+		if err != nil {
+			requestid.Logger(ctx).WithFields(logrus.Fields{
+				"method": info.FullMethod,
+				"code":   status.Code(err),
+			}).Debug("Response reported by status code")
+
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// statusCodeErrorHandler writes the errors of gRPC server to HTTP clients.
+// Failures of the Rancher server or the Kubernetes API server are reported as Bad Gateway
+func statusCodeErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, err error) {
+	if statuscode.Upstream(err) {
+		w = &badGatewayWriter{ResponseWriter: w}
+	}
+
+	runtime.DefaultHTTPProtoErrorHandler(ctx, mux, marshaler, w, req, err)
+}
+
+// badGatewayWriter replaces the status code of the response with Bad Gateway
+type badGatewayWriter struct {
+	http.ResponseWriter
+}
+
+// WriteHeader writes the response header with Bad Gateway status code
+func (w *badGatewayWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(http.StatusBadGateway)
+}
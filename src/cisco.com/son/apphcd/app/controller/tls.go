@@ -0,0 +1,286 @@
+// Author  <dorzheho@cisco.com>
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/soheilhy/cmux"
+	"google.golang.org/grpc/credentials"
+)
+
+// Interval between checks of the certificate files for changes
+const certsReloadInterval = 10 * time.Second
+
+// certStore keeps the server certificate and the client CAs loaded from disk
+type certStore struct {
+	certFile     string           // Server certificate file
+	keyFile      string           // Server private key file
+	clientCaFile string           // Client CA bundle file. Empty if client certificates are not verified
+	mu           sync.RWMutex     // Protects the fields below
+	cert         *tls.Certificate // Server certificate
+	clientCas    *x509.CertPool   // Client CAs
+	modTime      time.Time        // Latest modification time of the files
+}
+
+// newCertStore creates a new certificates store and loads the certificates
+func newCertStore(certFile, keyFile, clientCaFile string) (*certStore, error) {
+	s := &certStore{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCaFile: clientCaFile,
+	}
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// files gives back the list of files kept by the store
+func (s *certStore) files() []string {
+	files := []string{s.certFile, s.keyFile}
+	if s.clientCaFile != "" {
+		files = append(files, s.clientCaFile)
+	}
+
+	return files
+}
+
+// lastModified gives back the latest modification time of the files
+func (s *certStore) lastModified() (time.Time, error) {
+	var modTime time.Time
+	for _, f := range s.files() {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return modTime, err
+		}
+
+		if fi.ModTime().After(modTime) {
+			modTime = fi.ModTime()
+		}
+	}
+
+	return modTime, nil
+}
+
+// load reads the certificates from disk
+func (s *certStore) load() error {
+	modTime, err := s.lastModified()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(s.certFile, s.keyFile)
+	if err != nil {
+		return fmt.Errorf("unable to load server certificate: %v", err)
+	}
+
+	var clientCas *x509.CertPool
+	if s.clientCaFile != "" {
+		pem, err := ioutil.ReadFile(s.clientCaFile)
+		if err != nil {
+			return err
+		}
+
+		clientCas = x509.NewCertPool()
+		if !clientCas.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in %s", s.clientCaFile)
+		}
+	}
+
+	s.mu.Lock()
+	s.cert = &cert
+	s.clientCas = clientCas
+	s.modTime = modTime
+	s.mu.Unlock()
+
+	return nil
+}
+
+// watch reloads the certificates once the files are changed until the context is done
+func (s *certStore) watch(ctx context.Context) {
+	ticker := time.NewTicker(certsReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		modTime, err := s.lastModified()
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"error": err}).Warn("Unable to check TLS certificates for changes")
+			continue
+		}
+
+		s.mu.RLock()
+		changed := modTime.After(s.modTime)
+		s.mu.RUnlock()
+
+		if !changed {
+			continue
+		}
+
+		// Keep serving the previous certificates if the new ones are broken
+		if err := s.load(); err != nil {
+			logrus.WithFields(logrus.Fields{"error": err}).Error("Unable to reload TLS certificates")
+			continue
+		}
+
+		logrus.WithFields(logrus.Fields{"certificate": s.certFile, "status": "OK"}).Info("TLS certificates reloaded")
+	}
+}
+
+// certificate gives back the current server certificate
+func (s *certStore) certificate() *tls.Certificate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cert
+}
+
+// isServerCert tells whether the certificate is the server certificate
+func (s *certStore) isServerCert(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.Raw, s.certificate().Certificate[0])
+}
+
+// serverConfig gives back TLS configuration of the Controller listener
+func (s *certStore) serverConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			s.mu.RLock()
+			cert, clientCas := s.cert, s.clientCas
+			s.mu.RUnlock()
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   nextProtos(hello.SupportedProtos),
+			}
+
+			if clientCas != nil {
+				config.ClientAuth = tls.RequireAnyClientCert
+				config.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+					return verifyClientCert(rawCerts, cert, clientCas)
+				}
+			}
+
+			return config, nil
+		},
+	}
+}
+
+// gatewayConfig gives back TLS configuration of the gRPC Gateway connection to the gRPC server.
+// Both sides of the connection are authenticated with the server certificate
+func (s *certStore) gatewayConfig() *tls.Config {
+	return &tls.Config{
+		// The server certificate is not issued for the loopback address,
+		// hence it's pinned by VerifyPeerCertificate instead
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], s.certificate().Certificate[0]) {
+				return errors.New("unexpected gRPC server certificate")
+			}
+			return nil
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return s.certificate(), nil
+		},
+	}
+}
+
+// nextProtos negotiates application protocol. HTTP/1.1 is preferred whenever the client supports it
+// since HTTP/2 connections are dispatched to the gRPC server
+func nextProtos(supported []string) []string {
+	for _, p := range supported {
+		if p == "http/1.1" {
+			return []string{"http/1.1"}
+		}
+	}
+
+	return []string{"h2"}
+}
+
+// verifyClientCert verifies the client certificate chain against the client CAs.
+// The server certificate is accepted as well since the gRPC Gateway presents it
+func verifyClientCert(rawCerts [][]byte, serverCert *tls.Certificate, clientCas *x509.CertPool) error {
+	if len(rawCerts) == 0 {
+		return errors.New("client certificate required")
+	}
+
+	if bytes.Equal(rawCerts[0], serverCert.Certificate[0]) {
+		return nil
+	}
+
+	var certs []*x509.Certificate
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certs = append(certs, cert)
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         clientCas,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(opts)
+	return err
+}
+
+// terminatedTlsCreds passes the state of the connections terminated by the Controller listener to gRPC,
+// so the peer information contains the client certificates
+type terminatedTlsCreds struct{}
+
+// ClientHandshake is not supported
+func (terminatedTlsCreds) ClientHandshake(context.Context, string, net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("client handshake is not supported")
+}
+
+// ServerHandshake gives back TLS state of the connection
+func (terminatedTlsCreds) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if c, ok := conn.(*cmux.MuxConn); ok {
+		if tlsConn, ok := c.Conn.(*tls.Conn); ok {
+			return conn, credentials.TLSInfo{State: tlsConn.ConnectionState()}, nil
+		}
+	}
+
+	return conn, nil, nil
+}
+
+// Info gives back the protocol information
+func (terminatedTlsCreds) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "tls", SecurityVersion: "1.2"}
+}
+
+// Clone gives back a copy of the credentials
+func (c terminatedTlsCreds) Clone() credentials.TransportCredentials {
+	return c
+}
+
+// OverrideServerName is no-op
+func (terminatedTlsCreds) OverrideServerName(string) error {
+	return nil
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newCert creates a certificate signed by the parent. Self-signed if the parent is nil
+func newCert(t *testing.T, cn string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = tmpl, key
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert, key
+}
+
+// writePem writes PEM block to the file
+func writePem(t *testing.T, path, blockType string, raw []byte) {
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: raw}), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCertStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ca, caKey := newCert(t, "ca", nil, nil)
+	server, serverKey := newCert(t, "server", ca, caKey)
+	client, _ := newCert(t, "client", ca, caKey)
+	stranger, _ := newCert(t, "stranger", nil, nil)
+
+	keyRaw, err := x509.MarshalECPrivateKey(serverKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile, keyFile, caFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.crt")
+	writePem(t, certFile, "CERTIFICATE", server.Raw)
+	writePem(t, keyFile, "EC PRIVATE KEY", keyRaw)
+	writePem(t, caFile, "CERTIFICATE", ca.Raw)
+
+	s, err := newCertStore(certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, raw := range map[string][]byte{"server": server.Raw, "client": client.Raw} {
+		if err := verifyClientCert([][]byte{raw}, s.certificate(), s.clientCas); err != nil {
+			t.Fatalf("%s certificate rejected: %v", name, err)
+		}
+	}
+
+	if err := verifyClientCert([][]byte{stranger.Raw}, s.certificate(), s.clientCas); err == nil {
+		t.Fatal("certificate of unknown CA accepted")
+	}
+
+	if err := verifyClientCert(nil, s.certificate(), s.clientCas); err == nil {
+		t.Fatal("missing certificate accepted")
+	}
+
+	if p := nextProtos([]string{"h2", "http/1.1"}); p[0] != "http/1.1" {
+		t.Fatalf("unexpected protocol %s", p[0])
+	}
+
+	if p := nextProtos([]string{"h2"}); p[0] != "h2" {
+		t.Fatalf("unexpected protocol %s", p[0])
+	}
+}
@@ -0,0 +1,158 @@
+// Author  <dorzheho@cisco.com>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/tracing"
+)
+
+// traceContext propagates the spans as W3C Trace Context, i.e. traceparent and tracestate headers
+var traceContext = propagation.TraceContext{}
+
+// tracingHandler starts the spans of the HTTP requests served by the gRPC Gateway.
+// The trace of the caller is continued if the request carries W3C traceparent header
+func tracingHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := traceContext.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+		ctx, span := tracing.Start(ctx, fmt.Sprintf("HTTP %s", req.Method), trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("http.method", req.Method), attribute.String("http.target", req.URL.Path)))
+
+		if !span.IsRecording() {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		rec := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+		next.ServeHTTP(rec, req.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.status_code", rec.code))
+
+		var err error
+		if rec.code >= http.StatusInternalServerError {
+			err = fmt.Errorf("%d %s", rec.code, http.StatusText(rec.code))
+		}
+		tracing.Finish(span, &err)
+	})
+}
+
+// statusRecorder keeps the status code of the HTTP response
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.code = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Flush is required by the streaming calls
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// tracingUnaryClientInterceptor propagates the span of the gRPC Gateway request to the gRPC server
+func tracingUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(injectSpan(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// tracingStreamClientInterceptor propagates the span of the gRPC Gateway request to the gRPC server
+func tracingStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(injectSpan(ctx), desc, cc, method, opts...)
+	}
+}
+
+// injectSpan adds the span carried by the context to the outgoing metadata
+func injectSpan(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	traceContext.Inject(ctx, metadataCarrier(md))
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// metadataCarrier adapts the gRPC metadata to the propagators
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// tracingUnaryServerInterceptor starts the spans of the gRPC requests
+func tracingUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		defer tracing.Finish(span, &err)
+
+		resp, err = handler(ctx, req)
+		span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+
+		if s := responseStatus(resp); s != "" {
+			span.SetAttributes(attribute.String("apphc.response_status", s))
+		}
+
+		return resp, err
+	}
+}
+
+// tracingStreamServerInterceptor starts the spans of the streaming gRPC requests
+func tracingStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+		defer tracing.Finish(span, &err)
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+
+		err = handler(srv, wrapped)
+		span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+
+		return err
+	}
+}
+
+// startServerSpan starts the span of the gRPC request, a child of the span propagated by the caller if any
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = traceContext.Extract(ctx, metadataCarrier(md))
+
+	return tracing.Start(ctx, auth.ShortMethod(fullMethod), trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("rpc.system", "grpc"), attribute.String("rpc.method", fullMethod)))
+}
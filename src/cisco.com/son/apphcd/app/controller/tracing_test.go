@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc/metadata"
+
+	"cisco.com/son/apphcd/app/common/tracing"
+)
+
+func TestTracePropagation(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	var server trace.SpanContext
+	handler := tracingHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// The gRPC Gateway passes the request context over to the gRPC client
+		md, _ := metadata.FromOutgoingContext(injectSpan(req.Context()))
+		ctx, span := startServerSpan(metadata.NewIncomingContext(context.Background(), md),
+			"/com.cisco.son.apphcd.api.v1.appmanager.AppManager/GetApps")
+		server = trace.SpanContextFromContext(ctx)
+		tracing.Finish(span, nil)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/apps", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	g, h := spans[0], spans[1]
+	if h.SpanContext.TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" || h.Parent.SpanID().String() != "00f067aa0ba902b7" {
+		t.Fatalf("unexpected HTTP span %+v", h)
+	}
+
+	if !g.SpanContext.Equal(server) || g.SpanContext.TraceID() != h.SpanContext.TraceID() ||
+		g.Parent.SpanID() != h.SpanContext.SpanID() || g.SpanKind != trace.SpanKindServer {
+		t.Fatalf("unexpected gRPC span %+v", g)
+	}
+}
@@ -8,13 +8,16 @@ import (
 	"github.com/sirupsen/logrus"
 
 	pb "cisco.com/son/apphcd/api/v1/apphcmanager"
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/requestid"
 	"cisco.com/son/apphcd/app/grpc/apphcmanager/version"
 )
 
 type ApphcManager struct{}
 
 func (mgr *ApphcManager) GetVersion(ctx context.Context, req *pb.GetApphcVersionRequest) (*pb.GetApphcVersionResponse, error) {
-	logrus.WithFields(logrus.Fields{"service": "ApphcManager", "type": "grpc"}).Info("Received GetVersionRequest")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"service": "ApphcManager", "type": "grpc",
+		"identity": auth.IdentityName(ctx)}).Info("Received GetVersionRequest")
 
 	v := version.New()
 	resp := &pb.GetApphcVersionResponse{}
@@ -23,7 +26,7 @@ func (mgr *ApphcManager) GetVersion(ctx context.Context, req *pb.GetApphcVersion
 	resp.GitCommit = v.GitCommit
 	resp.GitState = v.GitTreeState
 
-	logrus.WithFields(logrus.Fields{"service": "ApphcManager", "type": "grpc"}).Info("Sending response")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"service": "ApphcManager", "type": "grpc"}).Info("Sending response")
 
 	return resp, nil
 }
@@ -0,0 +1,977 @@
+// Author  <dorzheho@cisco.com>
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/config"
+	"cisco.com/son/apphcd/app/common/longrunning"
+	"cisco.com/son/apphcd/app/common/requestid"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+	"cisco.com/son/apphcd/app/grpc/appmanager/common/chartutils"
+)
+
+const (
+	// Metadata created by the adapter is kept in the local applications repository
+	catalogId = appmgrcommon.CatalogAppsRepo
+)
+
+type memoryAppMgrAdapter struct {
+	mu        sync.Mutex      // Guards the applications and the templates
+	apps      map[string]*app // Deployed applications by name
+	templates templates       // Application instances metadata
+}
+
+// NewAdapter creates in-memory AppManager adapter.
+// Applications and their metadata are kept in the process memory
+// hence nothing survives the controller restart
+func NewAdapter() *memoryAppMgrAdapter {
+	return &memoryAppMgrAdapter{
+		apps:      make(map[string]*app),
+		templates: make(templates),
+	}
+}
+
+// Create an application and related instances
+func (adapter *memoryAppMgrAdapter) CreateApp(ctx context.Context, req *appmanager.CreateAppRequest) (*appmanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	// Identify application type
+	cycle, err := appmgrcommon.AppTypeToAppCycle(req.Cycle)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Create the temporary data for the new applications
+	apps := appmgrcommon.NewAppsData()
+	apps.AppendNewAppInstances(req, cycle, req.Description, req.AppState)
+
+	// Check if the requested application name and version already exist
+	if apps.NewAppInstancesDataEmpty() {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to deploy", nil)
+	}
+
+	// Changes are applied on a copy of the application
+	a := copyApp(adapter.apps[req.Name])
+	if a == nil {
+		a = &app{cycle: cycle, instances: make(map[string]*appInstance)}
+	}
+
+	now := time.Now()
+	var created []*appmgrcommon.AppInstanceData
+	var planned []*appmgrcommon.AppInstanceData
+	var doneList []*appmanager.AppInstance
+
+	for _, newAppInstance := range apps.NewAppInstancesData {
+		// We do not allow to spin up multiple versions of a particular application instance
+		if _, ok := a.instances[newAppInstance.InstanceName]; ok {
+			newAppInstance.NextAction = appmgrcommon.AppInstanceDataNextActionNone
+			planned = append(planned, newAppInstance)
+			continue
+		}
+
+		data, err := adapter.prepareInstanceData(newAppInstance, req, nil)
+		if err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		data.NextAction = appmgrcommon.AppInstanceDataNextActionCreate
+		a.instances[data.InstanceName] = &appInstance{data: data, createDate: now, updateDate: now}
+		created = append(created, data)
+		planned = append(planned, data)
+		doneList = append(doneList, generateProtoData(data, catalogId))
+	}
+
+	// Plan the changes instead of applying them
+	if req.DryRun {
+		return adapter.plan(ctx, req.Name, req.Cycle, cycle, planned)
+	}
+
+	// If nothing is added print appropriate message
+	if len(doneList) == 0 {
+		err := fmt.Errorf("application %s already deployed", req.Name)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_UNCHANGED, err.Error(), nil)
+	}
+
+	a.sharedStorage = req.SharedStorage
+	a.limits = req.GetSpec().GetResources().GetLimits()
+
+	adapter.apps[req.Name] = a
+	adapter.saveTemplates(req, created)
+
+	for _, data := range created {
+		longrunning.Step(ctx, "instance %s ready", data.InstanceName)
+	}
+
+	var msg string
+	if req.GetAppState() == appmanager.AppStateAfterDeployment_enabled {
+		msg = "Application deployed successfully"
+	} else {
+		msg = "Application deployed successfully but disabled"
+	}
+
+	// Generate response
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, msg, &appmanager.App{Name: req.Name,
+		Cycle: req.Cycle, Instances: doneList})
+}
+
+// Upgrade running application instance
+func (adapter *memoryAppMgrAdapter) UpgradeApp(ctx context.Context, req *appmanager.UpgradeAppRequest) (*appmanager.Response, error) {
+	return adapter.updateUpgradeApps(ctx, req, false)
+}
+
+// Update running application instance
+func (adapter *memoryAppMgrAdapter) UpdateApp(ctx context.Context, req *appmanager.UpdateAppRequest) (*appmanager.Response, error) {
+	return adapter.updateUpgradeApps(ctx, req, true)
+}
+
+// Roll back running application instances to the version of the metadata kept by the adapter
+func (adapter *memoryAppMgrAdapter) RollbackApp(ctx context.Context, req *appmanager.RollbackAppRequest) (*appmanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	// The metadata has no history
+	if req.Revision != "" {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR,
+			"rolling back to a catalog revision is not supported by the memory adapter", nil)
+	}
+
+	running := adapter.apps[req.Name]
+
+	var existingData []*appInstance
+	if running != nil {
+		for _, i := range running.sortedInstances() {
+			if matchInstance(i.data, "", req.RootGroupId, req.GroupIds) {
+				existingData = append(existingData, i)
+			}
+		}
+	}
+
+	if len(existingData) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to roll back", nil)
+	}
+
+	// Instances are replaced only once all of them can be rolled back
+	var rolledBack []*appmgrcommon.AppInstanceData
+	for _, i := range existingData {
+		// Instances already running the requested version are left as they are
+		if i.data.RequestedVersion == req.Version {
+			continue
+		}
+
+		templateName := i.data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName)
+		t := adapter.templates.get(req.Name, templateName, req.Version)
+		if t == nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR,
+				fmt.Sprintf("version %s for template %s is invalid", req.Version, templateName), nil)
+		}
+
+		data := copyInstanceData(t)
+		data.State = i.data.State
+		data.CurrentVersion = i.data.RequestedVersion
+		data.Annotations.Add(appmgrcommon.AppInstanceAnnotationState, fmt.Sprintf("%d", data.State))
+
+		data.NextAction = appmgrcommon.AppInstanceDataNextActionUpgrade
+		if config.Current().UpgradePolicyRecreate {
+			data.NextAction = appmgrcommon.AppInstanceDataNextActionRecreate
+		}
+
+		rolledBack = append(rolledBack, data)
+	}
+
+	if len(rolledBack) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_UNCHANGED,
+			fmt.Sprintf("Application %s already runs version %s", req.Name, req.Version), nil)
+	}
+
+	now := time.Now()
+	var doneList []*appmanager.AppInstance
+	for _, data := range rolledBack {
+		i := running.instances[data.InstanceName]
+		i.data = data
+		i.updateDate = now
+
+		longrunning.Step(ctx, "instance %s ready", data.InstanceName)
+		doneList = append(doneList, generateProtoData(data, catalogId))
+	}
+
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application rolled back successfully",
+		&appmanager.App{Name: req.Name, Cycle: running.cycle, Instances: doneList})
+}
+
+// ScaleApp changes the number of replicas of daemon application instances
+func (adapter *memoryAppMgrAdapter) ScaleApp(ctx context.Context, req *appmanager.ScaleAppRequest) (*appmanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	a := adapter.apps[req.Name]
+
+	var existingData []*appInstance
+	if a != nil {
+		for _, i := range a.sortedInstances() {
+			if matchInstance(i.data, req.Version, req.RootGroupId, req.GroupIds) {
+				existingData = append(existingData, i)
+			}
+		}
+	}
+
+	if len(existingData) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to scale", nil)
+	}
+
+	if a.cycle != appmgrcommon.TypeDaemon {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR,
+			fmt.Sprintf("application %s of type %s cannot be scaled", req.Name, a.cycle), nil)
+	}
+
+	// Instances are scaled only once all of them can be scaled
+	for _, i := range existingData {
+		if i.data.Autoscaling != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR,
+				fmt.Sprintf("application instance %s is scaled by the horizontal pod autoscaler", i.data.InstanceName), nil)
+		}
+	}
+
+	now := time.Now()
+	var doneList []*appmanager.AppInstance
+	for _, i := range existingData {
+		i.data.Replicas = int(req.Replicas)
+		i.updateDate = now
+
+		// Keep the metadata in sync with the running instance
+		if t := adapter.templates.get(req.Name, i.data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName),
+			i.data.RequestedVersion); t != nil {
+			t.Replicas = i.data.Replicas
+		}
+
+		longrunning.Step(ctx, "instance %s scaled", i.data.InstanceName)
+		doneList = append(doneList, generateProtoData(i.data, catalogId))
+	}
+
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application scaled successfully",
+		&appmanager.App{Name: req.Name, Cycle: a.cycle, Instances: doneList})
+}
+
+// List revisions of the application charts. The charts history is not kept by the adapter
+func (adapter *memoryAppMgrAdapter) ListAppRevisions(ctx context.Context, req *appmanager.ListAppRevisionsRequest) (*appmanager.Response, error) {
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, "application revisions are not supported by the memory adapter", nil)
+}
+
+// Get revision of the application chart. The charts history is not kept by the adapter
+func (adapter *memoryAppMgrAdapter) GetAppRevision(ctx context.Context, req *appmanager.GetAppRevisionRequest) (*appmanager.Response, error) {
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, "application revisions are not supported by the memory adapter", nil)
+}
+
+// DeleteApp deletes appropriate Application instance
+func (adapter *memoryAppMgrAdapter) DeleteApp(ctx context.Context, req *appmanager.DeleteAppRequest) (*appmanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	a := adapter.apps[req.Name]
+
+	var existingData []*appInstance
+	if a != nil {
+		for _, i := range a.sortedInstances() {
+			if matchInstance(i.data, req.Version, req.RootGroupId, req.GroupIds) {
+				existingData = append(existingData, i)
+			}
+		}
+	}
+
+	if len(existingData) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+	}
+
+	// Decide if application metadata should be deleted
+	purge := req.Purge || viper.GetBool(appcommon.EnvApphcPurgeAppMetadata)
+
+	doneList := adapter.deleteInstances(req.Name, existingData, purge)
+
+	// Generate response
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application instances successfully deleted",
+		&appmanager.App{Name: req.Name, Cycle: a.cycle, Instances: doneList})
+}
+
+// DeleteApps removes all instances of all applications created by controller
+func (adapter *memoryAppMgrAdapter) DeleteApps(ctx context.Context, req *appmanager.DeleteAppsRequest) (*appmanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	// cannot find running applications
+	if len(adapter.apps) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+	}
+
+	// Decide if application metadata should be deleted
+	purge := req.Purge || viper.GetBool(appcommon.EnvApphcPurgeAppMetadata)
+
+	doneApps := &appmanager.Apps{}
+
+	for _, appName := range adapter.appNames() {
+		a := adapter.apps[appName]
+		app := &appmanager.App{}
+		app.Name = appName
+		app.Cycle = a.cycle
+		app.Instances = adapter.deleteInstances(appName, a.sortedInstances(), purge)
+
+		// Add the app to the list
+		doneApps.Apps = append(doneApps.Apps, app)
+	}
+
+	// Generate response message
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Applications successfully deleted", doneApps)
+}
+
+// GetApps fetches information about running application instances
+func (adapter *memoryAppMgrAdapter) GetApps(ctx context.Context, req *appmanager.GetAppsRequest) (*appmanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	// Identify application cycle
+	cycle, _ := appmgrcommon.AppTypeToAppCycle(req.Cycle)
+
+	// Create empty body
+	body := &appmanager.AppsInfo{}
+	body.Apps = make(map[string]*appmanager.AppInfo)
+
+	for _, appName := range adapter.appNames() {
+		// Continue if the application name is no equal to the name in the request
+		if req.Name != "" && req.Name != appName {
+			continue
+		}
+
+		a := adapter.apps[appName]
+
+		// If application type appears in request , filter according to the type
+		if cycle != "" && cycle != a.cycle {
+			continue
+		}
+
+		for _, i := range a.sortedInstances() {
+			if !matchInstance(i.data, req.Version, req.RootGroupId, req.GroupIds) {
+				continue
+			}
+
+			if body.Apps[appName] == nil {
+				ai, err := getAppInfo(a)
+				if err != nil {
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+				}
+
+				body.Apps[appName] = ai
+			}
+
+			d := adapter.getInstanceData(appName, a, i, req.Verbose)
+
+			t := body.Apps[appName].TotalResources
+			t.Requests.Cpu = math.Round((t.Requests.Cpu+d.Resources.Requests.Cpu)*100) / 100
+			t.Requests.Memory += d.Resources.Requests.Memory
+			t.Limits.Cpu = math.Round((t.Limits.Cpu+d.Resources.Limits.Cpu)*100) / 100
+			t.Limits.Memory += d.Resources.Limits.Memory
+			t.PersistentStorage += d.Resources.PersistentStorage
+
+			body.Apps[appName].Instances = append(body.Apps[appName].Instances, d)
+		}
+	}
+
+	// There is no application
+	if len(body.Apps) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "no application found", nil)
+	}
+
+	// Generate a response
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Running applications", body)
+}
+
+// DeleteAppMetadata deletes metadata for appropriate application instance
+func (adapter *memoryAppMgrAdapter) DeleteAppMetadata(ctx context.Context, req *appmanager.DeleteAppMetadataRequest) (*appmanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	appTmplts := &appmanager.AppTemplates{}
+	appTmplts.AppName = req.AppName
+
+	var names []string
+
+	// In case request doesn't contain group_ids,
+	// we assume that need to remove metadata for
+	// all instances of a particular application
+	if len(req.GroupIds) == 0 {
+		if adapter.templates[req.AppName] == nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+		}
+
+		for name := range adapter.templates[req.AppName] {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+	} else {
+		// In case group_ids data has come with request
+		var name string
+		for _, gid := range req.GroupIds {
+			// Convert to the K8S comply format
+			if req.RootGroupId == "" {
+				name = req.AppName + "-" + gid
+			} else {
+				name = req.AppName + "-" + req.RootGroupId + "-" + gid
+			}
+
+			names = append(names, strings.ToLower(strings.Replace(name, "_", "-", -1)))
+		}
+	}
+
+	for _, name := range names {
+		// Delete metadata
+		t, err := adapter.removeTemplateData(req.AppName, name, req.Version)
+		if err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		// Add metadata information
+		appTmplts.Templates = append(appTmplts.Templates, t)
+	}
+
+	// If cannot find templates for appropriate application instance
+	if len(appTmplts.Templates) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+	}
+
+	// Generate response
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application metadata successfully deleted", appTmplts)
+}
+
+// EnableDisableApp enables or disables application instances
+func (adapter *memoryAppMgrAdapter) EnableDisableApp(ctx context.Context, req *appmanager.EnableDisableAppRequest) (*appmanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	var state appmanager.AppStateAfterDeployment
+
+	if req.GetDisable() {
+		state = appmanager.AppStateAfterDeployment_disabled
+	} else {
+		state = appmanager.AppStateAfterDeployment_enabled
+	}
+
+	// Create empty body
+	apps := &appmanager.AppsActivation{}
+	apps.Apps = make(map[string]*appmanager.AffectedAppInstances)
+
+	now := time.Now()
+	for _, appName := range adapter.appNames() {
+		// Continue if the application name is no equal to the name in the request
+		if req.Name != "" && req.Name != appName {
+			continue
+		}
+
+		for _, i := range adapter.apps[appName].sortedInstances() {
+			if !matchInstance(i.data, req.Version, req.RootGroupId, req.GroupIds) {
+				continue
+			}
+
+			// Set application instance state
+			i.data.State = state
+			i.data.Annotations.Add(appmgrcommon.AppInstanceAnnotationState, fmt.Sprintf("%d", state))
+			i.updateDate = now
+
+			ai := &appmanager.AffectedAppInstance{}
+			ai.Name = i.data.InstanceName
+			ai.Id = i.data.Annotations.Get(appmgrcommon.AppInstanceAnnotationId)
+			ai.Version = i.data.RequestedVersion
+			ai.RootGroupId = i.data.Annotations.Get(appmgrcommon.AppInstanceAnnotationRootGroupId)
+			ai.GroupId = i.data.Annotations.Get(appmgrcommon.AppInstanceAnnotationGroupId)
+
+			if apps.Apps[appName] == nil {
+				apps.Apps[appName] = &appmanager.AffectedAppInstances{}
+			}
+
+			apps.Apps[appName].Instances = append(apps.Apps[appName].Instances, ai)
+		}
+	}
+
+	if len(apps.Apps) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "no application found", apps)
+	}
+
+	var msg string
+	if req.GetDisable() {
+		msg = "Application(s) disabled successfully"
+	} else {
+		msg = "Application(s) enabled successfully"
+	}
+
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, msg, apps)
+}
+
+func (adapter *memoryAppMgrAdapter) updateUpgradeApps(ctx context.Context, req appmgrcommon.CreateUpgradeUpdateRequester, reuseValues bool) (*appmanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	// Identify application type
+	cycle, err := appmgrcommon.AppTypeToAppCycle(req.GetCycle())
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Create the temporary data for the new applications
+	apps := appmgrcommon.NewAppsData()
+	apps.AppendNewAppInstances(req, cycle, req.GetDescription(), req.GetAppState())
+
+	running := adapter.apps[req.GetName()]
+
+	// If we don't have any information in request and
+	// there are no running applications - return with appropriate response
+	if apps.NewAppInstancesDataEmpty() && running == nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to update/upgrade", nil)
+	}
+
+	// Values can be reused only from the running instances
+	if reuseValues && running == nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to update/upgrade", nil)
+	}
+
+	// Changes are applied on a copy of the application so the running one stays untouched till the end
+	a := copyApp(running)
+	if a == nil {
+		a = &app{cycle: cycle, instances: make(map[string]*appInstance)}
+	}
+
+	// In case nothing has come with request (except the application name and version)
+	// apply the request on all running instances
+	if apps.NewAppInstancesDataEmpty() {
+		for _, i := range a.sortedInstances() {
+			d := copyInstanceData(i.data)
+			if req.GetVersion() != "" {
+				d.RequestedVersion = req.GetVersion()
+			}
+
+			apps.NewAppInstancesData = append(apps.NewAppInstancesData, d)
+		}
+	} else {
+		for _, d := range apps.NewAppInstancesData {
+			if i, ok := a.instances[d.InstanceName]; ok && req.GetVersion() == "" {
+				d.RequestedVersion = i.data.RequestedVersion
+			}
+		}
+	}
+
+	// The first running instance is a sample for the instances that are not deployed yet
+	var sample *appmgrcommon.AppInstanceData
+	if instances := a.sortedInstances(); len(instances) > 0 {
+		sample = instances[0].data
+	}
+
+	now := time.Now()
+	var applied []*appmgrcommon.AppInstanceData
+	var bkpAppInstances []*appmanager.AppInstance
+	var doneList []*appmanager.AppInstance
+
+	for _, newAppInstance := range apps.NewAppInstancesData {
+		existing, ok := a.instances[newAppInstance.InstanceName]
+		if ok {
+			newAppInstance.CurrentVersion = existing.data.RequestedVersion
+		}
+
+		var base *appmgrcommon.AppInstanceData
+		if reuseValues {
+			if ok {
+				base = existing.data
+			} else {
+				base = sample
+			}
+		}
+
+		data, err := adapter.prepareInstanceData(newAppInstance, req, base)
+		if err != nil {
+			// Nothing was applied by dry run
+			if req.GetDryRun() {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+
+			// Previous state of the running instances is still available
+			if len(bkpAppInstances) > 0 && viper.GetBool(appcommon.EnvApphcAppsRollbackEnabled) {
+				requestid.Logger(ctx).WithFields(logrus.Fields{"app": req.GetName()}).Info("Rolling back the application")
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, "upgrade failed, the application was rolled back to previous state",
+					&appmanager.App{Name: req.GetName(), Cycle: req.GetCycle(), Instances: bkpAppInstances})
+			}
+
+			// Nothing to roll back or rollback is disabled - keep the instances applied so far
+			if len(applied) > 0 {
+				adapter.apps[req.GetName()] = a
+				adapter.saveTemplates(req, applied)
+			}
+
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		if ok {
+			// Running instance is recreated if the version doesn't change or the upgrade policy says so
+			data.NextAction = appmgrcommon.AppInstanceDataNextActionUpgrade
+			if config.Current().UpgradePolicyRecreate || data.CurrentVersion == data.RequestedVersion {
+				data.NextAction = appmgrcommon.AppInstanceDataNextActionRecreate
+			}
+
+			bkpAppInstances = append(bkpAppInstances, generateProtoData(existing.data, catalogId))
+			existing.data = data
+			existing.updateDate = now
+		} else {
+			data.NextAction = appmgrcommon.AppInstanceDataNextActionCreate
+			a.instances[data.InstanceName] = &appInstance{data: data, createDate: now, updateDate: now}
+		}
+
+		applied = append(applied, data)
+		doneList = append(doneList, generateProtoData(data, catalogId))
+	}
+
+	// Instances are applied batch by batch according to the upgrade strategy
+	batches, err := appmgrcommon.UpgradeBatches(applied, appmgrcommon.GetUpgradeStrategy(req))
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Plan the changes instead of applying them
+	if req.GetDryRun() {
+		return adapter.plan(ctx, req.GetName(), req.GetCycle(), cycle, applied)
+	}
+
+	a.sharedStorage = req.GetSharedStorage()
+	if limits := req.GetSpec().GetResources().GetLimits(); limits != nil || !reuseValues {
+		a.limits = limits
+	}
+
+	adapter.apps[req.GetName()] = a
+	adapter.saveTemplates(req, applied)
+
+	for n, batch := range batches {
+		for _, data := range batch {
+			longrunning.Step(ctx, "instance %s ready", data.InstanceName)
+		}
+
+		if len(batches) > 1 {
+			longrunning.Step(ctx, "batch %d of %d healthy", n+1, len(batches))
+		}
+	}
+
+	// Generate response
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application updated/upgraded successfully",
+		&appmanager.App{Name: req.GetName(), Cycle: req.GetCycle(), Instances: doneList})
+}
+
+// prepareInstanceData sets the application instance data either from the request
+// or from the metadata kept in the catalog. The base data is reused if provided
+func (adapter *memoryAppMgrAdapter) prepareInstanceData(data *appmgrcommon.AppInstanceData,
+	req appmgrcommon.CreateUpgradeUpdateRequester, base *appmgrcommon.AppInstanceData) (*appmgrcommon.AppInstanceData, error) {
+
+	templateName := data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName)
+
+	// Use existing metadata
+	if req.GetFromCatalog() {
+		t := adapter.templates.get(req.GetName(), templateName, data.RequestedVersion)
+		if t == nil {
+			return nil, fmt.Errorf("version %s for template %s is invalid", data.RequestedVersion, templateName)
+		}
+
+		c := copyInstanceData(t)
+		c.State = data.State
+		c.CurrentVersion = data.CurrentVersion
+		c.Annotations.Add(appmgrcommon.AppInstanceAnnotationState, fmt.Sprintf("%d", c.State))
+		return c, nil
+	}
+
+	var size int
+	if base != nil {
+		reuseInstanceData(data, base)
+		size = data.InstanceStorageSize
+	}
+
+	if err := chartutils.SetChartData(data, req, "", nil); err != nil {
+		return nil, err
+	}
+
+	// Keep the instance storage if the size didn't come with request
+	if base != nil && req.GetSpec().GetResources().GetPersistentStorage() == 0 {
+		data.InstanceStorageSize = size
+		data.Annotations.Add(appmgrcommon.AppInstanceAnnotationPersistentVolumeSize, strconv.Itoa(size))
+	}
+
+	data.Annotations.Add(appmgrcommon.AppInstanceAnnotationState, fmt.Sprintf("%d", data.State))
+
+	return data, nil
+}
+
+// plan generates the response to the dry run request. The values of the application instances
+// are compared with the ones of the templates the instances are running
+func (adapter *memoryAppMgrAdapter) plan(ctx context.Context, appName, appCycle, chartType string,
+	instances []*appmgrcommon.AppInstanceData) (*appmanager.Response, error) {
+
+	plan := &appmanager.Plan{Name: appName, Cycle: appCycle}
+	for _, data := range instances {
+		// The instance left as it is has nothing to render
+		if data.NextAction == appmgrcommon.AppInstanceDataNextActionNone {
+			plan.Instances = append(plan.Instances, chartutils.NewInstancePlan(data, nil, nil))
+			continue
+		}
+
+		var lastGoodValues []byte
+		if data.CurrentVersion != "" {
+			t := adapter.templates.get(appName, data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName), data.CurrentVersion)
+			if t != nil {
+				lastGoodValues = chartutils.RenderValues(chartType, t)
+			}
+		}
+
+		plan.Instances = append(plan.Instances, chartutils.NewInstancePlan(data, chartutils.RenderValues(chartType, data), lastGoodValues))
+	}
+
+	return appmgrcommon.GeneratePlanResponse(ctx, plan)
+}
+
+// saveTemplates keeps metadata of the application instances unless they were deployed from the catalog
+func (adapter *memoryAppMgrAdapter) saveTemplates(req appmgrcommon.CreateUpgradeUpdateRequester, instances []*appmgrcommon.AppInstanceData) {
+	if req.GetFromCatalog() {
+		return
+	}
+
+	for _, data := range instances {
+		adapter.templates.add(req.GetName(), data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName),
+			data.RequestedVersion, data)
+	}
+}
+
+// deleteInstances deletes application instances and optionally their metadata.
+// The application is removed as soon as all its instances are gone
+func (adapter *memoryAppMgrAdapter) deleteInstances(appName string, instances []*appInstance, purge bool) []*appmanager.AppInstance {
+	var doneList []*appmanager.AppInstance
+
+	a := adapter.apps[appName]
+	for _, i := range instances {
+		delete(a.instances, i.data.InstanceName)
+
+		// If need to remove metadata
+		if purge {
+			adapter.templates.remove(appName, i.data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName),
+				i.data.RequestedVersion)
+			doneList = append(doneList, generateProtoData(i.data, catalogId))
+		} else {
+			doneList = append(doneList, generateProtoData(i.data, ""))
+		}
+	}
+
+	if len(a.instances) == 0 {
+		delete(adapter.apps, appName)
+
+		// Remove the application metadata once all the instances are gone
+		if purge {
+			delete(adapter.templates, appName)
+		}
+	}
+
+	return doneList
+}
+
+// removeTemplateData removes application instance metadata
+func (adapter *memoryAppMgrAdapter) removeTemplateData(appName, instanceName, version string) (*appmanager.Template, error) {
+	t := &appmanager.Template{}
+
+	for _, v := range adapter.templates.versions(appName, instanceName) {
+		// Remove all versions if the "version" field is empty
+		if version == "" || version == v {
+			adapter.templates.remove(appName, instanceName, v)
+			t.Versions = append(t.Versions, v)
+		}
+	}
+
+	// Metadata not found
+	if len(t.Versions) == 0 {
+		return nil, fmt.Errorf("no metadata found for the instance %s version %s", instanceName, version)
+	}
+
+	t.CatalogId = catalogId
+	t.Name = instanceName
+	return t, nil
+}
+
+// appNames gives back sorted names of the deployed applications
+func (adapter *memoryAppMgrAdapter) appNames() []string {
+	var names []string
+	for name := range adapter.apps {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// getAppInfo provides information about particular application
+func getAppInfo(a *app) (*appmanager.AppInfo, error) {
+	ai := &appmanager.AppInfo{}
+
+	if a.cycle == appmgrcommon.TypePeriodic {
+		for _, i := range a.sortedInstances() {
+			if sched := i.data.Annotations.Get(appmgrcommon.AppAnnotationSchedule); sched != "" {
+				f, err := appmgrcommon.CronStringToCyclePeriodicRespAttr(sched)
+				if err != nil {
+					return nil, err
+				}
+
+				ai.CyclePeriodicFields = f
+				break
+			}
+		}
+	}
+
+	ai.TotalResources = &appmanager.Resources{}
+	ai.TotalResources.Requests = &appmanager.Resources_Requests{}
+	ai.TotalResources.Limits = &appmanager.Resources_Limits{}
+	ai.SharedStorage = a.sharedStorage
+
+	return ai, nil
+}
+
+// getInstanceData provides information about particular instance
+func (adapter *memoryAppMgrAdapter) getInstanceData(appName string, a *app, i *appInstance, verbose bool) *appmanager.Instance {
+	data := i.data
+
+	ai := &appmanager.Instance{}
+	ai.Name = data.InstanceName
+	ai.Id = data.Annotations.Get(appmgrcommon.AppInstanceAnnotationId)
+	ai.Version = data.RequestedVersion
+	ai.RootGroupId = data.Annotations.Get(appmgrcommon.AppInstanceAnnotationRootGroupId)
+	ai.GroupId = data.Annotations.Get(appmgrcommon.AppInstanceAnnotationGroupId)
+	ai.ImageRepo = data.Annotations.Get(appmgrcommon.AppInstanceAnnotationImageRepoName)
+	ai.ImageName = data.Annotations.Get(appmgrcommon.AppInstanceAnnotationImageName)
+	ai.ImageTag = data.Annotations.Get(appmgrcommon.AppInstanceAnnotationImageTag)
+	ai.Cycle = a.cycle
+	ai.Namespace = data.TargetNamespace
+	ai.CreateDate = i.createDate.UTC().Format(time.RFC3339)
+	ai.UpdateDate = i.updateDate.UTC().Format(time.RFC3339)
+
+	ai.Resources = &appmanager.Resources{}
+	ai.Resources.Requests = &appmanager.Resources_Requests{}
+	ai.Resources.Requests.Cpu = appmgrcommon.AppInstanceDefaultCpuRequestFloat64
+	ai.Resources.Requests.Memory = appmgrcommon.AppInstanceDefaultMemoryRequestUint32
+	ai.Resources.Limits = &appmanager.Resources_Limits{}
+	ai.Resources.Limits.Cpu = a.limits.GetCpu()
+	ai.Resources.Limits.Memory = a.limits.GetMemory()
+	if data.InstanceStorageSize > 0 {
+		ai.Resources.PersistentStorage = uint32(data.InstanceStorageSize)
+	}
+
+	// Disabled application instances do not have workloads
+	ai.State = "disabled"
+	ai.Scale = "-"
+
+	if data.State == appmanager.AppStateAfterDeployment_enabled {
+		switch a.cycle {
+		case appmgrcommon.TypePeriodic:
+			s := &appmanager.Instance_PeriodicFields{}
+			s.PeriodicFields = &appmanager.PeriodicFields{}
+			s.PeriodicFields.Schedule = data.CyclePeriodicSched
+			ai.CycleFields = s
+			ai.State = "active"
+
+		case appmgrcommon.TypeRunOnce:
+			s := &appmanager.Instance_RunOnceFields{}
+			s.RunOnceFields = &appmanager.RunOnceFields{}
+			s.RunOnceFields.Completions = 1
+			s.RunOnceFields.Succeeded = 1
+			s.RunOnceFields.StartTime = ai.UpdateDate
+			s.RunOnceFields.CompletionTime = ai.UpdateDate
+			ai.CycleFields = s
+			ai.State = "succeeded"
+
+		default:
+			// The autoscaler keeps the minimum number of replicas while idle
+			replicas := data.Replicas
+			if data.Autoscaling != nil {
+				replicas = data.Autoscaling.MinReplicas
+			} else if replicas == 0 {
+				replicas = 1
+			}
+
+			ai.State = "active"
+			ai.Scale = strconv.Itoa(replicas)
+			ai.DesiredReplicas = uint32(replicas)
+			ai.ReadyReplicas = uint32(replicas)
+			ai.AvailableReplicas = uint32(replicas)
+		}
+	}
+
+	// Print only if "verbose" flag was provided
+	if verbose {
+		c := &appmanager.Instance_Container{}
+		c.Name = data.InstanceName
+		c.State = ai.State
+		if data.Image != nil {
+			c.Image = data.Image.Repository + ":" + data.Image.Tag
+		}
+
+		for _, port := range data.Ports {
+			p := &appmanager.Instance_Container_Port{}
+			p.Name = port.Name
+			p.Proto = port.Proto
+			p.Port = int64(port.Number)
+			c.Ports = append(c.Ports, p)
+		}
+
+		ai.Containers = append(ai.Containers, c)
+
+		ai.Template = &appmanager.Template{}
+		ai.Template.Name = ai.Name
+		ai.Template.CatalogId = catalogId
+		ai.Template.Versions = adapter.templates.versions(appName, data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName))
+	}
+
+	return ai
+}
+
+// generateProtoData generates application properties that will be part of response
+func generateProtoData(app *appmgrcommon.AppInstanceData, catalogId string) *appmanager.AppInstance {
+	a := &appmanager.AppInstance{}
+
+	// Application instance name
+	a.Name = app.InstanceName
+
+	// Application ID: application name + RootGroupID + GroupID fetched from request
+	a.Id = app.Annotations.Get(appmgrcommon.AppInstanceAnnotationId)
+
+	// Application instance version
+	a.Version = app.RequestedVersion
+
+	// Root Group ID
+	a.RootGroupId = app.Annotations.Get(appmgrcommon.AppInstanceAnnotationRootGroupId)
+
+	// Group ID
+	a.GroupId = app.Annotations.Get(appmgrcommon.AppInstanceAnnotationGroupId)
+
+	// If catalog ID available
+	if catalogId != "" {
+		a.Template = &appmanager.Template{}
+
+		// Instance chart name
+		a.Template.Name = app.InstanceName
+
+		// Available versions for a particular metadata
+		a.Template.Versions = append(a.Template.Versions, app.Annotations.Get(appmgrcommon.AppInstanceAnnotationVersion))
+
+		// Catalog ID
+		a.Template.CatalogId = catalogId
+	}
+	return a
+}
@@ -0,0 +1,281 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/viper"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	appcommon "cisco.com/son/apphcd/app/common"
+)
+
+func TestAppLifecycle(t *testing.T) {
+	viper.Set(appcommon.EnvApphcAppsRollbackEnabled, true)
+
+	adapter := NewAdapter()
+
+	create := &appmanager.CreateAppRequest{
+		Name:     "foo",
+		Version:  "1.0.0",
+		Cycle:    "daemon",
+		GroupIds: []string{"g1", "g2"},
+		AppState: appmanager.AppStateAfterDeployment_enabled,
+		Spec:     &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "foo/bar", Tag: "1"}},
+	}
+
+	resp, _ := adapter.CreateApp(context.Background(), create)
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("create: %s", resp.Message)
+	}
+
+	resp, _ = adapter.CreateApp(context.Background(), create)
+	if resp.Status != appmanager.Status_UNCHANGED {
+		t.Fatalf("expected the application to be unchanged, got %s", resp.Status)
+	}
+
+	enabled := appmanager.AppStateAfterDeployment_enabled
+
+	resp, _ = adapter.UpgradeApp(context.Background(), &appmanager.UpgradeAppRequest{Name: "foo", Version: "1.1.0", Cycle: "daemon",
+		GroupIds: []string{"g1"}, AppState: enabled, Spec: &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "foo/bar", Tag: "2"}}})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("upgrade: %s", resp.Message)
+	}
+
+	resp, _ = adapter.UpgradeApp(context.Background(), &appmanager.UpgradeAppRequest{Name: "foo", Version: "1.0.0", Cycle: "daemon",
+		AppState: enabled, FromCatalog: true})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("upgrade from catalog: %s", resp.Message)
+	}
+
+	// The second instance has no metadata for the requested version
+	resp, _ = adapter.UpgradeApp(context.Background(), &appmanager.UpgradeAppRequest{Name: "foo", Version: "1.1.0", Cycle: "daemon",
+		AppState: enabled, FromCatalog: true})
+	if resp.Status != appmanager.Status_ERROR || resp.Message != "upgrade failed, the application was rolled back to previous state" {
+		t.Fatalf("unexpected response to the invalid version: %s", resp.Message)
+	}
+
+	resp, _ = adapter.UpgradeApp(context.Background(), &appmanager.UpgradeAppRequest{Name: "foo", Version: "2.0.0", Cycle: "daemon",
+		AppState: enabled, FromCatalog: true})
+	if resp.Status != appmanager.Status_ERROR || resp.Message != "version 2.0.0 for template foo-g1 is invalid" {
+		t.Fatalf("unexpected response to the invalid version: %s", resp.Message)
+	}
+
+	resp, _ = adapter.EnableDisableApp(context.Background(), &appmanager.EnableDisableAppRequest{Name: "foo", GroupIds: []string{"g2"}, Disable: true})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("disable: %s", resp.Message)
+	}
+
+	resp, _ = adapter.GetApps(context.Background(), &appmanager.GetAppsRequest{Name: "foo"})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("get: %s", resp.Message)
+	}
+
+	apps := &appmanager.AppsInfo{}
+	if err := ptypes.UnmarshalAny(resp.Body, apps); err != nil {
+		t.Fatal(err)
+	}
+
+	instances := apps.Apps["foo"].Instances
+	if len(instances) != 2 || instances[0].State != "active" || instances[1].State != "disabled" ||
+		instances[0].ImageTag != "1" || instances[0].Version != "1.0.0" {
+		t.Fatalf("unexpected instances: %v", instances)
+	}
+
+	resp, _ = adapter.DeleteApp(context.Background(), &appmanager.DeleteAppRequest{Name: "foo", Purge: true})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("delete: %s", resp.Message)
+	}
+
+	resp, _ = adapter.GetApps(context.Background(), &appmanager.GetAppsRequest{})
+	if resp.Status != appmanager.Status_NOT_FOUND {
+		t.Fatalf("expected no applications, got %s", resp.Status)
+	}
+
+	resp, _ = adapter.DeleteAppMetadata(context.Background(), &appmanager.DeleteAppMetadataRequest{AppName: "foo"})
+	if resp.Status != appmanager.Status_NOT_FOUND {
+		t.Fatalf("expected no metadata, got %s", resp.Status)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	adapter := NewAdapter()
+
+	create := &appmanager.CreateAppRequest{
+		Name:     "foo",
+		Version:  "1.0.0",
+		Cycle:    "daemon",
+		GroupIds: []string{"g1"},
+		Spec:     &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "foo/bar", Tag: "1"}},
+		DryRun:   true,
+	}
+
+	resp, _ := adapter.CreateApp(context.Background(), create)
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("create: %s", resp.Message)
+	}
+
+	plan := &appmanager.Plan{}
+	if err := ptypes.UnmarshalAny(resp.Body, plan); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plan.Instances) != 1 || plan.Instances[0].Action != appmanager.PlannedAction_CREATE ||
+		!strings.HasPrefix(plan.Instances[0].Diff, "--- /dev/null\n") {
+		t.Fatalf("unexpected plan: %v", plan)
+	}
+
+	// Nothing was deployed
+	resp, _ = adapter.GetApps(context.Background(), &appmanager.GetAppsRequest{})
+	if resp.Status != appmanager.Status_NOT_FOUND {
+		t.Fatalf("expected no applications, got %s", resp.Status)
+	}
+
+	create.DryRun = false
+	if resp, _ = adapter.CreateApp(context.Background(), create); resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("create: %s", resp.Message)
+	}
+
+	resp, _ = adapter.UpgradeApp(context.Background(), &appmanager.UpgradeAppRequest{Name: "foo", Version: "1.1.0", Cycle: "daemon",
+		GroupIds: []string{"g1"}, Spec: &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "foo/bar", Tag: "2"}}, DryRun: true})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("upgrade: %s", resp.Message)
+	}
+
+	plan = &appmanager.Plan{}
+	if err := ptypes.UnmarshalAny(resp.Body, plan); err != nil {
+		t.Fatal(err)
+	}
+
+	i := plan.Instances[0]
+	if i.Action != appmanager.PlannedAction_UPGRADE || i.CurrentVersion != "1.0.0" || i.Version != "1.1.0" ||
+		!strings.Contains(i.Diff, "-  tag: '1'\n+  tag: '2'\n") {
+		t.Fatalf("unexpected plan: %v", i)
+	}
+
+	// The running instance is left as it is
+	create.DryRun = true
+	if resp, _ = adapter.CreateApp(context.Background(), create); resp.Status != appmanager.Status_UNCHANGED {
+		t.Fatalf("expected the application to be unchanged, got %s", resp.Status)
+	}
+
+	resp, _ = adapter.GetApps(context.Background(), &appmanager.GetAppsRequest{Name: "foo"})
+	apps := &appmanager.AppsInfo{}
+	if err := ptypes.UnmarshalAny(resp.Body, apps); err != nil {
+		t.Fatal(err)
+	}
+
+	if instances := apps.Apps["foo"].Instances; len(instances) != 1 || instances[0].Version != "1.0.0" {
+		t.Fatalf("unexpected instances: %v", instances)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	adapter := NewAdapter()
+
+	resp, _ := adapter.CreateApp(context.Background(), &appmanager.CreateAppRequest{Name: "foo", Version: "1.0.0", Cycle: "daemon",
+		GroupIds: []string{"g1", "g2"}, Spec: &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "foo/bar", Tag: "1"}}})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("create: %s", resp.Message)
+	}
+
+	resp, _ = adapter.UpgradeApp(context.Background(), &appmanager.UpgradeAppRequest{Name: "foo", Version: "1.1.0", Cycle: "daemon",
+		Spec: &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "foo/bar", Tag: "2"}}})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("upgrade: %s", resp.Message)
+	}
+
+	resp, _ = adapter.RollbackApp(context.Background(), &appmanager.RollbackAppRequest{Name: "foo", Version: "1.0.0", GroupIds: []string{"g1"}})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("rollback: %s", resp.Message)
+	}
+
+	// Only the instance of the group is rolled back
+	resp, _ = adapter.GetApps(context.Background(), &appmanager.GetAppsRequest{Name: "foo"})
+	apps := &appmanager.AppsInfo{}
+	if err := ptypes.UnmarshalAny(resp.Body, apps); err != nil {
+		t.Fatal(err)
+	}
+
+	versions := make(map[string]int)
+	for _, i := range apps.Apps["foo"].Instances {
+		versions[i.Version]++
+	}
+	if versions["1.0.0"] != 1 || versions["1.1.0"] != 1 {
+		t.Fatalf("unexpected versions: %v", versions)
+	}
+
+	resp, _ = adapter.RollbackApp(context.Background(), &appmanager.RollbackAppRequest{Name: "foo", Version: "1.0.0", GroupIds: []string{"g1"}})
+	if resp.Status != appmanager.Status_UNCHANGED {
+		t.Fatalf("expected the application to be unchanged, got %s", resp.Status)
+	}
+
+	resp, _ = adapter.RollbackApp(context.Background(), &appmanager.RollbackAppRequest{Name: "foo", Version: "0.9.0"})
+	if resp.Status != appmanager.Status_ERROR {
+		t.Fatalf("expected unknown version to fail, got %s", resp.Status)
+	}
+
+	resp, _ = adapter.RollbackApp(context.Background(), &appmanager.RollbackAppRequest{Name: "bar", Version: "1.0.0"})
+	if resp.Status != appmanager.Status_NOT_FOUND {
+		t.Fatalf("expected %s, got %s", appmanager.Status_NOT_FOUND, resp.Status)
+	}
+}
+
+func TestScale(t *testing.T) {
+	adapter := NewAdapter()
+
+	resp, _ := adapter.CreateApp(context.Background(), &appmanager.CreateAppRequest{Name: "foo", Version: "1.0.0", Cycle: "daemon",
+		GroupIds: []string{"g1", "g2"}, AppState: appmanager.AppStateAfterDeployment_enabled,
+		Spec: &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "foo/bar", Tag: "1"}, Replicas: 2}})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("create: %s", resp.Message)
+	}
+
+	resp, _ = adapter.ScaleApp(context.Background(), &appmanager.ScaleAppRequest{Name: "foo", Replicas: 3, GroupIds: []string{"g1"}})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("scale: %s", resp.Message)
+	}
+
+	replicas := func() map[string]uint32 {
+		resp, _ := adapter.GetApps(context.Background(), &appmanager.GetAppsRequest{Name: "foo"})
+		apps := &appmanager.AppsInfo{}
+		if err := ptypes.UnmarshalAny(resp.Body, apps); err != nil {
+			t.Fatal(err)
+		}
+
+		m := make(map[string]uint32)
+		for _, i := range apps.Apps["foo"].Instances {
+			m[i.GroupId] = i.DesiredReplicas
+		}
+
+		return m
+	}
+
+	if r := replicas(); r["g1"] != 3 || r["g2"] != 2 {
+		t.Fatalf("unexpected replicas: %v", r)
+	}
+
+	// The autoscaler owns the replicas of the instance
+	resp, _ = adapter.UpdateApp(context.Background(), &appmanager.UpdateAppRequest{Name: "foo", Cycle: "daemon", GroupIds: []string{"g2"},
+		AppState: appmanager.AppStateAfterDeployment_enabled, Spec: &appmanager.Spec{
+			Autoscaling: &appmanager.Spec_Autoscaling{MinReplicas: 4, MaxReplicas: 8, TargetCpuUtilization: 70}}})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("update: %s", resp.Message)
+	}
+
+	if r := replicas(); r["g2"] != 4 {
+		t.Fatalf("unexpected replicas: %v", r)
+	}
+
+	resp, _ = adapter.ScaleApp(context.Background(), &appmanager.ScaleAppRequest{Name: "foo", Replicas: 1})
+	if resp.Status != appmanager.Status_ERROR {
+		t.Fatalf("expected the autoscaled instance to fail, got %s", resp.Status)
+	}
+
+	resp, _ = adapter.ScaleApp(context.Background(), &appmanager.ScaleAppRequest{Name: "foo", Replicas: 1, Version: "2.0.0"})
+	if resp.Status != appmanager.Status_NOT_FOUND {
+		t.Fatalf("expected %s, got %s", appmanager.Status_NOT_FOUND, resp.Status)
+	}
+}
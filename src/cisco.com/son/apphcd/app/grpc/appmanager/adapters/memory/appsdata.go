@@ -0,0 +1,193 @@
+// Author  <dorzheho@cisco.com>
+
+package memory
+
+import (
+	"sort"
+	"time"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	appcommon "cisco.com/son/apphcd/app/common"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+// appInstance is a deployed application instance
+type appInstance struct {
+	data       *appmgrcommon.AppInstanceData // Instance data
+	createDate time.Time                     // Time the instance was deployed
+	updateDate time.Time                     // Time the instance was updated or upgraded
+}
+
+// app is a deployed application
+type app struct {
+	cycle         string                            // Application cycle
+	sharedStorage uint32                            // Shared storage size in GiB
+	limits        *appmanager.Spec_Resources_Limits // Resources limits of every instance
+	instances     map[string]*appInstance           // Instances by name
+}
+
+// templates keeps application instances metadata: application name -> template name -> version
+type templates map[string]map[string]map[string]*appmgrcommon.AppInstanceData
+
+// get gives back metadata for appropriate version of the template
+func (t templates) get(appName, templateName, version string) *appmgrcommon.AppInstanceData {
+	return t[appName][templateName][version]
+}
+
+// add stores metadata for appropriate version of the template
+func (t templates) add(appName, templateName, version string, data *appmgrcommon.AppInstanceData) {
+	if t[appName] == nil {
+		t[appName] = make(map[string]map[string]*appmgrcommon.AppInstanceData)
+	}
+
+	if t[appName][templateName] == nil {
+		t[appName][templateName] = make(map[string]*appmgrcommon.AppInstanceData)
+	}
+
+	t[appName][templateName][version] = copyInstanceData(data)
+}
+
+// remove deletes metadata for appropriate version of the template
+func (t templates) remove(appName, templateName, version string) {
+	delete(t[appName][templateName], version)
+
+	if len(t[appName][templateName]) == 0 {
+		delete(t[appName], templateName)
+	}
+
+	if len(t[appName]) == 0 {
+		delete(t, appName)
+	}
+}
+
+// versions gives back sorted list of the template versions
+func (t templates) versions(appName, templateName string) []string {
+	var versions []string
+	for v := range t[appName][templateName] {
+		versions = append(versions, v)
+	}
+
+	sort.Strings(versions)
+	return versions
+}
+
+// copyApp creates a deep copy of the application
+func copyApp(a *app) *app {
+	if a == nil {
+		return nil
+	}
+
+	c := &app{cycle: a.cycle, sharedStorage: a.sharedStorage, limits: a.limits}
+	c.instances = make(map[string]*appInstance)
+	for name, i := range a.instances {
+		c.instances[name] = &appInstance{data: copyInstanceData(i.data), createDate: i.createDate, updateDate: i.updateDate}
+	}
+
+	return c
+}
+
+// sortedInstances gives back the application instances sorted by name
+func (a *app) sortedInstances() []*appInstance {
+	var names []string
+	for name := range a.instances {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var instances []*appInstance
+	for _, name := range names {
+		instances = append(instances, a.instances[name])
+	}
+
+	return instances
+}
+
+// copyInstanceData creates a deep copy of the application instance data
+func copyInstanceData(d *appmgrcommon.AppInstanceData) *appmgrcommon.AppInstanceData {
+	c := *d
+	c.Annotations = copyMap(d.Annotations)
+	c.Labels = copyMap(d.Labels)
+	c.AppConfigs = copyMap(d.AppConfigs)
+	c.EnvVars = copyMap(d.EnvVars)
+	c.Secrets = copyMap(d.Secrets)
+
+	if d.Image != nil {
+		image := *d.Image
+		c.Image = &image
+	}
+
+	if d.Autoscaling != nil {
+		autoscaling := *d.Autoscaling
+		c.Autoscaling = &autoscaling
+	}
+
+	c.Ports = nil
+	for _, p := range d.Ports {
+		port := *p
+		c.Ports = append(c.Ports, &port)
+	}
+
+	return &c
+}
+
+// copyMap creates a copy of the map
+func copyMap(m appcommon.Map) appcommon.Map {
+	if m == nil {
+		return nil
+	}
+
+	c := appcommon.MakeMap()
+	appcommon.MapMerge(c, m)
+	return c
+}
+
+// reuseInstanceData copies configuration of the running application instance to the new data
+func reuseInstanceData(dst, src *appmgrcommon.AppInstanceData) {
+	s := copyInstanceData(src)
+	dst.Image = s.Image
+	dst.AppConfigs = s.AppConfigs
+	dst.EnvVars = s.EnvVars
+	dst.Secrets = s.Secrets
+	dst.Ports = s.Ports
+	dst.CyclePeriodicSched = s.CyclePeriodicSched
+	dst.State = s.State
+	dst.InstanceStorageSize = s.InstanceStorageSize
+	dst.Replicas = s.Replicas
+	dst.Autoscaling = s.Autoscaling
+
+	for _, k := range []string{appmgrcommon.AppInstanceAnnotationImageRepoName, appmgrcommon.AppInstanceAnnotationImageName,
+		appmgrcommon.AppInstanceAnnotationImageTag} {
+		if v := s.Annotations.Get(k); v != "" && !dst.Annotations.KeyHasValue(k) {
+			dst.Annotations.Add(k, v)
+		}
+	}
+
+	if dst.Description == "" {
+		dst.Description = s.Description
+	}
+}
+
+// matchInstance tells whether the application instance matches the request filters
+func matchInstance(data *appmgrcommon.AppInstanceData, version, rootGroupId string, groupIds []string) bool {
+	if version != "" && version != data.Annotations.Get(appmgrcommon.AppInstanceAnnotationVersion) {
+		return false
+	}
+
+	if rootGroupId != "" && rootGroupId != data.Annotations.Get(appmgrcommon.AppInstanceAnnotationRootGroupId) {
+		return false
+	}
+
+	if len(groupIds) == 0 {
+		return true
+	}
+
+	groupId := data.Annotations.Get(appmgrcommon.AppInstanceAnnotationGroupId)
+	for _, gid := range groupIds {
+		if gid == groupId {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,1002 @@
+// Author  <dorzheho@cisco.com>
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"k8s.io/client-go/kubernetes"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/config"
+	"cisco.com/son/apphcd/app/common/longrunning"
+	"cisco.com/son/apphcd/app/common/requestid"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+	"cisco.com/son/apphcd/app/grpc/appmanager/common/chartutils"
+	"cisco.com/son/apphcd/app/grpc/common/resourcemgr"
+)
+
+const (
+	recreateErrorPrefix = "cannot recreate the application instance"
+	timeOutErrorPrefix  = "timed out waiting for pods readiness"
+
+	// Charts created by the adapter are kept in the local applications repository
+	catalogId = appmgrcommon.CatalogAppsRepo
+)
+
+type nativeAppMgrAdapter struct {
+	kc kubernetes.Interface
+}
+
+// Initialize a new native Kubernetes Adapter:
+// - create cache
+// - verify application chart templates availability
+func NewAdapter(kubeClient kubernetes.Interface) (*nativeAppMgrAdapter, error) {
+	// Set cache path
+	path, err := os.Stat(viper.GetString(appcommon.EnvApphcCachePath))
+	if os.IsNotExist(err) {
+		if err := os.MkdirAll(viper.GetString(appcommon.EnvApphcCachePath), os.FileMode(0755)); err != nil {
+			return nil, err
+		}
+	} else if !path.IsDir() {
+		return nil, fmt.Errorf("path %s exists but is not directory", path.Name())
+	}
+
+	// Create applications repository
+	if err := os.MkdirAll(appsRepoPath(), os.FileMode(0755)); err != nil {
+		return nil, err
+	}
+
+	// Check whether chart templates for all the application types available
+	for _, chartType := range []string{appmgrcommon.TypeDaemon, appmgrcommon.TypePeriodic, appmgrcommon.TypeRunOnce} {
+		if _, err := os.Stat(chartTemplatePath(chartType)); err != nil {
+			return nil, fmt.Errorf("cannot find chart template for the application type %s: %s", chartType, err.Error())
+		}
+	}
+
+	// Return native AppManager adapter
+	return &nativeAppMgrAdapter{kc: kubeClient}, nil
+}
+
+// Create an application and related instances
+func (adapter *nativeAppMgrAdapter) CreateApp(ctx context.Context, req *appmanager.CreateAppRequest) (*appmanager.Response, error) {
+	// Construct Application temporary data
+	apps := newAppsData()
+	if err := apps.appendRunningAppsData(adapter.kc, req, req.Cycle); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Identify application type
+	chartType, err := appmgrcommon.AppTypeToAppCycle(req.Cycle)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Create the temporary data for the new applications
+	apps.AppendNewAppInstances(req, chartType, req.Description, req.AppState)
+
+	// Check if the requested application name and version already exist
+	if apps.NewAppInstancesDataEmpty() {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to deploy", nil)
+	}
+
+	namespace := apps.NewAppInstancesData[0].TargetNamespace
+	if !req.DryRun {
+		if err := createNamespace(ctx, adapter.kc, namespace); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		// If shared storage requested
+		if req.SharedStorage > 0 {
+			if err := createSharedStorage(ctx, adapter.kc, req.Name, namespace, int(req.SharedStorage)); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+		}
+	}
+
+	// Check if the requested application instances already exist
+	runAppInstances := apps.GetRunningAppData(req.Name)
+
+	for _, newAppInstance := range apps.NewAppInstancesData {
+		// Check if appropriate chart available
+		newAppInstance.TemplateAvailable = chartAvailable(req.Name, appcommon.MapGet(newAppInstance.Annotations,
+			appmgrcommon.AppInstanceAnnotationTemplateName), newAppInstance.RequestedVersion)
+
+		// We do not allow to spin up multiple versions of a particular application instance
+		for _, runAppInstance := range runAppInstances {
+			if newAppInstance.InstanceName == runAppInstance.InstanceName {
+				newAppInstance.NextAction = appmgrcommon.AppInstanceDataNextActionNone
+				newAppInstance.TemplateAvailable = true
+				break
+			}
+		}
+	}
+
+	limits := req.GetSpec().GetResources().GetLimits()
+
+	if limits != nil {
+		if err := checkAvailableResources(ctx, adapter.kc, apps.GetNumberOfNewInstances(), req.Spec.Resources.Limits); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+	}
+
+	// if from_catalog property is not set or set to "false"
+	// create a new chart for the application instance
+	if !req.FromCatalog {
+		for _, newAppInstance := range apps.NewAppInstancesData {
+			if !newAppInstance.TemplateAvailable {
+				dstChart := chartPath(req.Name, appcommon.MapGet(newAppInstance.Annotations,
+					appmgrcommon.AppInstanceAnnotationTemplateName), newAppInstance.RequestedVersion)
+				if err := chartutils.SetChartData(newAppInstance, req, dstChart, nil); err != nil {
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+				}
+				if req.DryRun {
+					continue
+				}
+				if err := chartutils.CreateChart(ctx, chartTemplatePath(chartType), dstChart, chartType, req.Name, newAppInstance); err != nil {
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+				}
+				longrunning.Step(ctx, "chart created for instance %s", newAppInstance.InstanceName)
+				newAppInstance.TemplateAvailable = true
+			}
+		}
+	}
+
+	// Plan the changes instead of applying them
+	if req.DryRun {
+		plan := &appmanager.Plan{Name: req.Name, Cycle: req.Cycle}
+		for _, newAppInstance := range apps.NewAppInstancesData {
+			instancePlan, err := chartutils.PlanInstance(newAppInstance, chartType, filepath.Join(appsRepoPath(), req.Name),
+				req.FromCatalog || newAppInstance.TemplateAvailable)
+			if err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+			plan.Instances = append(plan.Instances, instancePlan)
+		}
+
+		return appmgrcommon.GeneratePlanResponse(ctx, plan)
+	}
+
+	if limits == nil {
+		if err := resourcemgr.DeleteLimitRange(ctx, adapter.kc, namespace); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+	} else {
+
+		if err := resourcemgr.CreateUpdateLimitRange(ctx, adapter.kc, namespace, req.GetSpec().GetResources().GetLimits().GetCpu(),
+			req.GetSpec().GetResources().GetLimits().GetMemory(), appmgrcommon.AppInstanceDefaultCpuRequest,
+			appmgrcommon.AppInstanceDefaultMemoryRequest); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+	}
+
+	// Create application instance
+	doneList, err := createUpgradeApps(ctx, adapter.kc, apps.NewAppInstancesData, catalogId)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// If nothing is added print appropriate message
+	if len(doneList) == 0 {
+		err := fmt.Errorf("application %s already deployed", req.Name)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_UNCHANGED, err.Error(), nil)
+	}
+
+	var msg string
+	if req.GetAppState() == appmanager.AppStateAfterDeployment_enabled {
+		msg = "Application deployed successfully"
+	} else {
+		msg = "Application deployed successfully but disabled"
+	}
+	// Generate response
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, msg, &appmanager.App{Name: req.Name,
+		Cycle: req.Cycle, Instances: doneList})
+}
+
+// Upgrade running application instance
+func (adapter *nativeAppMgrAdapter) UpgradeApp(ctx context.Context, req *appmanager.UpgradeAppRequest) (*appmanager.Response, error) {
+	return adapter.updateUpgradeApps(ctx, req, false)
+}
+
+// Update running application instance
+func (adapter *nativeAppMgrAdapter) UpdateApp(ctx context.Context, req *appmanager.UpdateAppRequest) (*appmanager.Response, error) {
+	return adapter.updateUpgradeApps(ctx, req, true)
+}
+
+// Roll back running application instances to the chart version kept in the local charts repository
+func (adapter *nativeAppMgrAdapter) RollbackApp(ctx context.Context, req *appmanager.RollbackAppRequest) (*appmanager.Response, error) {
+	// The local charts repository has no history
+	if req.Revision != "" {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR,
+			"rolling back to a catalog revision is not supported by the native adapter", nil)
+	}
+
+	apps := newAppsData()
+	if err := apps.appendRunningAppsData(adapter.kc, req, ""); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	instances := apps.GetRunningAppData(req.Name)
+	if len(instances) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to roll back", nil)
+	}
+
+	for _, instance := range instances {
+		// Instances already running the requested chart are left as they are
+		if instance.RequestedVersion == instance.CurrentVersion {
+			instance.NextAction = appmgrcommon.AppInstanceDataNextActionNone
+			continue
+		}
+
+		templateName := instance.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName)
+		if !chartAvailable(req.Name, templateName, instance.RequestedVersion) {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR,
+				fmt.Sprintf("version %s for template %s is invalid", instance.RequestedVersion, templateName), nil)
+		}
+
+		if config.Current().UpgradePolicyRecreate {
+			instance.NextAction = appmgrcommon.AppInstanceDataNextActionRecreate
+		}
+	}
+
+	doneList, err := createUpgradeApps(ctx, adapter.kc, instances, catalogId)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	if len(doneList) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_UNCHANGED,
+			fmt.Sprintf("Application %s already runs version %s", req.Name, req.Version), nil)
+	}
+
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application rolled back successfully",
+		&appmanager.App{Name: req.Name, Cycle: instances[0].Annotations.Get(appmgrcommon.AppAnnotationCycle), Instances: doneList})
+}
+
+// ScaleApp changes the number of replicas of daemon application instances
+func (adapter *nativeAppMgrAdapter) ScaleApp(ctx context.Context, req *appmanager.ScaleAppRequest) (*appmanager.Response, error) {
+	apps := newAppsData()
+	if err := apps.appendRunningAppsData(adapter.kc, req, ""); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Only the instances running the requested version are scaled
+	var instances []*appmgrcommon.AppInstanceData
+	for _, instance := range apps.GetRunningAppData(req.Name) {
+		if req.Version == "" || instance.CurrentVersion == req.Version {
+			instances = append(instances, instance)
+		}
+	}
+
+	if len(instances) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to scale", nil)
+	}
+
+	appCycle := instances[0].Annotations.Get(appmgrcommon.AppAnnotationCycle)
+	if appCycle != appmgrcommon.TypeDaemon {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR,
+			fmt.Sprintf("application %s of type %s cannot be scaled", req.Name, appCycle), nil)
+	}
+
+	// Instances are scaled only once all of them can be scaled
+	for _, instance := range instances {
+		autoscaled, err := chartutils.Autoscaled(chartPath(req.Name,
+			instance.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName), instance.CurrentVersion))
+		if err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		if autoscaled {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR,
+				fmt.Sprintf("application instance %s is scaled by the horizontal pod autoscaler", instance.InstanceName), nil)
+		}
+	}
+
+	var doneList []*appmanager.AppInstance
+	for _, instance := range instances {
+		// Keep the chart in sync with the running instance
+		if err := chartutils.SetReplicas(ctx, chartPath(req.Name, instance.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName),
+			instance.CurrentVersion), instance.InstanceName, int(req.Replicas)); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		if err := scaleAppInstance(ctx, adapter.kc, instance, int32(req.Replicas)); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		longrunning.Step(ctx, "instance %s scaled", instance.InstanceName)
+		doneList = append(doneList, generateProtoData(instance, catalogId))
+	}
+
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application scaled successfully",
+		&appmanager.App{Name: req.Name, Cycle: appCycle, Instances: doneList})
+}
+
+// List revisions of the application charts. The charts history is not kept by the adapter
+func (adapter *nativeAppMgrAdapter) ListAppRevisions(ctx context.Context, req *appmanager.ListAppRevisionsRequest) (*appmanager.Response, error) {
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, "application revisions are not supported by the native adapter", nil)
+}
+
+// Get revision of the application chart. The charts history is not kept by the adapter
+func (adapter *nativeAppMgrAdapter) GetAppRevision(ctx context.Context, req *appmanager.GetAppRevisionRequest) (*appmanager.Response, error) {
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, "application revisions are not supported by the native adapter", nil)
+}
+
+// DeleteApp deletes appropriate Application instance
+func (adapter *nativeAppMgrAdapter) DeleteApp(ctx context.Context, req *appmanager.DeleteAppRequest) (*appmanager.Response, error) {
+	// Create New Apps temporary data
+	apps := newAppsData()
+
+	// Add running applications data
+	if err := apps.appendAppsDataToDelete(adapter.kc, req); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Check if the requested application name and version already exist
+	existingData := apps.GetRunningAppData(req.Name)
+	if existingData == nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+	}
+
+	// Set Application type
+	appCycle := existingData[0].Annotations.Get(appmgrcommon.AppAnnotationCycle)
+
+	// Decide if application chart should be deleted
+	// In case global parameter is true or request enforces to delete the chart
+	// Controller will delete the application chart
+	purge := false
+	if req.Purge || viper.GetBool(appcommon.EnvApphcPurgeAppMetadata) {
+		purge = true
+	}
+
+	// Delete the app and/or related instances
+	doneList, err := deleteApps(ctx, adapter.kc, existingData, catalogId, req.Name, appsRepoPath(), purge)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	if len(doneList) == len(existingData) {
+		namespace := existingData[0].TargetNamespace
+		if err := deleteStorage(ctx, adapter.kc, namespace, sharedVolumeName(namespace), sharedClaimName(namespace)); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		if err := deleteNamespace(ctx, adapter.kc, namespace); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+	}
+
+	// Generate response
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application instances successfully deleted",
+		&appmanager.App{Name: req.Name, Cycle: appCycle, Instances: doneList})
+}
+
+// DeleteApps removes all instances of all applications created by controller
+func (adapter *nativeAppMgrAdapter) DeleteApps(ctx context.Context, req *appmanager.DeleteAppsRequest) (*appmanager.Response, error) {
+	// Construct Application temporary data
+	apps := newAppsData()
+	if err := apps.appendAppsDataToDelete(adapter.kc, nil); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// cannot find running applications
+	if apps.RunningAppsDataEmpty() {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+	}
+
+	doneApps := &appmanager.Apps{}
+
+	// Decide if application chart should be deleted
+	// In case global parameter is true or request enforces to delete the chart
+	// Controller will delete the application chart
+	purge := false
+	if req.Purge || viper.GetBool(appcommon.EnvApphcPurgeAppMetadata) {
+		purge = true
+	}
+
+	var err error
+	// Iterate over running applications
+	for appName, existingData := range apps.RunningAppsData {
+		app := &appmanager.App{}
+		app.Name = appName
+		app.Cycle = existingData[0].Annotations.Get(appmgrcommon.AppAnnotationCycle)
+		// Delete the app and/or related instances
+		app.Instances, err = deleteApps(ctx, adapter.kc, existingData, catalogId, appName, appsRepoPath(), purge)
+		if err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		if len(app.Instances) == len(existingData) {
+			namespace := existingData[0].TargetNamespace
+			if err := deleteStorage(ctx, adapter.kc, namespace, sharedVolumeName(namespace), sharedClaimName(namespace)); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+
+			if err := deleteNamespace(ctx, adapter.kc, namespace); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+		}
+
+		// Add the app to the list
+		doneApps.Apps = append(doneApps.Apps, app)
+	}
+
+	// Generate response message
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Applications successfully deleted", doneApps)
+}
+
+// GetApps fetches information about running application instances
+func (adapter *nativeAppMgrAdapter) GetApps(ctx context.Context, req *appmanager.GetAppsRequest) (*appmanager.Response, error) {
+	wList, err := getApps(ctx, adapter.kc, req, req.Cycle, catalogId, req.Verbose)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// There is neither workload nor application in the cluster
+	if len(wList.Apps) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "no application found", nil)
+	}
+
+	// Generate a response
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Running applications", wList)
+}
+
+// DeleteAppMetadata deletes metadata for appropriate application instance
+func (adapter *nativeAppMgrAdapter) DeleteAppMetadata(ctx context.Context, req *appmanager.DeleteAppMetadataRequest) (*appmanager.Response, error) {
+	// Set path to the appropriate application metadata cache
+	appChartRootDir := filepath.Join(appsRepoPath(), req.AppName)
+
+	appTmplts := &appmanager.AppTemplates{}
+	appTmplts.AppName = req.AppName
+
+	// In case request doesn't contain grpup_ids,
+	// we assume that need to remove metadata for
+	// all instances of a particular application
+	if len(req.GroupIds) == 0 {
+		// Get instances names
+		appInstances, err := appmgrcommon.GetSubDirs(appChartRootDir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+			}
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+		// Iterate over application instances
+		for _, appInstance := range appInstances {
+			// Remove metadata for appropriate application instance
+			t, err := removeTemplateData(ctx, appChartRootDir, catalogId, appInstance, req.Version)
+			if err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+			// Add metadata information
+			appTmplts.Templates = append(appTmplts.Templates, t)
+		}
+
+		// If application instance version is not sent with request
+		if req.GetVersion() == "" {
+			// Delete entire application
+			if err := chartutils.DeleteChart(ctx, appChartRootDir, "all", "all"); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+		}
+
+	} else {
+		// In case group_ids data has come with request
+		var name string
+		for _, gid := range req.GroupIds {
+			// Convert to the K8S comply format
+			if req.RootGroupId == "" {
+				name = req.AppName + "-" + gid
+			} else {
+				name = req.AppName + "-" + req.RootGroupId + "-" + gid
+			}
+
+			chartName := strings.ToLower(strings.Replace(name, "_", "-", -1))
+			// Delete metadata
+			t, err := removeTemplateData(ctx, appChartRootDir, catalogId, chartName, req.Version)
+			if err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+			// Add metadata information
+			appTmplts.Templates = append(appTmplts.Templates, t)
+		}
+	}
+
+	// If cannot find templates for appropriate application instance
+	if len(appTmplts.Templates) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+	}
+
+	// Generate response
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application metadata successfully deleted", appTmplts)
+}
+
+// EnableDisableApp enables or disables application instances
+func (adapter *nativeAppMgrAdapter) EnableDisableApp(ctx context.Context, req *appmanager.EnableDisableAppRequest) (*appmanager.Response, error) {
+	var state appmanager.AppStateAfterDeployment
+
+	if req.GetDisable() {
+		state = appmanager.AppStateAfterDeployment_disabled
+	} else {
+		state = appmanager.AppStateAfterDeployment_enabled
+	}
+
+	apps, err := enableDisableApp(ctx, adapter.kc, req, state)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	var msg string
+	if len(apps.Apps) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "no application found", apps)
+	}
+
+	if req.GetDisable() {
+		msg = "Application(s) disabled successfully"
+	} else {
+		msg = "Application(s) enabled successfully"
+	}
+
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, msg, apps)
+}
+
+func (adapter *nativeAppMgrAdapter) updateUpgradeApps(ctx context.Context, req appmgrcommon.CreateUpgradeUpdateRequester, reuseValues bool) (*appmanager.Response, error) {
+	// Construct Application temporary data
+	apps := newAppsData()
+	if err := apps.appendRunningAppsData(adapter.kc, req, req.GetCycle()); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Identify application type
+	chartType, err := appmgrcommon.AppTypeToAppCycle(req.GetCycle())
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Create the temporary data for the new applications
+	apps.AppendNewAppInstances(req, chartType, req.GetDescription(), req.GetAppState())
+
+	// Check for instances
+	existingData := apps.GetRunningAppData(req.GetName())
+
+	// If we don't have aby information in request and
+	// there are no running applications - return with appropriate response
+	if apps.NewAppInstancesDataEmpty() && existingData == nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to update/upgrade", nil)
+	}
+
+	namespace := strings.Replace(strings.ToLower(req.GetName()), "_", "-", -1)
+	if !req.GetDryRun() {
+		if err := createNamespace(ctx, adapter.kc, namespace); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+	}
+
+	var bkpAppInstances []*appmgrcommon.AppInstanceData
+
+	// In case nothing has come with request (except the application name and version)
+	// check for running instances related to the application
+	if apps.NewAppInstancesDataEmpty() {
+		for _, existingAppInstance := range existingData {
+			apps.NewAppInstancesData = append(apps.NewAppInstancesData, existingAppInstance)
+			bkpAppInstance, err := getBackupData(existingAppInstance)
+			if err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+
+			bkpAppInstances = append(bkpAppInstances, bkpAppInstance)
+		}
+		// If we have running app instances as well as the list of apps that came with request is not empty
+	} else {
+		// Iterate over the new data
+		for _, newAppInstance := range apps.NewAppInstancesData {
+			// Iterate over existing data
+			for _, existingAppInstance := range existingData {
+				// If new application instance name equal to
+				// the running application instance name
+				if newAppInstance.InstanceName == existingAppInstance.InstanceName {
+					// if the upgrade policy is set to recreate or
+					// the new application instance version equal to
+					// the running application instance version
+					if config.Current().UpgradePolicyRecreate ||
+						existingAppInstance.RequestedVersion == existingAppInstance.CurrentVersion {
+						newAppInstance.NextAction = appmgrcommon.AppInstanceDataNextActionRecreate
+					} else {
+						// Otherwise set to upgrade
+						newAppInstance.NextAction = appmgrcommon.AppInstanceDataNextActionUpgrade
+					}
+
+					newAppInstance.CurrentVersion = appcommon.MapGet(existingAppInstance.Annotations, appmgrcommon.AppInstanceAnnotationVersion)
+					bkpAppInstance, err := getBackupData(existingAppInstance)
+					if err != nil {
+						return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+					}
+
+					bkpAppInstances = append(bkpAppInstances, bkpAppInstance)
+				}
+
+				if req.GetVersion() == "" {
+					newAppInstance.RequestedVersion = existingAppInstance.RequestedVersion
+				}
+
+				// Set new application instance storage size
+				// to existing application instance storage size
+				newAppInstance.InstanceStorageSize = existingAppInstance.InstanceStorageSize
+			}
+		}
+	}
+
+	// Split the instances into batches according to the upgrade strategy
+	strategy := appmgrcommon.GetUpgradeStrategy(req)
+	batches, err := appmgrcommon.UpgradeBatches(apps.NewAppInstancesData, strategy)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	limits := req.GetSpec().GetResources().GetLimits()
+	if limits == nil && reuseValues {
+		cpu, memory, err := resourcemgr.GetResourcesLimit(ctx, adapter.kc, namespace)
+		if err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		limits = &appmanager.Spec_Resources_Limits{}
+		limits.Cpu = cpu
+		limits.Memory = memory
+	}
+
+	if limits != nil {
+		if err := checkAvailableResources(ctx, adapter.kc, apps.GetNumberOfNewInstances(), limits); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	bkpRootDir := "backup-" + id.String()
+
+	defer os.RemoveAll(filepath.Join(viper.GetString(appcommon.EnvApphcCachePath), bkpRootDir))
+
+	chartApp := filepath.Join(appsRepoPath(), req.GetName())
+
+	// if from_catalog property is not set or set to "false"
+	// create a new chart for the application instance
+	if !req.GetFromCatalog() {
+		var lastGoodKnownMetadataDir string
+		var cfg map[string]interface{}
+		var state string
+		if reuseValues {
+			if len(existingData) > 0 {
+				lastGoodKnownMetadataDir = filepath.Join(chartApp, appcommon.MapGet(existingData[0].Annotations,
+					appmgrcommon.AppInstanceAnnotationTemplateName), existingData[0].CurrentVersion)
+				state = appcommon.MapGet(existingData[0].Annotations, appmgrcommon.AppInstanceAnnotationState)
+			} else if apps.SampleInstance != nil {
+				lastGoodKnownMetadataDir = filepath.Join(chartApp, appcommon.MapGet(apps.SampleInstance.Annotations,
+					appmgrcommon.AppInstanceAnnotationTemplateName), apps.SampleInstance.CurrentVersion)
+				state = appcommon.MapGet(apps.SampleInstance.Annotations, appmgrcommon.AppInstanceAnnotationState)
+			} else {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to update/upgrade", nil)
+			}
+
+			if cfg, err = chartutils.ParseLastGoodConfig(filepath.Join(lastGoodKnownMetadataDir, "values.yaml")); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+
+			if state != "" {
+				a := cfg["annotations"]
+				a.(map[interface{}]interface{})[appmgrcommon.AppInstanceAnnotationState] = state
+			}
+		}
+
+		// Create charts for the new application instances
+		for _, newAppInstance := range apps.NewAppInstancesData {
+			if err := chartutils.SetChartData(newAppInstance, req, lastGoodKnownMetadataDir, cfg); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+
+			if req.GetDryRun() {
+				continue
+			}
+
+			// Backup old chart
+			bkpAppDir := filepath.Join(viper.GetString(appcommon.EnvApphcCachePath), bkpRootDir,
+				req.GetName(), appcommon.MapGet(newAppInstance.Annotations, appmgrcommon.AppInstanceAnnotationTemplateName))
+
+			_ = os.RemoveAll(bkpAppDir)
+
+			if err := os.MkdirAll(bkpAppDir, 0755); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+
+			srcBkpChart := filepath.Join(chartApp, appcommon.MapGet(newAppInstance.Annotations,
+				appmgrcommon.AppInstanceAnnotationTemplateName), newAppInstance.CurrentVersion)
+
+			if _, err := os.Stat(srcBkpChart); !os.IsNotExist(err) {
+				trgtBkpChart := filepath.Join(bkpAppDir, newAppInstance.CurrentVersion)
+
+				// Copy template for appropriate chart type
+				if err := chartutils.CopyChart(ctx, srcBkpChart, trgtBkpChart, newAppInstance.InstanceName,
+					newAppInstance.CurrentVersion); err != nil {
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+				}
+			}
+
+			// Set destination chart
+			dstChart := filepath.Join(chartApp, appcommon.MapGet(newAppInstance.Annotations,
+				appmgrcommon.AppInstanceAnnotationTemplateName), newAppInstance.RequestedVersion)
+
+			// Remove the chart
+			if err := chartutils.DeleteChart(ctx, dstChart, newAppInstance.InstanceName, newAppInstance.RequestedVersion); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+
+			// Create updated chart
+			if err := chartutils.CreateChart(ctx, chartTemplatePath(chartType), dstChart, chartType, req.GetName(), newAppInstance); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+			longrunning.Step(ctx, "chart created for instance %s", newAppInstance.InstanceName)
+		}
+	}
+
+	// Plan the changes instead of applying them
+	if req.GetDryRun() {
+		plan := &appmanager.Plan{Name: req.GetName(), Cycle: req.GetCycle()}
+		for _, newAppInstance := range apps.NewAppInstancesData {
+			instancePlan, err := chartutils.PlanInstance(newAppInstance, chartType, chartApp, req.GetFromCatalog())
+			if err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+			plan.Instances = append(plan.Instances, instancePlan)
+		}
+
+		return appmgrcommon.GeneratePlanResponse(ctx, plan)
+	}
+
+	if limits == nil && !reuseValues {
+		if err := resourcemgr.DeleteLimitRange(ctx, adapter.kc, namespace); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+	} else {
+		if err := resourcemgr.CreateUpdateLimitRange(ctx, adapter.kc, namespace, limits.Cpu, limits.Memory,
+			appmgrcommon.AppInstanceDefaultCpuRequest, appmgrcommon.AppInstanceDefaultMemoryRequest); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+	}
+
+	// If shared storage requested
+	if req.GetSharedStorage() > 0 {
+		if err := createSharedStorage(ctx, adapter.kc, req.GetName(), namespace, int(req.GetSharedStorage())); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+	} else {
+
+		if err := deleteStorage(ctx, adapter.kc, namespace, sharedVolumeName(namespace), sharedClaimName(namespace)); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+	}
+
+	// Create or upgrade instances batch by batch
+	doneList, touched, err := appmgrcommon.RunUpgradeBatches(ctx, batches, strategy,
+		func(ctx context.Context, batch []*appmgrcommon.AppInstanceData) ([]*appmanager.AppInstance, error) {
+			return createUpgradeApps(ctx, adapter.kc, batch, catalogId)
+		},
+		func(ctx context.Context, instance *appmgrcommon.AppInstanceData) error {
+			return waitForAppInstanceReadiness(ctx, adapter.kc, instance)
+		})
+
+	if err != nil {
+		// Staged upgrade halts on any failure
+		staged := strategy.GetType() != appmanager.UpgradeStrategyType_ALL_AT_ONCE
+		if !req.GetFromCatalog() && len(bkpAppInstances) > 0 && (staged ||
+			strings.HasPrefix(err.Error(), recreateErrorPrefix) || strings.Contains(err.Error(), timeOutErrorPrefix)) {
+			for _, appInstance := range bkpAppInstances {
+				requestid.Logger(ctx).WithFields(logrus.Fields{"instance": appInstance.InstanceName,
+					"current_version": appInstance.CurrentVersion,
+					"target_version":  appInstance.RequestedVersion}).Info("Rolling back the application instance")
+
+				// Backup old chart
+				bkpAppDir := filepath.Join(viper.GetString(appcommon.EnvApphcCachePath), bkpRootDir,
+					req.GetName(), appcommon.MapGet(appInstance.Annotations, appmgrcommon.AppInstanceAnnotationTemplateName))
+
+				chartToRestoreSrc := filepath.Join(bkpAppDir, appInstance.RequestedVersion)
+				chartToRestoreDst := filepath.Join(chartApp, appcommon.MapGet(appInstance.Annotations,
+					appmgrcommon.AppInstanceAnnotationTemplateName), appInstance.RequestedVersion)
+
+				// Remove the chart
+				if err := chartutils.DeleteChart(ctx, chartToRestoreDst, appInstance.InstanceName, appInstance.RequestedVersion); err != nil {
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+				}
+
+				if err := chartutils.CopyChart(ctx, chartToRestoreSrc, chartToRestoreDst, appInstance.InstanceName,
+					appInstance.RequestedVersion); err != nil {
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+				}
+			}
+
+		} else {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		// Staged upgrade rolls back only the instances of the batches applied so far,
+		// the charts of the rest are restored above. The instances created by the applied batches are deleted
+		msg := "upgrade failed, the application was rolled back to previous state"
+		if staged {
+			bkpAppInstances = appmgrcommon.InstancesByName(bkpAppInstances, touched)
+			msg = fmt.Sprintf("upgrade halted: %s, the upgraded application instances were rolled back to previous state "+
+				"and the created ones were deleted", err.Error())
+		}
+
+		// The rollback is not interrupted by cancellation of the operation
+		rollbackCtx := longrunning.Detach(ctx)
+		doneList, err = createUpgradeApps(rollbackCtx, adapter.kc, bkpAppInstances, catalogId)
+		if err == nil {
+			err = deleteCreatedApps(rollbackCtx, adapter.kc, touched)
+		}
+
+		if err == nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, msg,
+				&appmanager.App{Name: req.GetName(), Cycle: req.GetCycle(), Instances: doneList})
+		}
+
+		errMsg := fmt.Sprintf("upgrade and rollback failed: %s", err.Error())
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, errMsg, nil)
+	}
+
+	// Generate response
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application updated/upgraded successfully",
+		&appmanager.App{Name: req.GetName(), Cycle: req.GetCycle(), Instances: doneList})
+}
+
+// removeTemplateData removes application instance metadata
+func removeTemplateData(ctx context.Context, appChartRootDir, catalogId, instanceName, version string) (*appmanager.Template, error) {
+	// Get template versions
+	versions, err := getTemplateVersions(filepath.Join(appChartRootDir, instanceName))
+	if err != nil {
+		return nil, err
+	}
+
+	t := &appmanager.Template{}
+	// Remove all versions if the "version" field is empty
+	if version == "" {
+		for _, v := range versions {
+			t.Versions = append(t.Versions, v.String())
+		}
+		// Remove explicit version
+		if err := chartutils.DeleteChart(ctx, filepath.Join(appChartRootDir, instanceName), instanceName, "all"); err != nil {
+			return nil, err
+		}
+
+	} else {
+		// Otherwise iterate over available metadata versions
+		for _, v := range versions {
+			// If appropriate version found
+			if version == v.String() {
+				// Remove explicit version
+				if err := chartutils.DeleteChart(ctx, filepath.Join(appChartRootDir, instanceName, version), instanceName, version); err != nil {
+					return nil, err
+				}
+				// Add information
+				t.Versions = append(t.Versions, version)
+				break
+			}
+		}
+	}
+
+	// Metadata not found
+	if len(t.Versions) == 0 {
+		return nil, fmt.Errorf("no metadata found for the instance %s version %s", instanceName, version)
+	}
+
+	t.CatalogId = catalogId
+	t.Name = instanceName
+	return t, nil
+}
+
+// generateProtoData generates application properties that will be part of response
+func generateProtoData(app *appmgrcommon.AppInstanceData, catalogId string) *appmanager.AppInstance {
+	a := &appmanager.AppInstance{}
+
+	// Application instance name
+	a.Name = app.InstanceName
+
+	// Application ID: application name + RootGroupID + GroupID fetched from request
+	a.Id = app.Annotations.Get(appmgrcommon.AppInstanceAnnotationId)
+
+	// Application instance version
+	a.Version = app.RequestedVersion
+
+	// Root Group ID
+	a.RootGroupId = app.Annotations.Get(appmgrcommon.AppInstanceAnnotationRootGroupId)
+
+	// Group ID
+	a.GroupId = app.Annotations.Get(appmgrcommon.AppInstanceAnnotationGroupId)
+
+	// If catalog ID available
+	if catalogId != "" {
+		a.Template = &appmanager.Template{}
+
+		// Instance chart name
+		a.Template.Name = app.InstanceName
+
+		// Available versions for a particular metadata
+		a.Template.Versions = append(a.Template.Versions, app.Annotations.Get(appmgrcommon.AppInstanceAnnotationVersion))
+
+		// Catalog ID
+		a.Template.CatalogId = catalogId
+	}
+	return a
+}
+
+func getBackupData(existingAppInstance *appmgrcommon.AppInstanceData) (*appmgrcommon.AppInstanceData, error) {
+	// Collect data for backup
+	bkp := &appmgrcommon.AppInstanceData{}
+	bkp.InstanceName = existingAppInstance.InstanceName
+	bkp.Annotations = existingAppInstance.Annotations
+	bkp.TemplateAvailable = existingAppInstance.TemplateAvailable
+	bkp.Labels = existingAppInstance.Labels
+	bkp.Description = existingAppInstance.Description
+	bkp.CurrentVersion = existingAppInstance.RequestedVersion
+	bkp.RequestedVersion = existingAppInstance.CurrentVersion
+	bkp.TargetNamespace = existingAppInstance.TargetNamespace
+
+	if config.Current().UpgradePolicyRecreate ||
+		existingAppInstance.RequestedVersion == existingAppInstance.CurrentVersion {
+		bkp.NextAction = appmgrcommon.AppInstanceDataNextActionRecreate
+	} else {
+		bkp.NextAction = appmgrcommon.AppInstanceDataNextActionUpgrade
+	}
+
+	var err error
+	bkp.InstanceStorageSize, err = strconv.Atoi(appcommon.MapGet(existingAppInstance.Annotations, appmgrcommon.AppInstanceAnnotationPersistentVolumeSize))
+	if err != nil {
+		return nil, err
+	}
+
+	bkp.DeleteInstanceStorage = true
+
+	s := appcommon.MapGet(existingAppInstance.Annotations, appmgrcommon.AppInstanceAnnotationState)
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+
+	bkp.State = appmanager.AppStateAfterDeployment(i)
+
+	return bkp, nil
+}
+
+// appsRepoPath gives back the path to the local applications charts repository
+func appsRepoPath() string {
+	return filepath.Join(viper.GetString(appcommon.EnvApphcCachePath), appmgrcommon.CatalogAppsRepo)
+}
+
+// chartTemplatePath gives back the path to the chart template for appropriate application type
+func chartTemplatePath(chartType string) string {
+	return filepath.Join(viper.GetString(appcommon.EnvApphcAppTemplatesPath), chartType)
+}
+
+// chartPath gives back the path to the chart of appropriate application instance
+func chartPath(appName, templateName, version string) string {
+	return filepath.Join(appsRepoPath(), appName, templateName, version)
+}
+
+// chartAvailable figures out whether the chart of appropriate application instance exists
+func chartAvailable(appName, templateName, version string) bool {
+	_, err := os.Stat(filepath.Join(chartPath(appName, templateName, version), "Chart.yaml"))
+	return err == nil
+}
@@ -0,0 +1,422 @@
+package native
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/config"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+// Chart template of the daemon applications
+var daemonTemplate = map[string]string{
+	"templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+  annotations:
+{{ toYaml .Values.annotations | indent 4 }}
+spec:
+  replicas: {{ .Values.replicaCount }}
+  selector:
+    matchLabels:
+      app: {{ .Release.Name }}
+      release: {{ .Release.Name }}
+  template:
+    metadata:
+      labels:
+        app: {{ .Release.Name }}
+        release: {{ .Release.Name }}
+    spec:
+      containers:
+      - name: {{ .Release.Name }}
+        image: "{{ .Values.image.repository }}:{{ .Values.image.tag }}"
+`,
+	"templates/service.yaml": `apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+spec:
+  type: {{ .Values.service.type }}
+  selector:
+    app: {{ .Release.Name }}
+  ports:
+  - port: 80
+`,
+}
+
+// newTestAdapter creates the adapter on top of the fake clientset. The charts are kept in a temporary directory
+func newTestAdapter(t *testing.T) (*nativeAppMgrAdapter, *fake.Clientset, func()) {
+	dir, err := ioutil.TempDir("", "native")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	templates := filepath.Join(dir, "templates")
+	for _, chartType := range []string{appmgrcommon.TypePeriodic, appmgrcommon.TypeRunOnce} {
+		if err := os.MkdirAll(filepath.Join(templates, chartType), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, content := range daemonTemplate {
+		p := filepath.Join(templates, appmgrcommon.TypeDaemon, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	viper.Set(appcommon.EnvApphcCachePath, filepath.Join(dir, "cache"))
+	viper.Set(appcommon.EnvApphcAppTemplatesPath, templates)
+
+	kc := fake.NewSimpleClientset()
+	adapter, err := NewAdapter(kc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return adapter, kc, func() { _ = os.RemoveAll(dir) }
+}
+
+// runPods creates the running pods of the application instances, so they are ready once deployed
+func runPods(t *testing.T, kc *fake.Clientset, namespace string, instanceNames ...string) {
+	for _, name := range instanceNames {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-pod", Namespace: namespace, Labels: map[string]string{
+				appmgrcommon.PodLabelApp:     name,
+				appmgrcommon.PodLabelRelease: name,
+			}},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+
+		if _, err := kc.CoreV1().Pods(namespace).Create(pod); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// deploymentImage gives back the image of the deployment of appropriate application instance
+func deploymentImage(t *testing.T, kc *fake.Clientset, namespace, instanceName string) string {
+	d, err := kc.AppsV1().Deployments(namespace).Get(instanceName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d.Spec.Template.Spec.Containers[0].Image
+}
+
+// recordVersion gives back the version in the release record of appropriate application instance
+func recordVersion(t *testing.T, kc *fake.Clientset, namespace, instanceName string) string {
+	record, err := getReleaseRecord(kc, namespace, instanceName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return record.Annotations[appmgrcommon.AppInstanceAnnotationVersion]
+}
+
+// createTestApp deploys the enabled daemon application "first" with the given instances
+func createTestApp(t *testing.T, adapter *nativeAppMgrAdapter, groupIds ...string) {
+	resp, _ := adapter.CreateApp(context.Background(), &appmanager.CreateAppRequest{
+		Name:     "first",
+		Version:  "1.0.0",
+		Cycle:    appmgrcommon.TypeDaemon,
+		GroupIds: groupIds,
+		AppState: appmanager.AppStateAfterDeployment_enabled,
+		Spec:     &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "first", Tag: "1"}},
+	})
+
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("create: %s", resp.Message)
+	}
+}
+
+func TestAppLifecycle(t *testing.T) {
+	adapter, kc, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	runPods(t, kc, "first", "first-g1")
+	createTestApp(t, adapter, "g1")
+
+	if image := deploymentImage(t, kc, "first", "first-g1"); image != "first:1" {
+		t.Fatalf("unexpected image: %s", image)
+	}
+
+	if v := recordVersion(t, kc, "first", "first-g1"); v != "1.0.0" {
+		t.Fatalf("unexpected version: %s", v)
+	}
+
+	if _, err := kc.CoreV1().Namespaces().Get("first", metav1.GetOptions{}); err != nil {
+		t.Fatalf("namespace: %v", err)
+	}
+
+	// Deploying the same instance again changes nothing
+	resp, _ := adapter.CreateApp(context.Background(), &appmanager.CreateAppRequest{Name: "first", Version: "1.0.0",
+		Cycle: appmgrcommon.TypeDaemon, GroupIds: []string{"g1"}, AppState: appmanager.AppStateAfterDeployment_enabled})
+	if resp.Status != appmanager.Status_UNCHANGED {
+		t.Fatalf("expected the application to be unchanged, got %s", resp.Status)
+	}
+
+	// Disabled instance has no workload but keeps the release record
+	resp, _ = adapter.EnableDisableApp(context.Background(), &appmanager.EnableDisableAppRequest{Name: "first", Disable: true})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("disable: %s", resp.Message)
+	}
+
+	if _, err := kc.AppsV1().Deployments("first").Get("first-g1", metav1.GetOptions{}); err == nil {
+		t.Fatal("expected the deployment to be deleted")
+	}
+
+	record, err := getReleaseRecord(kc, "first", "first-g1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s := record.Annotations[appmgrcommon.AppInstanceAnnotationState]; s != "0" {
+		t.Fatalf("unexpected state: %s", s)
+	}
+
+	// Enabled instance gets the workload back from the chart
+	resp, _ = adapter.EnableDisableApp(context.Background(), &appmanager.EnableDisableAppRequest{Name: "first"})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("enable: %s", resp.Message)
+	}
+
+	if image := deploymentImage(t, kc, "first", "first-g1"); image != "first:1" {
+		t.Fatalf("unexpected image: %s", image)
+	}
+
+	// Delete the instance along with its chart
+	resp, _ = adapter.DeleteApp(context.Background(), &appmanager.DeleteAppRequest{Name: "first", Purge: true})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("delete: %s", resp.Message)
+	}
+
+	if _, err := getReleaseRecord(kc, "first", "first-g1"); err == nil {
+		t.Fatal("expected the release record to be deleted")
+	}
+
+	for _, get := range []func() error{
+		func() error { _, err := kc.AppsV1().Deployments("first").Get("first-g1", metav1.GetOptions{}); return err },
+		func() error { _, err := kc.CoreV1().Services("first").Get("first-g1", metav1.GetOptions{}); return err },
+		func() error { _, err := kc.CoreV1().Namespaces().Get("first", metav1.GetOptions{}); return err },
+	} {
+		if get() == nil {
+			t.Fatal("expected the objects of the instance to be deleted")
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(appsRepoPath(), "first")); !os.IsNotExist(err) {
+		t.Fatalf("expected the chart to be deleted: %v", err)
+	}
+
+	resp, _ = adapter.DeleteApp(context.Background(), &appmanager.DeleteAppRequest{Name: "first"})
+	if resp.Status != appmanager.Status_NOT_FOUND {
+		t.Fatalf("expected nothing to delete, got %s", resp.Status)
+	}
+}
+
+func TestUpgradeApp(t *testing.T) {
+	adapter, kc, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	runPods(t, kc, "first", "first-g1")
+	createTestApp(t, adapter, "g1")
+
+	// Cluster IP is allocated once the service is created
+	svc, err := kc.CoreV1().Services("first").Get("first-g1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc.Spec.ClusterIP = "10.0.0.10"
+	if _, err := kc.CoreV1().Services("first").Update(svc); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, _ := adapter.UpgradeApp(context.Background(), &appmanager.UpgradeAppRequest{Name: "first", Version: "2.0.0",
+		Cycle: appmgrcommon.TypeDaemon, GroupIds: []string{"g1"}, AppState: appmanager.AppStateAfterDeployment_enabled,
+		Spec: &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "first", Tag: "2"}}})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("upgrade: %s", resp.Message)
+	}
+
+	if image := deploymentImage(t, kc, "first", "first-g1"); image != "first:2" {
+		t.Fatalf("unexpected image: %s", image)
+	}
+
+	if v := recordVersion(t, kc, "first", "first-g1"); v != "2.0.0" {
+		t.Fatalf("unexpected version: %s", v)
+	}
+
+	if svc, err := kc.CoreV1().Services("first").Get("first-g1", metav1.GetOptions{}); err != nil || svc.Spec.ClusterIP != "10.0.0.10" {
+		t.Fatalf("expected the service to keep the cluster IP: %v %v", svc, err)
+	}
+}
+
+func TestUpgradeAppRecreate(t *testing.T) {
+	config.Store(&config.Settings{MonitorTemplates: map[string]string{}, UpgradePolicyRecreate: true})
+	defer config.Store(&config.Settings{MonitorTemplates: map[string]string{}})
+
+	adapter, kc, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	runPods(t, kc, "first", "first-g1")
+	createTestApp(t, adapter, "g1")
+
+	// The workload is deleted before the new version is deployed
+	deleted := false
+	kc.PrependReactor("delete", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		deleted = true
+		return false, nil, nil
+	})
+
+	resp, _ := adapter.UpgradeApp(context.Background(), &appmanager.UpgradeAppRequest{Name: "first", Version: "2.0.0",
+		Cycle: appmgrcommon.TypeDaemon, GroupIds: []string{"g1"}, AppState: appmanager.AppStateAfterDeployment_enabled,
+		Spec: &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "first", Tag: "2"}}})
+	if resp.Status != appmanager.Status_SUCCESS {
+		t.Fatalf("upgrade: %s", resp.Message)
+	}
+
+	if !deleted {
+		t.Fatal("expected the instance to be recreated")
+	}
+
+	if image := deploymentImage(t, kc, "first", "first-g1"); image != "first:2" {
+		t.Fatalf("unexpected image: %s", image)
+	}
+}
+
+func TestUpgradeAppRollback(t *testing.T) {
+	adapter, kc, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	runPods(t, kc, "first", "first-g1", "first-g2")
+	createTestApp(t, adapter, "g1", "g2")
+
+	// The second batch fails once, so the first one is rolled back
+	failed := false
+	kc.PrependReactor("update", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		d := action.(k8stesting.UpdateAction).GetObject().(*appsv1.Deployment)
+		if d.Name == "first-g2" && !failed {
+			failed = true
+			return true, nil, errors.New("update failed")
+		}
+
+		return false, nil, nil
+	})
+
+	resp, _ := adapter.UpgradeApp(context.Background(), &appmanager.UpgradeAppRequest{Name: "first", Version: "2.0.0",
+		Cycle: appmgrcommon.TypeDaemon, GroupIds: []string{"g1", "g2"}, AppState: appmanager.AppStateAfterDeployment_enabled,
+		Spec:     &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "first", Tag: "2"}},
+		Strategy: &appmanager.UpgradeStrategy{Type: appmanager.UpgradeStrategyType_ROLLING, MaxUnavailable: 1}})
+	if resp.Status != appmanager.Status_ERROR || !strings.HasPrefix(resp.Message, "upgrade halted: update failed") {
+		t.Fatalf("unexpected response: %s %s", resp.Status, resp.Message)
+	}
+
+	for _, name := range []string{"first-g1", "first-g2"} {
+		if image := deploymentImage(t, kc, "first", name); image != "first:1" {
+			t.Fatalf("expected %s to be rolled back, got image %s", name, image)
+		}
+
+		if v := recordVersion(t, kc, "first", name); v != "1.0.0" {
+			t.Fatalf("expected %s to be rolled back, got version %s", name, v)
+		}
+	}
+
+	// The charts of the previous version are restored
+	if !chartAvailable("first", "first-g1", "1.0.0") {
+		t.Fatal("expected the chart to be restored")
+	}
+}
+
+func TestUpgradeAppRollbackDeletesCreated(t *testing.T) {
+	adapter, kc, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	runPods(t, kc, "first", "first-g1", "first-g2")
+	createTestApp(t, adapter, "g1")
+
+	// The canary batch creates the new instance, the upgrade of the existing one fails
+	kc.PrependReactor("update", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		d := action.(k8stesting.UpdateAction).GetObject().(*appsv1.Deployment)
+		if d.Name == "first-g1" && d.Spec.Template.Spec.Containers[0].Image == "first:2" {
+			return true, nil, errors.New("update failed")
+		}
+
+		return false, nil, nil
+	})
+
+	resp, _ := adapter.UpgradeApp(context.Background(), &appmanager.UpgradeAppRequest{Name: "first", Version: "2.0.0",
+		Cycle: appmgrcommon.TypeDaemon, GroupIds: []string{"g1", "g2"}, AppState: appmanager.AppStateAfterDeployment_enabled,
+		Spec:     &appmanager.Spec{Image: &appmanager.Spec_Image{Repo: "first", Tag: "2"}},
+		Strategy: &appmanager.UpgradeStrategy{Type: appmanager.UpgradeStrategyType_CANARY, CanaryGroupIds: []string{"g2"}}})
+	if resp.Status != appmanager.Status_ERROR || !strings.Contains(resp.Message, "the created ones were deleted") {
+		t.Fatalf("unexpected response: %s %s", resp.Status, resp.Message)
+	}
+
+	if _, err := kc.AppsV1().Deployments("first").Get("first-g2", metav1.GetOptions{}); !k8serrors.IsNotFound(err) {
+		t.Fatalf("expected the created instance to be deleted: %v", err)
+	}
+
+	if image := deploymentImage(t, kc, "first", "first-g1"); image != "first:1" {
+		t.Fatalf("expected first-g1 to be rolled back, got image %s", image)
+	}
+}
+
+func TestApplyObject(t *testing.T) {
+	kc := fake.NewSimpleClientset(
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "first"}, Data: map[string]string{"a": "1"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "first"}, Spec: corev1.ServiceSpec{ClusterIP: "10.0.0.10"}},
+	)
+
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "first"}, Data: map[string]string{"a": "2"}}
+	if err := applyObject(context.Background(), kc, "first", cm); err != nil {
+		t.Fatal(err)
+	}
+
+	if cm, err := kc.CoreV1().ConfigMaps("first").Get("first", metav1.GetOptions{}); err != nil || cm.Data["a"] != "2" {
+		t.Fatalf("expected the config map to be updated: %v %v", cm, err)
+	}
+
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "first"}, Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP}}
+	if err := applyObject(context.Background(), kc, "first", svc); err != nil {
+		t.Fatal(err)
+	}
+
+	if svc, err := kc.CoreV1().Services("first").Get("first", metav1.GetOptions{}); err != nil ||
+		svc.Spec.Type != corev1.ServiceTypeClusterIP || svc.Spec.ClusterIP != "10.0.0.10" {
+		t.Fatalf("expected the service to be updated keeping the cluster IP: %v %v", svc, err)
+	}
+
+	// Objects missing in the cluster are created
+	d := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "first"}}
+	if err := applyObject(context.Background(), kc, "first", d); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := kc.AppsV1().Deployments("first").Get("first", metav1.GetOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,965 @@
+// Author <dorzheho@cisco.com>
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	gover "github.com/hashicorp/go-version"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/metrics"
+	"cisco.com/son/apphcd/app/common/requestid"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+	"cisco.com/son/apphcd/app/grpc/common/resourcemgr"
+)
+
+// createAppInstance renders the chart of appropriate application instance and applies
+// the objects to the cluster. The same flow is used for upgrading running instances
+func createAppInstance(ctx context.Context, kc kubernetes.Interface, data *appmgrcommon.AppInstanceData, version string) error {
+	// Set the template name
+	templateName := data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName)
+	appName := data.Annotations.Get(appmgrcommon.AppAnnotationBaseName)
+
+	// Find the chart
+	if !chartAvailable(appName, templateName, version) {
+		return fmt.Errorf("version %s for template %s is invalid", version, templateName)
+	}
+
+	// Create persistent volume if requested
+	if data.InstanceStorageSize > 0 {
+		if err := createInstanceStorage(ctx, kc, data); err != nil {
+			return err
+		}
+	}
+
+	// Add application instance state
+	appcommon.MapAdd(data.Annotations, appmgrcommon.AppInstanceAnnotationState, fmt.Sprintf("%d", data.State))
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "version": version}).Info("Creating application")
+
+	objs, err := renderManifests(ctx, chartPath(appName, templateName, version), data)
+	if err != nil {
+		return err
+	}
+
+	autoscaled := false
+	for _, obj := range objs {
+		// Disabled application instances do not have workloads
+		if (isWorkload(obj) || isAutoscaler(obj)) && data.State == appmanager.AppStateAfterDeployment_disabled {
+			continue
+		}
+
+		if err := applyObject(ctx, kc, data.TargetNamespace, obj); err != nil {
+			return err
+		}
+
+		autoscaled = autoscaled || isAutoscaler(obj)
+	}
+
+	// The autoscaler is removed once the chart doesn't render it anymore
+	if !autoscaled {
+		if err := deleteAutoscaler(kc, data.TargetNamespace, data.InstanceName); err != nil {
+			return err
+		}
+	}
+
+	// Record the release
+	if err := saveReleaseRecord(ctx, kc, data); err != nil {
+		return err
+	}
+
+	if err := postDeploymentAction(ctx, kc, data, version); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "version": version,
+		"status": "OK"}).Info("Creating application")
+
+	return nil
+}
+
+// deleteAppInstance deletes all the objects belonging to appropriate application instance
+func deleteAppInstance(ctx context.Context, kc kubernetes.Interface, data *appmgrcommon.AppInstanceData, version string) error {
+	namespace := data.TargetNamespace
+	name := data.InstanceName
+
+	// Delete application instance
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": name, "version": version}).Info("Deleting application")
+
+	if err := deleteWorkload(ctx, kc, namespace, name, data.Annotations.Get(appmgrcommon.AppAnnotationCycle)); err != nil {
+		return fmt.Errorf("timed out waiting for deleting application instance %s-%s: %s", name, version, err.Error())
+	}
+
+	for _, del := range []func() error{
+		func() error { return kc.CoreV1().Services(namespace).Delete(name, &metav1.DeleteOptions{}) },
+		func() error {
+			return kc.CoreV1().ConfigMaps(namespace).Delete(name+"-configmap", &metav1.DeleteOptions{})
+		},
+		func() error { return kc.CoreV1().Secrets(namespace).Delete(name+"-secrets", &metav1.DeleteOptions{}) },
+		func() error { return kc.ExtensionsV1beta1().Ingresses(namespace).Delete(name, &metav1.DeleteOptions{}) },
+		func() error { return deleteAutoscaler(kc, namespace, name) },
+	} {
+		if err := del(); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if err := deleteReleaseRecord(kc, namespace, name); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": name, "version": version, "status": "OK"}).Info("Deleting application")
+
+	if data.DeleteInstanceStorage {
+		if err := deleteStorage(ctx, kc, namespace, instanceVolumeName(name), instanceClaimName(name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getApps shows information about running applications and their instances
+func getApps(ctx context.Context, kc kubernetes.Interface, req appmgrcommon.GenericRequester, appCycle, catalogId string,
+	verbose bool) (*appmanager.AppsInfo, error) {
+
+	// Identify application cycle
+	cycle, _ := appmgrcommon.AppTypeToAppCycle(appCycle)
+
+	// Get release records
+	records, err := listReleaseRecords(kc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create empty body
+	body := &appmanager.AppsInfo{}
+	body.Apps = make(map[string]*appmanager.AppInfo)
+
+	limits := &appmanager.Resources_Limits{}
+	var requests *appmanager.Resources_Requests
+
+	// Iterate over release records
+	for _, item := range records {
+		if appName := appcommon.MapGet(item.Annotations, appmgrcommon.AppAnnotationBaseName); appName != "" {
+			// Continue if the annotation value is no equal to the name in the request
+			if req.GetName() != "" && req.GetName() != appName {
+				continue
+			}
+
+			if req.GetRootGroupId() != "" && req.GetRootGroupId() != appcommon.MapGet(item.Annotations,
+				appmgrcommon.AppInstanceAnnotationRootGroupId) {
+				continue
+			}
+
+			// Find Group ID
+			appAnnotationGroupId := appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationGroupId)
+			if appAnnotationGroupId == "" {
+				continue
+			}
+
+			// If application type appears in request , filter according to the type
+			appAnnotationsCycle := appcommon.MapGet(item.Annotations, appmgrcommon.AppAnnotationCycle)
+			if cycle != "" && cycle != appAnnotationsCycle {
+				continue
+			}
+
+			found := false
+			if len(req.GetGroupIds()) > 0 {
+				// if more that a single Group ID provided , iterate over the list
+				// and find a particular Group ID
+				for _, gid := range req.GetGroupIds() {
+					if gid == appAnnotationGroupId {
+						found = true
+						break
+					}
+				}
+				if !found {
+					continue
+				}
+			}
+
+			// If version comes in request , get application instance version
+			version := appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationVersion)
+			if req.GetVersion() != "" && req.GetVersion() != version {
+				continue
+			}
+
+			if body.Apps[appName] == nil {
+				limits.Cpu, limits.Memory, err = resourcemgr.GetResourcesLimit(ctx, kc, item.Namespace)
+				if err != nil {
+					return nil, err
+				}
+
+				if limits != nil {
+					requests = &appmanager.Resources_Requests{}
+					requests.Cpu, err = strconv.ParseFloat(appmgrcommon.AppInstanceDefaultCpuRequest, 64)
+					if err != nil {
+						return nil, err
+					}
+
+					requests.Memory, err = resourcemgr.ParseMemoryString(appmgrcommon.AppInstanceDefaultMemoryRequest)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				ai := &appmanager.AppInfo{}
+				if appAnnotationsCycle == appmgrcommon.TypePeriodic {
+					if sched := appcommon.MapGet(item.Annotations, appmgrcommon.AppAnnotationSchedule); sched != "" {
+						f, err := appmgrcommon.CronStringToCyclePeriodicRespAttr(sched)
+						if err != nil {
+							return nil, err
+						}
+
+						ai.CyclePeriodicFields = f
+					}
+				}
+
+				ai.TotalResources = &appmanager.Resources{}
+				ai.TotalResources.Requests = &appmanager.Resources_Requests{}
+				ai.TotalResources.Limits = &appmanager.Resources_Limits{}
+
+				if ai.SharedStorage, err = getStorageCapacity(kc, sharedVolumeName(item.Namespace)); err != nil {
+					return nil, err
+				}
+
+				body.Apps[appName] = ai
+			}
+
+			d, err := getInstanceData(kc, &item, catalogId, verbose)
+			if err != nil {
+				return nil, err
+			}
+
+			if limits != nil {
+				d.Resources.Limits = limits
+				d.Resources.Requests = requests
+				body.Apps[appName].TotalResources.Requests.Cpu += d.Resources.Requests.Cpu
+				body.Apps[appName].TotalResources.Requests.Memory += d.Resources.Requests.Memory
+				body.Apps[appName].TotalResources.Limits.Cpu += d.Resources.Limits.Cpu
+				body.Apps[appName].TotalResources.Limits.Memory += d.Resources.Limits.Memory
+				body.Apps[appName].TotalResources.PersistentStorage += d.Resources.PersistentStorage
+				body.Apps[appName].TotalResources.Requests.Cpu = math.Round(body.Apps[appName].TotalResources.Requests.Cpu*100) / 100
+				body.Apps[appName].TotalResources.Limits.Cpu = math.Round(body.Apps[appName].TotalResources.Limits.Cpu*100) / 100
+			}
+			body.Apps[appName].Instances = append(body.Apps[appName].Instances, d)
+		}
+	}
+
+	// Return body
+	return body, nil
+}
+
+// getInstanceData provides information about particular instance
+func getInstanceData(kc kubernetes.Interface, item *corev1.ConfigMap, catalogId string, verbose bool) (*appmanager.Instance, error) {
+	ai := &appmanager.Instance{}
+
+	// Application instance name (converted to the Kubernetes format)
+	ai.Name = recordInstanceName(item)
+
+	// Application instance ID (not converted to the Kubernetes format)
+	ai.Id = appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationId)
+
+	// Application instance version
+	ai.Version = appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationVersion)
+
+	// Application instance root group ID
+	ai.RootGroupId = appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationRootGroupId)
+
+	// Application instance group ID
+	ai.GroupId = appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationGroupId)
+
+	// Application image repo
+	ai.ImageRepo = appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationImageRepoName)
+
+	// Application image name
+	ai.ImageName = appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationImageName)
+
+	// Application image tag
+	ai.ImageTag = appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationImageTag)
+
+	ai.Cycle = appcommon.MapGet(item.Annotations, appmgrcommon.AppAnnotationCycle)
+	ai.Namespace = item.Namespace
+	ai.Resources = &appmanager.Resources{}
+	ai.Resources.Requests = &appmanager.Resources_Requests{}
+	ai.Resources.Limits = &appmanager.Resources_Limits{}
+
+	// Disabled application instances do not have workloads
+	ai.State = "disabled"
+	ai.Scale = "-"
+	ai.CreateDate = item.CreationTimestamp.UTC().Format(time.RFC3339)
+
+	var podSpec *corev1.PodSpec
+
+	// Switch application type
+	switch ai.Cycle {
+
+	// Type cronJob
+	case appmgrcommon.TypePeriodic:
+		w, err := kc.BatchV1beta1().CronJobs(item.Namespace).Get(ai.Name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+
+		if err == nil {
+			s := &appmanager.Instance_PeriodicFields{}
+			s.PeriodicFields = &appmanager.PeriodicFields{}
+
+			// CronJob scheduler
+			s.PeriodicFields.Schedule = w.Spec.Schedule
+
+			// Last time the job was scheduled
+			if w.Status.LastScheduleTime != nil {
+				s.PeriodicFields.LastScheduleTime = w.Status.LastScheduleTime.UTC().Format(time.RFC3339)
+			}
+
+			// Policy telling how many successful jobs need to be preserved in the cluster
+			if w.Spec.SuccessfulJobsHistoryLimit != nil {
+				s.PeriodicFields.SuccessfulJobsHistoryLimit = int64(*w.Spec.SuccessfulJobsHistoryLimit)
+			}
+
+			// Policy telling how many failed jobs need to be preserved in the cluster
+			if w.Spec.FailedJobsHistoryLimit != nil {
+				s.PeriodicFields.FailedJobsHistoryLimit = int64(*w.Spec.FailedJobsHistoryLimit)
+			}
+
+			ai.CycleFields = s
+			ai.State = "active"
+			if w.Spec.Suspend != nil && *w.Spec.Suspend {
+				ai.State = "suspended"
+			}
+			ai.CreateDate = w.CreationTimestamp.UTC().Format(time.RFC3339)
+			podSpec = &w.Spec.JobTemplate.Spec.Template.Spec
+		}
+
+		// Type job
+	case appmgrcommon.TypeRunOnce:
+		w, err := kc.BatchV1().Jobs(item.Namespace).Get(ai.Name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+
+		if err == nil {
+			s := &appmanager.Instance_RunOnceFields{}
+			s.RunOnceFields = &appmanager.RunOnceFields{}
+
+			// Number of completions
+			if w.Spec.Completions != nil {
+				s.RunOnceFields.Completions = int64(*w.Spec.Completions)
+			}
+
+			// Number of active jobs
+			s.RunOnceFields.Active = int64(w.Status.Active)
+
+			// Job start time
+			if w.Status.StartTime != nil {
+				s.RunOnceFields.StartTime = w.Status.StartTime.UTC().Format(time.RFC3339)
+			}
+
+			// Job completion time
+			if w.Status.CompletionTime != nil {
+				s.RunOnceFields.CompletionTime = w.Status.CompletionTime.UTC().Format(time.RFC3339)
+			}
+
+			// Succeeded jobs
+			s.RunOnceFields.Succeeded = int64(w.Status.Succeeded)
+
+			// Failed jobs
+			s.RunOnceFields.Failed = int64(w.Status.Failed)
+
+			ai.CycleFields = s
+
+			switch {
+			case w.Status.Active > 0:
+				ai.State = "active"
+			case w.Status.Failed > 0:
+				ai.State = "failed"
+			default:
+				ai.State = "succeeded"
+			}
+			ai.CreateDate = w.CreationTimestamp.UTC().Format(time.RFC3339)
+			podSpec = &w.Spec.Template.Spec
+		}
+
+		// Type daemon
+	default:
+		w, err := kc.AppsV1().Deployments(item.Namespace).Get(ai.Name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+
+		if err == nil {
+			if w.Spec.Replicas != nil {
+				ai.Scale = strconv.Itoa(int(*w.Spec.Replicas))
+				ai.DesiredReplicas = uint32(*w.Spec.Replicas)
+			}
+			ai.ReadyReplicas = uint32(w.Status.ReadyReplicas)
+			ai.AvailableReplicas = uint32(w.Status.AvailableReplicas)
+
+			if w.Status.ReadyReplicas > 0 && w.Status.UnavailableReplicas == 0 {
+				ai.State = "active"
+			} else {
+				ai.State = "unavailable"
+			}
+			ai.CreateDate = w.CreationTimestamp.UTC().Format(time.RFC3339)
+			podSpec = &w.Spec.Template.Spec
+		}
+	}
+
+	var err error
+	if ai.Resources.PersistentStorage, err = getStorageCapacity(kc, instanceVolumeName(ai.Name)); err != nil {
+		return nil, err
+	}
+
+	// Print only if "verbose" flag was provided
+	if verbose {
+		if podSpec != nil {
+			for _, cont := range podSpec.Containers {
+				c := &appmanager.Instance_Container{}
+				c.Name = cont.Name
+				c.Image = cont.Image
+				c.State = ai.State
+
+				if q, ok := cont.Resources.Requests[corev1.ResourceCPU]; ok {
+					ai.Resources.Requests.Cpu += float64(q.MilliValue()) / 1000
+				}
+
+				if q, ok := cont.Resources.Requests[corev1.ResourceMemory]; ok {
+					ai.Resources.Requests.Memory += uint32(q.Value() / (1 << 20))
+				}
+
+				if q, ok := cont.Resources.Limits[corev1.ResourceCPU]; ok {
+					ai.Resources.Limits.Cpu += float64(q.MilliValue()) / 1000
+				}
+
+				if q, ok := cont.Resources.Limits[corev1.ResourceMemory]; ok {
+					ai.Resources.Limits.Memory += uint32(q.Value() / (1 << 20))
+				}
+
+				for _, vol := range cont.VolumeMounts {
+					v := &appmanager.Instance_Container_VolumeMount{}
+					v.Name = vol.Name
+					v.MountPath = vol.MountPath
+					v.ReadOnly = vol.ReadOnly
+					v.SubPath = vol.SubPath
+					c.VolMounts = append(c.VolMounts, v)
+				}
+
+				for _, port := range cont.Ports {
+					p := &appmanager.Instance_Container_Port{}
+					p.Name = port.Name
+					p.Proto = string(port.Protocol)
+					p.Port = int64(port.ContainerPort)
+					p.SrcPort = int64(port.HostPort)
+					p.HostIp = port.HostIP
+					c.Ports = append(c.Ports, p)
+				}
+				ai.Containers = append(ai.Containers, c)
+			}
+		}
+
+		// Get the list of available templates
+		versions, err := getTemplateVersions(chartPath(appcommon.MapGet(item.Annotations, appmgrcommon.AppAnnotationBaseName),
+			appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationTemplateName), ""))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		ai.Template = &appmanager.Template{}
+		ai.Template.Name = ai.Name
+		ai.Template.CatalogId = catalogId
+		for _, v := range versions {
+			ai.Template.Versions = append(ai.Template.Versions, v.String())
+		}
+	}
+
+	return ai, nil
+}
+
+// enableDisableApp enables or disables application instances
+func enableDisableApp(ctx context.Context, kc kubernetes.Interface, req appmgrcommon.GenericRequester,
+	state appmanager.AppStateAfterDeployment) (*appmanager.AppsActivation, error) {
+
+	// Fetch release records from the cluster
+	records, err := listReleaseRecords(kc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create empty body
+	body := &appmanager.AppsActivation{}
+	body.Apps = make(map[string]*appmanager.AffectedAppInstances)
+
+	// Iterate over the records
+	for _, item := range records {
+		// All the application instances deployed by Controller
+		// have appropriate annotation - "apphc.app.basename"
+		if appName := appcommon.MapGet(item.Annotations, appmgrcommon.AppAnnotationBaseName); appName != "" {
+			version := appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationVersion)
+			if req != nil {
+				// Continue if the annotation value is no equal to the name in the request
+				if req.GetName() != "" && req.GetName() != appName {
+					continue
+				}
+
+				// If version appears in request use it for filtering
+				if req.GetVersion() != "" && req.GetVersion() != version {
+					continue
+				}
+
+				// If root group ID appears in request use it for filtering
+				if req.GetRootGroupId() != "" &&
+					req.GetRootGroupId() != appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationRootGroupId) {
+					continue
+				}
+
+				// Find Group ID
+				groupId := appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationGroupId)
+				if groupId == "" {
+					continue
+				}
+
+				found := false
+				if len(req.GetGroupIds()) > 0 {
+					// if more that a single Group ID provided , iterate over the list
+					// and find a particular Group ID
+					for _, gid := range req.GetGroupIds() {
+						if gid == groupId {
+							found = true
+							break
+						}
+					}
+					if !found {
+						continue
+					}
+				}
+			}
+
+			// Create temporary data
+			tmpApp := &appmgrcommon.AppInstanceData{}
+			tmpApp.InstanceName = recordInstanceName(&item)
+			tmpApp.Description = item.Data[releaseRecordKeyDescription]
+			tmpApp.Annotations = item.Annotations
+			tmpApp.Labels = recordInstanceLabels(&item)
+			tmpApp.TargetNamespace = item.Namespace
+			tmpApp.RequestedVersion = version
+			tmpApp.State = state
+
+			cycle := appcommon.MapGet(item.Annotations, appmgrcommon.AppAnnotationCycle)
+
+			// Disable workload
+			if state == appmanager.AppStateAfterDeployment_disabled {
+				// Add application instance state
+				appcommon.MapAdd(tmpApp.Annotations, appmgrcommon.AppInstanceAnnotationState, fmt.Sprintf("%d", state))
+
+				// Update the release record
+				if err := saveReleaseRecord(ctx, kc, tmpApp); err != nil {
+					return nil, err
+				}
+
+				if err := disableAppInstance(ctx, kc, tmpApp, version); err != nil {
+					return nil, err
+				}
+
+				// Enable workload
+			} else {
+				exists, err := workloadExists(kc, tmpApp.TargetNamespace, tmpApp.InstanceName, cycle)
+				if err != nil {
+					return nil, err
+				}
+
+				// If the application type is not job use existing metadata in order to create the workload
+				if !exists && cycle != appmgrcommon.TypeRunOnce {
+					if err := createAppInstance(ctx, kc, tmpApp, tmpApp.RequestedVersion); err != nil {
+						return nil, err
+					}
+				} else {
+					// Add application instance state
+					appcommon.MapAdd(tmpApp.Annotations, appmgrcommon.AppInstanceAnnotationState, fmt.Sprintf("%d", state))
+
+					// Update the release record
+					if err := saveReleaseRecord(ctx, kc, tmpApp); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			ai := &appmanager.AffectedAppInstance{}
+
+			// Application instance name (converted to the Kubernetes format)
+			ai.Name = tmpApp.InstanceName
+
+			// Application instance ID (not converted to the Kubernetes format)
+			ai.Id = appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationId)
+
+			// Application instance version
+			ai.Version = version
+
+			// Application instance root group ID
+			ai.RootGroupId = appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationRootGroupId)
+
+			// Application instance group ID
+			ai.GroupId = appcommon.MapGet(item.Annotations, appmgrcommon.AppInstanceAnnotationGroupId)
+
+			if body.Apps[appName] == nil {
+				body.Apps[appName] = &appmanager.AffectedAppInstances{}
+			}
+
+			body.Apps[appName].Instances = append(body.Apps[appName].Instances, ai)
+		}
+	}
+
+	return body, nil
+}
+
+// postDeploymentAction applies appropriate actions as soon as application is deployed.
+// Currently supported actions are enable or disable applications
+func postDeploymentAction(ctx context.Context, kc kubernetes.Interface, data *appmgrcommon.AppInstanceData, version string) error {
+	if data.State == appmanager.AppStateAfterDeployment_enabled {
+		// Periodic applications do not have running pods right after deployment
+		if data.Annotations.Get(appmgrcommon.AppAnnotationCycle) == appmgrcommon.TypePeriodic {
+			return nil
+		}
+
+		// Wait for Pods readiness
+		if err := waitForPodsReadiness(ctx, kc, data.TargetNamespace, data.InstanceName); err != nil {
+			requestid.Logger(ctx).Error(err)
+			return err
+		}
+
+		return nil
+	}
+
+	// In case the application instance should be disabled , controller will delete appropriate workload
+	return disableAppInstance(ctx, kc, data, version)
+}
+
+// disableAppInstance disables a particular application instance
+func disableAppInstance(ctx context.Context, kc kubernetes.Interface, data *appmgrcommon.AppInstanceData, version string) error {
+	cycle := data.Annotations.Get(appmgrcommon.AppAnnotationCycle)
+
+	exists, err := workloadExists(kc, data.TargetNamespace, data.InstanceName, cycle)
+	if err != nil || !exists {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "version": version}).
+		Info("Disabling application")
+
+	// Delete workload
+	if err := deleteWorkload(ctx, kc, data.TargetNamespace, data.InstanceName, cycle); err != nil {
+		return err
+	}
+
+	if err := deleteAutoscaler(kc, data.TargetNamespace, data.InstanceName); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "version": version,
+		"status": "OK"}).Info("Disabling application")
+
+	return nil
+}
+
+// scaleAppInstance changes the number of replicas of the workload of appropriate application instance.
+// Disabled application instances get the replicas from the chart once enabled
+func scaleAppInstance(ctx context.Context, kc kubernetes.Interface, data *appmgrcommon.AppInstanceData, replicas int32) error {
+	if data.State != appmanager.AppStateAfterDeployment_enabled {
+		return nil
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "replicas": replicas}).Info("Scaling application")
+
+	c := kc.AppsV1().Deployments(data.TargetNamespace)
+	w, err := c.Get(data.InstanceName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	w.Spec.Replicas = &replicas
+	if _, err := c.Update(w); err != nil {
+		return err
+	}
+
+	if err := waitForPodsReadiness(ctx, kc, data.TargetNamespace, data.InstanceName); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "replicas": replicas,
+		"status": "OK"}).Info("Scaling application")
+
+	return nil
+}
+
+// workloadExists tells whether the workload of appropriate application instance exists
+func workloadExists(kc kubernetes.Interface, namespace, instanceName, cycle string) (bool, error) {
+	var err error
+
+	switch cycle {
+	case appmgrcommon.TypePeriodic:
+		_, err = kc.BatchV1beta1().CronJobs(namespace).Get(instanceName, metav1.GetOptions{})
+	case appmgrcommon.TypeRunOnce:
+		_, err = kc.BatchV1().Jobs(namespace).Get(instanceName, metav1.GetOptions{})
+	default:
+		_, err = kc.AppsV1().Deployments(namespace).Get(instanceName, metav1.GetOptions{})
+	}
+
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// waitForAppInstanceReadiness waits till the pods of the enabled application instance are ready
+func waitForAppInstanceReadiness(ctx context.Context, kc kubernetes.Interface, data *appmgrcommon.AppInstanceData) error {
+	// Disabled and periodic application instances do not have running pods
+	if data.State != appmanager.AppStateAfterDeployment_enabled ||
+		data.Annotations.Get(appmgrcommon.AppAnnotationCycle) == appmgrcommon.TypePeriodic {
+		return nil
+	}
+
+	return waitForPodsReadiness(ctx, kc, data.TargetNamespace, data.InstanceName)
+}
+
+// Kubernetes doesn't wait till all pods and containers in the application instance
+// are ready hence waitForPodsReadiness implements this functionality
+func waitForPodsReadiness(ctx context.Context, kc kubernetes.Interface, namespace, appInstanceName string) (err error) {
+	defer metrics.ObserveStep(metrics.StepWaitForPodsReadiness, time.Now(), &err)
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": appInstanceName}).Info("Waiting for pods readiness")
+
+	selector := labels.SelectorFromSet(labels.Set{appmgrcommon.PodLabelApp: appInstanceName, appmgrcommon.PodLabelRelease: appInstanceName})
+
+	// Start the timer
+	startTime := time.Now()
+	timeout := time.Duration(0)
+
+	for {
+		// Get the state of pods and containers
+		msg, err := getPodsInErrorState(kc, namespace, selector.String())
+		if err != nil {
+			return err
+		}
+
+		// If no error message , exit the loop
+		if msg == "" {
+			break
+		}
+
+		// In case the message tells that container is being created we do not consider it as an error
+		// but an ordinary transition message. In this case set the timeout to 6 minutes. Otherwise set to 10 seconds
+		if msg == "ContainerCreating" || msg == "PodInitializing" || msg == "Pending" {
+			timeout = 360
+		} else {
+			timeout = 10
+		}
+
+		// If the timer is exceeded , return appropriate error
+		if time.Since(startTime)/time.Second > timeout {
+			return fmt.Errorf("timed out waiting for pods readiness: %s", msg)
+		}
+
+		// Wait and continue in the loop
+		time.Sleep(1 * time.Second)
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": appInstanceName, "status": "OK"}).Info("Waiting for pods readiness")
+
+	// No error so far
+	return nil
+}
+
+// getPodsInErrorState gives back the reason the pods of the application instance are not ready yet
+func getPodsInErrorState(kc kubernetes.Interface, namespace, selector string) (string, error) {
+	// Get list of pods
+	pods, err := kc.CoreV1().Pods(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return "", err
+	}
+
+	// The workload controller has not created the pods yet
+	if len(pods.Items) == 0 {
+		return "Pending", nil
+	}
+
+	// Iterate over pods data
+	for _, pod := range pods.Items {
+		// Completed pods are not transitioning
+		if pod.Status.Phase == corev1.PodSucceeded {
+			continue
+		}
+
+		// Iterate over containers data
+		for _, c := range pod.Status.ContainerStatuses {
+			// In case container in transitioning state
+			if c.State.Waiting != nil {
+				// Return transitioning message
+				return c.State.Waiting.Reason, nil
+			}
+		}
+
+		if pod.Status.Phase == corev1.PodPending {
+			return string(pod.Status.Phase), nil
+		}
+	}
+
+	return "", nil
+}
+
+// getTemplateVersions gets versions of a particular metadata
+func getTemplateVersions(templatePath string) ([]*gover.Version, error) {
+	dirs, err := appmgrcommon.GetSubDirs(templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []*gover.Version
+	for _, d := range dirs {
+		v, err := gover.NewVersion(d)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+
+	sort.Sort(gover.Collection(versions))
+	return versions, nil
+}
+
+// createNamespace creates namespace
+func createNamespace(ctx context.Context, kc kubernetes.Interface, namespace string) error {
+	_, err := kc.CoreV1().Namespaces().Get(namespace, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	// Create the namespace
+	requestid.Logger(ctx).WithFields(logrus.Fields{"namespace": namespace}).Info("Creating namespace")
+
+	ns := &corev1.Namespace{}
+	ns.Name = namespace
+	if _, err := kc.CoreV1().Namespaces().Create(ns); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"namespace": namespace, "status": "OK"}).Info("Creating namespace")
+
+	return nil
+}
+
+// deleteNamespace deletes namespace
+func deleteNamespace(ctx context.Context, kc kubernetes.Interface, namespace string) error {
+	requestid.Logger(ctx).WithFields(logrus.Fields{"namespace": namespace}).Info("Deleting namespace")
+
+	if err := kc.CoreV1().Namespaces().Delete(namespace, &metav1.DeleteOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	// Wait till the namespaces will be removed
+	startTime := time.Now()
+	for {
+		if time.Since(startTime)/time.Second > 50 {
+			return fmt.Errorf("timed out waiting for deleting namespace %s", namespace)
+		}
+
+		if _, err := kc.CoreV1().Namespaces().Get(namespace, metav1.GetOptions{}); errors.IsNotFound(err) {
+			break
+		}
+
+		time.Sleep(1 * time.Second)
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"namespace": namespace, "status": "OK"}).Info("Deleting namespace")
+
+	return nil
+}
+
+// checkAvailableResources checks whether amount of free resources in the cluster satisfies request
+func checkAvailableResources(ctx context.Context, kc kubernetes.Interface, numberOfInstances int, limits *appmanager.Spec_Resources_Limits) error {
+
+	requestid.Logger(ctx).Info("Verifying resources availability")
+
+	nodes, err := kc.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	if len(nodes.Items) == 0 {
+		return fmt.Errorf("no cluster nodes found")
+	}
+
+	var allocatableCpu float64
+	var allocatableMemory int64
+	for _, n := range nodes.Items {
+		cpu := n.Status.Allocatable[corev1.ResourceCPU]
+		allocatableCpu += float64(cpu.MilliValue()) / 1000
+		memory := n.Status.Allocatable[corev1.ResourceMemory]
+		allocatableMemory += memory.Value()
+	}
+
+	if limits.GetCpu() > 0 {
+		requested := limits.GetCpu() * float64(numberOfInstances)
+		if requested > allocatableCpu {
+			return fmt.Errorf("number of requested CPUs (%.2f) exceeds number of available CPUs (%.2f)", requested, allocatableCpu)
+		}
+	}
+
+	if limits.GetMemory() > 0 {
+		pods, err := kc.CoreV1().Pods(metav1.NamespaceAll).List(metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+
+		// Sum up memory requested by the running pods
+		var requestedMemory int64
+		for _, pod := range pods.Items {
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+
+			for _, c := range pod.Spec.Containers {
+				if q, ok := c.Resources.Requests[corev1.ResourceMemory]; ok {
+					requestedMemory += q.Value()
+				}
+			}
+		}
+
+		free := uint32((allocatableMemory - requestedMemory) / (1 << 20))
+		if allocatableMemory < requestedMemory {
+			free = 0
+		}
+
+		requested := limits.GetMemory() * uint32(numberOfInstances)
+
+		if requested >= free {
+			return fmt.Errorf("requested memory (%d MiB) exceeds available memory (%d MiB)", requested, free)
+		}
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"status": "OK"}).Info("Verifying resources availability")
+
+	return nil
+}
@@ -0,0 +1,203 @@
+// Author  <dorzheho@cisco.com>
+
+package native
+
+import (
+	"strconv"
+
+	"k8s.io/client-go/kubernetes"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	gcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+// Application temporary data
+type appsData struct {
+	*common.AppsData
+}
+
+// Create temporary data structure
+func newAppsData() *appsData {
+	return &appsData{common.NewAppsData()}
+}
+
+// Append running applications
+func (apps *appsData) appendRunningAppsData(kc kubernetes.Interface, req common.GenericRequester, cycle string) error {
+	// Fetch release records from the cluster
+	records, err := listReleaseRecords(kc)
+	if err != nil {
+		return err
+	}
+
+	// Iterate over the records
+	for _, item := range records {
+		// All the application instances deployed by Controller
+		// have appropriate annotation - "apphc.app.basename"
+		if appName := gcommon.MapGet(item.Annotations, common.AppAnnotationBaseName); appName != "" {
+			// Continue if the annotation value is no equal to the name in the request
+			if req.GetName() != "" && req.GetName() != appName {
+				continue
+			}
+
+			// If the cycle field received with request use it for filtering
+			if cycle != "" && cycle != gcommon.MapGet(item.Annotations, common.AppAnnotationCycle) {
+				continue
+			}
+
+			// If root group ID appears in request use it for filtering
+			if req.GetRootGroupId() != "" &&
+				req.GetRootGroupId() != gcommon.MapGet(item.Annotations, common.AppInstanceAnnotationRootGroupId) {
+				continue
+			}
+
+			// Find Group ID
+			groupId := gcommon.MapGet(item.Annotations, common.AppInstanceAnnotationGroupId)
+			if groupId == "" {
+				continue
+			}
+
+			// The instance keeps the state it was left in
+			var state appmanager.AppStateAfterDeployment
+			if s := gcommon.MapGet(item.Annotations, common.AppInstanceAnnotationState); s != "" {
+				r, err := strconv.Atoi(s)
+				if err != nil {
+					return err
+				}
+				state = appmanager.AppStateAfterDeployment(r)
+			}
+
+			instanceName := recordInstanceName(&item)
+
+			if apps.SampleInstance == nil {
+				apps.SampleInstance = &common.AppInstanceData{}
+				apps.SampleInstance.InstanceName = instanceName
+				apps.SampleInstance.State = state
+				apps.SampleInstance.CurrentVersion = gcommon.MapGet(item.Annotations, common.AppInstanceAnnotationVersion)
+				apps.SampleInstance.RequestedVersion = apps.SampleInstance.CurrentVersion
+				apps.SampleInstance.Annotations = item.Annotations
+				apps.SampleInstance.TargetNamespace = item.Namespace
+			}
+
+			found := false
+			if len(req.GetGroupIds()) > 0 {
+				// if more that a single Group ID provided , iterate over the list
+				// and find a particular Group ID
+				for _, gid := range req.GetGroupIds() {
+					if gid == groupId {
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					continue
+				}
+			}
+
+			// Create the new application instance data
+			appInstance := &common.AppInstanceData{}
+
+			size, err := strconv.Atoi(gcommon.MapGet(item.Annotations, common.AppInstanceAnnotationPersistentVolumeSize))
+			if err != nil {
+				return err
+			}
+
+			appInstance.InstanceStorageSize = size
+			appInstance.InstanceName = instanceName
+			appInstance.State = state
+			appInstance.Description = item.Data[releaseRecordKeyDescription]
+			appInstance.CurrentVersion = gcommon.MapGet(item.Annotations, common.AppInstanceAnnotationVersion)
+			appInstance.TemplateAvailable = true
+			appInstance.NextAction = common.AppInstanceDataNextActionUpgrade
+			appInstance.TargetNamespace = item.Namespace
+
+			if req.GetVersion() == "" || appInstance.CurrentVersion == req.GetVersion() {
+				appInstance.RequestedVersion = appInstance.CurrentVersion
+				appInstance.NextAction = common.AppInstanceDataNextActionRecreate
+			} else {
+				appInstance.RequestedVersion = req.GetVersion()
+			}
+
+			appInstance.Annotations = item.Annotations
+			appInstance.Labels = recordInstanceLabels(&item)
+			apps.RunningAppsData[appName] = append(apps.RunningAppsData[appName], appInstance)
+		}
+	}
+
+	return nil
+}
+
+// Append all running application instances to the temporary data store
+func (apps *appsData) appendAppsDataToDelete(kc kubernetes.Interface, req common.GenericRequester) error {
+	// Fetch release records from the cluster
+	records, err := listReleaseRecords(kc)
+	if err != nil {
+		return err
+	}
+
+	var requestedVersion string
+
+	// Iterate over the records
+	for _, item := range records {
+		// All the application instances deployed by Controller
+		// have appropriate annotation - "apphc.app.basename"
+		if appName := gcommon.MapGet(item.Annotations, common.AppAnnotationBaseName); appName != "" {
+			if req != nil {
+				// Continue if the annotation value is no equal to the name in the request
+				if req.GetName() != "" && req.GetName() != appName {
+					continue
+				}
+
+				// If version appears in request use it for filtering
+				if req.GetVersion() != "" &&
+					req.GetVersion() != gcommon.MapGet(item.Annotations, common.AppInstanceAnnotationVersion) {
+					continue
+				}
+
+				// Set the version to the version came with request
+				requestedVersion = req.GetVersion()
+
+				// If root group ID appears in request use it for filtering
+				if req.GetRootGroupId() != "" &&
+					req.GetRootGroupId() != gcommon.MapGet(item.Annotations, common.AppInstanceAnnotationRootGroupId) {
+					continue
+				}
+
+				// Find Group ID
+				groupId := gcommon.MapGet(item.Annotations, common.AppInstanceAnnotationGroupId)
+				if groupId == "" {
+					continue
+				}
+
+				found := false
+				if len(req.GetGroupIds()) > 0 {
+					// if more that a single Group ID provided , iterate over the list
+					// and find a particular Group ID
+					for _, gid := range req.GetGroupIds() {
+						if gid == groupId {
+							found = true
+							break
+						}
+					}
+					if !found {
+						continue
+					}
+				}
+			}
+
+			// Create the new application instance data
+			appInstance := &common.AppInstanceData{}
+			appInstance.InstanceName = recordInstanceName(&item)
+			appInstance.CurrentVersion = gcommon.MapGet(item.Annotations, common.AppInstanceAnnotationVersion)
+			appInstance.RequestedVersion = requestedVersion
+			appInstance.TemplateAvailable = true
+			appInstance.Annotations = item.Annotations
+			appInstance.TargetNamespace = item.Namespace
+			appInstance.NextAction = common.AppInstanceDataNextActionDelete
+			apps.RunningAppsData[appName] = append(apps.RunningAppsData[appName], appInstance)
+		}
+	}
+
+	return nil
+}
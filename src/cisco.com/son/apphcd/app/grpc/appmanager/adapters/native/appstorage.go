@@ -0,0 +1,264 @@
+// Author <dorzheho@cisco.com>
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/requestid"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+// sharedVolumeName gives back the name of the persistent volume shared by all instances of the application
+func sharedVolumeName(namespace string) string {
+	return namespace + "-shared-pv"
+}
+
+// sharedClaimName gives back the name of the persistent volume claim shared by all instances of the application
+func sharedClaimName(namespace string) string {
+	return namespace + "-shared-pvc"
+}
+
+// instanceVolumeName gives back the name of the persistent volume of appropriate application instance
+func instanceVolumeName(instanceName string) string {
+	return instanceName + "-pv"
+}
+
+// instanceClaimName gives back the name of the persistent volume claim of appropriate application instance
+func instanceClaimName(instanceName string) string {
+	return instanceName + "-pvc"
+}
+
+// createSharedStorage creates persistent volume and its claim shared by all instances of the application
+func createSharedStorage(ctx context.Context, kc kubernetes.Interface, appName, namespace string, volSize int) error {
+	volumeName := sharedVolumeName(namespace)
+	claimName := sharedClaimName(namespace)
+
+	pv, err := kc.CoreV1().PersistentVolumes().Get(volumeName, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	if err == nil {
+		if volSize == storageGb(pv.Spec.Capacity) {
+			requestid.Logger(ctx).WithFields(logrus.Fields{"volume": volumeName}).Info("Persistent volume already exists")
+			return createPersistentVolumeClaim(ctx, kc, appName, volumeName, claimName, namespace, nil, nil,
+				corev1.ReadWriteMany, volSize)
+		}
+
+		// Size has changed, the volume should be recreated
+		if err := deleteStorage(ctx, kc, namespace, volumeName, claimName); err != nil {
+			return err
+		}
+	}
+
+	a := appcommon.MakeMap()
+	a.Add(appmgrcommon.AppAnnotationBaseName, appName)
+	path := filepath.Join(appcommon.AppdataPath, appName, "shared")
+
+	if err := createPersistentVolume(ctx, kc, volumeName, path, a, nil, corev1.ReadWriteMany, volSize); err != nil {
+		return err
+	}
+
+	return createPersistentVolumeClaim(ctx, kc, appName, volumeName, claimName, namespace, a, nil, corev1.ReadWriteMany, volSize)
+}
+
+// createInstanceStorage creates persistent volume and its claim for appropriate application instance
+func createInstanceStorage(ctx context.Context, kc kubernetes.Interface, data *appmgrcommon.AppInstanceData) error {
+	volumeName := instanceVolumeName(data.InstanceName)
+	claimName := instanceClaimName(data.InstanceName)
+	appName := data.Annotations.Get(appmgrcommon.AppAnnotationBaseName)
+
+	_, err := kc.CoreV1().PersistentVolumes().Get(volumeName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		path := filepath.Join(appcommon.AppdataPath, appName, data.InstanceName)
+		if err := createPersistentVolume(ctx, kc, volumeName, path, data.Annotations, data.Labels,
+			corev1.ReadWriteOnce, data.InstanceStorageSize); err != nil {
+			return err
+		}
+
+	} else if err != nil {
+		return err
+	}
+
+	return createPersistentVolumeClaim(ctx, kc, appName, volumeName, claimName, data.TargetNamespace, data.Annotations,
+		data.Labels, corev1.ReadWriteOnce, data.InstanceStorageSize)
+}
+
+// createPersistentVolume creates a new host path persistent volume
+func createPersistentVolume(ctx context.Context, kc kubernetes.Interface, volumeName, path string, annotations, labels appcommon.Map,
+	mode corev1.PersistentVolumeAccessMode, volSize int) error {
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": volumeName}).Info("Creating persistent volume")
+
+	size, err := resource.ParseQuantity(fmt.Sprintf("%dGi", volSize))
+	if err != nil {
+		return err
+	}
+
+	pv := &corev1.PersistentVolume{}
+	pv.Name = volumeName
+	pv.Annotations = annotations
+	pv.Labels = labels
+	pv.Spec.HostPath = &corev1.HostPathVolumeSource{Path: path}
+	pv.Spec.Capacity = corev1.ResourceList{corev1.ResourceStorage: size}
+	pv.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{mode}
+	pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+
+	if _, err := kc.CoreV1().PersistentVolumes().Create(pv); err != nil {
+		requestid.Logger(ctx).Error(err)
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": volumeName, "status": "OK"}).Info("Creating persistent volume")
+
+	return nil
+}
+
+// createPersistentVolumeClaim creates new PVC and binds it to appropriate Persistent Volume (PV)
+func createPersistentVolumeClaim(ctx context.Context, kc kubernetes.Interface, appName, volumeName, claimName, namespace string,
+	annotations, labels appcommon.Map, mode corev1.PersistentVolumeAccessMode, volSize int) error {
+
+	if _, err := kc.CoreV1().PersistentVolumeClaims(namespace).Get(claimName, metav1.GetOptions{}); err == nil {
+		return nil
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+
+	size, err := resource.ParseQuantity(fmt.Sprintf("%dGi", volSize))
+	if err != nil {
+		return err
+	}
+
+	// Disable dynamic provisioning, the claim is bound to the volume created by controller
+	storageClass := ""
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	pvc.Name = claimName
+	pvc.Namespace = namespace
+	pvc.Annotations = appcommon.MakeMap()
+	appcommon.MapMerge(pvc.Annotations, annotations)
+	pvc.Annotations[appmgrcommon.AppAnnotationBaseName] = appName
+	pvc.Labels = labels
+	pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{mode}
+	pvc.Spec.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: size}
+	pvc.Spec.StorageClassName = &storageClass
+	pvc.Spec.VolumeName = volumeName
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"claim": claimName}).Info("Creating persistent volume claim")
+
+	if _, err := kc.CoreV1().PersistentVolumeClaims(namespace).Create(pvc); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"claim": claimName, "status": "OK"}).Info("Creating persistent volume claim")
+
+	// Wait till the PV and PVC will be in the "Bound" state
+	return waitForVolumePhase(ctx, kc, volumeName, corev1.VolumeBound)
+}
+
+// deleteStorage deletes persistent volume claim and the volume bound to it
+func deleteStorage(ctx context.Context, kc kubernetes.Interface, namespace, volumeName, claimName string) error {
+	_, err := kc.CoreV1().PersistentVolumeClaims(namespace).Get(claimName, metav1.GetOptions{})
+	if err == nil {
+		requestid.Logger(ctx).WithFields(logrus.Fields{"claim": claimName}).Info("Deleting persistent volume claim")
+
+		if err := kc.CoreV1().PersistentVolumeClaims(namespace).Delete(claimName, &metav1.DeleteOptions{}); err != nil &&
+			!errors.IsNotFound(err) {
+			return err
+		}
+
+		requestid.Logger(ctx).WithFields(logrus.Fields{"claim": claimName, "status": "OK"}).Info("Deleting persistent volume claim")
+
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": volumeName}).Info("Deleting persistent volume")
+
+	if err := kc.CoreV1().PersistentVolumes().Delete(volumeName, &metav1.DeleteOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	// Wait till the PV will be removed
+	if err := waitForVolumePhase(ctx, kc, volumeName, ""); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": volumeName, "status": "OK"}).Info("Deleting persistent volume")
+
+	return nil
+}
+
+// waitForVolumePhase waits until particular volume reaches appropriate phase.
+// Empty phase means the volume should be removed
+func waitForVolumePhase(ctx context.Context, kc kubernetes.Interface, volumeName string, expectedPhase corev1.PersistentVolumePhase) error {
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": volumeName}).Info("Waiting for the volume")
+
+	timeout := time.After(time.Duration(100) * time.Second)
+	every := time.Tick(1 * time.Second)
+
+LOOP:
+	for {
+		pv, err := kc.CoreV1().PersistentVolumes().Get(volumeName, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			if expectedPhase == "" {
+				break LOOP
+			}
+		} else if err != nil {
+			return err
+		} else if expectedPhase != "" && pv.Status.Phase == expectedPhase {
+			break LOOP
+		}
+
+		select {
+		case <-timeout:
+			if expectedPhase == "" {
+				return fmt.Errorf("timed out waiting for PV %s removal", volumeName)
+			}
+			return fmt.Errorf("timed out waiting for PV state %s", expectedPhase)
+		case <-every:
+		}
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": volumeName, "status": "OK"}).Info("Waiting for the volume")
+
+	return nil
+}
+
+// getStorageCapacity gives back capacity of appropriate persistent volume in GiB
+func getStorageCapacity(kc kubernetes.Interface, volumeName string) (uint32, error) {
+	pv, err := kc.CoreV1().PersistentVolumes().Get(volumeName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return uint32(storageGb(pv.Spec.Capacity)), nil
+}
+
+// storageGb gives back the storage quantity in GiB
+func storageGb(l corev1.ResourceList) int {
+	q, ok := l[corev1.ResourceStorage]
+	if !ok {
+		return 0
+	}
+
+	return int(q.Value() / (1 << 30))
+}
@@ -0,0 +1,183 @@
+// Author <dorzheho@cisco.com>
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	"cisco.com/son/apphcd/app/common/longrunning"
+	"cisco.com/son/apphcd/app/grpc/appmanager/common"
+	"cisco.com/son/apphcd/app/grpc/appmanager/common/chartutils"
+)
+
+// createUpgradeApps responsible for making a decision whether the application
+// instance should be created, upgraded/updated
+func createUpgradeApps(ctx context.Context, kc kubernetes.Interface, appInstances []*common.AppInstanceData, catalogId string) ([]*appmanager.AppInstance, error) {
+
+	// Wait for the group of subroutines
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errors []error
+	var doneList []*appmanager.AppInstance
+
+	// done records the result of a particular action
+	done := func(instance *common.AppInstanceData, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errors = append(errors, err)
+		} else {
+			doneList = append(doneList, generateProtoData(instance, catalogId))
+			longrunning.Step(ctx, "instance %s ready", instance.InstanceName)
+		}
+	}
+
+	// Set once the operation is cancelled
+	var cancelled error
+
+	// iterate over the list of temporary data
+	for _, instance := range appInstances {
+		// The remaining instances are skipped once the operation is cancelled
+		if ctx.Err() != nil {
+			cancelled = fmt.Errorf("operation cancelled before handling the application instance %s", instance.InstanceName)
+			break
+		}
+
+		if instance.NextAction != common.AppInstanceDataNextActionNone {
+			// create a separate subroutine for a particular action
+			wg.Add(1)
+			go func(instance *common.AppInstanceData) {
+				defer wg.Done()
+
+				switch instance.NextAction {
+				case common.AppInstanceDataNextActionCreate, common.AppInstanceDataNextActionUpgrade:
+					// Create a new application instance or apply the new version on top of the running one
+					done(instance, createAppInstance(ctx, kc, instance, instance.RequestedVersion))
+
+				case common.AppInstanceDataNextActionRecreate:
+					if err := deleteAppInstance(ctx, kc, instance, instance.CurrentVersion); err != nil {
+						done(instance, err)
+					} else if err := createAppInstance(ctx, kc, instance, instance.RequestedVersion); err != nil {
+						done(instance, fmt.Errorf("cannot recreate the application instance %s: %s", instance.InstanceName, err.Error()))
+					} else {
+						done(instance, nil)
+					}
+				}
+			}(instance)
+
+			time.Sleep(time.Second * 1)
+		}
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	if cancelled != nil {
+		return nil, cancelled
+	}
+
+	return doneList, nil
+}
+
+// deleteApps deletes application instances and optionally their charts
+func deleteApps(ctx context.Context, kc kubernetes.Interface, appInstances []*common.AppInstanceData, catalogId, appName,
+	repoPath string, purge bool) ([]*appmanager.AppInstance, error) {
+
+	// Wait for the group of subroutines
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errors []error
+	var doneList []*appmanager.AppInstance
+
+	// Set path to the application root directory
+	root := filepath.Join(repoPath, appName)
+
+	// iterate over the list of temporary data
+	for _, instance := range appInstances {
+		if instance.NextAction != common.AppInstanceDataNextActionNone {
+			// create a separate subroutine for a particular action
+			wg.Add(1)
+			go func(instance *common.AppInstanceData) {
+				defer wg.Done()
+
+				if instance.NextAction != common.AppInstanceDataNextActionDelete {
+					return
+				}
+
+				instance.DeleteInstanceStorage = true
+				if err := deleteAppInstance(ctx, kc, instance, instance.CurrentVersion); err != nil {
+					mu.Lock()
+					errors = append(errors, err)
+					mu.Unlock()
+					return
+				}
+
+				longrunning.Step(ctx, "instance %s deleted", instance.InstanceName)
+
+				// If need to remove metadata
+				if purge {
+					// Set path to the chart
+					chartPath := filepath.Join(root, instance.InstanceName)
+					if instance.RequestedVersion != "" {
+						chartPath = filepath.Join(chartPath, instance.RequestedVersion)
+					}
+
+					// Delete the chart
+					err := chartutils.DeleteChart(ctx, chartPath, instance.InstanceName, instance.RequestedVersion)
+
+					mu.Lock()
+					if err != nil {
+						errors = append(errors, err)
+					}
+					// Add to the list
+					doneList = append(doneList, generateProtoData(instance, catalogId))
+					mu.Unlock()
+				} else {
+					mu.Lock()
+					doneList = append(doneList, generateProtoData(instance, ""))
+					mu.Unlock()
+				}
+			}(instance)
+
+			time.Sleep(time.Second * 1)
+		}
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	// Remove the application root directory once all the instances are gone
+	if purge && len(doneList) == len(appInstances) {
+		_ = os.RemoveAll(root)
+	}
+
+	return doneList, nil
+}
+
+// deleteCreatedApps deletes the application instances created by the upgrade being rolled back
+func deleteCreatedApps(ctx context.Context, kc kubernetes.Interface, appInstances []*common.AppInstanceData) error {
+	for _, instance := range common.CreatedInstances(appInstances) {
+		instance.DeleteInstanceStorage = true
+		if err := deleteAppInstance(ctx, kc, instance, instance.RequestedVersion); err != nil {
+			return fmt.Errorf("cannot delete the created application instance %s: %s", instance.InstanceName, err.Error())
+		}
+
+		longrunning.Step(ctx, "created instance %s deleted", instance.InstanceName)
+	}
+
+	return nil
+}
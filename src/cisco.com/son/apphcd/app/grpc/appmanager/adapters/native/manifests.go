@@ -0,0 +1,360 @@
+// Author <dorzheho@cisco.com>
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	extv1beta1 "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/requestid"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+	"cisco.com/son/apphcd/app/grpc/appmanager/common/chartutils"
+)
+
+const (
+	// Every application instance deployed by the native adapter is recorded in a ConfigMap
+	// which plays the role of Rancher application object
+	releaseRecordSuffix         = "-apphc-release"
+	releaseRecordLabel          = "apphc.app.release"
+	releaseRecordKeyDescription = "description"
+
+	// Name of the service rendering the charts
+	releaseService = "apphc"
+)
+
+// releaseRecordName gives back the name of the release record of appropriate application instance
+func releaseRecordName(instanceName string) string {
+	return instanceName + releaseRecordSuffix
+}
+
+// listReleaseRecords fetches release records of all application instances in the cluster
+func listReleaseRecords(kc kubernetes.Interface) ([]corev1.ConfigMap, error) {
+	l, err := kc.CoreV1().ConfigMaps(metav1.NamespaceAll).List(metav1.ListOptions{LabelSelector: releaseRecordLabel})
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the order stable
+	sort.Slice(l.Items, func(i, j int) bool {
+		return l.Items[i].Name < l.Items[j].Name
+	})
+
+	return l.Items, nil
+}
+
+// getReleaseRecord fetches release record of appropriate application instance
+func getReleaseRecord(kc kubernetes.Interface, namespace, instanceName string) (*corev1.ConfigMap, error) {
+	return kc.CoreV1().ConfigMaps(namespace).Get(releaseRecordName(instanceName), metav1.GetOptions{})
+}
+
+// recordInstanceName gives back the name of the application instance the record belongs to
+func recordInstanceName(record *corev1.ConfigMap) string {
+	return record.Labels[releaseRecordLabel]
+}
+
+// recordInstanceLabels gives back the labels of the application instance the record belongs to
+func recordInstanceLabels(record *corev1.ConfigMap) appcommon.Map {
+	l := appcommon.MakeMap()
+	appcommon.MapMerge(l, record.Labels, releaseRecordLabel)
+	return l
+}
+
+// saveReleaseRecord creates or updates release record of appropriate application instance
+func saveReleaseRecord(ctx context.Context, kc kubernetes.Interface, data *appmgrcommon.AppInstanceData) error {
+	record := &corev1.ConfigMap{}
+	record.Name = releaseRecordName(data.InstanceName)
+	record.Namespace = data.TargetNamespace
+	record.Annotations = appcommon.MakeMap()
+	appcommon.MapMerge(record.Annotations, data.Annotations)
+	record.Labels = appcommon.MakeMap()
+	appcommon.MapMerge(record.Labels, data.Labels)
+	record.Labels[releaseRecordLabel] = data.InstanceName
+	record.Data = map[string]string{releaseRecordKeyDescription: data.Description}
+
+	return applyObject(ctx, kc, data.TargetNamespace, record)
+}
+
+// deleteReleaseRecord deletes release record of appropriate application instance
+func deleteReleaseRecord(kc kubernetes.Interface, namespace, instanceName string) error {
+	err := kc.CoreV1().ConfigMaps(namespace).Delete(releaseRecordName(instanceName), &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// renderManifests renders the chart of appropriate application instance and decodes
+// the Kubernetes objects. Workloads are placed at the end of the list
+func renderManifests(ctx context.Context, chartPath string, data *appmgrcommon.AppInstanceData) ([]runtime.Object, error) {
+	manifests, err := chartutils.RenderChart(ctx, chartPath, chartutils.Release{Name: data.InstanceName,
+		Namespace: data.TargetNamespace, Service: releaseService})
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name := range manifests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var objs []runtime.Object
+	var workloads []runtime.Object
+
+	decoder := scheme.Codecs.UniversalDeserializer()
+	for _, name := range names {
+		for _, doc := range strings.Split(manifests[name], "\n---") {
+			if strings.TrimSpace(doc) == "" {
+				continue
+			}
+
+			obj, _, err := decoder.Decode([]byte(doc), nil, nil)
+			if err != nil {
+				return nil, fmt.Errorf("cannot decode manifest %s: %s", name, err.Error())
+			}
+
+			// Deployments defined by the chart templates are converted to the apps group
+			if d, ok := obj.(*extv1beta1.Deployment); ok {
+				if obj, err = convertDeployment(d); err != nil {
+					return nil, err
+				}
+			}
+
+			if isWorkload(obj) {
+				workloads = append(workloads, obj)
+			} else {
+				objs = append(objs, obj)
+			}
+		}
+	}
+
+	return append(objs, workloads...), nil
+}
+
+// convertDeployment converts Deployment of the extensions group to the apps group
+func convertDeployment(d *extv1beta1.Deployment) (*appsv1.Deployment, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &appsv1.Deployment{}
+	if err := json.Unmarshal(b, out); err != nil {
+		return nil, err
+	}
+
+	out.APIVersion = appsv1.SchemeGroupVersion.String()
+
+	// Selector is mandatory for the apps group
+	if out.Spec.Selector == nil {
+		out.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{
+			appmgrcommon.PodLabelApp:     out.Spec.Template.Labels[appmgrcommon.PodLabelApp],
+			appmgrcommon.PodLabelRelease: out.Spec.Template.Labels[appmgrcommon.PodLabelRelease],
+		}}
+	}
+
+	return out, nil
+}
+
+// isWorkload tells whether the object runs the application containers
+func isWorkload(obj runtime.Object) bool {
+	switch obj.(type) {
+	case *appsv1.Deployment, *batchv1beta1.CronJob, *batchv1.Job:
+		return true
+	}
+
+	return false
+}
+
+// isAutoscaler tells whether the object scales the workload of the application
+func isAutoscaler(obj runtime.Object) bool {
+	_, ok := obj.(*autoscalingv2beta1.HorizontalPodAutoscaler)
+	return ok
+}
+
+// deleteAutoscaler deletes the horizontal pod autoscaler of appropriate application instance if exists
+func deleteAutoscaler(kc kubernetes.Interface, namespace, instanceName string) error {
+	err := kc.AutoscalingV2beta1().HorizontalPodAutoscalers(namespace).Delete(instanceName, &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// applyObject creates the object or updates it if already exists
+func applyObject(ctx context.Context, kc kubernetes.Interface, namespace string, obj runtime.Object) error {
+	var err error
+
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		o.Namespace = namespace
+		c := kc.CoreV1().ConfigMaps(namespace)
+		var cur *corev1.ConfigMap
+		if cur, err = c.Get(o.Name, metav1.GetOptions{}); errors.IsNotFound(err) {
+			_, err = c.Create(o)
+		} else if err == nil {
+			o.ResourceVersion = cur.ResourceVersion
+			_, err = c.Update(o)
+		}
+
+	case *corev1.Secret:
+		o.Namespace = namespace
+		c := kc.CoreV1().Secrets(namespace)
+		var cur *corev1.Secret
+		if cur, err = c.Get(o.Name, metav1.GetOptions{}); errors.IsNotFound(err) {
+			_, err = c.Create(o)
+		} else if err == nil {
+			o.ResourceVersion = cur.ResourceVersion
+			_, err = c.Update(o)
+		}
+
+	case *corev1.Service:
+		o.Namespace = namespace
+		c := kc.CoreV1().Services(namespace)
+		var cur *corev1.Service
+		if cur, err = c.Get(o.Name, metav1.GetOptions{}); errors.IsNotFound(err) {
+			_, err = c.Create(o)
+		} else if err == nil {
+			// Cluster IP is immutable
+			o.ResourceVersion = cur.ResourceVersion
+			o.Spec.ClusterIP = cur.Spec.ClusterIP
+			_, err = c.Update(o)
+		}
+
+	case *extv1beta1.Ingress:
+		o.Namespace = namespace
+		c := kc.ExtensionsV1beta1().Ingresses(namespace)
+		var cur *extv1beta1.Ingress
+		if cur, err = c.Get(o.Name, metav1.GetOptions{}); errors.IsNotFound(err) {
+			_, err = c.Create(o)
+		} else if err == nil {
+			o.ResourceVersion = cur.ResourceVersion
+			_, err = c.Update(o)
+		}
+
+	case *appsv1.Deployment:
+		o.Namespace = namespace
+		c := kc.AppsV1().Deployments(namespace)
+		var cur *appsv1.Deployment
+		if cur, err = c.Get(o.Name, metav1.GetOptions{}); errors.IsNotFound(err) {
+			_, err = c.Create(o)
+		} else if err == nil {
+			o.ResourceVersion = cur.ResourceVersion
+			_, err = c.Update(o)
+		}
+
+	case *autoscalingv2beta1.HorizontalPodAutoscaler:
+		o.Namespace = namespace
+		c := kc.AutoscalingV2beta1().HorizontalPodAutoscalers(namespace)
+		var cur *autoscalingv2beta1.HorizontalPodAutoscaler
+		if cur, err = c.Get(o.Name, metav1.GetOptions{}); errors.IsNotFound(err) {
+			_, err = c.Create(o)
+		} else if err == nil {
+			o.ResourceVersion = cur.ResourceVersion
+			_, err = c.Update(o)
+		}
+
+	case *batchv1beta1.CronJob:
+		o.Namespace = namespace
+		c := kc.BatchV1beta1().CronJobs(namespace)
+		var cur *batchv1beta1.CronJob
+		if cur, err = c.Get(o.Name, metav1.GetOptions{}); errors.IsNotFound(err) {
+			_, err = c.Create(o)
+		} else if err == nil {
+			o.ResourceVersion = cur.ResourceVersion
+			_, err = c.Update(o)
+		}
+
+	case *batchv1.Job:
+		// Job template is immutable hence the job is recreated
+		o.Namespace = namespace
+		if err = deleteWorkload(ctx, kc, namespace, o.Name, appmgrcommon.TypeRunOnce); err == nil {
+			_, err = kc.BatchV1().Jobs(namespace).Create(o)
+		}
+
+	default:
+		err = fmt.Errorf("unsupported object kind %s", obj.GetObjectKind().GroupVersionKind().Kind)
+	}
+
+	return err
+}
+
+// deleteWorkload deletes the workload of appropriate application instance and waits until it is gone
+func deleteWorkload(ctx context.Context, kc kubernetes.Interface, namespace, instanceName, cycle string) error {
+	propagation := metav1.DeletePropagationBackground
+	opts := &metav1.DeleteOptions{PropagationPolicy: &propagation}
+
+	var get func() error
+	var err error
+
+	switch cycle {
+	case appmgrcommon.TypePeriodic:
+		err = kc.BatchV1beta1().CronJobs(namespace).Delete(instanceName, opts)
+		get = func() error {
+			_, err := kc.BatchV1beta1().CronJobs(namespace).Get(instanceName, metav1.GetOptions{})
+			return err
+		}
+	case appmgrcommon.TypeRunOnce:
+		err = kc.BatchV1().Jobs(namespace).Delete(instanceName, opts)
+		get = func() error {
+			_, err := kc.BatchV1().Jobs(namespace).Get(instanceName, metav1.GetOptions{})
+			return err
+		}
+	default:
+		err = kc.AppsV1().Deployments(namespace).Delete(instanceName, opts)
+		get = func() error {
+			_, err := kc.AppsV1().Deployments(namespace).Get(instanceName, metav1.GetOptions{})
+			return err
+		}
+	}
+
+	if errors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	for {
+		err := get()
+		if errors.IsNotFound(err) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if time.Since(startTime)/time.Second > 30 {
+			return fmt.Errorf("timed out waiting for deleting workload %s", instanceName)
+		}
+
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": instanceName, "namespace": namespace}).Debug("Workload deleted")
+
+	return nil
+}
@@ -3,6 +3,7 @@
 package rancher
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -11,7 +12,6 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	projectClient "github.com/rancher/types/client/project/v3"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"golang.org/x/sync/errgroup"
@@ -19,6 +19,10 @@ import (
 
 	"cisco.com/son/apphcd/api/v1/appmanager"
 	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/config"
+	"cisco.com/son/apphcd/app/common/longrunning"
+	"cisco.com/son/apphcd/app/common/metrics"
+	"cisco.com/son/apphcd/app/common/requestid"
 	"cisco.com/son/apphcd/app/grpc/appmanager/adapters/rancher/apiclient"
 	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
 	"cisco.com/son/apphcd/app/grpc/appmanager/common/chartutils"
@@ -33,16 +37,15 @@ const (
 )
 
 type rancherAppMgrAdapter struct {
-	mc *rancher.MasterClient
-	pc *projectClient.Client
-	kc *kubernetes.Clientset
+	clients *rancher.Clients // Clients of the Applications and the Services projects
+	kc      *kubernetes.Clientset
 }
 
 // Initialize a new Rancher Adapter:
 // - parse Application Controller config file
 // - create cache
 // - create a new commander
-func NewAdapter(mc *rancher.MasterClient, pc *projectClient.Client, kubeClient *kubernetes.Clientset) (*rancherAppMgrAdapter, error) {
+func NewAdapter(clients *rancher.Clients, kubeClient *kubernetes.Clientset) (*rancherAppMgrAdapter, error) {
 	// Set cache path
 	path, err := os.Stat(viper.GetString(appcommon.EnvApphcCachePath))
 	if os.IsNotExist(err) {
@@ -61,7 +64,7 @@ func NewAdapter(mc *rancher.MasterClient, pc *projectClient.Client, kubeClient *
 		if err := os.RemoveAll(targetDir); err != nil {
 			sysCatalogErrCh <- err
 		}
-		sysCatalogErrCh <- syncer.Clone(viper.GetString(rancher.EnvApphcAdaptersRancherCatalogProto),
+		sysCatalogErrCh <- syncer.Clone(context.Background(), viper.GetString(rancher.EnvApphcAdaptersRancherCatalogProto),
 			appmgrcommon.CatalogUser, viper.GetString(rancher.EnvApphcAdaptersRancherCatalogPassword),
 			viper.GetString(appcommon.EnvApphcGitServerEndpoint), appmgrcommon.CatalogTemplatesRepo,
 			targetDir, viper.GetString(rancher.EnvApphcAdaptersRancherTemplatesCatalogBranch))
@@ -74,7 +77,7 @@ func NewAdapter(mc *rancher.MasterClient, pc *projectClient.Client, kubeClient *
 			appsCatalogErrCh <- err
 		}
 
-		appsCatalogErrCh <- syncer.Clone(viper.GetString(rancher.EnvApphcAdaptersRancherCatalogProto),
+		appsCatalogErrCh <- syncer.Clone(context.Background(), viper.GetString(rancher.EnvApphcAdaptersRancherCatalogProto),
 			appmgrcommon.CatalogUser, viper.GetString(rancher.EnvApphcAdaptersRancherCatalogPassword),
 			viper.GetString(appcommon.EnvApphcGitServerEndpoint),
 			appmgrcommon.CatalogAppsRepo, targetDir,
@@ -94,31 +97,33 @@ func NewAdapter(mc *rancher.MasterClient, pc *projectClient.Client, kubeClient *
 	}
 
 	// Return Rancher AppManager adapter
-	return &rancherAppMgrAdapter{mc: mc, pc: pc, kc: kubeClient}, nil
+	return &rancherAppMgrAdapter{clients: clients, kc: kubeClient}, nil
 }
 
 // Create an application and related instances
-func (adapter *rancherAppMgrAdapter) CreateApp(req *appmanager.CreateAppRequest) (*appmanager.Response, error) {
+func (adapter *rancherAppMgrAdapter) CreateApp(ctx context.Context, req *appmanager.CreateAppRequest) (*appmanager.Response, error) {
 	// Synchronize cache
-	if err := syncCache(); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := syncCache(ctx); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
-	// Refresh catalog
-	if err := apiclient.RefreshCatalog(adapter.mc, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	// Refresh catalog. Dry run leaves Rancher untouched
+	if !req.DryRun {
+		if err := apiclient.RefreshCatalog(ctx, adapter.clients.Apps(), viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
 	}
 
 	// Construct Application temporary data
 	apps := NewAppsData()
-	if err := apps.AppendRunningAppsData(adapter.mc, req, req.Cycle, req.AppState); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := apps.AppendRunningAppsData(adapter.clients.Apps(), req, req.Cycle, req.AppState); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Identify application type
 	chartType, err := appmgrcommon.AppTypeToAppCycle(req.Cycle)
 	if err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Create the temporary data for the new applications
@@ -126,19 +131,21 @@ func (adapter *rancherAppMgrAdapter) CreateApp(req *appmanager.CreateAppRequest)
 
 	// Check if the requested application name and version already exist
 	if apps.NewAppInstancesDataEmpty() {
-		return appmgrcommon.GenerateResponse(appmanager.Status_NOT_FOUND, "Nothing to deploy", nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to deploy", nil)
 	}
 
 	namespace := apps.NewAppInstancesData[0].TargetNamespace
-	if err := apiclient.CreateNamespace(adapter.mc, namespace); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
-	}
+	if !req.DryRun {
+		if err := apiclient.CreateNamespace(ctx, adapter.clients.Apps(), namespace); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
 
-	// If shared storage requested
-	if req.SharedStorage > 0 {
-		kubeConvAppName := namespace
-		if err := apiclient.CreateSharedStorage(adapter.mc, req.Name, kubeConvAppName, namespace, int(req.SharedStorage)); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		// If shared storage requested
+		if req.SharedStorage > 0 {
+			kubeConvAppName := namespace
+			if err := apiclient.CreateSharedStorage(ctx, adapter.clients.Apps(), req.Name, kubeConvAppName, namespace, int(req.SharedStorage)); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
 		}
 	}
 
@@ -157,11 +164,11 @@ func (adapter *rancherAppMgrAdapter) CreateApp(req *appmanager.CreateAppRequest)
 				}
 
 				// Check if appropriate template available
-				value, err := apiclient.TemplateAvailable(adapter.mc, appcommon.MapGet(newAppInstance.Annotations,
+				value, err := apiclient.TemplateAvailable(ctx, adapter.clients.Apps(), appcommon.MapGet(newAppInstance.Annotations,
 					appmgrcommon.AppInstanceAnnotationTemplateName), newAppInstance.RequestedVersion,
 					viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName))
 				if err != nil {
-					return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 				}
 				newAppInstance.TemplateAvailable = value
 			}
@@ -171,8 +178,8 @@ func (adapter *rancherAppMgrAdapter) CreateApp(req *appmanager.CreateAppRequest)
 	limits := req.GetSpec().GetResources().GetLimits()
 
 	if limits != nil {
-		if err := apiclient.CheckAvailableResources(adapter.mc, apps.GetNumberOfNewInstances(), req.Spec.Resources.Limits); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if err := apiclient.CheckAvailableResources(ctx, adapter.clients.Apps(), apps.GetNumberOfNewInstances(), req.Spec.Resources.Limits); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 	}
 
@@ -187,47 +194,67 @@ func (adapter *rancherAppMgrAdapter) CreateApp(req *appmanager.CreateAppRequest)
 					req.Name, appcommon.MapGet(newAppInstance.Annotations, appmgrcommon.AppInstanceAnnotationTemplateName),
 					newAppInstance.RequestedVersion)
 				if err := chartutils.SetChartData(newAppInstance, req, dstChart, nil); err != nil {
-					return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+				}
+				if req.DryRun {
+					continue
 				}
-				if err := chartutils.CreateChart(chartTemplate, dstChart, chartType, req.Name, newAppInstance); err != nil {
-					return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+				if err := chartutils.CreateChart(ctx, chartTemplate, dstChart, chartType, req.Name, newAppInstance); err != nil {
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 				}
+				longrunning.Step(ctx, "chart created for instance %s", newAppInstance.InstanceName)
 				createdEntries++
 			}
 		}
 
 		// Update the chart store only if necessary
 		if createdEntries > 0 {
-			if err := syncCatalog(adapter.mc, apps.NewAppInstancesData, req.Description); err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			if err := syncCatalog(ctx, adapter.clients.Apps(), apps.NewAppInstancesData, req.Description); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+			longrunning.Step(ctx, "catalog synced")
+		}
+	}
+
+	// Plan the changes instead of applying them
+	if req.DryRun {
+		plan := &appmanager.Plan{Name: req.Name, Cycle: req.Cycle}
+		chartApp := filepath.Join(viper.GetString(appcommon.EnvApphcCachePath), appmgrcommon.CatalogAppsRepo, req.Name)
+		for _, newAppInstance := range apps.NewAppInstancesData {
+			instancePlan, err := chartutils.PlanInstance(newAppInstance, chartType, chartApp, req.FromCatalog || newAppInstance.TemplateAvailable)
+			if err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
+			plan.Instances = append(plan.Instances, instancePlan)
 		}
+
+		return appmgrcommon.GeneratePlanResponse(ctx, plan)
 	}
 
 	if limits == nil {
-		if err := resourcemgr.DeleteLimitRange(adapter.kc, namespace); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if err := resourcemgr.DeleteLimitRange(ctx, adapter.kc, namespace); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 
 	} else {
 
-		if err := resourcemgr.CreateUpdateLimitRange(adapter.kc, namespace, req.GetSpec().GetResources().GetLimits().GetCpu(),
+		if err := resourcemgr.CreateUpdateLimitRange(ctx, adapter.kc, namespace, req.GetSpec().GetResources().GetLimits().GetCpu(),
 			req.GetSpec().GetResources().GetLimits().GetMemory(), appmgrcommon.AppInstanceDefaultCpuRequest,
 			appmgrcommon.AppInstanceDefaultMemoryRequest); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 	}
 
 	// Create application instance
-	doneList, err := createUpgradeApps(adapter.mc, apps.NewAppInstancesData, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName))
+	doneList, err := createUpgradeApps(ctx, adapter.clients.Apps(), apps.NewAppInstancesData, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName))
 	if err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// If nothing is added print appropriate message
 	if len(doneList) == 0 {
 		err := fmt.Errorf("application %s already deployed", req.Name)
-		return appmgrcommon.GenerateResponse(appmanager.Status_UNCHANGED, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_UNCHANGED, err.Error(), nil)
 	}
 
 	var msg string
@@ -237,49 +264,240 @@ func (adapter *rancherAppMgrAdapter) CreateApp(req *appmanager.CreateAppRequest)
 		msg = "Application deployed successfully but disabled"
 	}
 	// Generate response
-	return appmgrcommon.GenerateResponse(appmanager.Status_SUCCESS, msg, &appmanager.App{Name: req.Name,
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, msg, &appmanager.App{Name: req.Name,
 		Cycle: req.Cycle, Instances: doneList})
 }
 
 // Upgrade running application instance
-func (adapter *rancherAppMgrAdapter) UpgradeApp(req *appmanager.UpgradeAppRequest) (*appmanager.Response, error) {
-	namespace := strings.Replace(strings.ToLower(req.Name),"_","-", -1)
-	if err := apiclient.CreateNamespace(adapter.mc, namespace); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+func (adapter *rancherAppMgrAdapter) UpgradeApp(ctx context.Context, req *appmanager.UpgradeAppRequest) (*appmanager.Response, error) {
+	if !req.DryRun {
+		namespace := strings.Replace(strings.ToLower(req.Name),"_","-", -1)
+		if err := apiclient.CreateNamespace(ctx, adapter.clients.Apps(), namespace); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
 	}
 
-	return adapter.updateUpgradeApps(req, false)
+	return adapter.updateUpgradeApps(ctx, req, false)
 }
 
 // Update running application instance
-func (adapter *rancherAppMgrAdapter) UpdateApp(req *appmanager.UpdateAppRequest) (*appmanager.Response, error) {
-	return adapter.updateUpgradeApps(req, true)
+func (adapter *rancherAppMgrAdapter) UpdateApp(ctx context.Context, req *appmanager.UpdateAppRequest) (*appmanager.Response, error) {
+	return adapter.updateUpgradeApps(ctx, req, true)
+}
+
+// Roll back running application instances to the chart version or the apps catalog revision
+func (adapter *rancherAppMgrAdapter) RollbackApp(ctx context.Context, req *appmanager.RollbackAppRequest) (*appmanager.Response, error) {
+	// Synchronize cache
+	if err := syncCache(ctx); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	apps := NewAppsData()
+	if err := apps.AppendRunningAppsData(adapter.clients.Apps(), req, "", appmanager.AppStateAfterDeployment_enabled); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	instances := apps.GetRunningAppData(req.Name)
+	if len(instances) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to roll back", nil)
+	}
+
+	repoPath := filepath.Join(viper.GetString(appcommon.EnvApphcCachePath), appmgrcommon.CatalogAppsRepo)
+
+	// Charts shared by the instances are restored once
+	restored := make(map[string]bool)
+	var restoredInstances []*appmgrcommon.AppInstanceData
+
+	for _, instance := range instances {
+		// Instances already running the requested chart are left as they are
+		if req.Revision == "" && instance.RequestedVersion == instance.CurrentVersion {
+			instance.NextAction = appmgrcommon.AppInstanceDataNextActionNone
+			continue
+		}
+
+		if config.Current().UpgradePolicyRecreate {
+			instance.NextAction = appmgrcommon.AppInstanceDataNextActionRecreate
+		}
+
+		chartDir := filepath.Join(req.Name, instance.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName),
+			instance.RequestedVersion)
+
+		// The chart kept in the catalog is used unless a particular revision requested
+		if req.Revision == "" {
+			if _, err := os.Stat(filepath.Join(repoPath, chartDir)); err == nil {
+				continue
+			}
+		}
+
+		if !restored[chartDir] {
+			revision, err := syncer.Restore(ctx, repoPath, req.Revision, chartDir)
+			if err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+
+			restored[chartDir] = true
+			longrunning.Step(ctx, "chart of instance %s restored from revision %s", instance.InstanceName, revision)
+		}
+
+		restoredInstances = append(restoredInstances, instance)
+	}
+
+	if len(restoredInstances) > 0 {
+		if err := syncCatalog(ctx, adapter.clients.Apps(), restoredInstances, "Roll back application charts"); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+		longrunning.Step(ctx, "catalog synced")
+	}
+
+	doneList, err := createUpgradeApps(ctx, adapter.clients.Apps(), instances, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName))
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	if len(doneList) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_UNCHANGED,
+			fmt.Sprintf("Application %s already runs version %s", req.Name, req.Version), nil)
+	}
+
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application rolled back successfully",
+		&appmanager.App{Name: req.Name, Cycle: instances[0].Annotations.Get(appmgrcommon.AppAnnotationCycle), Instances: doneList})
+}
+
+// ScaleApp changes the number of replicas of daemon application instances
+func (adapter *rancherAppMgrAdapter) ScaleApp(ctx context.Context, req *appmanager.ScaleAppRequest) (*appmanager.Response, error) {
+	// Synchronize cache
+	if err := syncCache(ctx); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	apps := NewAppsData()
+	if err := apps.AppendRunningAppsData(adapter.clients.Apps(), req, "", appmanager.AppStateAfterDeployment_enabled); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Only the instances running the requested version are scaled
+	var instances []*appmgrcommon.AppInstanceData
+	for _, instance := range apps.GetRunningAppData(req.Name) {
+		if req.Version == "" || instance.CurrentVersion == req.Version {
+			instances = append(instances, instance)
+		}
+	}
+
+	if len(instances) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to scale", nil)
+	}
+
+	appCycle := instances[0].Annotations.Get(appmgrcommon.AppAnnotationCycle)
+	if appCycle != appmgrcommon.TypeDaemon {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR,
+			fmt.Sprintf("application %s of type %s cannot be scaled", req.Name, appCycle), nil)
+	}
+
+	repoPath := filepath.Join(viper.GetString(appcommon.EnvApphcCachePath), appmgrcommon.CatalogAppsRepo)
+
+	chartDir := func(instance *appmgrcommon.AppInstanceData) string {
+		return filepath.Join(repoPath, req.Name, instance.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName),
+			instance.CurrentVersion)
+	}
+
+	// Instances are scaled only once all of them can be scaled
+	for _, instance := range instances {
+		autoscaled, err := chartutils.Autoscaled(chartDir(instance))
+		if err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		if autoscaled {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR,
+				fmt.Sprintf("application instance %s is scaled by the horizontal pod autoscaler", instance.InstanceName), nil)
+		}
+	}
+
+	// Keep the charts in sync with the running instances
+	for _, instance := range instances {
+		if err := chartutils.SetReplicas(ctx, chartDir(instance), instance.InstanceName, int(req.Replicas)); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+	}
+
+	if err := syncCatalog(ctx, adapter.clients.Apps(), instances, "Scale application charts"); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+	longrunning.Step(ctx, "catalog synced")
+
+	var doneList []*appmanager.AppInstance
+	for _, instance := range instances {
+		if err := apiclient.ScaleAppInstance(ctx, adapter.clients.Apps(), instance, int(req.Replicas)); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		longrunning.Step(ctx, "instance %s scaled", instance.InstanceName)
+		doneList = append(doneList, generateProtoData(instance, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)))
+	}
+
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application scaled successfully",
+		&appmanager.App{Name: req.Name, Cycle: appCycle, Instances: doneList})
+}
+
+// List revisions of the application charts kept in the apps catalog
+func (adapter *rancherAppMgrAdapter) ListAppRevisions(ctx context.Context, req *appmanager.ListAppRevisionsRequest) (*appmanager.Response, error) {
+	// The history is read from the objects of the cache repository. It's not pulled, since the mutations
+	// may be writing the charts meanwhile. Every mutation pulls the repository, which keeps it up to date
+	revisions, err := chartutils.ListRevisions(ctx, filepath.Join(viper.GetString(appcommon.EnvApphcCachePath),
+		appmgrcommon.CatalogAppsRepo), req)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	if len(revisions) == 0 {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "no revision found", nil)
+	}
+
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application revisions",
+		&appmanager.AppRevisions{Name: req.Name, Revisions: revisions})
+}
+
+// Get revision of the application chart kept in the apps catalog and the changes made by it
+func (adapter *rancherAppMgrAdapter) GetAppRevision(ctx context.Context, req *appmanager.GetAppRevisionRequest) (*appmanager.Response, error) {
+	// The history is read from the objects of the cache repository without pulling it, see ListAppRevisions
+	diff, err := chartutils.GetRevision(ctx, filepath.Join(viper.GetString(appcommon.EnvApphcCachePath),
+		appmgrcommon.CatalogAppsRepo), req)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
+	if diff == nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND,
+			fmt.Sprintf("revision %s didn't change application %s", req.Revision, req.Name), nil)
+	}
+
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application revision", diff)
 }
 
 // DeleteApp deletes appropriate Application instance
-func (adapter *rancherAppMgrAdapter) DeleteApp(req *appmanager.DeleteAppRequest) (*appmanager.Response, error) {
+func (adapter *rancherAppMgrAdapter) DeleteApp(ctx context.Context, req *appmanager.DeleteAppRequest) (*appmanager.Response, error) {
 	// Synchronize cache
-	if err := syncCache(); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := syncCache(ctx); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Refresh catalog
-	if err := apiclient.RefreshCatalog(adapter.mc, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := apiclient.RefreshCatalog(ctx, adapter.clients.Apps(), viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Create New Apps temporary data
 	apps := NewAppsData()
 
 	// Add running applications data
-	if err := apps.AppendAppsDataToDelete(adapter.mc, req); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := apps.AppendAppsDataToDelete(adapter.clients.Apps(), req); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Check if the requested application name and version already exist
 	existingData := apps.GetRunningAppData(req.Name)
 	if existingData == nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
 	}
 
 	// Set Application type
@@ -297,58 +515,58 @@ func (adapter *rancherAppMgrAdapter) DeleteApp(req *appmanager.DeleteAppRequest)
 	}
 
 	// Delete the app and/or related instances
-	doneList, err := deleteApps(adapter.mc, existingData, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName),
+	doneList, err := deleteApps(ctx, adapter.clients.Apps(), existingData, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName),
 		req.Name, repoPath, purge)
 	if err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// If purge flag is set, delete application chart
 	if purge {
-		if err := syncer.Push(repoPath, "Delete application charts"); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if err := syncer.Push(ctx, repoPath, "Delete application charts"); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
-		if err := apiclient.RefreshCatalog(adapter.mc, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if err := apiclient.RefreshCatalog(ctx, adapter.clients.Apps(), viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 	}
 
 	if len(doneList) == len(existingData) {
-		if err := apiclient.DeleteStorage(adapter.mc, req.Name+"-shared-pv", req.Name+"-shared-pvc"); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if err := apiclient.DeleteStorage(ctx, adapter.clients.Apps(), req.Name+"-shared-pv", req.Name+"-shared-pvc"); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 
-		if err := apiclient.DeleteNamespace(adapter.mc, existingData[0].TargetNamespace); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if err := apiclient.DeleteNamespace(ctx, adapter.clients.Apps(), existingData[0].TargetNamespace); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 	}
 
 	// Generate response
-	return appmgrcommon.GenerateResponse(appmanager.Status_SUCCESS, "Application instances successfully deleted",
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application instances successfully deleted",
 		&appmanager.App{Name: req.Name, Cycle: appCycle, Instances: doneList})
 }
 
 // DeleteApps removes all instances of all applications created by controller
-func (adapter *rancherAppMgrAdapter) DeleteApps(req *appmanager.DeleteAppsRequest) (*appmanager.Response, error) {
+func (adapter *rancherAppMgrAdapter) DeleteApps(ctx context.Context, req *appmanager.DeleteAppsRequest) (*appmanager.Response, error) {
 	// Synchronize cache
-	if err := syncCache(); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := syncCache(ctx); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Refresh catalog
-	if err := apiclient.RefreshCatalog(adapter.mc, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := apiclient.RefreshCatalog(ctx, adapter.clients.Apps(), viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Construct Application temporary data
 	apps := NewAppsData()
-	if err := apps.AppendAppsDataToDelete(adapter.mc, nil); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := apps.AppendAppsDataToDelete(adapter.clients.Apps(), nil); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// cannot find running applications
 	if apps.RunningAppsDataEmpty() {
-		return appmgrcommon.GenerateResponse(appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
 	}
 
 	// Set path to the application repository
@@ -371,19 +589,19 @@ func (adapter *rancherAppMgrAdapter) DeleteApps(req *appmanager.DeleteAppsReques
 		app.Name = appName
 		app.Cycle = existingData[0].Annotations.Get(appmgrcommon.AppAnnotationCycle)
 		// Delete the app and/or related instances
-		app.Instances, err = deleteApps(adapter.mc, existingData, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName),
+		app.Instances, err = deleteApps(ctx, adapter.clients.Apps(), existingData, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName),
 			appName, repoPath, purge)
 		if err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 
 		if len(app.Instances) == len(existingData) {
-			if err := apiclient.DeleteStorage(adapter.mc, appName+"-shared-pv", appName+"-shared-pvc"); err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			if err := apiclient.DeleteStorage(ctx, adapter.clients.Apps(), appName+"-shared-pv", appName+"-shared-pvc"); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
 
-			if err := apiclient.DeleteNamespace(adapter.mc, existingData[0].TargetNamespace); err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			if err := apiclient.DeleteNamespace(ctx, adapter.clients.Apps(), existingData[0].TargetNamespace); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
 		}
 
@@ -393,42 +611,42 @@ func (adapter *rancherAppMgrAdapter) DeleteApps(req *appmanager.DeleteAppsReques
 
 	// If purge flag is set, delete application chart
 	if purge {
-		if err := syncer.Push(repoPath, "Delete application charts"); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if err := syncer.Push(ctx, repoPath, "Delete application charts"); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 	}
 
 	// Refresh catalog
-	if err := apiclient.RefreshCatalog(adapter.mc, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := apiclient.RefreshCatalog(ctx, adapter.clients.Apps(), viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Generate response message
-	return appmgrcommon.GenerateResponse(appmanager.Status_SUCCESS, "Applications successfully deleted", doneApps)
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Applications successfully deleted", doneApps)
 }
 
 // GetApps fetches information about running application instances
-func (adapter *rancherAppMgrAdapter) GetApps(req *appmanager.GetAppsRequest) (*appmanager.Response, error) {
-	wList, err := apiclient.GetApps(adapter.mc, adapter.pc, adapter.kc, req, req.Cycle,
+func (adapter *rancherAppMgrAdapter) GetApps(ctx context.Context, req *appmanager.GetAppsRequest) (*appmanager.Response, error) {
+	wList, err := apiclient.GetApps(ctx, adapter.clients.Apps(), adapter.clients.Svcs().ProjectClient, adapter.kc, req, req.Cycle,
 		viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName), req.Verbose)
 	if err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// There is neither workload nor application in the cluster
 	if len(wList.Apps) == 0 {
-		return appmgrcommon.GenerateResponse(appmanager.Status_NOT_FOUND, "no application found", nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "no application found", nil)
 	}
 
 	// Generate a response
-	return appmgrcommon.GenerateResponse(appmanager.Status_SUCCESS, "Running applications", wList)
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Running applications", wList)
 }
 
 // DeleteAppMetadata deletes metadata for appropriate application instance
-func (adapter *rancherAppMgrAdapter) DeleteAppMetadata(req *appmanager.DeleteAppMetadataRequest) (*appmanager.Response, error) {
+func (adapter *rancherAppMgrAdapter) DeleteAppMetadata(ctx context.Context, req *appmanager.DeleteAppMetadataRequest) (*appmanager.Response, error) {
 	// Synchronize cache
-	if err := syncCache(); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := syncCache(ctx); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Set path to the applications metadata cache
@@ -447,15 +665,15 @@ func (adapter *rancherAppMgrAdapter) DeleteAppMetadata(req *appmanager.DeleteApp
 		// Get instances names
 		appInstances, err := appmgrcommon.GetSubDirs(appChartRootDir)
 		if err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 		// Iterate over application instances
 		for _, appInstance := range appInstances {
 			// Remove metadata for appropriate application instance
-			t, err := removeTemplateData(adapter.mc, appChartRootDir,
+			t, err := removeTemplateData(ctx, adapter.clients.Apps(), appChartRootDir,
 				viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName), appInstance, req.Version)
 			if err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
 			// Add metadata information
 			appTmplts.Templates = append(appTmplts.Templates, t)
@@ -464,8 +682,8 @@ func (adapter *rancherAppMgrAdapter) DeleteAppMetadata(req *appmanager.DeleteApp
 		// If application instance version is not sent with request
 		if req.GetVersion() == "" {
 			// Delete entire application
-			if err := chartutils.DeleteChart(appChartRootDir, "all", "all"); err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			if err := chartutils.DeleteChart(ctx, appChartRootDir, "all", "all"); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
 		}
 
@@ -482,10 +700,10 @@ func (adapter *rancherAppMgrAdapter) DeleteAppMetadata(req *appmanager.DeleteApp
 
 			chartName := strings.ToLower(strings.Replace(name, "_", "-", -1))
 			// Delete metadata
-			t, err := removeTemplateData(adapter.mc, appChartRootDir,
+			t, err := removeTemplateData(ctx, adapter.clients.Apps(), appChartRootDir,
 				viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName), chartName, req.Version)
 			if err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
 			// Add metadata information
 			appTmplts.Templates = append(appTmplts.Templates, t)
@@ -494,44 +712,46 @@ func (adapter *rancherAppMgrAdapter) DeleteAppMetadata(req *appmanager.DeleteApp
 
 	// If cannot find templates for appropriate application instance
 	if len(appTmplts.Templates) == 0 {
-		return appmgrcommon.GenerateResponse(appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to delete", nil)
 	}
 
 	// Update chart store
-	if err := syncer.Push(repoPath, "Delete application charts"); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := syncer.Push(ctx, repoPath, "Delete application charts"); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Refresh catalog
-	if err := apiclient.RefreshCatalog(adapter.mc, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := apiclient.RefreshCatalog(ctx, adapter.clients.Apps(), viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Generate response
-	return appmgrcommon.GenerateResponse(appmanager.Status_SUCCESS, "Application metadata successfully deleted", appTmplts)
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, "Application metadata successfully deleted", appTmplts)
 }
 
-func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUpgradeUpdateRequester, reuseValues bool) (*appmanager.Response, error) {
+func (adapter *rancherAppMgrAdapter) updateUpgradeApps(ctx context.Context, req appmgrcommon.CreateUpgradeUpdateRequester, reuseValues bool) (*appmanager.Response, error) {
 	// Synchronize cache
-	if err := syncCache(); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := syncCache(ctx); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
-	// Refresh catalog
-	if err := apiclient.RefreshCatalog(adapter.mc, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	// Refresh catalog. Dry run leaves Rancher untouched
+	if !req.GetDryRun() {
+		if err := apiclient.RefreshCatalog(ctx, adapter.clients.Apps(), viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
 	}
 
 	// Construct Application temporary data
 	apps := NewAppsData()
-	if err := apps.AppendRunningAppsData(adapter.mc, req, req.GetCycle(), req.GetAppState()); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if err := apps.AppendRunningAppsData(adapter.clients.Apps(), req, req.GetCycle(), req.GetAppState()); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Identify application type
 	chartType, err := appmgrcommon.AppTypeToAppCycle(req.GetCycle())
 	if err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Create the temporary data for the new applications
@@ -543,12 +763,14 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 	// If we don't have aby information in request and
 	// there are no running applications - return with appropriate response
 	if apps.NewAppInstancesDataEmpty() && existingData == nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_NOT_FOUND, "Nothing to update/upgrade", nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to update/upgrade", nil)
 	}
 
 	namespace := strings.Replace(strings.ToLower(req.GetName()),"_","-", -1)
-	if err := apiclient.CreateNamespace(adapter.mc, namespace); err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+	if !req.GetDryRun() {
+		if err := apiclient.CreateNamespace(ctx, adapter.clients.Apps(), namespace); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
 	}
 
 	var bkpAppInstances []*appmgrcommon.AppInstanceData
@@ -560,7 +782,7 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 			apps.NewAppInstancesData = append(apps.NewAppInstancesData, existingAppInstance)
 			bkpAppInstance, err := getBackupData(existingAppInstance)
 			if err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
 
 			bkpAppInstances = append(bkpAppInstances, bkpAppInstance)
@@ -577,7 +799,7 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 					// if the upgrade policy is set to recreate or
 					// the new application instance version equal to
 					// the running application instance version
-					if viper.GetBool(appcommon.EnvApphcAppsUpgradePolicyRecreate) ||
+					if config.Current().UpgradePolicyRecreate ||
 						existingAppInstance.RequestedVersion == existingAppInstance.CurrentVersion {
 						newAppInstance.NextAction = appmgrcommon.AppInstanceDataNextActionRecreate
 					} else {
@@ -588,7 +810,7 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 					newAppInstance.CurrentVersion = appcommon.MapGet(existingAppInstance.Annotations, appmgrcommon.AppInstanceAnnotationVersion)
 					bkpAppInstance, err := getBackupData(existingAppInstance)
 					if err != nil {
-						return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+						return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 					}
 
 					bkpAppInstances = append(bkpAppInstances, bkpAppInstance)
@@ -605,11 +827,18 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 		}
 	}
 
+	// Split the instances into batches according to the upgrade strategy
+	strategy := appmgrcommon.GetUpgradeStrategy(req)
+	batches, err := appmgrcommon.UpgradeBatches(apps.NewAppInstancesData, strategy)
+	if err != nil {
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+	}
+
 	limits := req.GetSpec().GetResources().GetLimits()
 	if limits == nil && reuseValues {
-		cpu, memory, err := resourcemgr.GetResourcesLimit(adapter.kc, req.GetName())
+		cpu, memory, err := resourcemgr.GetResourcesLimit(ctx, adapter.kc, req.GetName())
 		if err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 
 		limits = &appmanager.Spec_Resources_Limits{}
@@ -618,14 +847,14 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 	}
 
 	if limits != nil {
-		if err := apiclient.CheckAvailableResources(adapter.mc, apps.GetNumberOfNewInstances(), limits); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if err := apiclient.CheckAvailableResources(ctx, adapter.clients.Apps(), apps.GetNumberOfNewInstances(), limits); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 	}
 
 	id, err := uuid.NewRandom()
 	if err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	bkpRootDir := "backup-" + id.String()
@@ -654,11 +883,11 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 					appmgrcommon.AppInstanceAnnotationTemplateName), apps.SampleInstance.CurrentVersion)
 				state = appcommon.MapGet(apps.SampleInstance.Annotations, appmgrcommon.AppInstanceAnnotationState)
 			} else {
-				return appmgrcommon.GenerateResponse(appmanager.Status_NOT_FOUND, "Nothing to update/upgrade", nil)
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "Nothing to update/upgrade", nil)
 			}
 
 			if cfg, err = chartutils.ParseLastGoodConfig(filepath.Join(lastGoodKnownMetadataDir, "values.yaml")); err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
 
 			if state != "" {
@@ -670,7 +899,11 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 		// Create charts for the new application instances
 		for _, newAppInstance := range apps.NewAppInstancesData {
 			if err := chartutils.SetChartData(newAppInstance, req, lastGoodKnownMetadataDir, cfg); err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+
+			if req.GetDryRun() {
+				continue
 			}
 
 			// Backup old chart
@@ -680,7 +913,7 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 			_ = os.RemoveAll(bkpAppDir)
 
 			if err := os.MkdirAll(bkpAppDir, 0755); err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
 
 			srcBkpChart := filepath.Join(chartApp, appcommon.MapGet(newAppInstance.Annotations,
@@ -690,9 +923,9 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 				trgtBkpChart := filepath.Join(bkpAppDir, newAppInstance.CurrentVersion)
 
 				// Copy template for appropriate chart type
-				if err := chartutils.CopyChart(srcBkpChart, trgtBkpChart, newAppInstance.InstanceName,
+				if err := chartutils.CopyChart(ctx, srcBkpChart, trgtBkpChart, newAppInstance.InstanceName,
 					newAppInstance.CurrentVersion); err != nil {
-					return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 				}
 			}
 
@@ -702,56 +935,82 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 
 			//	newAppInstance.RequestedChartPath = dstChart
 			// Remove the chart
-			if err := chartutils.DeleteChart(dstChart, newAppInstance.InstanceName, newAppInstance.RequestedVersion); err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			if err := chartutils.DeleteChart(ctx, dstChart, newAppInstance.InstanceName, newAppInstance.RequestedVersion); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
 
 			// Create updated chart
-			if err := chartutils.CreateChart(chartTemplate, dstChart, chartType, req.GetName(), newAppInstance); err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			if err := chartutils.CreateChart(ctx, chartTemplate, dstChart, chartType, req.GetName(), newAppInstance); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
+			longrunning.Step(ctx, "chart created for instance %s", newAppInstance.InstanceName)
 		}
 
-		if err := syncCatalog(adapter.mc, apps.NewAppInstancesData, req.GetDescription()); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if !req.GetDryRun() {
+			if err := syncCatalog(ctx, adapter.clients.Apps(), apps.NewAppInstancesData, req.GetDescription()); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+			longrunning.Step(ctx, "catalog synced")
 		}
 	}
 
+	// Plan the changes instead of applying them
+	if req.GetDryRun() {
+		plan := &appmanager.Plan{Name: req.GetName(), Cycle: req.GetCycle()}
+		for _, newAppInstance := range apps.NewAppInstancesData {
+			instancePlan, err := chartutils.PlanInstance(newAppInstance, chartType, chartApp, req.GetFromCatalog())
+			if err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+			}
+			plan.Instances = append(plan.Instances, instancePlan)
+		}
+
+		return appmgrcommon.GeneratePlanResponse(ctx, plan)
+	}
+
 	if limits == nil && !reuseValues {
-		if err := resourcemgr.DeleteLimitRange(adapter.kc, req.GetName()); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if err := resourcemgr.DeleteLimitRange(ctx, adapter.kc, req.GetName()); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 
 	} else {
-		if err := resourcemgr.CreateUpdateLimitRange(adapter.kc, namespace, limits.Cpu, limits.Memory,
+		if err := resourcemgr.CreateUpdateLimitRange(ctx, adapter.kc, namespace, limits.Cpu, limits.Memory,
 			appmgrcommon.AppInstanceDefaultCpuRequest, appmgrcommon.AppInstanceDefaultMemoryRequest); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 	}
 
 	//// If shared storage requested
 	kubeConvAppName := apps.NewAppInstancesData[0].TargetNamespace
 	if req.GetSharedStorage() > 0 {
-		if err := apiclient.CreateSharedStorage(adapter.mc, req.GetName(), kubeConvAppName,
+		if err := apiclient.CreateSharedStorage(ctx, adapter.clients.Apps(), req.GetName(), kubeConvAppName,
 			apps.NewAppInstancesData[0].TargetNamespace, int(req.GetSharedStorage())); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 
 	} else {
 
-		if err := apiclient.DeleteStorage(adapter.mc, kubeConvAppName+"-shared-pv", kubeConvAppName+"-shared-pvc"); err != nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		if err := apiclient.DeleteStorage(ctx, adapter.clients.Apps(), kubeConvAppName+"-shared-pv", kubeConvAppName+"-shared-pvc"); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 		}
 	}
 
-	// Create or upgrade instances
-	doneList, err := createUpgradeApps(adapter.mc, apps.NewAppInstancesData, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName))
+	// Create or upgrade instances batch by batch
+	doneList, touched, err := appmgrcommon.RunUpgradeBatches(ctx, batches, strategy,
+		func(ctx context.Context, batch []*appmgrcommon.AppInstanceData) ([]*appmanager.AppInstance, error) {
+			return createUpgradeApps(ctx, adapter.clients.Apps(), batch, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName))
+		},
+		func(ctx context.Context, instance *appmgrcommon.AppInstanceData) error {
+			return apiclient.WaitForAppInstanceReadiness(ctx, adapter.clients.Apps(), instance)
+		})
 
 	if err != nil {
-		if !req.GetFromCatalog() && len(bkpAppInstances) > 0 &&
-			(strings.HasPrefix(err.Error(), recreateErrorPrefix) || strings.Contains(err.Error(), timeOutErrorPrefix)) {
+		// Staged upgrade halts on any failure
+		staged := strategy.GetType() != appmanager.UpgradeStrategyType_ALL_AT_ONCE
+		if !req.GetFromCatalog() && len(bkpAppInstances) > 0 && (staged ||
+			strings.HasPrefix(err.Error(), recreateErrorPrefix) || strings.Contains(err.Error(), timeOutErrorPrefix)) {
 			for _, appInstance := range bkpAppInstances {
-				logrus.WithFields(logrus.Fields{"instance": appInstance.InstanceName,
+				requestid.Logger(ctx).WithFields(logrus.Fields{"instance": appInstance.InstanceName,
 					"current_version": appInstance.CurrentVersion,
 					"target_version":  appInstance.RequestedVersion}).Info("Rolling back the application instance")
 
@@ -764,33 +1023,48 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 					appmgrcommon.AppInstanceAnnotationTemplateName), appInstance.RequestedVersion)
 
 				// Remove the chart
-				if err := chartutils.DeleteChart(chartToRestoreDst, appInstance.InstanceName, appInstance.RequestedVersion); err != nil {
-					return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+				if err := chartutils.DeleteChart(ctx, chartToRestoreDst, appInstance.InstanceName, appInstance.RequestedVersion); err != nil {
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 				}
 
-				if err := chartutils.CopyChart(chartToRestoreSrc, chartToRestoreDst, appInstance.InstanceName,
+				if err := chartutils.CopyChart(ctx, chartToRestoreSrc, chartToRestoreDst, appInstance.InstanceName,
 					appInstance.RequestedVersion); err != nil {
-					return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+					return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 				}
 			}
 
-			if err := syncCatalog(adapter.mc, bkpAppInstances, ""); err != nil {
-				return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			if err := syncCatalog(ctx, adapter.clients.Apps(), bkpAppInstances, ""); err != nil {
+				return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 			}
 
 		} else {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
+		}
+
+		// Staged upgrade rolls back only the instances of the batches applied so far,
+		// the charts of the rest are restored above. The instances created by the applied batches are deleted
+		msg := "upgrade failed, the application was rolled back to previous state"
+		if staged {
+			bkpAppInstances = appmgrcommon.InstancesByName(bkpAppInstances, touched)
+			msg = fmt.Sprintf("upgrade halted: %s, the upgraded application instances were rolled back to previous state "+
+				"and the created ones were deleted", err.Error())
 		}
 
-		doneList, err = createUpgradeApps(adapter.mc, bkpAppInstances,
+		// The rollback is not interrupted by cancellation of the operation
+		rollbackCtx := longrunning.Detach(ctx)
+		doneList, err = createUpgradeApps(rollbackCtx, adapter.clients.Apps(), bkpAppInstances,
 			viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName))
 		if err == nil {
-			return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, "upgrade failed, the application was rolled back to previous state",
+			err = deleteCreatedApps(rollbackCtx, adapter.clients.Apps(), touched)
+		}
+
+		if err == nil {
+			return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, msg,
 				&appmanager.App{Name: req.GetName(), Cycle: req.GetCycle(), Instances: doneList})
 		}
 
 		errMsg := fmt.Sprintf("upgrade and rollback failed: %s", err.Error())
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, errMsg, nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, errMsg, nil)
 	}
 
 	var msg string
@@ -801,11 +1075,11 @@ func (adapter *rancherAppMgrAdapter) updateUpgradeApps(req appmgrcommon.CreateUp
 	//}
 
 	// Generate response
-	return appmgrcommon.GenerateResponse(appmanager.Status_SUCCESS, msg, &appmanager.App{Name: req.GetName(),
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, msg, &appmanager.App{Name: req.GetName(),
 		Cycle: req.GetCycle(), Instances: doneList})
 }
 
-func (adapter *rancherAppMgrAdapter) EnableDisableApp(req *appmanager.EnableDisableAppRequest) (*appmanager.Response, error) {
+func (adapter *rancherAppMgrAdapter) EnableDisableApp(ctx context.Context, req *appmanager.EnableDisableAppRequest) (*appmanager.Response, error) {
 
 	var state appmanager.AppStateAfterDeployment
 
@@ -815,14 +1089,14 @@ func (adapter *rancherAppMgrAdapter) EnableDisableApp(req *appmanager.EnableDisa
 		state = appmanager.AppStateAfterDeployment_enabled
 	}
 
-	apps, err := apiclient.EnableDisableApp(adapter.mc, req, state)
+	apps, err := apiclient.EnableDisableApp(ctx, adapter.clients.Apps(), req, state)
 	if err != nil {
-		return appmgrcommon.GenerateResponse(appmanager.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_ERROR, err.Error(), nil)
 	}
 
 	var msg string
 	if len(apps.Apps) == 0 {
-		return appmgrcommon.GenerateResponse(appmanager.Status_NOT_FOUND, "no application found", apps)
+		return appmgrcommon.GenerateResponse(ctx, appmanager.Status_NOT_FOUND, "no application found", apps)
 	}
 
 	if req.GetDisable() {
@@ -831,14 +1105,14 @@ func (adapter *rancherAppMgrAdapter) EnableDisableApp(req *appmanager.EnableDisa
 		msg = "Application(s) enabled successfully"
 	}
 
-	return appmgrcommon.GenerateResponse(appmanager.Status_SUCCESS, msg, apps)
+	return appmgrcommon.GenerateResponse(ctx, appmanager.Status_SUCCESS, msg, apps)
 }
 
 // removeTemplateData removes application instance metadata
-func removeTemplateData(mc *rancher.MasterClient, appChartRootDir, catalogId, instanceName,
+func removeTemplateData(ctx context.Context, mc *rancher.MasterClient, appChartRootDir, catalogId, instanceName,
 	version string) (*appmanager.Template, error) {
 	// Get template versions
-	versions, err := apiclient.GetTemplateVersions(mc, instanceName)
+	versions, err := apiclient.GetTemplateVersions(ctx, mc, instanceName)
 	if err != nil {
 		return nil, err
 	}
@@ -850,7 +1124,7 @@ func removeTemplateData(mc *rancher.MasterClient, appChartRootDir, catalogId, in
 			t.Versions = append(t.Versions, v.String())
 		}
 		// Remove explicit version
-		if err := chartutils.DeleteChart(filepath.Join(appChartRootDir, instanceName), instanceName, "all"); err != nil {
+		if err := chartutils.DeleteChart(ctx, filepath.Join(appChartRootDir, instanceName), instanceName, "all"); err != nil {
 			return nil, err
 		}
 
@@ -860,7 +1134,7 @@ func removeTemplateData(mc *rancher.MasterClient, appChartRootDir, catalogId, in
 			// If appropriate version found
 			if version == v.String() {
 				// Remove explicit version
-				if err := chartutils.DeleteChart(filepath.Join(appChartRootDir, instanceName, version), instanceName, version); err != nil {
+				if err := chartutils.DeleteChart(ctx, filepath.Join(appChartRootDir, instanceName, version), instanceName, version); err != nil {
 					return nil, err
 				}
 				// Add information
@@ -881,9 +1155,10 @@ func removeTemplateData(mc *rancher.MasterClient, appChartRootDir, catalogId, in
 }
 
 // waitForCatalogEntry waits until appropriate template will be ready in catalog
-func waitForCatalogEntry(mc *rancher.MasterClient, apps []*appmgrcommon.AppInstanceData, appsCatalogId string) error {
+func waitForCatalogEntry(ctx context.Context, mc *rancher.MasterClient, apps []*appmgrcommon.AppInstanceData, appsCatalogId string) (err error) {
+	defer metrics.ObserveStep(metrics.StepWaitForCatalogEntry, time.Now(), &err)
 
-	logrus.WithFields(logrus.Fields{"catalog": appsCatalogId}).Info("Synchronizing catalog")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"catalog": appsCatalogId}).Info("Synchronizing catalog")
 
 	foundApps := make(map[string]bool)
 
@@ -891,7 +1166,7 @@ func waitForCatalogEntry(mc *rancher.MasterClient, apps []*appmgrcommon.AppInsta
 		for _, app := range apps {
 			//if !app.TemplateAvailable {
 			// Send a request to the catalog
-			available, err := apiclient.TemplateAvailable(mc, appcommon.MapGet(app.Annotations,
+			available, err := apiclient.TemplateAvailable(ctx, mc, appcommon.MapGet(app.Annotations,
 				appmgrcommon.AppInstanceAnnotationTemplateName),
 				app.Annotations.Get(appmgrcommon.AppInstanceAnnotationVersion),
 				appsCatalogId)
@@ -924,7 +1199,7 @@ func waitForCatalogEntry(mc *rancher.MasterClient, apps []*appmgrcommon.AppInsta
 		return fmt.Errorf("not all application instances available in catalog")
 	}
 
-	logrus.WithFields(logrus.Fields{"catalog": appsCatalogId, "status": "OK"}).Info("Synchronizing catalog")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"catalog": appsCatalogId, "status": "OK"}).Info("Synchronizing catalog")
 
 	return nil
 }
@@ -975,7 +1250,7 @@ func getBackupData(existingAppInstance *appmgrcommon.AppInstanceData) (*appmgrco
 	bkp.RequestedVersion = existingAppInstance.CurrentVersion
 	bkp.TargetNamespace = existingAppInstance.TargetNamespace
 
-	if viper.GetBool(appcommon.EnvApphcAppsUpgradePolicyRecreate) ||
+	if config.Current().UpgradePolicyRecreate ||
 		existingAppInstance.RequestedVersion == existingAppInstance.CurrentVersion {
 		bkp.NextAction = appmgrcommon.AppInstanceDataNextActionRecreate
 	} else {
@@ -1003,7 +1278,7 @@ func getBackupData(existingAppInstance *appmgrcommon.AppInstanceData) (*appmgrco
 }
 
 // SyncCache synchronizes local chart cache against remote repository
-func syncCache() error {
+func syncCache(ctx context.Context) error {
 
 	// Instantiate the group
 	var g errgroup.Group
@@ -1011,15 +1286,15 @@ func syncCache() error {
 	// Sync templates cache
 	g.Go(func() error {
 		repoPath := filepath.Join(viper.GetString(appcommon.EnvApphcCachePath), appmgrcommon.CatalogTemplatesRepo)
-		logrus.WithFields(logrus.Fields{"path": repoPath}).Info("Synchronizing cache")
-		return syncer.Pull(repoPath, viper.GetString(rancher.EnvApphcAdaptersRancherTemplatesCatalogBranch))
+		requestid.Logger(ctx).WithFields(logrus.Fields{"path": repoPath}).Info("Synchronizing cache")
+		return syncer.Pull(ctx, repoPath, viper.GetString(rancher.EnvApphcAdaptersRancherTemplatesCatalogBranch))
 	})
 
 	// Sync applications metadata cache
 	g.Go(func() error {
 		repoPath := filepath.Join(viper.GetString(appcommon.EnvApphcCachePath), appmgrcommon.CatalogAppsRepo)
-		logrus.WithFields(logrus.Fields{"path": repoPath}).Info("Synchronizing cache")
-		return syncer.Pull(repoPath, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogBranch))
+		requestid.Logger(ctx).WithFields(logrus.Fields{"path": repoPath}).Info("Synchronizing cache")
+		return syncer.Pull(ctx, repoPath, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogBranch))
 	})
 
 	// Wait for completion
@@ -1027,18 +1302,18 @@ func syncCache() error {
 }
 
 // Synchronize catalog
-func syncCatalog(mc *rancher.MasterClient, apps []*appmgrcommon.AppInstanceData, description string) error {
+func syncCatalog(ctx context.Context, mc *rancher.MasterClient, apps []*appmgrcommon.AppInstanceData, description string) error {
 	// Update the chart store
-	if err := syncer.Push(filepath.Join(viper.GetString(appcommon.EnvApphcCachePath),
+	if err := syncer.Push(ctx, filepath.Join(viper.GetString(appcommon.EnvApphcCachePath),
 		appmgrcommon.CatalogAppsRepo), description); err != nil {
 		return err
 	}
 
 	// Refresh catalog
-	if err := apiclient.RefreshCatalog(mc, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
+	if err := apiclient.RefreshCatalog(ctx, mc, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName)); err != nil {
 		return err
 	}
 
 	// Wait until catalog will be updated with the new stuff
-	return waitForCatalogEntry(mc, apps, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName))
+	return waitForCatalogEntry(ctx, mc, apps, viper.GetString(rancher.EnvApphcAdaptersRancherAppsCatalogName))
 }
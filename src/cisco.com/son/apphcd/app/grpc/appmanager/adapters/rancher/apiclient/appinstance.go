@@ -3,6 +3,7 @@
 package apiclient
 
 import (
+	"context"
 	"fmt"
 	"github.com/rancher/norman/types"
 	"math"
@@ -22,6 +23,9 @@ import (
 
 	"cisco.com/son/apphcd/api/v1/appmanager"
 	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/metrics"
+	"cisco.com/son/apphcd/app/common/requestid"
+	"cisco.com/son/apphcd/app/common/tracing"
 	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
 	grpccommon "cisco.com/son/apphcd/app/grpc/common"
 	"cisco.com/son/apphcd/app/grpc/common/rancher"
@@ -29,11 +33,14 @@ import (
 )
 
 // ApiCreateApp deploys a new application instance
-func CreateAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceData, version string) error {
+func CreateAppInstance(ctx context.Context, mc *rancher.MasterClient, data *appmgrcommon.AppInstanceData, version string) (err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.CreateAppInstance")
+	defer tracing.Finish(span, &err)
+
 	// Set the template name
 	templateName := data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName)
 
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName,
 		"resourceType": "template", "templateName": templateName}).
 		Debug("Looking up for resource")
 
@@ -43,7 +50,7 @@ func CreateAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName,
 		"resource.ID": resource.ID}).Debug("Searching template by resource ID")
 
 	// Lookup the template by ID
@@ -53,7 +60,7 @@ func CreateAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 	}
 
 	// Get template ID for a particular version
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName,
 		"resource.ID": resource.ID}).Debug("Searching template by version")
 
 	templateVersionID := templateVersionIDFromVersionLink(template.VersionLinks[template.DefaultVersion])
@@ -66,7 +73,7 @@ func CreateAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 	}
 
 	// Get template version
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName,
 		"ID": resource.ID}).Debug("Searching template by ID")
 	templateVersion, err := mc.ManagementClient.TemplateVersion.ByID(templateVersionID)
 	if err != nil {
@@ -79,13 +86,13 @@ func CreateAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 		volumeName := data.InstanceName + "-pv"
 		opts.Filters["name"] = volumeName
 
-		pvId, err := cleanUpVolume(mc, opts)
+		pvId, err := cleanUpVolume(ctx, mc, opts)
 		if err != nil {
 			return err
 		}
 
 		if pvId == "" {
-			logrus.WithFields(logrus.Fields{"instance": data.InstanceName, "volume": volumeName}).Info("Creating persistent volume for instance")
+			requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "volume": volumeName}).Info("Creating persistent volume for instance")
 
 			// Configure the volume
 			newPv := &clusterClient.PersistentVolume{}
@@ -103,7 +110,7 @@ func CreateAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 				return err
 			}
 
-			logrus.WithFields(logrus.Fields{"instance": newPv.Name, "volume": newPv.Name, "status": "OK"}).
+			requestid.Logger(ctx).WithFields(logrus.Fields{"instance": newPv.Name, "volume": newPv.Name, "status": "OK"}).
 				Info("Creating persistent volume for instance")
 
 		}
@@ -132,19 +139,19 @@ func CreateAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 			newPvc.Selector.MatchLabels["name"] = data.InstanceName
 			newPvc.VolumeID = volumeName
 
-			logrus.WithFields(logrus.Fields{"instance": newPvc.Name, "claim": newPvc.Name}).
+			requestid.Logger(ctx).WithFields(logrus.Fields{"instance": newPvc.Name, "claim": newPvc.Name}).
 				Info("Creating persistent volume claim")
 
 			if _, err := mc.ProjectClient.PersistentVolumeClaim.Create(newPvc); err != nil {
 				return err
 			}
 
-			logrus.WithFields(logrus.Fields{"instance": newPvc.Name, "claim": newPvc.Name, "status": "OK"}).
+			requestid.Logger(ctx).WithFields(logrus.Fields{"instance": newPvc.Name, "claim": newPvc.Name, "status": "OK"}).
 				Info("Creating persistent volume claim")
 
 			// Wait till the PV and PVC will be in the "Bound" state
 			opts.Filters["name"] = volumeName
-			if err := waitForVolumeState(mc, opts, "bound"); err != nil {
+			if err := waitForVolumeState(ctx, mc, opts, "bound"); err != nil {
 				return err
 			}
 		}
@@ -164,7 +171,7 @@ func CreateAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 		Notes:           "",
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName, "version": version}).Info("Creating application")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "version": version}).Info("Creating application")
 
 	// Create the application instance
 	createdApp, err := mc.ProjectClient.App.Create(app)
@@ -186,18 +193,21 @@ func CreateAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 		}
 	}
 
-	if err := postDeploymentAction(mc, data.InstanceName, version, data.State); err != nil {
+	if err := postDeploymentAction(ctx, mc, data.InstanceName, version, data.State); err != nil {
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName, "version": version,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "version": version,
 		"status": "OK"}).Info("Creating application")
 
 	return nil
 }
 
 // ApiUpgradeApp upgrades appropriate application instance
-func UpgradeAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceData) error {
+func UpgradeAppInstance(ctx context.Context, mc *rancher.MasterClient, data *appmgrcommon.AppInstanceData) (err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.UpgradeAppInstance")
+	defer tracing.Finish(span, &err)
+
 	// Find the resource
 	resource, err := rancher.Lookup(mc, data.InstanceName, "app")
 	if err != nil {
@@ -256,7 +266,7 @@ func UpgradeAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstance
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName, "version": data.RequestedVersion}).
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "version": data.RequestedVersion}).
 		Info("Upgrading application")
 
 	// Upgrade application instance
@@ -264,7 +274,7 @@ func UpgradeAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstance
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName, "version": data.RequestedVersion,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "version": data.RequestedVersion,
 		"status": "OK"}).Info("Upgrading application")
 
 	// If persistent volume requested
@@ -272,12 +282,12 @@ func UpgradeAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstance
 		// Wait till Persistent Volume and its Claim will be available and bound
 		opts := rancher.DefaultListOpts()
 		opts.Filters["name"] = data.InstanceName + "-pv"
-		if err := waitForVolumeState(mc, opts, "bound"); err != nil {
+		if err := waitForVolumeState(ctx, mc, opts, "bound"); err != nil {
 			return err
 		}
 	}
 
-	if err := postDeploymentAction(mc, data.InstanceName,
+	if err := postDeploymentAction(ctx, mc, data.InstanceName,
 		appcommon.MapGet(data.Annotations, appmgrcommon.AppInstanceAnnotationVersion), data.State); err != nil {
 		return err
 	}
@@ -286,7 +296,9 @@ func UpgradeAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstance
 }
 
 // ApiDeleteApp deletes appropriate application instance
-func DeleteAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceData, version string) error {
+func DeleteAppInstance(ctx context.Context, mc *rancher.MasterClient, data *appmgrcommon.AppInstanceData, version string) (err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.DeleteAppInstance")
+	defer tracing.Finish(span, &err)
 
 	wResource, _ := rancher.Lookup(mc, data.InstanceName, "workload")
 
@@ -318,7 +330,7 @@ func DeleteAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 	}
 
 	// Delete application instance
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName,
 		"version": version}).Info("Deleting application")
 
 	if err := mc.ProjectClient.App.Delete(appInstance); err != nil {
@@ -338,12 +350,12 @@ func DeleteAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 		time.Sleep(500 * time.Millisecond)
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName, "version": version, "status": "OK"}).Info("Deleting application")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "version": version, "status": "OK"}).Info("Deleting application")
 
 	if data.DeleteInstanceStorage {
 		volumeName := data.InstanceName + "-pv"
 		claimName := data.InstanceName + "-pvc"
-		if err := DeleteStorage(mc, volumeName, claimName); err != nil {
+		if err := DeleteStorage(ctx, mc, volumeName, claimName); err != nil {
 			return err
 		}
 	}
@@ -352,8 +364,10 @@ func DeleteAppInstance(mc *rancher.MasterClient, data *appmgrcommon.AppInstanceD
 }
 
 // ApiGetApps shows information about running applications and their instances
-func GetApps(mc *rancher.MasterClient, pc *projectClient.Client, kc *kubernetes.Clientset, req appmgrcommon.GenericRequester,
-	appCycle, catalogId string, verbose bool) (*appmanager.AppsInfo, error) {
+func GetApps(ctx context.Context, mc *rancher.MasterClient, pc *projectClient.Client, kc *kubernetes.Clientset, req appmgrcommon.GenericRequester,
+	appCycle, catalogId string, verbose bool) (info *appmanager.AppsInfo, err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.GetApps")
+	defer tracing.Finish(span, &err)
 
 	// Identify application type
 	appType, _ := appmgrcommon.AppCycleToAppType(appCycle)
@@ -436,7 +450,7 @@ func GetApps(mc *rancher.MasterClient, pc *projectClient.Client, kc *kubernetes.
 			}
 
 			if body.Apps[appName] == nil {
-				limits.Cpu, limits.Memory, err = resourcemgr.GetResourcesLimit(kc, item.TargetNamespace)
+				limits.Cpu, limits.Memory, err = resourcemgr.GetResourcesLimit(ctx, kc, item.TargetNamespace)
 				if err != nil {
 					return nil, err
 				}
@@ -493,10 +507,10 @@ func GetApps(mc *rancher.MasterClient, pc *projectClient.Client, kc *kubernetes.
 
 			if len(w.Data) > 0 {
 				// Gather instances for a particular application
-				if d, err = getWorkloadInstanceData(mc, &w.Data[0], catalogId, verbose); err != nil {
+				if d, err = getWorkloadInstanceData(ctx, mc, &w.Data[0], catalogId, verbose); err != nil {
 					return nil, err
 				}
-			} else if d, err = getAppInstanceData(mc, &item, catalogId, verbose); err != nil {
+			} else if d, err = getAppInstanceData(ctx, mc, &item, catalogId, verbose); err != nil {
 				return nil, err
 			}
 
@@ -520,8 +534,10 @@ func GetApps(mc *rancher.MasterClient, pc *projectClient.Client, kc *kubernetes.
 }
 
 // Enable or disable application
-func EnableDisableApp(mc *rancher.MasterClient, req appmgrcommon.GenericRequester,
-	state appmanager.AppStateAfterDeployment) (*appmanager.AppsActivation, error) {
+func EnableDisableApp(ctx context.Context, mc *rancher.MasterClient, req appmgrcommon.GenericRequester,
+	state appmanager.AppStateAfterDeployment) (activation *appmanager.AppsActivation, err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.EnableDisableApp")
+	defer tracing.Finish(span, &err)
 
 	opts := rancher.DefaultListOpts()
 
@@ -604,7 +620,7 @@ func EnableDisableApp(mc *rancher.MasterClient, req appmgrcommon.GenericRequeste
 
 				// If the workload exists
 				if len(wc.Data) > 0 {
-					if err := disableAppInstance(mc, wc, version); err != nil {
+					if err := disableAppInstance(ctx, mc, wc, version); err != nil {
 						return nil, err
 					}
 				}
@@ -624,7 +640,7 @@ func EnableDisableApp(mc *rancher.MasterClient, req appmgrcommon.GenericRequeste
 
 					cycle := appcommon.MapGet(instance.Annotations, appmgrcommon.AppAnnotationCycle)
 					// Delete the application
-					if err := DeleteAppInstance(mc, tmpApp, version); err != nil {
+					if err := DeleteAppInstance(ctx, mc, tmpApp, version); err != nil {
 						return nil, err
 					}
 
@@ -635,7 +651,7 @@ func EnableDisableApp(mc *rancher.MasterClient, req appmgrcommon.GenericRequeste
 						go func(tmpApp *appmgrcommon.AppInstanceData) {
 							defer wg.Done()
 							tmpApp.State = appmanager.AppStateAfterDeployment_enabled
-							if err := CreateAppInstance(mc, tmpApp, tmpApp.RequestedVersion); err != nil {
+							if err := CreateAppInstance(ctx, mc, tmpApp, tmpApp.RequestedVersion); err != nil {
 								errors = append(errors, err)
 							}
 						}(tmpApp)
@@ -682,8 +698,13 @@ func EnableDisableApp(mc *rancher.MasterClient, req appmgrcommon.GenericRequeste
 }
 
 // RefreshCatalog updates catalog indexes
-func RefreshCatalog(mc *rancher.MasterClient, catalogId string) error {
-	logrus.WithFields(logrus.Fields{"resourceType": "catalog",
+func RefreshCatalog(ctx context.Context, mc *rancher.MasterClient, catalogId string) (err error) {
+	defer metrics.ObserveStep(metrics.StepRefreshCatalog, time.Now(), &err)
+
+	ctx, span := tracing.Start(ctx, "apiclient.RefreshCatalog")
+	defer tracing.Finish(span, &err)
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"resourceType": "catalog",
 		"catalogId": catalogId}).Debug("Looking up for the catalog resource")
 
 	// Lookup catalog according to its name
@@ -692,7 +713,7 @@ func RefreshCatalog(mc *rancher.MasterClient, catalogId string) error {
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"resource.ID": resource.ID}).Debug("Looking for the catalog by ID")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"resource.ID": resource.ID}).Debug("Looking for the catalog by ID")
 
 	// Find catalog resource according to the resource ID
 	catalog, err := mc.ManagementClient.Catalog.ByID(resource.ID)
@@ -700,18 +721,73 @@ func RefreshCatalog(mc *rancher.MasterClient, catalogId string) error {
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"catalog": catalog}).Debug("Refreshing catalog")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"catalog": catalog}).Debug("Refreshing catalog")
 
 	// Refresh the catalog
 	return mc.ManagementClient.Catalog.ActionRefresh(catalog)
 }
 
+// WaitForAppInstanceReadiness waits till the pods of the enabled application instance are ready
+func WaitForAppInstanceReadiness(ctx context.Context, mc *rancher.MasterClient, data *appmgrcommon.AppInstanceData) (err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.WaitForAppInstanceReadiness")
+	defer tracing.Finish(span, &err)
+
+	// Disabled application instances do not have workloads
+	if data.State != appmanager.AppStateAfterDeployment_enabled {
+		return nil
+	}
+
+	return waitForPodsReadiness(ctx, mc, data.InstanceName)
+}
+
+// ScaleAppInstance changes the number of replicas of the workload of appropriate application instance.
+// Disabled application instances get the replicas from the chart once enabled
+func ScaleAppInstance(ctx context.Context, mc *rancher.MasterClient, data *appmgrcommon.AppInstanceData, replicas int) (err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.ScaleAppInstance")
+	defer tracing.Finish(span, &err)
+
+	if data.State != appmanager.AppStateAfterDeployment_enabled {
+		return nil
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "replicas": replicas}).Info("Scaling application")
+
+	filter := rancher.DefaultListOpts()
+	filter.Filters["name"] = data.InstanceName
+
+	wc, err := mc.ProjectClient.Workload.List(filter)
+	if err != nil {
+		return err
+	}
+
+	if len(wc.Data) == 0 {
+		return fmt.Errorf("workload of application instance %s not found", data.InstanceName)
+	}
+
+	if _, err := mc.ProjectClient.Workload.Update(&wc.Data[0],
+		map[string]interface{}{projectClient.WorkloadFieldScale: int64(replicas)}); err != nil {
+		return err
+	}
+
+	if err := waitForPodsReadiness(ctx, mc, data.InstanceName); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName, "replicas": replicas,
+		"status": "OK"}).Info("Scaling application")
+
+	return nil
+}
+
 // TemplateAvailable figures out whether required template available
-func TemplateAvailable(mc *rancher.MasterClient, templateName, appInstanceVersion, catalogId string) (bool, error) {
+func TemplateAvailable(ctx context.Context, mc *rancher.MasterClient, templateName, appInstanceVersion, catalogId string) (available bool, err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.TemplateAvailable")
+	defer tracing.Finish(span, &err)
+
 	// Construct filter from the default options
 	filter := rancher.DefaultListOpts()
 
-	logrus.WithFields(logrus.Fields{"templateName": templateName}).Debug("Looking up for the template resource")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"templateName": templateName}).Debug("Looking up for the template resource")
 
 	// Lookup resource according to its name and type
 	resource, err := rancher.Lookup(mc, templateName, "template")
@@ -743,7 +819,10 @@ func TemplateAvailable(mc *rancher.MasterClient, templateName, appInstanceVersio
 }
 
 // GetTemplateVersions gets versions of a particular metadata
-func GetTemplateVersions(mc *rancher.MasterClient, appInstanceName string) ([]*gover.Version, error) {
+func GetTemplateVersions(ctx context.Context, mc *rancher.MasterClient, appInstanceName string) (versions []*gover.Version, err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.GetTemplateVersions")
+	defer tracing.Finish(span, &err)
+
 	// Lookup the resource
 	resource, err := rancher.Lookup(mc, appInstanceName, "template")
 	if err != nil {
@@ -755,7 +834,6 @@ func GetTemplateVersions(mc *rancher.MasterClient, appInstanceName string) ([]*g
 		return nil, err
 	}
 
-	var versions []*gover.Version
 	for key := range template.VersionLinks {
 		v, err := gover.NewVersion(key)
 		if err != nil {
@@ -769,7 +847,9 @@ func GetTemplateVersions(mc *rancher.MasterClient, appInstanceName string) ([]*g
 }
 
 // DeleteNamespace deletes namespace
-func DeleteNamespace(mc *rancher.MasterClient, namespace string) error {
+func DeleteNamespace(ctx context.Context, mc *rancher.MasterClient, namespace string) (err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.DeleteNamespace")
+	defer tracing.Finish(span, &err)
 
 	opts := rancher.DefaultListOpts()
 	opts.Filters["name"] = namespace
@@ -786,7 +866,7 @@ func DeleteNamespace(mc *rancher.MasterClient, namespace string) error {
 	// Fetch namespace ID
 	nsId := c.Data[0].ID
 
-	logrus.WithFields(logrus.Fields{"namespace": opts.Filters["name"]}).Info("Deleting namespace")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"namespace": opts.Filters["name"]}).Info("Deleting namespace")
 
 	// Create the namespace
 	if err := mc.ClusterClient.Namespace.Delete(&c.Data[0]); err != nil {
@@ -807,15 +887,17 @@ func DeleteNamespace(mc *rancher.MasterClient, namespace string) error {
 		time.Sleep(1 * time.Second)
 	}
 
-	logrus.WithFields(logrus.Fields{"namespace": opts.Filters["name"], "status": "OK"}).Info("Deleting namespace")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"namespace": opts.Filters["name"], "status": "OK"}).Info("Deleting namespace")
 
 	return nil
 }
 
 // CheckAvailableResources checks whether amount of free resources in the cluster satisfies request
-func CheckAvailableResources(mc *rancher.MasterClient, numberOfInstances int, limits *appmanager.Spec_Resources_Limits) error {
+func CheckAvailableResources(ctx context.Context, mc *rancher.MasterClient, numberOfInstances int, limits *appmanager.Spec_Resources_Limits) (err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.CheckAvailableResources")
+	defer tracing.Finish(span, &err)
 
-	logrus.Info("Verifying resources availability")
+	requestid.Logger(ctx).Info("Verifying resources availability")
 	opts := rancher.DefaultListOpts()
 
 	col, err := mc.ManagementClient.Cluster.List(opts)
@@ -854,7 +936,7 @@ func CheckAvailableResources(mc *rancher.MasterClient, numberOfInstances int, li
 		}
 	}
 
-	logrus.WithFields(logrus.Fields{"status": "OK"}).Info("Verifying resources availability")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"status": "OK"}).Info("Verifying resources availability")
 
 	return nil
 }
@@ -3,6 +3,7 @@
 package apiclient
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 
@@ -11,13 +12,18 @@ import (
 	"github.com/sirupsen/logrus"
 
 	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/tracing"
 	"cisco.com/son/apphcd/app/grpc/appmanager/common"
 	grpccommon "cisco.com/son/apphcd/app/grpc/common"
 
+	"cisco.com/son/apphcd/app/common/requestid"
 	"cisco.com/son/apphcd/app/grpc/common/rancher"
 )
 
-func CreateSharedStorage(mc *rancher.MasterClient, appName, kubeConvAppName, namespace string, volSize int) error {
+func CreateSharedStorage(ctx context.Context, mc *rancher.MasterClient, appName, kubeConvAppName, namespace string, volSize int) (err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.CreateSharedStorage")
+	defer tracing.Finish(span, &err)
+
 	volumeName := kubeConvAppName + "-shared-pv"
 	claimName := kubeConvAppName + "-shared-pvc"
 
@@ -42,33 +48,33 @@ func CreateSharedStorage(mc *rancher.MasterClient, appName, kubeConvAppName, nam
 		}
 
 		if volSize == currentSize {
-			logrus.WithFields(logrus.Fields{"volume": volumeName}).Info("Persistent volume already exists")
+			requestid.Logger(ctx).WithFields(logrus.Fields{"volume": volumeName}).Info("Persistent volume already exists")
 			if len(pvcCol.Data) > 0 {
 				return nil
 			}
 
-			return createPersistentVolumeClaim(mc, appName, kubeConvAppName, volumeName, claimName, namespace, volSize)
+			return createPersistentVolumeClaim(ctx, mc, appName, kubeConvAppName, volumeName, claimName, namespace, volSize)
 		}
 
 		opts.Filters["name"] = claimName
 		// If appropriate PVC found
-		if err := deletePersistentVolumeClaim(mc, opts); err != nil {
+		if err := deletePersistentVolumeClaim(ctx, mc, opts); err != nil {
 			return err
 		}
 
 		// Delete persistent volume
 		opts.Filters["name"] = volumeName
-		if err := deletePersistentVolume(mc, opts); err != nil {
+		if err := deletePersistentVolume(ctx, mc, opts); err != nil {
 			return err
 		}
 
 		// Wait till the PV will be removed
-		if err := waitForVolumeState(mc, opts, "removed"); err != nil {
+		if err := waitForVolumeState(ctx, mc, opts, "removed"); err != nil {
 			return err
 		}
 	}
 
-	logrus.WithFields(logrus.Fields{"volume": volumeName}).Info("Creating persistent volume")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": volumeName}).Info("Creating persistent volume")
 
 	// Configure volume
 	v := &clusterClient.PersistentVolume{}
@@ -83,14 +89,14 @@ func CreateSharedStorage(mc *rancher.MasterClient, appName, kubeConvAppName, nam
 	v.PersistentVolumeReclaimPolicy = "Retain"
 
 	if _, err := mc.ClusterClient.PersistentVolume.Create(v); err != nil {
-		logrus.Error(err)
+		requestid.Logger(ctx).Error(err)
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"volume": volumeName, "status": "OK"}).Info("Creating persistent volume")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": volumeName, "status": "OK"}).Info("Creating persistent volume")
 
 	if len(pvcCol.Data) == 0 {
-		return createPersistentVolumeClaim(mc, appName, kubeConvAppName, volumeName, claimName, namespace, volSize)
+		return createPersistentVolumeClaim(ctx, mc, appName, kubeConvAppName, volumeName, claimName, namespace, volSize)
 	}
 
 	pvc := pvcCol.Data[0]
@@ -100,32 +106,35 @@ func CreateSharedStorage(mc *rancher.MasterClient, appName, kubeConvAppName, nam
 	}
 
 	if currentSize != volSize {
-		return createPersistentVolumeClaim(mc, appName, kubeConvAppName, volumeName, claimName, namespace, volSize)
+		return createPersistentVolumeClaim(ctx, mc, appName, kubeConvAppName, volumeName, claimName, namespace, volSize)
 	}
 
 	return nil
 }
 
-func DeleteStorage(mc *rancher.MasterClient, volumeName, claimName string) error {
+func DeleteStorage(ctx context.Context, mc *rancher.MasterClient, volumeName, claimName string) (err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.DeleteStorage")
+	defer tracing.Finish(span, &err)
+
 	opts := rancher.DefaultListOpts()
 	opts.Filters["name"] = claimName
 	// If appropriate PVC found
-	if err := deletePersistentVolumeClaim(mc, opts); err != nil {
+	if err := deletePersistentVolumeClaim(ctx, mc, opts); err != nil {
 		return err
 	}
 
 	// Delete persistent volume
 	opts.Filters["name"] = volumeName
-	if err := deletePersistentVolume(mc, opts); err != nil {
+	if err := deletePersistentVolume(ctx, mc, opts); err != nil {
 		return err
 	}
 
 	// Wait till the PV will be removed
-	return waitForVolumeState(mc, opts, "removed")
+	return waitForVolumeState(ctx, mc, opts, "removed")
 }
 
 // createPersistentVolumeClaim creates new PVC and binds it to appropriate Persistent Volume (PV)
-func createPersistentVolumeClaim(mc *rancher.MasterClient, appName, kubeConvAppName, volumeName, claimName, namespace string, volSize int) error {
+func createPersistentVolumeClaim(ctx context.Context, mc *rancher.MasterClient, appName, kubeConvAppName, volumeName, claimName, namespace string, volSize int) error {
 	newPvc := &projectClient.PersistentVolumeClaim{}
 	newPvc.Annotations = make(map[string]string)
 	newPvc.Name = claimName
@@ -141,17 +150,17 @@ func createPersistentVolumeClaim(mc *rancher.MasterClient, appName, kubeConvAppN
 
 	appcommon.MapAdd(newPvc.Annotations, common.AppAnnotationBaseName, appName)
 
-	logrus.WithFields(logrus.Fields{"claim": newPvc.Name}).Info("Creating persistent volume claim")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"claim": newPvc.Name}).Info("Creating persistent volume claim")
 
 	if _, err := mc.ProjectClient.PersistentVolumeClaim.Create(newPvc); err != nil {
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": newPvc.Name, "claim": newPvc.Name, "status": "OK"}).
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": newPvc.Name, "claim": newPvc.Name, "status": "OK"}).
 		Info("Creating persistent volume claim")
 
 	// Wait till the PV and PVC will be in the "Bound" state
 	opts := rancher.DefaultListOpts()
 	opts.Filters["name"] = volumeName
-	return waitForVolumeState(mc, opts, "bound")
+	return waitForVolumeState(ctx, mc, opts, "bound")
 }
@@ -3,6 +3,7 @@
 package apiclient
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -15,13 +16,18 @@ import (
 
 	"cisco.com/son/apphcd/api/v1/appmanager"
 	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/metrics"
+	"cisco.com/son/apphcd/app/common/requestid"
+	"cisco.com/son/apphcd/app/common/tracing"
 	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
 	"cisco.com/son/apphcd/app/grpc/common/rancher"
 	"cisco.com/son/apphcd/app/grpc/common/resourcemgr"
 )
 
 // createNamespace creates namespace
-func CreateNamespace(mc *rancher.MasterClient, namespace string) error {
+func CreateNamespace(ctx context.Context, mc *rancher.MasterClient, namespace string) (err error) {
+	ctx, span := tracing.Start(ctx, "apiclient.CreateNamespace")
+	defer tracing.Finish(span, &err)
 
 	opts := rancher.DefaultListOpts()
 	opts.Filters["name"] = namespace
@@ -40,7 +46,7 @@ func CreateNamespace(mc *rancher.MasterClient, namespace string) error {
 		}
 
 		// Create the namespace
-		logrus.WithFields(logrus.Fields{"namespace": opts.Filters["name"]}).Info("Creating namespace")
+		requestid.Logger(ctx).WithFields(logrus.Fields{"namespace": opts.Filters["name"]}).Info("Creating namespace")
 
 		ns, err := mc.ClusterClient.Namespace.Create(newNamespace)
 		if err != nil {
@@ -65,7 +71,7 @@ func CreateNamespace(mc *rancher.MasterClient, namespace string) error {
 			time.Sleep(500 * time.Millisecond)
 		}
 
-		logrus.WithFields(logrus.Fields{"namespace": opts.Filters["name"], "status": "OK"}).
+		requestid.Logger(ctx).WithFields(logrus.Fields{"namespace": opts.Filters["name"], "status": "OK"}).
 			Info("Creating namespace")
 
 	} else {
@@ -77,7 +83,7 @@ func CreateNamespace(mc *rancher.MasterClient, namespace string) error {
 	return nil
 }
 
-func cleanUpVolume(mc *rancher.MasterClient, opts *types.ListOpts) (pvId string, err error) {
+func cleanUpVolume(ctx context.Context, mc *rancher.MasterClient, opts *types.ListOpts) (pvId string, err error) {
 	c, err := mc.ClusterClient.PersistentVolume.List(opts)
 	if err != nil {
 		return
@@ -89,11 +95,11 @@ func cleanUpVolume(mc *rancher.MasterClient, opts *types.ListOpts) (pvId string,
 		// In case we have a volume in state "Released"
 		if c.Data[0].Name != "" && c.Data[0].Status.Phase == "Released" {
 			// Delete the volume
-			if err = deletePersistentVolume(mc, opts); err != nil {
+			if err = deletePersistentVolume(ctx, mc, opts); err != nil {
 				return
 			}
 			// Wait till the PV will be removed
-			if err = waitForVolumeState(mc, opts, "removed"); err != nil {
+			if err = waitForVolumeState(ctx, mc, opts, "removed"); err != nil {
 				return
 			}
 		}
@@ -103,7 +109,7 @@ func cleanUpVolume(mc *rancher.MasterClient, opts *types.ListOpts) (pvId string,
 }
 
 // deletePersistentVolume removes appropriate persistent volume (PV)
-func deletePersistentVolume(mc *rancher.MasterClient, opts *types.ListOpts) error {
+func deletePersistentVolume(ctx context.Context, mc *rancher.MasterClient, opts *types.ListOpts) error {
 
 	// Lookup persistentVolume resource according to the schema
 	c, err := mc.ClusterClient.PersistentVolume.List(opts)
@@ -138,18 +144,18 @@ func deletePersistentVolume(mc *rancher.MasterClient, opts *types.ListOpts) erro
 	}
 
 	// Delete PV
-	logrus.WithFields(logrus.Fields{"volume": pv.Name}).Info("Deleting persistent volume")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": pv.Name}).Info("Deleting persistent volume")
 	if err := mc.ClusterClient.PersistentVolume.Delete(pv); err != nil {
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"volume": pv.Name, "status": "OK"}).Info("Deleting persistent volume")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": pv.Name, "status": "OK"}).Info("Deleting persistent volume")
 
 	return nil
 }
 
 // deletePersistentVolumeClaim removes appropriate persistent volume claim (PVC)
-func deletePersistentVolumeClaim(mc *rancher.MasterClient, opts *types.ListOpts) error {
+func deletePersistentVolumeClaim(ctx context.Context, mc *rancher.MasterClient, opts *types.ListOpts) error {
 
 	// List the PVC collection
 	c, err := mc.ProjectClient.PersistentVolumeClaim.List(opts)
@@ -168,7 +174,7 @@ func deletePersistentVolumeClaim(mc *rancher.MasterClient, opts *types.ListOpts)
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"claim": opts.Filters["name"]}).Info("Deleting persistent volume claim")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"claim": opts.Filters["name"]}).Info("Deleting persistent volume claim")
 
 	startTime := time.Now()
 	for {
@@ -188,7 +194,7 @@ func deletePersistentVolumeClaim(mc *rancher.MasterClient, opts *types.ListOpts)
 		time.Sleep(1 * time.Second)
 	}
 
-	logrus.WithFields(logrus.Fields{"claim": opts.Filters["name"], "status": "OK"}).Info("Deleting persistent volume claim")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"claim": opts.Filters["name"], "status": "OK"}).Info("Deleting persistent volume claim")
 
 	return nil
 }
@@ -218,8 +224,8 @@ func getPodsInErrorState(mc *rancher.MasterClient, opts *types.ListOpts) (string
 }
 
 // waitForVolume waits until particular volume will be ready
-func waitForVolumeState(mc *rancher.MasterClient, opts *types.ListOpts, expectedState string) error {
-	logrus.WithFields(logrus.Fields{"volume": opts.Filters["name"]}).Info("Waiting for the volume")
+func waitForVolumeState(ctx context.Context, mc *rancher.MasterClient, opts *types.ListOpts, expectedState string) error {
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": opts.Filters["name"]}).Info("Waiting for the volume")
 
 	timeout := time.After(time.Duration(100) * time.Second)
 	every := time.Tick(1 * time.Second)
@@ -247,16 +253,17 @@ LOOP:
 		}
 	}
 
-	logrus.WithFields(logrus.Fields{"volume": opts.Filters["name"], "status": "OK"}).Info("Waiting for the volume")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"volume": opts.Filters["name"], "status": "OK"}).Info("Waiting for the volume")
 
 	return nil
 }
 
 // Rancher doesn't wait till all pods and containers in the application instance
 // are ready hence apiWaitForPodsReadiness implements this functionality
-func waitForPodsReadiness(mc *rancher.MasterClient, appInstanceName string) error {
+func waitForPodsReadiness(ctx context.Context, mc *rancher.MasterClient, appInstanceName string) (err error) {
+	defer metrics.ObserveStep(metrics.StepWaitForPodsReadiness, time.Now(), &err)
 
-	logrus.WithFields(logrus.Fields{"instance": appInstanceName}).Info("Waiting for pods readiness")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": appInstanceName}).Info("Waiting for pods readiness")
 
 	filter := rancher.DefaultListOpts()
 	filter.Filters["name"] = appInstanceName
@@ -306,7 +313,7 @@ func waitForPodsReadiness(mc *rancher.MasterClient, appInstanceName string) erro
 			time.Sleep(1 * time.Second)
 		}
 
-		logrus.WithFields(logrus.Fields{"instance": appInstanceName, "status": "OK"}).Info("Waiting for pods readiness")
+		requestid.Logger(ctx).WithFields(logrus.Fields{"instance": appInstanceName, "status": "OK"}).Info("Waiting for pods readiness")
 
 	}
 	// No error so far
@@ -314,7 +321,7 @@ func waitForPodsReadiness(mc *rancher.MasterClient, appInstanceName string) erro
 }
 
 // getWorkloadInstanceData creates appmanager.Workload proto message
-func getWorkloadInstanceData(mc *rancher.MasterClient, item *projectClient.Workload, catalogId string, verbose bool) (*appmanager.Instance, error) {
+func getWorkloadInstanceData(ctx context.Context, mc *rancher.MasterClient, item *projectClient.Workload, catalogId string, verbose bool) (*appmanager.Instance, error) {
 	ai := &appmanager.Instance{}
 
 	// Application instance name (converted to the Kubernetes format)
@@ -391,6 +398,15 @@ func getWorkloadInstanceData(mc *rancher.MasterClient, item *projectClient.Workl
 		// Type daemon
 	default:
 		ai.Cycle = appmgrcommon.TypeDaemon
+
+		if item.Scale != nil {
+			ai.DesiredReplicas = uint32(*item.Scale)
+		}
+
+		if item.DeploymentStatus != nil {
+			ai.ReadyReplicas = uint32(item.DeploymentStatus.ReadyReplicas)
+			ai.AvailableReplicas = uint32(item.DeploymentStatus.AvailableReplicas)
+		}
 	}
 
 	ai.State = item.State
@@ -501,7 +517,7 @@ func getWorkloadInstanceData(mc *rancher.MasterClient, item *projectClient.Workl
 		}
 
 		// Get the list of available templates
-		versions, err := GetTemplateVersions(mc, ai.Name)
+		versions, err := GetTemplateVersions(ctx, mc, ai.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -518,7 +534,7 @@ func getWorkloadInstanceData(mc *rancher.MasterClient, item *projectClient.Workl
 }
 
 // getAppInstanceData provides information about particular instance
-func getAppInstanceData(mc *rancher.MasterClient, item *projectClient.App, catalogId string, verbose bool) (*appmanager.Instance, error) {
+func getAppInstanceData(ctx context.Context, mc *rancher.MasterClient, item *projectClient.App, catalogId string, verbose bool) (*appmanager.Instance, error) {
 	ai := &appmanager.Instance{}
 
 	// Application instance name (converted to the Kubernetes format)
@@ -569,7 +585,7 @@ func getAppInstanceData(mc *rancher.MasterClient, item *projectClient.App, catal
 		ai.Template.CatalogId = catalogId
 
 		// Get the list of available templates
-		versions, err := GetTemplateVersions(mc, ai.Name)
+		versions, err := GetTemplateVersions(ctx, mc, ai.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -595,13 +611,13 @@ func getAppLogsEndpoint(endpoint, appName, rootGroupId string) string {
 
 // postDeploymentAction applies appropriate actions as soon as application is deployed.
 // Currently supported actions are enable or disable applications
-func postDeploymentAction(mc *rancher.MasterClient, instanceName, instanceVersion string,
+func postDeploymentAction(ctx context.Context, mc *rancher.MasterClient, instanceName, instanceVersion string,
 	state appmanager.AppStateAfterDeployment) error {
 
 	if state == appmanager.AppStateAfterDeployment_enabled {
 		// Wait for Pods readiness
-		if err := waitForPodsReadiness(mc, instanceName); err != nil {
-			logrus.Error(err)
+		if err := waitForPodsReadiness(ctx, mc, instanceName); err != nil {
+			requestid.Logger(ctx).Error(err)
 			return err
 		}
 
@@ -619,7 +635,7 @@ func postDeploymentAction(mc *rancher.MasterClient, instanceName, instanceVersio
 
 		// If the workload exists
 		if len(wc.Data) > 0 {
-			if err := disableAppInstance(mc, wc, instanceVersion); err != nil {
+			if err := disableAppInstance(ctx, mc, wc, instanceVersion); err != nil {
 				return err
 			}
 		}
@@ -629,11 +645,11 @@ func postDeploymentAction(mc *rancher.MasterClient, instanceName, instanceVersio
 }
 
 // disableAppInstance disables a particular application instance
-func disableAppInstance(mc *rancher.MasterClient, wc *projectClient.WorkloadCollection, instanceVersion string) error {
+func disableAppInstance(ctx context.Context, mc *rancher.MasterClient, wc *projectClient.WorkloadCollection, instanceVersion string) error {
 
 	instance := &wc.Data[0]
 
-	logrus.WithFields(logrus.Fields{"instance": instance.Name, "version": instanceVersion}).
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": instance.Name, "version": instanceVersion}).
 		Info("Disabling application")
 
 	// Delete workload
@@ -641,7 +657,7 @@ func disableAppInstance(mc *rancher.MasterClient, wc *projectClient.WorkloadColl
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": instance.Name, "version": instanceVersion,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": instance.Name, "version": instanceVersion,
 		"status": "OK"}).Info("Disabling application")
 
 	return nil
@@ -3,10 +3,8 @@
 package rancher
 
 import (
-	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 
 	"cisco.com/son/apphcd/api/v1/appmanager"
 	gcommon "cisco.com/son/apphcd/app/common"
@@ -16,127 +14,12 @@ import (
 
 // Application temporary data
 type AppsData struct {
-	NewAppInstancesData []*common.AppInstanceData
-	RunningAppsData     map[string][]*common.AppInstanceData
-	SampleInstance      *common.AppInstanceData
+	*common.AppsData
 }
 
 // Create temporary data structure
 func NewAppsData() *AppsData {
-	d := new(AppsData)
-	d.RunningAppsData = make(map[string][]*common.AppInstanceData)
-	d.NewAppInstancesData = []*common.AppInstanceData{}
-	return d
-}
-
-// Create temporary data for a new application instance
-func (apps *AppsData) AppendNewAppInstances(req common.GenericRequester, appCycle string,
-	description string, state appmanager.AppStateAfterDeployment) {
-	// Iterate over Group IDs
-	for _, gid := range req.GetGroupIds() {
-		// Create annotation map
-		a := gcommon.MakeMap()
-
-		// Create labels map
-		l := gcommon.MakeMap()
-
-		appName := req.GetName()
-
-		// Add "apphc.app.basename" annotation - required for all instances deployed by controller
-		a.Add(common.AppAnnotationBaseName, appName)
-
-		var truncAppName string
-		if len(appName) > common.AppNameStringLength {
-			truncAppName = appName[0 : common.AppNameStringLength-1]
-		} else {
-			truncAppName = appName
-		}
-
-		var truncAppGroupId string
-		if len(gid) > common.AppGroupIdLength {
-			truncAppGroupId = gid[common.AppGroupIdLength-1:]
-		} else {
-			truncAppGroupId = gid
-		}
-
-		var appInstanceId string
-		var kubeConvName string
-
-		if req.GetRootGroupId() == "" {
-			// Instance ID consists of the application name and GroupID
-			appInstanceId = strings.Join([]string{appName, gid}, "-")
-			//
-			kubeConvName = strings.ToLower(strings.Join([]string{truncAppName, truncAppGroupId}, "-"))
-		} else {
-			// Instance ID consists of the application name, RoodGroupID and GroupID
-			appInstanceId = strings.Join([]string{appName, req.GetRootGroupId(), gid}, "-")
-			// Add annotation "apphc.app.instance.root_group_id"
-			a.Add(common.AppInstanceAnnotationRootGroupId, req.GetRootGroupId())
-			// Add label "apphc.app.instance.root_group_id"
-			l.Add(common.AppLabelRootGroupId, req.GetRootGroupId())
-			//
-			// Root Group ID
-			rootGroupId := req.GetRootGroupId()
-			if len(rootGroupId) > common.AppRootGroupIdLength {
-				rootGroupId = req.GetRootGroupId()[0 : common.AppRootGroupIdLength-1]
-			}
-			//
-			kubeConvName = strings.ToLower(strings.Join([]string{truncAppName, rootGroupId, truncAppGroupId}, "-"))
-		}
-
-		a.Add(common.AppInstanceAnnotationId, appInstanceId)
-
-		kubeConvName = strings.ToLower(strings.Replace(kubeConvName, "_", "-", -1))
-
-		appInstance := &common.AppInstanceData{}
-		appInstance.InstanceName = kubeConvName
-		appInstance.TargetNamespace = strings.Replace(strings.ToLower(appName),"_","-", -1)
-		appInstance.State = state
-		appInstance.TemplateAvailable = false
-		appInstance.NextAction = common.AppInstanceDataNextActionCreate
-		appInstance.InstanceStorageSize = -1
-
-		// In case description is empty - set default
-		if description == "" {
-			appInstance.Description = fmt.Sprintf("Application %s, instance ID %s", appName, appInstanceId)
-		} else {
-			appInstance.Description = description
-		}
-
-		appInstance.RequestedVersion = req.GetVersion()
-
-		// Since the list consists of the new app instance,
-		// Set it as not deployed
-		// Add annotation "apphc.app.cycle"
-		a.Add(common.AppAnnotationCycle, appCycle)
-
-		// Add annotation "apphc.app.instance.group_id"
-		a.Add(common.AppInstanceAnnotationGroupId, gid)
-
-		// Add annotation "apphc.app.instance.version"
-		a.Add(common.AppInstanceAnnotationVersion, appInstance.RequestedVersion)
-
-		// Add annotation "apphc.app.instance.id
-		a.Add(common.AppInstanceAnnotationId, appInstanceId)
-
-		// Add annotation "apphc.app.instance.template_name"
-		a.Add(common.AppInstanceAnnotationTemplateName, kubeConvName)
-
-		// Add annotations
-		appInstance.Annotations = a
-
-		// Add label "apphc.app.cycle"
-		l.Add(common.AppLabelCycle, appCycle)
-
-		// Add label "apphc.app.instance.group_id"
-		l.Add(common.AppLabelGroupId, gid)
-
-		// Add labels
-		appInstance.Labels = l
-
-		// Append the app
-		apps.NewAppInstancesData = append(apps.NewAppInstancesData, appInstance)
-	}
+	return &AppsData{common.NewAppsData()}
 }
 
 // Append running applications
@@ -327,48 +210,6 @@ func (apps *AppsData) AppendAppsDataToDelete(apiClient *rancher.MasterClient, re
 	return nil
 }
 
-// Get list of running applications
-func (apps *AppsData) GetRunningAppsData() map[string][]*common.AppInstanceData {
-	return apps.RunningAppsData
-}
-
-// Get running application
-func (apps *AppsData) GetRunningAppData(appName string) []*common.AppInstanceData {
-	if appInstances, ok := apps.RunningAppsData[appName]; ok {
-		return appInstances
-	}
-	return nil
-}
-
-// Check whether the list containing appropriate data related to the
-// running instances is not empty
-func (apps *AppsData) RunningAppsDataNotEmpty() bool {
-	return len(apps.RunningAppsData) > 0
-}
-
-// Check whether the list containing appropriate data related to the
-// running instances is empty
-func (apps *AppsData) RunningAppsDataEmpty() bool {
-	return len(apps.RunningAppsData) == 0
-}
-
-// Check whether the list containing appropriate data related to the
-// instances that requested to be created is not empty
-func (apps *AppsData) NewAppInstancesDataNotEmpty() bool {
-	return len(apps.NewAppInstancesData) > 0
-}
-
-// Check whether the list containing appropriate data related to the
-// instances that requested to be created is empty
-func (apps *AppsData) NewAppInstancesDataEmpty() bool {
-	return len(apps.NewAppInstancesData) == 0
-}
-
-// Get number of new instances
-func (apps *AppsData) GetNumberOfNewInstances() int {
-	return len(apps.NewAppInstancesData)
-}
-
 // getExternalID gives back a map with the keys catalog, template and version
 func getExternalID(e string) (map[string]string, error) {
 	// Allocate map for the parsed data
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"cisco.com/son/apphcd/api/v1/appmanager"
+	"cisco.com/son/apphcd/app/common/longrunning"
 	"cisco.com/son/apphcd/app/grpc/appmanager/adapters/rancher/apiclient"
 	"cisco.com/son/apphcd/app/grpc/appmanager/common"
 	"cisco.com/son/apphcd/app/grpc/appmanager/common/chartutils"
@@ -19,7 +20,7 @@ import (
 
 // createUpgradeApps responsible for making a decision whether the application
 // instance should be created, upgraded/updated
-func createUpgradeApps(apiClient *rancher.MasterClient, appInstances []*common.AppInstanceData, catalogId string) ([]*appmanager.AppInstance, error) {
+func createUpgradeApps(ctx context.Context, apiClient *rancher.MasterClient, appInstances []*common.AppInstanceData, catalogId string) ([]*appmanager.AppInstance, error) {
 
 	// Wait for the group of subroutines
 	var wg sync.WaitGroup
@@ -29,8 +30,17 @@ func createUpgradeApps(apiClient *rancher.MasterClient, appInstances []*common.A
 	_, cancel := context.WithCancel(context.Background())
 	defer cancel() // Make sure it's called to release resources even if no errors
 
+	// Set once the operation is cancelled
+	var cancelled error
+
 	// iterate over the list of temporary data
 	for _, instance := range appInstances {
+		// The remaining instances are skipped once the operation is cancelled
+		if ctx.Err() != nil {
+			cancelled = fmt.Errorf("operation cancelled before handling the application instance %s", instance.InstanceName)
+			break
+		}
+
 		if instance.NextAction != common.AppInstanceDataNextActionNone {
 			// create a separate subroutine for a particular action
 			wg.Add(1)
@@ -40,31 +50,33 @@ func createUpgradeApps(apiClient *rancher.MasterClient, appInstances []*common.A
 				switch instance.NextAction {
 				case common.AppInstanceDataNextActionCreate:
 					// Create a new application instance
-					if err := apiclient.CreateAppInstance(apiClient, instance, instance.RequestedVersion); err != nil {
+					if err := apiclient.CreateAppInstance(ctx, apiClient, instance, instance.RequestedVersion); err != nil {
 						errors = append(errors, err)
 					} else {
 						doneList = append(doneList, generateProtoData(instance, catalogId))
+						longrunning.Step(ctx, "instance %s ready", instance.InstanceName)
 					}
 
 				case common.AppInstanceDataNextActionUpgrade:
 					// Upgrade application instance
-					if err := apiclient.UpgradeAppInstance(apiClient, instance); err != nil {
+					if err := apiclient.UpgradeAppInstance(ctx, apiClient, instance); err != nil {
 						errors = append(errors, err)
 					} else {
 						doneList = append(doneList, generateProtoData(instance, catalogId))
+						longrunning.Step(ctx, "instance %s ready", instance.InstanceName)
 					}
 
 				case common.AppInstanceDataNextActionRecreate:
-					if err := apiclient.DeleteAppInstance(apiClient, instance, instance.CurrentVersion); err != nil {
+					if err := apiclient.DeleteAppInstance(ctx, apiClient, instance, instance.CurrentVersion); err != nil {
 						errors = append(errors, err)
 					} else {
-						if err := apiclient.CreateAppInstance(apiClient, instance, instance.RequestedVersion); err != nil {
+						if err := apiclient.CreateAppInstance(ctx, apiClient, instance, instance.RequestedVersion); err != nil {
 							err = fmt.Errorf("cannot recreate the application instance %s: %s", instance.InstanceName, err.Error())
 							errors = append(errors, err)
 							cancel()
 						} else {
 							doneList = append(doneList, generateProtoData(instance, catalogId))
-
+							longrunning.Step(ctx, "instance %s ready", instance.InstanceName)
 						}
 					}
 				}
@@ -80,12 +92,16 @@ func createUpgradeApps(apiClient *rancher.MasterClient, appInstances []*common.A
 		return nil, errors[0]
 	}
 
+	if cancelled != nil {
+		return nil, cancelled
+	}
+
 	return doneList, nil
 }
 
 // doApps responsible for making a decision whether the application
 // instance should be created, upgraded or deleted
-func deleteApps(apiClient *rancher.MasterClient, appInstances []*common.AppInstanceData, catalogId, appName,
+func deleteApps(ctx context.Context, apiClient *rancher.MasterClient, appInstances []*common.AppInstanceData, catalogId, appName,
 	repoPath string, purge bool) ([]*appmanager.AppInstance, error) {
 
 	// Wait for the group of subroutines
@@ -104,9 +120,11 @@ func deleteApps(apiClient *rancher.MasterClient, appInstances []*common.AppInsta
 				switch instance.NextAction {
 				case common.AppInstanceDataNextActionDelete:
 					instance.DeleteInstanceStorage = true
-					if err := apiclient.DeleteAppInstance(apiClient, instance, instance.CurrentVersion); err != nil {
+					if err := apiclient.DeleteAppInstance(ctx, apiClient, instance, instance.CurrentVersion); err != nil {
 						errors = append(errors, err)
 					} else {
+						longrunning.Step(ctx, "instance %s deleted", instance.InstanceName)
+
 						// If need to remove metadata
 						if purge {
 							// Set path to the application root directory
@@ -117,7 +135,7 @@ func deleteApps(apiClient *rancher.MasterClient, appInstances []*common.AppInsta
 								chartPath = filepath.Join(chartPath, instance.RequestedVersion)
 							}
 							// Delete the chart
-							if err := chartutils.DeleteChart(chartPath, instance.InstanceName, instance.RequestedVersion); err != nil {
+							if err := chartutils.DeleteChart(ctx, chartPath, instance.InstanceName, instance.RequestedVersion); err != nil {
 								errors = append(errors, err)
 							}
 							// Add to the list
@@ -144,3 +162,17 @@ func deleteApps(apiClient *rancher.MasterClient, appInstances []*common.AppInsta
 
 	return doneList, nil
 }
+
+// deleteCreatedApps deletes the application instances created by the upgrade being rolled back
+func deleteCreatedApps(ctx context.Context, apiClient *rancher.MasterClient, appInstances []*common.AppInstanceData) error {
+	for _, instance := range common.CreatedInstances(appInstances) {
+		instance.DeleteInstanceStorage = true
+		if err := apiclient.DeleteAppInstance(ctx, apiClient, instance, instance.RequestedVersion); err != nil {
+			return fmt.Errorf("cannot delete the created application instance %s: %s", instance.InstanceName, err.Error())
+		}
+
+		longrunning.Step(ctx, "created instance %s deleted", instance.InstanceName)
+	}
+
+	return nil
+}
@@ -4,12 +4,23 @@ package appmanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes"
 
 	pb "cisco.com/son/apphcd/api/v1/appmanager"
+	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/longrunning"
 	"cisco.com/son/apphcd/app/common/mutex"
+	"cisco.com/son/apphcd/app/common/requestid"
 	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
 	"cisco.com/son/apphcd/app/grpc/common/resourcemgr"
 )
@@ -18,220 +29,538 @@ import (
 type manager struct {
 	// AppManager adapter
 	adapter appmgrcommon.AppManagerAdapter
+	// Long-running operations
+	operations *longrunning.Registry
+	// Streams the changes of application instances
+	watcher *watcher
+	// Kubernetes client. Nil if the adapter is not backed by a Kubernetes cluster
+	kc *kubernetes.Clientset
+	// Closed once the streams must be closed
+	shutdown <-chan struct{}
 }
 
-// New method instantiates a new AppManager server
-func New(adapter appmgrcommon.AppManagerAdapter) pb.AppManagerServer {
-	return &manager{adapter}
+// New method instantiates a new AppManager server. The application instances are watched
+// through the Kubernetes client unless it's nil, until the context is done
+func New(ctx context.Context, adapter appmgrcommon.AppManagerAdapter, kc *kubernetes.Clientset,
+	operations *longrunning.Registry) pb.AppManagerServer {
+
+	w := newWatcher(adapter, kc)
+	go w.run(ctx)
+
+	return &manager{adapter: adapter, operations: operations, watcher: w, kc: kc, shutdown: ctx.Done()}
 }
 
 func (mgr *manager) CreateApp(ctx context.Context, req *pb.CreateAppRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "AppManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received CreateAppRequest")
 
-	logrus.Debugf("CreateAppRequest message: %q", req.String())
+	requestid.Logger(ctx).Debugf("CreateAppRequest message: %q", req.String())
 
 	appLocker := req.Name + "" + req.RootGroupId
 
-	if mutex.IsLocked(appLocker) {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
+	if err := req.Validate(); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	if err := req.Validate(); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+	if err := appmgrcommon.ValidateAutoscaling(req.GetCycle(), req.GetSpec().GetAutoscaling()); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
 	if err := resourcemgr.ValidateInputs(req.GetSpec().GetResources().GetLimits().GetCpu(),
 		appmgrcommon.AppInstanceDefaultCpuRequestFloat64, req.GetSpec().GetResources().GetLimits().GetMemory(),
 		appmgrcommon.AppInstanceDefaultMemoryRequestUint32); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	mutex.Lock(appLocker, nil)
-	defer mutex.Unlock(appLocker)
+	if !mutex.TryLock(appLocker, "CreateApp "+req.Name, auth.IdentityName(ctx)) {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
+	}
+
+	fn := func(ctx context.Context) (*pb.Response, error) {
+		if err := validateDockerImage(req.GetSpec().GetImage()); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
+		}
+
+		return mgr.adapter.CreateApp(ctx, req)
+	}
 
-	if err := appmgrcommon.ValidateDockerImage(req.GetSpec().GetImage().GetRepo(), req.GetSpec().GetImage().GetTag()); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+	if req.DryRun {
+		return mgr.dryRun(ctx, appLocker, fn)
 	}
 
-	return mgr.adapter.CreateApp(req)
+	return mgr.runOperation(ctx, "CreateApp", req.Name, appLocker, req.Async, fn)
 }
 
 func (mgr *manager) UpgradeApp(ctx context.Context, req *pb.UpgradeAppRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "AppManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received UpgradeAppRequest")
 
-	logrus.Debugf("UpgradeAppRequest message: %q", req.String())
+	requestid.Logger(ctx).Debugf("UpgradeAppRequest message: %q", req.String())
 
 	appLocker := req.Name + "" + req.RootGroupId
 
-	if mutex.IsLocked(appLocker) {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
+	if err := req.Validate(); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	if err := req.Validate(); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+	if err := appmgrcommon.ValidateAutoscaling(req.GetCycle(), req.GetSpec().GetAutoscaling()); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	mutex.Lock(appLocker, nil)
-	defer mutex.Unlock(appLocker)
+	if err := appmgrcommon.ValidateUpgradeStrategy(req.GetStrategy()); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
+	}
+
+	if !mutex.TryLock(appLocker, "UpgradeApp "+req.Name, auth.IdentityName(ctx)) {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
+	}
+
+	fn := func(ctx context.Context) (*pb.Response, error) {
+		if err := validateDockerImage(req.GetSpec().GetImage()); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
+		}
+
+		return mgr.adapter.UpgradeApp(ctx, req)
+	}
 
-	if err := appmgrcommon.ValidateDockerImage(req.GetSpec().GetImage().GetRepo(), req.GetSpec().GetImage().GetTag()); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+	if req.DryRun {
+		return mgr.dryRun(ctx, appLocker, fn)
 	}
 
-	return mgr.adapter.UpgradeApp(req)
+	return mgr.runOperation(ctx, "UpgradeApp", req.Name, appLocker, req.Async, fn)
 }
 
 func (mgr *manager) UpdateApp(ctx context.Context, req *pb.UpdateAppRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "AppManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received UpdateAppRequest")
 
-	logrus.Debugf("UpdateAppRequest message: %q", req.String())
+	requestid.Logger(ctx).Debugf("UpdateAppRequest message: %q", req.String())
 
 	appLocker := req.Name + "" + req.RootGroupId
 
-	if mutex.IsLocked(appLocker) {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
+	if err := req.Validate(); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	if err := req.Validate(); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+	if err := appmgrcommon.ValidateAutoscaling(req.GetCycle(), req.GetSpec().GetAutoscaling()); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	mutex.Lock(appLocker, nil)
-	defer mutex.Unlock(appLocker)
+	if err := appmgrcommon.ValidateUpgradeStrategy(req.GetStrategy()); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
+	}
 
 	if req.GetSpec() != nil {
 		if req.GetSpec().GetImage() != nil {
 			if err := req.GetSpec().GetImage().Validate(); err != nil {
-				return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+				return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 			}
 		}
 
 		if req.GetSpec().GetPorts() != nil {
 			for _, p := range req.GetSpec().GetPorts() {
 				if err := p.Validate(); err != nil {
-					return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+					return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 				}
 			}
 		}
 	}
 
 	if req.GetVersion() != "" {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, "the field version is not supported by the UpdateApp request", nil)
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, "the field version is not supported by the UpdateApp request", nil)
+	}
+
+	if !mutex.TryLock(appLocker, "UpdateApp "+req.Name, auth.IdentityName(ctx)) {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
+	}
+
+	fn := func(ctx context.Context) (*pb.Response, error) {
+		if err := validateDockerImage(req.GetSpec().GetImage()); err != nil {
+			return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
+		}
+
+		return mgr.adapter.UpdateApp(ctx, req)
+	}
+
+	if req.DryRun {
+		return mgr.dryRun(ctx, appLocker, fn)
+	}
+
+	return mgr.runOperation(ctx, "UpdateApp", req.Name, appLocker, req.Async, fn)
+}
+
+func (mgr *manager) RollbackApp(ctx context.Context, req *pb.RollbackAppRequest) (*pb.Response, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received RollbackAppRequest")
+
+	requestid.Logger(ctx).Debugf("RollbackAppRequest message: %q", req.String())
+
+	appLocker := req.Name + "" + req.RootGroupId
+
+	if err := req.Validate(); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
+	}
+
+	if req.Version == "" && req.Revision == "" {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, "either version or revision is required by the RollbackApp request", nil)
+	}
+
+	if !mutex.TryLock(appLocker, "RollbackApp "+req.Name, auth.IdentityName(ctx)) {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
+	}
+
+	return mgr.runOperation(ctx, "RollbackApp", req.Name, appLocker, req.Async, func(ctx context.Context) (*pb.Response, error) {
+		return mgr.adapter.RollbackApp(ctx, req)
+	})
+}
+
+func (mgr *manager) ScaleApp(ctx context.Context, req *pb.ScaleAppRequest) (*pb.Response, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received ScaleAppRequest")
+
+	requestid.Logger(ctx).Debugf("ScaleAppRequest message: %q", req.String())
+
+	appLocker := req.Name + "" + req.RootGroupId
+
+	if err := req.Validate(); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	if err := appmgrcommon.ValidateDockerImage(req.GetSpec().GetImage().GetRepo(), req.GetSpec().GetImage().GetTag()); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+	if !mutex.TryLock(appLocker, "ScaleApp "+req.Name, auth.IdentityName(ctx)) {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
 	}
 
-	return mgr.adapter.UpdateApp(req)
+	return mgr.runOperation(ctx, "ScaleApp", req.Name, appLocker, req.Async, func(ctx context.Context) (*pb.Response, error) {
+		return mgr.adapter.ScaleApp(ctx, req)
+	})
 }
 
 func (mgr *manager) EnableDisableApp(ctx context.Context, req *pb.EnableDisableAppRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "AppManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received EnableDisableAppRequest")
 
-	logrus.Debugf("EnableDisableAppRequest message: %q", req.String())
+	requestid.Logger(ctx).Debugf("EnableDisableAppRequest message: %q", req.String())
 
-	if mutex.IsLocked(mutex.LockActionEnableDisableApps) {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
+	if !mutex.TryLock(mutex.LockActionEnableDisableApps, "EnableDisableApp "+req.Name, auth.IdentityName(ctx)) {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
 	}
-
-	mutex.Lock(mutex.LockActionEnableDisableApps, nil)
 	defer mutex.Unlock(mutex.LockActionEnableDisableApps)
+	defer mgr.watcher.notify()
 
-	return mgr.adapter.EnableDisableApp(req)
+	return mgr.adapter.EnableDisableApp(ctx, req)
 }
 
 func (mgr *manager) DeleteApp(ctx context.Context, req *pb.DeleteAppRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "AppManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received DeleteAppRequest")
 
-	logrus.Debugf("DeleteAppRequest message: %q", req.String())
+	requestid.Logger(ctx).Debugf("DeleteAppRequest message: %q", req.String())
 
 	appLocker := req.Name + "" + req.RootGroupId
 
-	if mutex.IsLocked(appLocker) {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
-	}
-
 	if err := req.Validate(); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	mutex.Lock(appLocker, nil)
-	defer mutex.Unlock(appLocker)
+	if !mutex.TryLock(appLocker, "DeleteApp "+req.Name, auth.IdentityName(ctx)) {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, fmt.Sprintf("application %s is locked", req.Name), nil)
+	}
 
-	return mgr.adapter.DeleteApp(req)
+	return mgr.runOperation(ctx, "DeleteApp", req.Name, appLocker, req.Async, func(ctx context.Context) (*pb.Response, error) {
+		return mgr.adapter.DeleteApp(ctx, req)
+	})
 }
 
 func (mgr *manager) DeleteApps(ctx context.Context, req *pb.DeleteAppsRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "AppManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received DeleteAppsRequest")
 
-	logrus.Debugf("DeleteAppsRequest message: %q", req.String())
-
-	if mutex.IsLocked(mutex.LockActionDeleteApps) {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, "applications are locked", nil)
-	}
+	requestid.Logger(ctx).Debugf("DeleteAppsRequest message: %q", req.String())
 
 	if err := req.Validate(); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	mutex.Lock(mutex.LockActionDeleteApps, nil)
-	defer mutex.Unlock(mutex.LockActionDeleteApps)
+	if !mutex.TryLock(mutex.LockActionDeleteApps, "DeleteApps", auth.IdentityName(ctx)) {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, "applications are locked", nil)
+	}
 
-	return mgr.adapter.DeleteApps(req)
+	return mgr.runOperation(ctx, "DeleteApps", "", mutex.LockActionDeleteApps, req.Async, func(ctx context.Context) (*pb.Response, error) {
+		return mgr.adapter.DeleteApps(ctx, req)
+	})
 }
 
 func (mgr *manager) GetApps(ctx context.Context, req *pb.GetAppsRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "AppManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received GetAppsRequest")
 
-	logrus.Debugf("GetAppsRequest message: %q", req.String())
+	requestid.Logger(ctx).Debugf("GetAppsRequest message: %q", req.String())
 
 	if err := req.Validate(); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	return mgr.adapter.GetApps(req)
+	return mgr.adapter.GetApps(ctx, req)
 }
 
-func (mgr *manager) DeleteAppMetadata(ctx context.Context, req *pb.DeleteAppMetadataRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "AppManager",
-		"type":    "grpc",
-	}).Info("Received DeleteAppMetadataRequest")
+func (mgr *manager) ListAppRevisions(ctx context.Context, req *pb.ListAppRevisionsRequest) (*pb.Response, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received ListAppRevisionsRequest")
 
-	logrus.Debugf("DeleteAppMetadataRequest message: %q", req.String())
+	requestid.Logger(ctx).Debugf("ListAppRevisionsRequest message: %q", req.String())
 
-	if mutex.IsLocked(req.AppName) {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, fmt.Sprintf("metadata for application %s is locked", req.AppName), nil)
+	if err := req.Validate(); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
+	return mgr.adapter.ListAppRevisions(ctx, req)
+}
+
+func (mgr *manager) GetAppRevision(ctx context.Context, req *pb.GetAppRevisionRequest) (*pb.Response, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received GetAppRevisionRequest")
+
+	requestid.Logger(ctx).Debugf("GetAppRevisionRequest message: %q", req.String())
+
 	if err := req.Validate(); err != nil {
-		return appmgrcommon.GenerateResponse(pb.Status_ERROR, err.Error(), nil)
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
 	}
 
-	mutex.Lock(req.AppName, nil)
+	return mgr.adapter.GetAppRevision(ctx, req)
+}
+
+func (mgr *manager) WatchApps(req *pb.WatchAppsRequest, stream pb.AppManager_WatchAppsServer) error {
+	ctx := stream.Context()
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received WatchAppsRequest")
+
+	requestid.Logger(ctx).Debugf("WatchAppsRequest message: %q", req.String())
+
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s, events, err := mgr.watcher.subscribe(ctx, req)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer mgr.watcher.unsubscribe(s)
+
+	for {
+		for _, e := range events {
+			if err := stream.Send(e); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case batch, ok := <-s.events:
+			if !ok {
+				return status.Error(codes.Unavailable, s.err.Error())
+			}
+
+			events = batch
+		}
+	}
+}
+
+func (mgr *manager) StreamAppLogs(req *pb.StreamAppLogsRequest, stream pb.AppManager_StreamAppLogsServer) error {
+	ctx := stream.Context()
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received StreamAppLogsRequest")
+
+	requestid.Logger(ctx).Debugf("StreamAppLogsRequest message: %q", req.String())
+
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if mgr.kc == nil {
+		return status.Error(codes.Unimplemented, "log streaming is not supported by the adapter")
+	}
+
+	opts, err := podLogOptions(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sources, err := mgr.logSources(ctx, req)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if len(sources) == 0 {
+		return status.Errorf(codes.NotFound, "no running instance of application %s found", req.Name)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	lines := make(chan *pb.LogLine)
+	errc := make(chan error, len(sources))
+
+	var wg sync.WaitGroup
+	for _, src := range sources {
+		wg.Add(1)
+		go func(src *logSource) {
+			defer wg.Done()
+
+			if err := readLogs(ctx, mgr.kc, src, *opts, lines); err != nil {
+				requestid.Logger(ctx).WithFields(logrus.Fields{
+					"instance":  src.instance,
+					"pod":       src.pod,
+					"container": src.container,
+					"error":     err,
+				}).Warn("Unable to stream logs")
+				errc <- err
+			}
+		}(src)
+	}
+
+	go func() {
+		wg.Wait()
+		close(lines)
+	}()
+
+	for {
+		select {
+		case <-mgr.shutdown:
+			return status.Error(codes.Unavailable, errShuttingDown.Error())
+		case l, ok := <-lines:
+			if !ok {
+				// Fail the stream only if none of the containers logs could be read
+				if len(errc) == len(sources) {
+					return status.Error(codes.Internal, (<-errc).Error())
+				}
+
+				return nil
+			}
+
+			if err := stream.Send(l); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (mgr *manager) DeleteAppMetadata(ctx context.Context, req *pb.DeleteAppMetadataRequest) (*pb.Response, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AppManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received DeleteAppMetadataRequest")
+
+	requestid.Logger(ctx).Debugf("DeleteAppMetadataRequest message: %q", req.String())
+
+	if err := req.Validate(); err != nil {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
+	}
+
+	if !mutex.TryLock(req.AppName, "DeleteAppMetadata "+req.AppName, auth.IdentityName(ctx)) {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, fmt.Sprintf("metadata for application %s is locked", req.AppName), nil)
+	}
 	defer mutex.Unlock(req.AppName)
 
-	return mgr.adapter.DeleteAppMetadata(req)
+	return mgr.adapter.DeleteAppMetadata(ctx, req)
+}
+
+// dryRun plans the mutation right away instead of running it as a long-running operation.
+// The lock is held until the plan is made, so the application is not changed meanwhile
+func (mgr *manager) dryRun(ctx context.Context, lock string, fn func(ctx context.Context) (*pb.Response, error)) (*pb.Response, error) {
+	defer mutex.Unlock(lock)
+
+	return fn(ctx)
+}
+
+// runOperation runs the mutation as a long-running operation. The lock is held until the operation is finished.
+// Waits for the result unless asynchronous execution is requested
+func (mgr *manager) runOperation(ctx context.Context, method, target, lock string, async bool,
+	fn func(ctx context.Context) (*pb.Response, error)) (*pb.Response, error) {
+
+	var resp *pb.Response
+	var respErr error
+
+	op, err := mgr.operations.Run(ctx, "AppManager/"+method, target, auth.IdentityName(ctx), func(ctx context.Context) (proto.Message, error) {
+		defer mgr.watcher.notify()
+		defer mutex.Unlock(lock)
+
+		resp, respErr = fn(ctx)
+		if respErr != nil {
+			return resp, respErr
+		}
+
+		if resp.GetStatus() == pb.Status_ERROR {
+			return resp, errors.New(resp.GetMessage())
+		}
+
+		return resp, nil
+	})
+	if err != nil {
+		mutex.Unlock(lock)
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_ERROR, err.Error(), nil)
+	}
+
+	if async {
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_IN_PROGRESS, fmt.Sprintf("operation %s started", op.Id()), op.Proto())
+	}
+
+	select {
+	case <-op.Done():
+		return resp, respErr
+	case <-ctx.Done():
+		// The caller has gone, the operation keeps running
+		return appmgrcommon.GenerateResponse(ctx, pb.Status_IN_PROGRESS, fmt.Sprintf("operation %s is running", op.Id()), op.Proto())
+	}
+}
+
+// validateDockerImage validates the image unless the validation is disabled
+func validateDockerImage(image *pb.Spec_Image) error {
+	if !viper.GetBool(appcommon.EnvApphcAppsImageValidationEnabled) {
+		return nil
+	}
+
+	return appmgrcommon.ValidateDockerImage(image.GetRepo(), image.GetTag())
 }
@@ -19,6 +19,14 @@ type Port struct {
 	Proto  string // Protocol ("TCP" or "UDP")
 }
 
+// Autoscaling holds the horizontal pod autoscaler settings
+type Autoscaling struct {
+	MinReplicas  int // Minimum number of replicas
+	MaxReplicas  int // Maximum number of replicas
+	TargetCpu    int // Target average CPU utilization in percent
+	TargetMemory int // Target average memory utilization in percent
+}
+
 // AppInstanceData is the data belonging to appropriate application instance
 type AppInstanceData struct {
 	InstanceName          string                             // Application instance name
@@ -40,4 +48,6 @@ type AppInstanceData struct {
 	InstanceStorageSize   int                                // Instance storage size in GiB
 	SharedStorageEnabled  bool                               // Indicates whether application shared storage is enabled or not
 	DeleteInstanceStorage bool                               // Indicates whether the instance storage should be deleted
+	Replicas              int                                // Number of replicas
+	Autoscaling           *Autoscaling                       // Horizontal pod autoscaler settings (if applicable)
 }
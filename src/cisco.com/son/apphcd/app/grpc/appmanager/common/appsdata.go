@@ -0,0 +1,178 @@
+// Author  <dorzheho@cisco.com>
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	appcommon "cisco.com/son/apphcd/app/common"
+)
+
+// Application temporary data
+type AppsData struct {
+	NewAppInstancesData []*AppInstanceData
+	RunningAppsData     map[string][]*AppInstanceData
+	SampleInstance      *AppInstanceData
+}
+
+// Create temporary data structure
+func NewAppsData() *AppsData {
+	d := new(AppsData)
+	d.RunningAppsData = make(map[string][]*AppInstanceData)
+	d.NewAppInstancesData = []*AppInstanceData{}
+	return d
+}
+
+// Create temporary data for a new application instance
+func (apps *AppsData) AppendNewAppInstances(req GenericRequester, appCycle string,
+	description string, state appmanager.AppStateAfterDeployment) {
+	// Iterate over Group IDs
+	for _, gid := range req.GetGroupIds() {
+		// Create annotation map
+		a := appcommon.MakeMap()
+
+		// Create labels map
+		l := appcommon.MakeMap()
+
+		appName := req.GetName()
+
+		// Add "apphc.app.basename" annotation - required for all instances deployed by controller
+		a.Add(AppAnnotationBaseName, appName)
+
+		var truncAppName string
+		if len(appName) > AppNameStringLength {
+			truncAppName = appName[0 : AppNameStringLength-1]
+		} else {
+			truncAppName = appName
+		}
+
+		var truncAppGroupId string
+		if len(gid) > AppGroupIdLength {
+			truncAppGroupId = gid[AppGroupIdLength-1:]
+		} else {
+			truncAppGroupId = gid
+		}
+
+		var appInstanceId string
+		var kubeConvName string
+
+		if req.GetRootGroupId() == "" {
+			// Instance ID consists of the application name and GroupID
+			appInstanceId = strings.Join([]string{appName, gid}, "-")
+			//
+			kubeConvName = strings.ToLower(strings.Join([]string{truncAppName, truncAppGroupId}, "-"))
+		} else {
+			// Instance ID consists of the application name, RoodGroupID and GroupID
+			appInstanceId = strings.Join([]string{appName, req.GetRootGroupId(), gid}, "-")
+			// Add annotation "apphc.app.instance.root_group_id"
+			a.Add(AppInstanceAnnotationRootGroupId, req.GetRootGroupId())
+			// Add label "apphc.app.instance.root_group_id"
+			l.Add(AppLabelRootGroupId, req.GetRootGroupId())
+			//
+			// Root Group ID
+			rootGroupId := req.GetRootGroupId()
+			if len(rootGroupId) > AppRootGroupIdLength {
+				rootGroupId = req.GetRootGroupId()[0 : AppRootGroupIdLength-1]
+			}
+			//
+			kubeConvName = strings.ToLower(strings.Join([]string{truncAppName, rootGroupId, truncAppGroupId}, "-"))
+		}
+
+		a.Add(AppInstanceAnnotationId, appInstanceId)
+
+		kubeConvName = strings.ToLower(strings.Replace(kubeConvName, "_", "-", -1))
+
+		appInstance := &AppInstanceData{}
+		appInstance.InstanceName = kubeConvName
+		appInstance.TargetNamespace = strings.Replace(strings.ToLower(appName), "_", "-", -1)
+		appInstance.State = state
+		appInstance.TemplateAvailable = false
+		appInstance.NextAction = AppInstanceDataNextActionCreate
+		appInstance.InstanceStorageSize = -1
+
+		// In case description is empty - set default
+		if description == "" {
+			appInstance.Description = fmt.Sprintf("Application %s, instance ID %s", appName, appInstanceId)
+		} else {
+			appInstance.Description = description
+		}
+
+		appInstance.RequestedVersion = req.GetVersion()
+
+		// Since the list consists of the new app instance,
+		// Set it as not deployed
+		// Add annotation "apphc.app.cycle"
+		a.Add(AppAnnotationCycle, appCycle)
+
+		// Add annotation "apphc.app.instance.group_id"
+		a.Add(AppInstanceAnnotationGroupId, gid)
+
+		// Add annotation "apphc.app.instance.version"
+		a.Add(AppInstanceAnnotationVersion, appInstance.RequestedVersion)
+
+		// Add annotation "apphc.app.instance.id
+		a.Add(AppInstanceAnnotationId, appInstanceId)
+
+		// Add annotation "apphc.app.instance.template_name"
+		a.Add(AppInstanceAnnotationTemplateName, kubeConvName)
+
+		// Add annotations
+		appInstance.Annotations = a
+
+		// Add label "apphc.app.cycle"
+		l.Add(AppLabelCycle, appCycle)
+
+		// Add label "apphc.app.instance.group_id"
+		l.Add(AppLabelGroupId, gid)
+
+		// Add labels
+		appInstance.Labels = l
+
+		// Append the app
+		apps.NewAppInstancesData = append(apps.NewAppInstancesData, appInstance)
+	}
+}
+
+// Get list of running applications
+func (apps *AppsData) GetRunningAppsData() map[string][]*AppInstanceData {
+	return apps.RunningAppsData
+}
+
+// Get running application
+func (apps *AppsData) GetRunningAppData(appName string) []*AppInstanceData {
+	if appInstances, ok := apps.RunningAppsData[appName]; ok {
+		return appInstances
+	}
+	return nil
+}
+
+// Check whether the list containing appropriate data related to the
+// running instances is not empty
+func (apps *AppsData) RunningAppsDataNotEmpty() bool {
+	return len(apps.RunningAppsData) > 0
+}
+
+// Check whether the list containing appropriate data related to the
+// running instances is empty
+func (apps *AppsData) RunningAppsDataEmpty() bool {
+	return len(apps.RunningAppsData) == 0
+}
+
+// Check whether the list containing appropriate data related to the
+// instances that requested to be created is not empty
+func (apps *AppsData) NewAppInstancesDataNotEmpty() bool {
+	return len(apps.NewAppInstancesData) > 0
+}
+
+// Check whether the list containing appropriate data related to the
+// instances that requested to be created is empty
+func (apps *AppsData) NewAppInstancesDataEmpty() bool {
+	return len(apps.NewAppInstancesData) == 0
+}
+
+// Get number of new instances
+func (apps *AppsData) GetNumberOfNewInstances() int {
+	return len(apps.NewAppInstancesData)
+}
@@ -4,11 +4,13 @@ package chartutils
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -19,12 +21,13 @@ import (
 
 	"cisco.com/son/apphcd/api/v1/appmanager"
 	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/requestid"
 	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
 )
 
 // CreateChart creates a new helm chart for appropriate application instance
-func CreateChart(srcChart, trgtChart, chartType, appName string, data *appmgrcommon.AppInstanceData) error {
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName,
+func CreateChart(ctx context.Context, srcChart, trgtChart, chartType, appName string, data *appmgrcommon.AppInstanceData) error {
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName,
 		"version": data.RequestedVersion, "chart": trgtChart}).Info("Creating helm chart")
 
 	// Copy template for appropriate chart type
@@ -33,74 +36,104 @@ func CreateChart(srcChart, trgtChart, chartType, appName string, data *appmgrcom
 	}
 
 	// Create Chart.yaml file
-	if err := createChartYaml(trgtChart, chartType, data); err != nil {
+	if err := createChartYaml(ctx, trgtChart, chartType, data); err != nil {
 		return fmt.Errorf("cannot create Chart.yaml for application %s version %s",
 			data.InstanceName, data.Annotations.Get(appmgrcommon.AppInstanceAnnotationVersion))
 	}
 
 	// Create values.yaml file
-	if err := createValuesYaml(trgtChart, chartType, data); err != nil {
+	if err := createValuesYaml(ctx, trgtChart, chartType, data); err != nil {
 		return fmt.Errorf("cannot create Values.yaml for application %s version %s",
 			data.InstanceName, data.Annotations.Get(appmgrcommon.AppInstanceAnnotationVersion))
 	}
 
+	// Daemon applications may be scaled by the horizontal pod autoscaler
+	if chartType == appmgrcommon.TypeDaemon {
+		if err := ioutil.WriteFile(filepath.Join(trgtChart, chartTemplatesDir, autoscalerTemplateFile),
+			[]byte(autoscalerTemplate), 0644); err != nil {
+			return err
+		}
+	}
+
 	// Create configmap file
 	if len(data.AppConfigs) > 0 {
-		if err := createConfigMap(trgtChart, data); err != nil {
+		if err := createConfigMap(ctx, trgtChart, data); err != nil {
 			return err
 		}
 	}
 
 	if len(data.Secrets) > 0 {
-		if err := createSecrets(trgtChart, data); err != nil {
+		if err := createSecrets(ctx, trgtChart, data); err != nil {
 			return err
 		}
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": data.InstanceName,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": data.InstanceName,
 		"version": data.RequestedVersion, "chart": trgtChart, "status": "OK"}).Info("Creating helm chart")
 
 	return nil
 }
 
-func CopyChart(srcChart, trgtChart, instanceName, version string) error {
-	logrus.WithFields(logrus.Fields{"instance": instanceName,
+func CopyChart(ctx context.Context, srcChart, trgtChart, instanceName, version string) error {
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": instanceName,
 		"version": version, "src": srcChart, "target": trgtChart}).Info("Copying the chart")
 
 	if err := copy.Copy(srcChart, trgtChart); err != nil {
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": instanceName,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": instanceName,
 		"version": version, "src": srcChart, "target": trgtChart, "status": "OK"}).Info("Copying the chart")
 
 	return nil
 }
 
 // DeleteChart deletes appropriate application instance chart
-func DeleteChart(chartPath, instanceName, version string) error {
-	logrus.WithFields(logrus.Fields{"instance": instanceName,
+func DeleteChart(ctx context.Context, chartPath, instanceName, version string) error {
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": instanceName,
 		"version": version, "chart": chartPath}).Info("Deleting helm chart")
 
 	if err := os.RemoveAll(chartPath); err != nil {
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"instance": instanceName,
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": instanceName,
 		"version": version, "chart": chartPath, "status": "OK"}).Info("Deleting helm chart")
 
 	return nil
 }
 
 // createValuesYaml creates Values.yaml file
-func createValuesYaml(chartPath, chartType string, data *appmgrcommon.AppInstanceData) error {
-	logrus.Debug("Constructing values.yaml data")
+func createValuesYaml(ctx context.Context, chartPath, chartType string, data *appmgrcommon.AppInstanceData) error {
+	requestid.Logger(ctx).Debug("Constructing values.yaml data")
 
+	f, err := os.OpenFile(filepath.Join(chartPath, "values.yaml"), os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	defer f.Close()
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(RenderValues(chartType, data)); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx).Debug("The file values.yaml is created")
+
+	return nil
+}
+
+// RenderValues gives back the content of values.yaml file for appropriate application instance.
+// The keys of the maps are sorted, so the same data is always rendered the same way
+func RenderValues(chartType string, data *appmgrcommon.AppInstanceData) []byte {
 	var buffer bytes.Buffer
 
 	buffer.WriteString(fmt.Sprintf("namespace: %s\n", data.TargetNamespace))
 	buffer.WriteString("pullPolicy: IfNotPresent\n")
-	buffer.WriteString("replicaCount: 1\n")
+	replicas := data.Replicas
+	if replicas == 0 {
+		replicas = 1
+	}
+	buffer.WriteString(fmt.Sprintf("replicaCount: %d\n", replicas))
 	buffer.WriteString("nodeSelector: {}\n")
 	buffer.WriteString("affinity: {}\n")
 	buffer.WriteString("liveness:\n")
@@ -124,19 +157,31 @@ func createValuesYaml(chartPath, chartType string, data *appmgrcommon.AppInstanc
 
 	case appmgrcommon.TypeRunOnce:
 		buffer.WriteString("restartPolicy: OnFailure\n")
+
+	case appmgrcommon.TypeDaemon:
+		buffer.WriteString("autoscaling:\n")
+		if a := data.Autoscaling; a != nil {
+			buffer.WriteString("  enabled: true\n")
+			buffer.WriteString(fmt.Sprintf("  minReplicas: %d\n", a.MinReplicas))
+			buffer.WriteString(fmt.Sprintf("  maxReplicas: %d\n", a.MaxReplicas))
+			buffer.WriteString(fmt.Sprintf("  targetCPUUtilizationPercentage: %d\n", a.TargetCpu))
+			buffer.WriteString(fmt.Sprintf("  targetMemoryUtilizationPercentage: %d\n", a.TargetMemory))
+		} else {
+			buffer.WriteString("  enabled: false\n")
+		}
 	}
 
 	if !data.Labels.Empty() {
 		buffer.WriteString("labels:\n")
-		for k, v := range data.Labels {
-			buffer.WriteString(fmt.Sprintf(" %s: '%s'\n", k, v))
+		for _, k := range sortedKeys(data.Labels) {
+			buffer.WriteString(fmt.Sprintf(" %s: '%s'\n", k, data.Labels[k]))
 		}
 	}
 
 	if !data.Annotations.Empty() {
 		buffer.WriteString("annotations:\n")
-		for k, v := range data.Annotations {
-			buffer.WriteString(fmt.Sprintf(" %s: '%s'\n", k, v))
+		for _, k := range sortedKeys(data.Annotations) {
+			buffer.WriteString(fmt.Sprintf(" %s: '%s'\n", k, data.Annotations[k]))
 		}
 	}
 
@@ -145,8 +190,8 @@ func createValuesYaml(chartPath, chartType string, data *appmgrcommon.AppInstanc
 	buffer.WriteString(fmt.Sprintf("\n  tag: '%s'", data.Image.Tag))
 
 	buffer.WriteString("\nenv:\n")
-	for k, v := range data.EnvVars {
-		buffer.WriteString(fmt.Sprintf(" %s: '%s'\n", k, v))
+	for _, k := range sortedKeys(data.EnvVars) {
+		buffer.WriteString(fmt.Sprintf(" %s: '%s'\n", k, data.EnvVars[k]))
 	}
 
 	buffer.WriteString("service:\n")
@@ -195,24 +240,23 @@ func createValuesYaml(chartPath, chartType string, data *appmgrcommon.AppInstanc
 
 	buffer.WriteString("resources: {}\n")
 
-	f, err := os.OpenFile(filepath.Join(chartPath, "values.yaml"), os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
-	defer f.Close()
-	if err != nil {
-		return err
-	}
+	return buffer.Bytes()
+}
 
-	if _, err := f.Write(buffer.Bytes()); err != nil {
-		return err
+// sortedKeys gives back the keys of the map in ascending order
+func sortedKeys(m appcommon.Map) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
 
-	logrus.Debug("The file values.yaml is created")
-
-	return nil
+	sort.Strings(keys)
+	return keys
 }
 
 // createChartYaml creates Chart.yaml file for appropriate chart
-func createChartYaml(chartPath, chartType string, data *appmgrcommon.AppInstanceData) error {
-	logrus.Debugf("Creating chart %s metadata", data.InstanceName)
+func createChartYaml(ctx context.Context, chartPath, chartType string, data *appmgrcommon.AppInstanceData) error {
+	requestid.Logger(ctx).Debugf("Creating chart %s metadata", data.InstanceName)
 
 	if data.Description == "" {
 		data.Description = fmt.Sprintf("Application %s , version %s",
@@ -240,8 +284,8 @@ func createChartYaml(chartPath, chartType string, data *appmgrcommon.AppInstance
 }
 
 // createConfigMap creates configuration file that will be used as a key for appropriate configmap
-func createConfigMap(chartPath string, data *appmgrcommon.AppInstanceData) error {
-	logrus.Debug("Creating configmap")
+func createConfigMap(ctx context.Context, chartPath string, data *appmgrcommon.AppInstanceData) error {
+	requestid.Logger(ctx).Debug("Creating configmap")
 
 	configPath := filepath.Join(chartPath, "resources/configs")
 	if err := os.MkdirAll(configPath, 0755); err != nil {
@@ -258,8 +302,8 @@ func createConfigMap(chartPath string, data *appmgrcommon.AppInstanceData) error
 }
 
 // createSecrets creates secret files
-func createSecrets(chartPath string, data *appmgrcommon.AppInstanceData) error {
-	logrus.Debug("Creating secrets")
+func createSecrets(ctx context.Context, chartPath string, data *appmgrcommon.AppInstanceData) error {
+	requestid.Logger(ctx).Debug("Creating secrets")
 
 	secretPath := filepath.Join(chartPath, "resources/secrets")
 	if err := os.MkdirAll(secretPath, 0755); err != nil {
@@ -481,6 +525,14 @@ func SetChartData(data *appmgrcommon.AppInstanceData, req appmgrcommon.CreateUpg
 		if sched, ok := reusedValues["schedule"].(string); ok {
 			data.CyclePeriodicSched = sched
 		}
+
+		// Number of replicas of a running instance
+		if replicas, ok := reusedValues["replicaCount"].(int); ok {
+			data.Replicas = replicas
+		}
+
+		// Horizontal pod autoscaler settings of a running instance
+		data.Autoscaling = parseAutoscaling(reusedValues)
 	}
 
 	// Set filters for annotations
@@ -567,6 +619,29 @@ func SetChartData(data *appmgrcommon.AppInstanceData, req appmgrcommon.CreateUpg
 		}
 	}
 
+	// Get the number of replicas from request
+	if req.GetSpec().GetReplicas() > 0 {
+		data.Replicas = int(req.GetSpec().GetReplicas())
+	}
+
+	// Get horizontal pod autoscaler settings from request. The autoscaler is removed
+	// if the maximum number of replicas is not set
+	if a := req.GetSpec().GetAutoscaling(); a != nil {
+		data.Autoscaling = nil
+		if a.GetMaxReplicas() > 0 {
+			data.Autoscaling = &appmgrcommon.Autoscaling{
+				MinReplicas:  int(a.GetMinReplicas()),
+				MaxReplicas:  int(a.GetMaxReplicas()),
+				TargetCpu:    int(a.GetTargetCpuUtilization()),
+				TargetMemory: int(a.GetTargetMemoryUtilization()),
+			}
+
+			if data.Autoscaling.MinReplicas == 0 {
+				data.Autoscaling.MinReplicas = 1
+			}
+		}
+	}
+
 	// Get storage size from request
 	// If the field is empty , the value will be 0
 	newSize := int(req.GetSpec().GetResources().GetPersistentStorage())
@@ -0,0 +1,142 @@
+// Author  <dorzheho@cisco.com>
+
+package chartutils
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Number of unchanged lines shown around the changed ones
+const diffContext = 3
+
+// diffLine is a line of the diff
+type diffLine struct {
+	kind byte   // ' ' if the line is unchanged, '-' if removed or '+' if added
+	text string // Line content
+	from int    // Number of the original lines preceding the line
+	to   int    // Number of the new lines preceding the line
+}
+
+// Diff gives back the unified diff of the original and the new content. Empty if the content is equal
+func Diff(fromName, toName string, from, to []byte) string {
+	lines := diffLines(splitLines(from), splitLines(to))
+
+	var buffer bytes.Buffer
+	for i := 0; i < len(lines); {
+		if lines[i].kind == ' ' {
+			i++
+			continue
+		}
+
+		// Changes separated by a few unchanged lines are kept in the same hunk
+		last := i
+		for j := i; j < len(lines) && j-last <= 2*diffContext+1; j++ {
+			if lines[j].kind != ' ' {
+				last = j
+			}
+		}
+
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+
+		end := last + diffContext + 1
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		if buffer.Len() == 0 {
+			buffer.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", fromName, toName))
+		}
+
+		writeHunk(&buffer, lines[start:end])
+		i = end
+	}
+
+	return buffer.String()
+}
+
+// writeHunk writes the lines of the hunk preceded by the hunk header
+func writeHunk(buffer *bytes.Buffer, lines []diffLine) {
+	var fromCount, toCount int
+	for _, l := range lines {
+		if l.kind != '+' {
+			fromCount++
+		}
+		if l.kind != '-' {
+			toCount++
+		}
+	}
+
+	buffer.WriteString(fmt.Sprintf("@@ -%s +%s @@\n", hunkRange(lines[0].from, fromCount), hunkRange(lines[0].to, toCount)))
+	for _, l := range lines {
+		buffer.WriteByte(l.kind)
+		buffer.WriteString(l.text)
+		buffer.WriteByte('\n')
+	}
+}
+
+// hunkRange gives back the range of the hunk lines. The range of no lines starts at the line preceding the hunk
+func hunkRange(preceding, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", preceding)
+	case 1:
+		return fmt.Sprintf("%d", preceding+1)
+	default:
+		return fmt.Sprintf("%d,%d", preceding+1, count)
+	}
+}
+
+// diffLines gives back the lines of the diff based on the longest common subsequence of the lines
+func diffLines(from, to []string) []diffLine {
+	// lcs[i][j] is the length of the longest common subsequence of from[i:] and to[j:]
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		switch {
+		case i < len(from) && j < len(to) && from[i] == to[j]:
+			lines = append(lines, diffLine{kind: ' ', text: from[i], from: i, to: j})
+			i++
+			j++
+		// Removed lines go before the added ones
+		case j < len(to) && (i == len(from) || lcs[i][j+1] > lcs[i+1][j]):
+			lines = append(lines, diffLine{kind: '+', text: to[j], from: i, to: j})
+			j++
+		default:
+			lines = append(lines, diffLine{kind: '-', text: from[i], from: i, to: j})
+			i++
+		}
+	}
+
+	return lines
+}
+
+// splitLines splits the content to lines
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+}
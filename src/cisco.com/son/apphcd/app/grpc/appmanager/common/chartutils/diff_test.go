@@ -0,0 +1,40 @@
+package chartutils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	from := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+	to := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\nn\n"
+
+	expected := `--- a/values.yaml
++++ b/values.yaml
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -11,3 +11,4 @@
+ k
+ l
+ m
++n
+`
+	if diff := Diff("a/values.yaml", "b/values.yaml", []byte(from), []byte(to)); diff != expected {
+		t.Fatalf("unexpected diff:\n%s", diff)
+	}
+
+	if diff := Diff("a", "b", []byte(from), []byte(from)); diff != "" {
+		t.Fatalf("expected no diff, got:\n%s", diff)
+	}
+
+	// The whole content is added if there was nothing
+	diff := Diff("/dev/null", "b", nil, []byte("x\ny\n"))
+	if !strings.HasPrefix(diff, "--- /dev/null\n+++ b\n@@ -0,0 +1,2 @@\n+x\n+y\n") {
+		t.Fatalf("unexpected diff:\n%s", diff)
+	}
+}
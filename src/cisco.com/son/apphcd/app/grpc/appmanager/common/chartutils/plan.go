@@ -0,0 +1,103 @@
+// Author  <dorzheho@cisco.com>
+
+package chartutils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+// Changes planned for the next actions of the application instances
+var plannedActions = map[appmgrcommon.AppInstanceDataNextAction]appmanager.PlannedAction{
+	appmgrcommon.AppInstanceDataNextActionCreate:   appmanager.PlannedAction_CREATE,
+	appmgrcommon.AppInstanceDataNextActionUpgrade:  appmanager.PlannedAction_UPGRADE,
+	appmgrcommon.AppInstanceDataNextActionRecreate: appmanager.PlannedAction_RECREATE,
+	appmgrcommon.AppInstanceDataNextActionDelete:   appmanager.PlannedAction_DELETE,
+	appmgrcommon.AppInstanceDataNextActionNone:     appmanager.PlannedAction_NONE,
+}
+
+// NewInstancePlan gives back the plan of the application instance deployed with the values.
+// The values are compared with the last good values of the instance. Nil last good values
+// stand for the instance that has never been deployed
+func NewInstancePlan(data *appmgrcommon.AppInstanceData, values, lastGoodValues []byte) *appmanager.InstancePlan {
+	plan := &appmanager.InstancePlan{
+		Name:           data.InstanceName,
+		Action:         plannedActions[data.NextAction],
+		CurrentVersion: data.CurrentVersion,
+		Version:        data.RequestedVersion,
+		RootGroupId:    data.Annotations.Get(appmgrcommon.AppInstanceAnnotationRootGroupId),
+		GroupId:        data.Annotations.Get(appmgrcommon.AppInstanceAnnotationGroupId),
+	}
+
+	if plan.Action == appmanager.PlannedAction_NONE {
+		return plan
+	}
+
+	templateName := data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName)
+
+	fromName := "/dev/null"
+	if lastGoodValues != nil {
+		fromName = path.Join("a", templateName, data.CurrentVersion, "values.yaml")
+	}
+
+	plan.Values = string(values)
+	plan.Diff = Diff(fromName, path.Join("b", templateName, data.RequestedVersion, "values.yaml"), lastGoodValues, values)
+
+	return plan
+}
+
+// PlanInstance gives back the plan of the application instance which charts are kept in the application
+// charts directory. The values are rendered from the instance data unless the chart is taken from the catalog
+func PlanInstance(data *appmgrcommon.AppInstanceData, chartType, appChartDir string, fromCatalog bool) (*appmanager.InstancePlan, error) {
+	// The instance left as it is has nothing to render
+	if data.NextAction == appmgrcommon.AppInstanceDataNextActionNone {
+		return NewInstancePlan(data, nil, nil), nil
+	}
+
+	templateDir := filepath.Join(appChartDir, data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName))
+
+	var values []byte
+	if fromCatalog {
+		v, err := readValues(filepath.Join(templateDir, data.RequestedVersion))
+		if err != nil {
+			return nil, err
+		}
+
+		if v == nil {
+			return nil, fmt.Errorf("version %s for template %s is invalid", data.RequestedVersion,
+				data.Annotations.Get(appmgrcommon.AppInstanceAnnotationTemplateName))
+		}
+
+		values = v
+	} else {
+		values = RenderValues(chartType, data)
+	}
+
+	var lastGoodValues []byte
+	if data.CurrentVersion != "" {
+		v, err := readValues(filepath.Join(templateDir, data.CurrentVersion))
+		if err != nil {
+			return nil, err
+		}
+
+		lastGoodValues = v
+	}
+
+	return NewInstancePlan(data, values, lastGoodValues), nil
+}
+
+// readValues reads values.yaml file of the chart. Nil if the chart doesn't exist
+func readValues(chartPath string) ([]byte, error) {
+	values, err := ioutil.ReadFile(filepath.Join(chartPath, "values.yaml"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	return values, err
+}
@@ -0,0 +1,321 @@
+// Author  <dorzheho@cisco.com>
+
+package chartutils
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	"cisco.com/son/apphcd/app/common/requestid"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	chartTemplatesDir = "templates"
+	chartNotesFile    = "NOTES.txt"
+)
+
+// Release holds the release information available to the chart templates as .Release
+type Release struct {
+	Name      string // Release name (application instance name)
+	Namespace string // Target namespace
+	Service   string // Name of the service that renders the chart
+}
+
+// chart holds the chart metadata available to the chart templates as .Chart
+type chart struct {
+	Name        string `yaml:"name"`
+	Version     string `yaml:"version"`
+	Description string `yaml:"description"`
+}
+
+// Files holds the non-template files of a chart available to the chart templates as .Files
+type Files map[string][]byte
+
+// Glob gives back the files which names match appropriate pattern
+func (f Files) Glob(pattern string) Files {
+	matched := Files{}
+	for name, content := range f {
+		if ok, _ := path.Match(pattern, name); ok {
+			matched[name] = content
+		}
+	}
+
+	return matched
+}
+
+// AsConfig gives back the files as a YAML map suitable for the ConfigMap data
+func (f Files) AsConfig() string {
+	m := make(map[string]string)
+	for name, content := range f {
+		m[path.Base(name)] = string(content)
+	}
+
+	return toYaml(m)
+}
+
+// AsSecrets gives back the files as a YAML map with base64 encoded values suitable for the Secret data
+func (f Files) AsSecrets() string {
+	m := make(map[string]string)
+	for name, content := range f {
+		m[path.Base(name)] = base64.StdEncoding.EncodeToString(content)
+	}
+
+	return toYaml(m)
+}
+
+// RenderChart renders templates of appropriate chart in-process and gives back
+// the manifests keyed by the template name. Templates rendered to an empty document are skipped
+func RenderChart(ctx context.Context, chartPath string, release Release) (map[string]string, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{"chart": chartPath, "release": release.Name}).Debug("Rendering helm chart")
+
+	// Read chart metadata
+	c := &chart{}
+	content, err := ioutil.ReadFile(filepath.Join(chartPath, "Chart.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(content, c); err != nil {
+		return nil, fmt.Errorf("cannot parse Chart.yaml of the chart %s: %s", chartPath, err.Error())
+	}
+
+	// Read chart values
+	values, err := ParseLastGoodConfig(filepath.Join(chartPath, "values.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the files that are not templates
+	files, err := loadChartFiles(chartPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse all the templates including partials
+	t := template.New(filepath.Base(chartPath))
+	t.Funcs(chartFuncMap(t)).Option("missingkey=zero")
+
+	templatesPath := filepath.Join(chartPath, chartTemplatesDir)
+	entries, err := ioutil.ReadDir(templatesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || e.Name() == chartNotesFile {
+			continue
+		}
+
+		body, err := ioutil.ReadFile(filepath.Join(templatesPath, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Join(chartTemplatesDir, e.Name())
+		if _, err := t.New(name).Parse(string(body)); err != nil {
+			return nil, fmt.Errorf("cannot parse template %s: %s", name, err.Error())
+		}
+
+		// Partials are not rendered on their own
+		if !strings.HasPrefix(e.Name(), "_") {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	data := map[string]interface{}{
+		"Values":  normalizeValues(values),
+		"Chart":   c,
+		"Release": release,
+		"Files":   files,
+	}
+
+	manifests := make(map[string]string)
+	for _, name := range names {
+		var buf bytes.Buffer
+		if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+			return nil, fmt.Errorf("cannot render template %s: %s", name, err.Error())
+		}
+
+		m := strings.Replace(buf.String(), "<no value>", "", -1)
+		if strings.TrimSpace(m) == "" {
+			continue
+		}
+
+		manifests[name] = m
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"chart": chartPath, "release": release.Name,
+		"status": "OK"}).Debug("Rendering helm chart")
+
+	return manifests, nil
+}
+
+// loadChartFiles reads all the chart files that are not templates
+func loadChartFiles(chartPath string) (Files, error) {
+	files := Files{}
+	err := filepath.Walk(chartPath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(chartPath, p)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if rel == chartTemplatesDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(rel)] = content
+		return nil
+	})
+
+	return files, err
+}
+
+// chartFuncMap gives back the subset of the Helm template functions used by the application charts
+func chartFuncMap(t *template.Template) template.FuncMap {
+	return template.FuncMap{
+		"include": func(name string, data interface{}) (string, error) {
+			var buf bytes.Buffer
+			if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+				return "", err
+			}
+			return buf.String(), nil
+		},
+		"tpl": func(text string, data interface{}) (string, error) {
+			c, err := t.Clone()
+			if err != nil {
+				return "", err
+			}
+			tt, err := c.New("tpl").Parse(text)
+			if err != nil {
+				return "", err
+			}
+			var buf bytes.Buffer
+			if err := tt.Execute(&buf, data); err != nil {
+				return "", err
+			}
+			return buf.String(), nil
+		},
+		"default": func(d interface{}, given ...interface{}) interface{} {
+			if len(given) == 0 || empty(given[0]) {
+				return d
+			}
+			return given[0]
+		},
+		"trunc": func(c int, s string) string {
+			if len(s) <= c {
+				return s
+			}
+			return s[0:c]
+		},
+		"trimSuffix": func(suffix, s string) string {
+			return strings.TrimSuffix(s, suffix)
+		},
+		"replace": func(old, new, s string) string {
+			return strings.Replace(s, old, new, -1)
+		},
+		"quote": func(v ...interface{}) string {
+			var out []string
+			for _, s := range v {
+				if s != nil {
+					out = append(out, fmt.Sprintf("%q", fmt.Sprint(s)))
+				}
+			}
+			return strings.Join(out, " ")
+		},
+		"indent": func(spaces int, s string) string {
+			pad := strings.Repeat(" ", spaces)
+			return pad + strings.Replace(s, "\n", "\n"+pad, -1)
+		},
+		"toYaml": toYaml,
+		"b64enc": func(s string) string {
+			return base64.StdEncoding.EncodeToString([]byte(s))
+		},
+	}
+}
+
+// toYaml marshals a value to YAML without the trailing new line
+func toYaml(v interface{}) string {
+	out, err := yaml.Marshal(v)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(string(out), "\n")
+}
+
+// empty tells whether the value is considered empty by the templates
+func empty(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	case reflect.Bool:
+		return !rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return rv.Float() == 0
+	case reflect.Ptr, reflect.Interface:
+		return rv.IsNil()
+	}
+
+	return false
+}
+
+// normalizeValues converts the maps parsed from YAML to the maps keyed by strings
+func normalizeValues(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			m[fmt.Sprint(k)] = normalizeValues(val)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			m[k] = normalizeValues(val)
+		}
+		return m
+	case []interface{}:
+		for i, val := range t {
+			t[i] = normalizeValues(val)
+		}
+		return t
+	}
+
+	return v
+}
@@ -0,0 +1,65 @@
+package chartutils
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderChart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"Chart.yaml":           "name: foo-g1\nversion: 1.0.0\n",
+		"values.yaml":          "replicaCount: 1\nconfigs:\n  enabled: true\nlabels:\n  a: 'b'\n",
+		"configs/app.conf":     "x: 1\n",
+		"templates/_name.tpl":  `{{- define "fullname" -}}{{ .Chart.Name | trunc 63 | trimSuffix "-" }}{{- end -}}`,
+		"templates/NOTES.txt":  "{{ .Values.notDefined.field }}",
+		"templates/empty.yaml": "{{- if .Values.ingress }}kind: Ingress{{ end }}",
+		"templates/cm.yaml": `kind: ConfigMap
+metadata:
+  name: {{ include "fullname" . }}-configmap
+  namespace: {{ .Release.Namespace }}
+  labels:
+{{ toYaml .Values.labels | indent 4 }}
+data:
+{{- if .Values.configs.enabled }}
+{{ (.Files.Glob "configs/*").AsConfig | indent 2 }}
+{{- end }}
+  missing: {{ .Values.missing | default "none" | quote }}
+`,
+	}
+
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m, err := RenderChart(context.Background(), dir, Release{Name: "foo-g1", Namespace: "foo", Service: "apphc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(m) != 1 {
+		t.Fatalf("expected a single manifest, got %d", len(m))
+	}
+
+	cm := m["templates/cm.yaml"]
+	for _, s := range []string{"name: foo-g1-configmap", "namespace: foo", "    a: b", "  app.conf: |", `missing: "none"`} {
+		if !strings.Contains(cm, s) {
+			t.Fatalf("manifest doesn't contain %q:\n%s", s, cm)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+// Author  <dorzheho@cisco.com>
+
+package chartutils
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"gopkg.in/yaml.v2"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	"cisco.com/son/apphcd/app/grpc/common/syncer"
+)
+
+const (
+	// Directory of the chart holding the application configuration files
+	chartConfigsDir = "resources/configs/"
+	// Prefix of the environment variables in values.yaml
+	valuesEnvPrefix = "env."
+	// Prefix of the image values in values.yaml
+	valuesImagePrefix = "image."
+)
+
+// ListRevisions gives back the revisions of the application charts kept in the applications repository,
+// the latest first. Every chart changed by a commit of the repository is a revision
+func ListRevisions(ctx context.Context, repoPath string, req *appmanager.ListAppRevisionsRequest) ([]*appmanager.AppRevision, error) {
+	var revisions []*appmanager.AppRevision
+
+	// Charts are kept in <application>/<template>/<version>
+	err := syncer.Walk(ctx, repoPath, "", req.Name, 2, func(c *syncer.Commit) (bool, error) {
+		matched, err := commitRevisions(c, req.Template, req.Version)
+		if err != nil {
+			return false, err
+		}
+
+		for _, r := range matched {
+			revisions = append(revisions, r)
+			if req.Limit > 0 && len(revisions) == int(req.Limit) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// commitRevisions gives back the revisions of the charts changed by the commit. Empty template or version matches any
+func commitRevisions(c *syncer.Commit, template, version string) ([]*appmanager.AppRevision, error) {
+	timestamp, err := ptypes.TimestampProto(c.When)
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []*appmanager.AppRevision
+	for _, dir := range c.Dirs {
+		parts := strings.Split(dir, "/")
+		if template != "" && template != parts[0] || version != "" && version != parts[1] {
+			continue
+		}
+
+		revisions = append(revisions, &appmanager.AppRevision{
+			Revision:    c.Hash,
+			Timestamp:   timestamp,
+			Description: c.Message,
+			Template:    parts[0],
+			Version:     parts[1],
+		})
+	}
+
+	return revisions, nil
+}
+
+// GetRevision gives back the revision of the application chart and the changes between the chart and the one
+// of the base revision. Nil if the revision didn't change any chart of the application.
+// The history is walked back from the revision only as far as the previous revision of the chart
+func GetRevision(ctx context.Context, repoPath string, req *appmanager.GetAppRevisionRequest) (*appmanager.AppRevisionDiff, error) {
+	diff := &appmanager.AppRevisionDiff{}
+
+	err := syncer.Walk(ctx, repoPath, req.Revision, req.Name, 2, func(c *syncer.Commit) (bool, error) {
+		if diff.Revision == nil {
+			// The revision itself comes first unless it didn't change the application
+			if !strings.HasPrefix(c.Hash, req.Revision) {
+				return false, nil
+			}
+
+			revisions, err := commitRevisions(c, req.Template, req.Version)
+			if err != nil {
+				return false, err
+			}
+
+			switch len(revisions) {
+			case 0:
+				return false, nil
+			case 1:
+				diff.Revision = revisions[0]
+			default:
+				return false, fmt.Errorf("revision %s matches several charts of application %s, the template and the version are required",
+					req.Revision, req.Name)
+			}
+
+			// The previous revision of the template is the base one unless requested
+			return req.BaseRevision == "", nil
+		}
+
+		revisions, err := commitRevisions(c, diff.Revision.Template, "")
+		if err != nil || len(revisions) == 0 {
+			return err == nil, err
+		}
+
+		diff.Base = revisions[0]
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if diff.Revision == nil {
+		return nil, nil
+	}
+
+	_, files, err := syncer.Files(ctx, repoPath, diff.Revision.Revision, path.Join(req.Name, diff.Revision.Template, diff.Revision.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	var baseFiles map[string][]byte
+	if req.BaseRevision != "" {
+		version := req.BaseVersion
+		if version == "" {
+			version = diff.Revision.Version
+		}
+
+		dir := path.Join(req.Name, diff.Revision.Template, version)
+		c, f, err := syncer.Files(ctx, repoPath, req.BaseRevision, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		if f == nil {
+			return nil, fmt.Errorf("cannot find %s at revision %s", dir, req.BaseRevision)
+		}
+
+		timestamp, err := ptypes.TimestampProto(c.When)
+		if err != nil {
+			return nil, err
+		}
+
+		diff.Base = &appmanager.AppRevision{Revision: c.Hash, Timestamp: timestamp, Description: c.Message,
+			Template: diff.Revision.Template, Version: version}
+		baseFiles = f
+	} else if diff.Base != nil {
+		if _, baseFiles, err = syncer.Files(ctx, repoPath, diff.Base.Revision,
+			path.Join(req.Name, diff.Base.Template, diff.Base.Version)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := diffCharts(diff, baseFiles, files); err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+// diffCharts sets the changes between the files of the charts
+func diffCharts(diff *appmanager.AppRevisionDiff, from, to map[string][]byte) error {
+	fromValues, err := flatValues(from["values.yaml"])
+	if err != nil {
+		return err
+	}
+
+	toValues, err := flatValues(to["values.yaml"])
+	if err != nil {
+		return err
+	}
+
+	// Values are split to the image, the environment variables and the rest
+	fromEnv, toEnv := make(map[string]string), make(map[string]string)
+	fromOther, toOther := make(map[string]string), make(map[string]string)
+	for _, s := range []struct {
+		values, env, other map[string]string
+	}{{fromValues, fromEnv, fromOther}, {toValues, toEnv, toOther}} {
+		for k, v := range s.values {
+			switch {
+			case strings.HasPrefix(k, valuesEnvPrefix):
+				s.env[strings.TrimPrefix(k, valuesEnvPrefix)] = v
+			case strings.HasPrefix(k, valuesImagePrefix) || k == "env" || k == "image":
+				// The image is compared as a whole
+			default:
+				s.other[k] = v
+			}
+		}
+	}
+
+	if changes := valueChanges(map[string]string{"image": image(fromValues)}, map[string]string{"image": image(toValues)}); len(changes) > 0 {
+		diff.Image = changes[0]
+	}
+
+	diff.EnvVars = valueChanges(fromEnv, toEnv)
+	diff.Values = valueChanges(fromOther, toOther)
+
+	// Only the keys of the configuration files are shown
+	diff.Configs = valueChanges(configs(from), configs(to))
+	for _, c := range diff.Configs {
+		c.From, c.To = "", ""
+	}
+
+	return nil
+}
+
+// flatValues parses values.yaml to the values by their dotted keys
+func flatValues(content []byte) (map[string]string, error) {
+	m := make(map[interface{}]interface{})
+	if err := yaml.Unmarshal(content, &m); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	flatten("", m, values)
+	return values, nil
+}
+
+// flatten adds the value to the values by its dotted key. Maps and lists are added item by item
+func flatten(key string, value interface{}, values map[string]string) {
+	join := func(k interface{}) string {
+		if key == "" {
+			return fmt.Sprint(k)
+		}
+		return fmt.Sprintf("%s.%v", key, k)
+	}
+
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		for k, item := range v {
+			flatten(join(k), item, values)
+		}
+	case []interface{}:
+		for i, item := range v {
+			flatten(join(i), item, values)
+		}
+	case nil:
+		values[key] = ""
+	default:
+		values[key] = fmt.Sprint(v)
+	}
+}
+
+// image gives back the image of the values as <repository>:<tag>. Empty if there is no image
+func image(values map[string]string) string {
+	repository, tag := values[valuesImagePrefix+"repository"], values[valuesImagePrefix+"tag"]
+	if repository == "" {
+		return ""
+	}
+
+	return repository + ":" + tag
+}
+
+// configs gives back the content of the application configuration files of the chart by their names
+func configs(files map[string][]byte) map[string]string {
+	c := make(map[string]string)
+	for name, content := range files {
+		if strings.HasPrefix(name, chartConfigsDir) {
+			c[strings.TrimPrefix(name, chartConfigsDir)] = string(content)
+		}
+	}
+
+	return c
+}
+
+// valueChanges gives back the changes between the values sorted by key. Empty values stand for missing ones
+func valueChanges(from, to map[string]string) []*appmanager.ValueChange {
+	keys := make(map[string]bool)
+	for k := range from {
+		keys[k] = true
+	}
+	for k := range to {
+		keys[k] = true
+	}
+
+	var sorted []string
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+
+	sort.Strings(sorted)
+
+	var changes []*appmanager.ValueChange
+	for _, k := range sorted {
+		f, inFrom := from[k]
+		t, inTo := to[k]
+
+		switch {
+		case !inFrom || inTo && f == "" && t != "":
+			changes = append(changes, &appmanager.ValueChange{Key: k, Type: appmanager.ValueChangeType_VALUE_ADDED, To: t})
+		case !inTo || t == "" && f != "":
+			changes = append(changes, &appmanager.ValueChange{Key: k, Type: appmanager.ValueChangeType_VALUE_REMOVED, From: f})
+		case f != t:
+			changes = append(changes, &appmanager.ValueChange{Key: k, Type: appmanager.ValueChangeType_VALUE_MODIFIED, From: f, To: t})
+		}
+	}
+
+	return changes
+}
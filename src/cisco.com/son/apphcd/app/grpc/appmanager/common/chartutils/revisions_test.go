@@ -0,0 +1,133 @@
+package chartutils
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+)
+
+func commitChart(t *testing.T, w *git.Worktree, when time.Time, description, chartDir string, files map[string]string) string {
+	for name, content := range files {
+		path := filepath.Join(w.Filesystem.Root(), chartDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.AddGlob("."); err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := w.Commit(description, &git.CommitOptions{
+		All:    true,
+		Author: &object.Signature{Name: "catalog", Email: "catalog@cisco.com", When: when},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return h.String()
+}
+
+func TestRevisions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "revisions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	first := commitChart(t, w, now.Add(-2*time.Minute), "create", "foo/foo-g1/1.0", map[string]string{
+		"values.yaml":              "replicaCount: 1\nimage: \n  repository: foo/bar\n  tag: '1'\nenv:\n A: 'a'\n B: 'b'\n",
+		"resources/configs/a.conf": "x: 1\n",
+	})
+	commitChart(t, w, now.Add(-time.Minute), "other", "bar/bar-g1/1.0", map[string]string{"values.yaml": "replicaCount: 1\n"})
+	second := commitChart(t, w, now, "upgrade", "foo/foo-g1/1.1", map[string]string{
+		"values.yaml":              "replicaCount: 2\nimage: \n  repository: foo/bar\n  tag: '2'\nenv:\n A: 'c'\n C: 'c'\n",
+		"resources/configs/b.conf": "y: 1\n",
+	})
+
+	revisions, err := ListRevisions(context.Background(), dir, &appmanager.ListAppRevisionsRequest{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(revisions) != 2 || revisions[0].Revision != second || revisions[0].Version != "1.1" ||
+		revisions[0].Description != "upgrade" || revisions[1].Revision != first || revisions[1].Template != "foo-g1" {
+		t.Fatalf("unexpected revisions: %v", revisions)
+	}
+
+	// The walk stops at the limit
+	if revisions, err = ListRevisions(context.Background(), dir, &appmanager.ListAppRevisionsRequest{Name: "foo", Limit: 1}); err != nil ||
+		len(revisions) != 1 || revisions[0].Revision != second {
+		t.Fatalf("unexpected revisions %v: %v", revisions, err)
+	}
+
+	diff, err := GetRevision(context.Background(), dir, &appmanager.GetAppRevisionRequest{Name: "foo", Revision: second[:8]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff.Revision.Revision != second || diff.Base.Revision != first || diff.Base.Version != "1.0" {
+		t.Fatalf("unexpected revisions: %v", diff)
+	}
+
+	if diff.Image.From != "foo/bar:1" || diff.Image.To != "foo/bar:2" {
+		t.Fatalf("unexpected image change: %v", diff.Image)
+	}
+
+	expected := []*appmanager.ValueChange{
+		{Key: "A", Type: appmanager.ValueChangeType_VALUE_MODIFIED, From: "a", To: "c"},
+		{Key: "B", Type: appmanager.ValueChangeType_VALUE_REMOVED, From: "b"},
+		{Key: "C", Type: appmanager.ValueChangeType_VALUE_ADDED, To: "c"},
+	}
+	if len(diff.EnvVars) != len(expected) {
+		t.Fatalf("unexpected environment variables changes: %v", diff.EnvVars)
+	}
+	for i, c := range diff.EnvVars {
+		if !proto.Equal(c, expected[i]) {
+			t.Fatalf("unexpected environment variable change: %v", c)
+		}
+	}
+
+	if len(diff.Configs) != 2 || diff.Configs[0].Key != "a.conf" || diff.Configs[0].Type != appmanager.ValueChangeType_VALUE_REMOVED ||
+		diff.Configs[1].Key != "b.conf" || diff.Configs[1].Type != appmanager.ValueChangeType_VALUE_ADDED {
+		t.Fatalf("unexpected configs changes: %v", diff.Configs)
+	}
+
+	if len(diff.Values) != 1 || diff.Values[0].Key != "replicaCount" || diff.Values[0].From != "1" || diff.Values[0].To != "2" {
+		t.Fatalf("unexpected values changes: %v", diff.Values)
+	}
+
+	// The chart created by the revision has no base
+	if diff, err = GetRevision(context.Background(), dir, &appmanager.GetAppRevisionRequest{Name: "foo", Revision: first}); err != nil || diff.Base != nil {
+		t.Fatalf("unexpected base revision %v: %v", diff, err)
+	}
+
+	// The revision didn't change the application
+	if diff, err = GetRevision(context.Background(), dir, &appmanager.GetAppRevisionRequest{Name: "bar", Revision: first}); err != nil || diff != nil {
+		t.Fatalf("expected no revision, got %v: %v", diff, err)
+	}
+}
@@ -0,0 +1,122 @@
+// Author  <dorzheho@cisco.com>
+
+package chartutils
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+
+	"cisco.com/son/apphcd/app/common/requestid"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+const (
+	// Template of the horizontal pod autoscaler added to the charts of daemon applications
+	autoscalerTemplateFile = "apphc-autoscaler.yaml"
+	autoscalerTemplate     = `{{- if .Values.autoscaling.enabled }}
+apiVersion: autoscaling/v2beta1
+kind: HorizontalPodAutoscaler
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: {{ .Release.Name }}
+  minReplicas: {{ .Values.autoscaling.minReplicas }}
+  maxReplicas: {{ .Values.autoscaling.maxReplicas }}
+  metrics:
+{{- if .Values.autoscaling.targetCPUUtilizationPercentage }}
+  - type: Resource
+    resource:
+      name: cpu
+      targetAverageUtilization: {{ .Values.autoscaling.targetCPUUtilizationPercentage }}
+{{- end }}
+{{- if .Values.autoscaling.targetMemoryUtilizationPercentage }}
+  - type: Resource
+    resource:
+      name: memory
+      targetAverageUtilization: {{ .Values.autoscaling.targetMemoryUtilizationPercentage }}
+{{- end }}
+{{- end }}
+`
+)
+
+// replicaCountRegexp matches the number of replicas in values.yaml
+var replicaCountRegexp = regexp.MustCompile(`(?m)^replicaCount:.*$`)
+
+// SetReplicas sets the number of replicas in the chart of appropriate application instance.
+// Charts of the instances scaled by the horizontal pod autoscaler are not changed
+func SetReplicas(ctx context.Context, chartPath, instanceName string, replicas int) error {
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": instanceName, "replicas": replicas,
+		"chart": chartPath}).Info("Setting number of replicas")
+
+	autoscaled, err := Autoscaled(chartPath)
+	if err != nil {
+		return err
+	}
+
+	if autoscaled {
+		return fmt.Errorf("application instance %s is scaled by the horizontal pod autoscaler", instanceName)
+	}
+
+	valuesFile := filepath.Join(chartPath, "values.yaml")
+
+	content, err := ioutil.ReadFile(valuesFile)
+	if err != nil {
+		return err
+	}
+
+	line := fmt.Sprintf("replicaCount: %d", replicas)
+	if replicaCountRegexp.Match(content) {
+		content = replicaCountRegexp.ReplaceAll(content, []byte(line))
+	} else {
+		content = append(content, []byte(line+"\n")...)
+	}
+
+	if err := ioutil.WriteFile(valuesFile, content, 0644); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"instance": instanceName, "replicas": replicas,
+		"chart": chartPath, "status": "OK"}).Info("Setting number of replicas")
+
+	return nil
+}
+
+// Autoscaled tells whether the horizontal pod autoscaler is enabled in appropriate chart
+func Autoscaled(chartPath string) (bool, error) {
+	values, err := ParseLastGoodConfig(filepath.Join(chartPath, "values.yaml"))
+	if err != nil {
+		return false, err
+	}
+
+	return parseAutoscaling(values) != nil, nil
+}
+
+// parseAutoscaling gives back the horizontal pod autoscaler settings of appropriate values
+// or nil if the autoscaler is disabled
+func parseAutoscaling(values map[string]interface{}) *appmgrcommon.Autoscaling {
+	a, ok := values["autoscaling"].(map[interface{}]interface{})
+	if !ok || a["enabled"] != true {
+		return nil
+	}
+
+	get := func(key string) int {
+		v, _ := a[key].(int)
+		return v
+	}
+
+	return &appmgrcommon.Autoscaling{
+		MinReplicas:  get("minReplicas"),
+		MaxReplicas:  get("maxReplicas"),
+		TargetCpu:    get("targetCPUUtilizationPercentage"),
+		TargetMemory: get("targetMemoryUtilizationPercentage"),
+	}
+}
@@ -0,0 +1,97 @@
+package chartutils
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeScaleChart(t *testing.T, dir, values string) {
+	files := map[string]string{
+		"Chart.yaml":  "name: foo-g1\nversion: 1.0.0\n",
+		"values.yaml": values,
+		filepath.Join("templates", autoscalerTemplateFile): autoscalerTemplate,
+	}
+
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSetReplicas(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeScaleChart(t, dir, "replicaCount: 1\nautoscaling:\n  enabled: false\n")
+
+	if err := SetReplicas(context.Background(), dir, "foo-g1", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := ParseLastGoodConfig(filepath.Join(dir, "values.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if values["replicaCount"] != 3 {
+		t.Fatalf("unexpected replicaCount %v", values["replicaCount"])
+	}
+
+	// The autoscaler is not rendered unless enabled
+	m, err := RenderChart(context.Background(), dir, Release{Name: "foo-g1", Namespace: "foo", Service: "apphc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(m) != 0 {
+		t.Fatalf("unexpected manifests %v", m)
+	}
+}
+
+func TestSetReplicasAutoscaled(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeScaleChart(t, dir, "replicaCount: 2\nautoscaling:\n  enabled: true\n  minReplicas: 2\n  maxReplicas: 5\n"+
+		"  targetCPUUtilizationPercentage: 80\n")
+
+	if autoscaled, err := Autoscaled(dir); err != nil || !autoscaled {
+		t.Fatalf("expected the chart to be autoscaled: %v", err)
+	}
+
+	if err := SetReplicas(context.Background(), dir, "foo-g1", 3); err == nil {
+		t.Fatal("expected error for the chart scaled by the autoscaler")
+	}
+
+	m, err := RenderChart(context.Background(), dir, Release{Name: "foo-g1", Namespace: "foo", Service: "apphc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hpa := m[filepath.Join("templates", autoscalerTemplateFile)]
+	for _, s := range []string{"kind: HorizontalPodAutoscaler", "name: foo-g1", "minReplicas: 2", "maxReplicas: 5",
+		"name: cpu", "targetAverageUtilization: 80"} {
+		if !strings.Contains(hpa, s) {
+			t.Fatalf("expected %q in:\n%s", s, hpa)
+		}
+	}
+
+	if strings.Contains(hpa, "name: memory") {
+		t.Fatalf("unexpected memory metric in:\n%s", hpa)
+	}
+}
@@ -4,6 +4,7 @@ package common
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"strconv"
@@ -18,6 +19,8 @@ import (
 
 	"cisco.com/son/apphcd/api/v1/appmanager"
 	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/config"
+	"cisco.com/son/apphcd/app/common/requestid"
 )
 
 // GetSubDirs returns a content of root directory
@@ -80,7 +83,7 @@ func AppCycleToAppType(appCycle string) (string, error) {
 }
 
 // GenerateResponse creates appropriate response
-func GenerateResponse(statusCode appmanager.Status, msg string, m proto.Message) (*appmanager.Response, error) {
+func GenerateResponse(ctx context.Context, statusCode appmanager.Status, msg string, m proto.Message) (*appmanager.Response, error) {
 	var err error
 	resp := new(appmanager.Response)
 
@@ -101,15 +104,27 @@ func GenerateResponse(statusCode appmanager.Status, msg string, m proto.Message)
 
 	// If the protobuf message is nil we assume that an error occurred hence the error message is printed
 	if statusCode == appmanager.Status_ERROR {
-		logrus.Error(msg)
+		requestid.Logger(ctx).Error(msg)
 	}
 
-	logrus.WithFields(logrus.Fields{"service": "AppManager", "type": "grpc", "status": statusCode}).Info("Sending response")
-	logrus.Debugf("Response message: %q", resp.String())
+	requestid.Logger(ctx).WithFields(logrus.Fields{"service": "AppManager", "type": "grpc", "status": statusCode}).Info("Sending response")
+	requestid.Logger(ctx).Debugf("Response message: %q", resp.String())
 
 	return resp, nil
 }
 
+// GeneratePlanResponse creates the response to the dry run request.
+// The status is UNCHANGED if none of the application instances would be changed
+func GeneratePlanResponse(ctx context.Context, plan *appmanager.Plan) (*appmanager.Response, error) {
+	for _, instance := range plan.Instances {
+		if instance.Action != appmanager.PlannedAction_NONE {
+			return GenerateResponse(ctx, appmanager.Status_SUCCESS, "Dry run, nothing was changed", plan)
+		}
+	}
+
+	return GenerateResponse(ctx, appmanager.Status_UNCHANGED, fmt.Sprintf("Dry run, application %s would not be changed", plan.Name), plan)
+}
+
 // ValidateDockerImage validates the following:
 // - Access to the Docker repository
 // - Tag availability
@@ -189,13 +204,13 @@ func GetAppMonitorUrl(endpoint, appName, appCycle string) (string, error) {
 	var tmplt string
 	switch appCycle {
 	case TypeDaemon:
-		tmplt = viper.GetString(TemplatesUrlMonitorAppsDaemon)
+		tmplt = config.Current().MonitorTemplate(TemplatesUrlMonitorAppsDaemon)
 
 	case TypePeriodic:
-		tmplt = viper.GetString(TemplatesUrlMonitorAppsPeriodic)
+		tmplt = config.Current().MonitorTemplate(TemplatesUrlMonitorAppsPeriodic)
 
 	case TypeRunOnce:
-		tmplt = viper.GetString(TemplatesUrlMonitorAppsRunonce)
+		tmplt = config.Current().MonitorTemplate(TemplatesUrlMonitorAppsRunonce)
 	}
 
 	t, err := template.New("app").Parse(tmplt)
@@ -223,6 +238,29 @@ func Validate(requester CreateUpgradeUpdateRequester) error {
 	return nil
 }
 
+// ValidateAutoscaling checks the horizontal pod autoscaler settings of appropriate application type
+func ValidateAutoscaling(cycle string, autoscaling *appmanager.Spec_Autoscaling) error {
+	// The autoscaler is removed if the maximum number of replicas is not set
+	if autoscaling.GetMaxReplicas() == 0 {
+		return nil
+	}
+
+	// An empty cycle keeps the cycle of the application being updated
+	if cycle != "" && cycle != TypeDaemon {
+		return fmt.Errorf("autoscaling is supported by daemon applications only")
+	}
+
+	if autoscaling.GetMinReplicas() > autoscaling.GetMaxReplicas() {
+		return fmt.Errorf("max_replicas must be greater than or equal to min_replicas")
+	}
+
+	if autoscaling.GetTargetCpuUtilization() == 0 && autoscaling.GetTargetMemoryUtilization() == 0 {
+		return fmt.Errorf("autoscaling requires target_cpu_utilization or target_memory_utilization")
+	}
+
+	return nil
+}
+
 // PeriodicToCronString converts Periodic properties to Kubernetes property
 func PeriodicToCronString(req CreateUpgradeUpdateRequester) string {
 	var weekDays []string
@@ -71,6 +71,10 @@ const (
 	DraftAppLabelBasename = "apphc.draft.app.basename" // Required for Draft APPH plugin
 	MonAppLabelBasename   = "apphc.mon.app_basename"   // Required by APPH Prometheus design
 
+	// Pod labels added by the chart templates. Both are set to the application instance name
+	PodLabelApp     = "app"
+	PodLabelRelease = "release"
+
 	TemplatesUrlMonitorAppsDaemon   = "templates.url.monitor.apph.apps.daemon"
 	TemplatesUrlMonitorAppsPeriodic = "templates.url.monitor.apph.apps.periodic"
 	TemplatesUrlMonitorAppsRunonce  = "templates.url.monitor.apph.apps.runonce"
@@ -3,19 +3,25 @@
 package common
 
 import (
+	"context"
+
 	"cisco.com/son/apphcd/api/v1/appmanager"
 )
 
 // AppManagerAdapter interface
 type AppManagerAdapter interface {
-	CreateApp(request *appmanager.CreateAppRequest) (*appmanager.Response, error)
-	UpgradeApp(request *appmanager.UpgradeAppRequest) (*appmanager.Response, error)
-	UpdateApp(request *appmanager.UpdateAppRequest) (*appmanager.Response, error)
-	DeleteApp(request *appmanager.DeleteAppRequest) (*appmanager.Response, error)
-	DeleteApps(*appmanager.DeleteAppsRequest) (*appmanager.Response, error)
-	GetApps(request *appmanager.GetAppsRequest) (*appmanager.Response, error)
-	DeleteAppMetadata(request *appmanager.DeleteAppMetadataRequest) (*appmanager.Response, error)
-	EnableDisableApp(request *appmanager.EnableDisableAppRequest) (*appmanager.Response, error)
+	CreateApp(ctx context.Context, request *appmanager.CreateAppRequest) (*appmanager.Response, error)
+	UpgradeApp(ctx context.Context, request *appmanager.UpgradeAppRequest) (*appmanager.Response, error)
+	UpdateApp(ctx context.Context, request *appmanager.UpdateAppRequest) (*appmanager.Response, error)
+	RollbackApp(ctx context.Context, request *appmanager.RollbackAppRequest) (*appmanager.Response, error)
+	ListAppRevisions(ctx context.Context, request *appmanager.ListAppRevisionsRequest) (*appmanager.Response, error)
+	GetAppRevision(ctx context.Context, request *appmanager.GetAppRevisionRequest) (*appmanager.Response, error)
+	ScaleApp(ctx context.Context, request *appmanager.ScaleAppRequest) (*appmanager.Response, error)
+	DeleteApp(ctx context.Context, request *appmanager.DeleteAppRequest) (*appmanager.Response, error)
+	DeleteApps(ctx context.Context, request *appmanager.DeleteAppsRequest) (*appmanager.Response, error)
+	GetApps(ctx context.Context, request *appmanager.GetAppsRequest) (*appmanager.Response, error)
+	DeleteAppMetadata(ctx context.Context, request *appmanager.DeleteAppMetadataRequest) (*appmanager.Response, error)
+	EnableDisableApp(ctx context.Context, request *appmanager.EnableDisableAppRequest) (*appmanager.Response, error)
 }
 
 // CreateUpgradeRequester interface
@@ -36,6 +42,12 @@ type CreateUpgradeUpdateRequester interface {
 	GetAnnotations() map[string]string
 	GetSharedStorage() uint32
 	GetSpec() *appmanager.Spec
+	GetDryRun() bool
+}
+
+// UpgradeStrategyRequester interface
+type UpgradeStrategyRequester interface {
+	GetStrategy() *appmanager.UpgradeStrategy
 }
 
 // GenericRequester interface
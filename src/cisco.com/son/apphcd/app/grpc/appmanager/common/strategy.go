@@ -0,0 +1,222 @@
+// Author  <dorzheho@cisco.com>
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	"cisco.com/son/apphcd/app/common/longrunning"
+	"cisco.com/son/apphcd/app/common/requestid"
+)
+
+// GetUpgradeStrategy returns the upgrade strategy of the request if the request supports it
+func GetUpgradeStrategy(req CreateUpgradeUpdateRequester) *appmanager.UpgradeStrategy {
+	if r, ok := req.(UpgradeStrategyRequester); ok {
+		return r.GetStrategy()
+	}
+
+	return nil
+}
+
+// ValidateUpgradeStrategy checks the attributes of the upgrade strategy match its type
+func ValidateUpgradeStrategy(strategy *appmanager.UpgradeStrategy) error {
+	if strategy == nil {
+		return nil
+	}
+
+	switch strategy.GetType() {
+	case appmanager.UpgradeStrategyType_ALL_AT_ONCE:
+		if strategy.GetMaxUnavailable() > 0 || len(strategy.GetCanaryGroupIds()) > 0 || strategy.GetHealthCheckDelay() > 0 {
+			return fmt.Errorf("the all-at-once upgrade strategy doesn't take any attributes")
+		}
+	case appmanager.UpgradeStrategyType_ROLLING:
+		if len(strategy.GetCanaryGroupIds()) > 0 {
+			return fmt.Errorf("canary_group_ids is supported by the canary upgrade strategy only")
+		}
+	case appmanager.UpgradeStrategyType_CANARY:
+		if len(strategy.GetCanaryGroupIds()) == 0 {
+			return fmt.Errorf("the canary upgrade strategy requires canary_group_ids")
+		}
+	}
+
+	return nil
+}
+
+// UpgradeBatches splits the application instances into the batches applied one after another
+// according to the upgrade strategy. Instances left as they are do not belong to any batch
+func UpgradeBatches(instances []*AppInstanceData, strategy *appmanager.UpgradeStrategy) ([][]*AppInstanceData, error) {
+	var pending []*AppInstanceData
+	for _, instance := range instances {
+		if instance.NextAction != AppInstanceDataNextActionNone {
+			pending = append(pending, instance)
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	switch strategy.GetType() {
+	case appmanager.UpgradeStrategyType_ROLLING:
+		size := int(strategy.GetMaxUnavailable())
+		if size == 0 {
+			size = 1
+		}
+
+		return splitInstances(pending, size), nil
+
+	case appmanager.UpgradeStrategyType_CANARY:
+		// Tells whether the canary group has an application instance to upgrade
+		groups := make(map[string]bool)
+		for _, gid := range strategy.GetCanaryGroupIds() {
+			groups[gid] = false
+		}
+
+		var canary, rest []*AppInstanceData
+		for _, instance := range pending {
+			gid := instance.Annotations.Get(AppInstanceAnnotationGroupId)
+			if _, ok := groups[gid]; ok {
+				groups[gid] = true
+				canary = append(canary, instance)
+			} else {
+				rest = append(rest, instance)
+			}
+		}
+
+		for _, gid := range strategy.GetCanaryGroupIds() {
+			if !groups[gid] {
+				return nil, fmt.Errorf("canary group %s has no application instance to upgrade", gid)
+			}
+		}
+
+		batches := [][]*AppInstanceData{canary}
+		if len(rest) == 0 {
+			return batches, nil
+		}
+
+		// The rest of the instances is upgraded at once unless the batch size is limited
+		if size := int(strategy.GetMaxUnavailable()); size > 0 {
+			return append(batches, splitInstances(rest, size)...), nil
+		}
+
+		return append(batches, rest), nil
+
+	default:
+		return [][]*AppInstanceData{pending}, nil
+	}
+}
+
+// splitInstances splits the application instances into batches of the given size
+func splitInstances(instances []*AppInstanceData, size int) [][]*AppInstanceData {
+	var batches [][]*AppInstanceData
+	for len(instances) > size {
+		batches = append(batches, instances[:size])
+		instances = instances[size:]
+	}
+
+	return append(batches, instances)
+}
+
+// RunUpgradeBatches applies the batches one after another. Unless all the instances are upgraded at once,
+// every instance of the applied batch is checked by healthy once the health check delay is over and the
+// next batch starts only if all of them are healthy. On failure the instances of the batches applied so
+// far, including the failed one, are returned along with the error so they can be rolled back
+func RunUpgradeBatches(ctx context.Context, batches [][]*AppInstanceData, strategy *appmanager.UpgradeStrategy,
+	apply func(ctx context.Context, batch []*AppInstanceData) ([]*appmanager.AppInstance, error),
+	healthy func(ctx context.Context, instance *AppInstanceData) error) ([]*appmanager.AppInstance, []*AppInstanceData, error) {
+
+	var doneList []*appmanager.AppInstance
+	var touched []*AppInstanceData
+
+	staged := strategy.GetType() != appmanager.UpgradeStrategyType_ALL_AT_ONCE
+
+	for n, batch := range batches {
+		if ctx.Err() != nil {
+			return nil, touched, fmt.Errorf("operation cancelled before upgrading batch %d of %d", n+1, len(batches))
+		}
+
+		if staged {
+			requestid.Logger(ctx).WithFields(logrus.Fields{"strategy": strategy.GetType().String(), "batch": n + 1,
+				"batches": len(batches), "instances": instanceNames(batch)}).Info("Upgrading batch of application instances")
+		}
+
+		touched = append(touched, batch...)
+
+		done, err := apply(ctx, batch)
+		if err != nil {
+			return nil, touched, err
+		}
+
+		doneList = append(doneList, done...)
+
+		if !staged {
+			continue
+		}
+
+		// Health gate
+		if delay := time.Duration(strategy.GetHealthCheckDelay()) * time.Second; delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, touched, fmt.Errorf("operation cancelled while checking batch %d of %d", n+1, len(batches))
+			}
+		}
+
+		for _, instance := range batch {
+			if err := healthy(ctx, instance); err != nil {
+				return nil, touched, fmt.Errorf("application instance %s is unhealthy: %s", instance.InstanceName, err.Error())
+			}
+		}
+
+		requestid.Logger(ctx).WithFields(logrus.Fields{"strategy": strategy.GetType().String(), "batch": n + 1,
+			"batches": len(batches), "status": "OK"}).Info("Upgrading batch of application instances")
+		longrunning.Step(ctx, "batch %d of %d healthy", n+1, len(batches))
+	}
+
+	return doneList, touched, nil
+}
+
+// CreatedInstances returns the application instances created rather than upgraded. They have no previous state
+// to roll back to
+func CreatedInstances(instances []*AppInstanceData) []*AppInstanceData {
+	var created []*AppInstanceData
+	for _, instance := range instances {
+		if instance.NextAction == AppInstanceDataNextActionCreate {
+			created = append(created, instance)
+		}
+	}
+
+	return created
+}
+
+// InstancesByName returns the application instances whose names belong to the given instances
+func InstancesByName(instances, names []*AppInstanceData) []*AppInstanceData {
+	set := make(map[string]bool)
+	for _, n := range names {
+		set[n.InstanceName] = true
+	}
+
+	var filtered []*AppInstanceData
+	for _, instance := range instances {
+		if set[instance.InstanceName] {
+			filtered = append(filtered, instance)
+		}
+	}
+
+	return filtered
+}
+
+// instanceNames returns the names of the application instances
+func instanceNames(instances []*AppInstanceData) []string {
+	var names []string
+	for _, instance := range instances {
+		names = append(names, instance.InstanceName)
+	}
+
+	return names
+}
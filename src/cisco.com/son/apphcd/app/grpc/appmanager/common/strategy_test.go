@@ -0,0 +1,114 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	appcommon "cisco.com/son/apphcd/app/common"
+)
+
+func newInstances(groupIds ...string) []*AppInstanceData {
+	var instances []*AppInstanceData
+	for _, gid := range groupIds {
+		instances = append(instances, &AppInstanceData{
+			InstanceName: "app-" + gid,
+			NextAction:   AppInstanceDataNextActionUpgrade,
+			Annotations:  appcommon.Map{AppInstanceAnnotationGroupId: gid},
+		})
+	}
+
+	return instances
+}
+
+func batchNames(batches [][]*AppInstanceData) [][]string {
+	var names [][]string
+	for _, batch := range batches {
+		names = append(names, instanceNames(batch))
+	}
+
+	return names
+}
+
+func TestUpgradeBatches(t *testing.T) {
+	instances := newInstances("g1", "g2", "g3", "g4", "g5")
+	instances[1].NextAction = AppInstanceDataNextActionNone
+
+	tests := []struct {
+		strategy *appmanager.UpgradeStrategy
+		expected [][]string
+	}{
+		{nil, [][]string{{"app-g1", "app-g3", "app-g4", "app-g5"}}},
+		{&appmanager.UpgradeStrategy{Type: appmanager.UpgradeStrategyType_ROLLING},
+			[][]string{{"app-g1"}, {"app-g3"}, {"app-g4"}, {"app-g5"}}},
+		{&appmanager.UpgradeStrategy{Type: appmanager.UpgradeStrategyType_ROLLING, MaxUnavailable: 3},
+			[][]string{{"app-g1", "app-g3", "app-g4"}, {"app-g5"}}},
+		{&appmanager.UpgradeStrategy{Type: appmanager.UpgradeStrategyType_CANARY, CanaryGroupIds: []string{"g4"}},
+			[][]string{{"app-g4"}, {"app-g1", "app-g3", "app-g5"}}},
+		{&appmanager.UpgradeStrategy{Type: appmanager.UpgradeStrategyType_CANARY, CanaryGroupIds: []string{"g5", "g1"}, MaxUnavailable: 1},
+			[][]string{{"app-g1", "app-g5"}, {"app-g3"}, {"app-g4"}}},
+	}
+
+	for _, test := range tests {
+		batches, err := UpgradeBatches(instances, test.strategy)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(batchNames(batches), test.expected) {
+			t.Fatalf("unexpected batches for %v: %v", test.strategy, batchNames(batches))
+		}
+	}
+
+	// The instance of the canary group is left as it is
+	if _, err := UpgradeBatches(instances, &appmanager.UpgradeStrategy{Type: appmanager.UpgradeStrategyType_CANARY,
+		CanaryGroupIds: []string{"g2"}}); err == nil {
+		t.Fatal("expected error for canary group without instances to upgrade")
+	}
+}
+
+func TestRunUpgradeBatches(t *testing.T) {
+	strategy := &appmanager.UpgradeStrategy{Type: appmanager.UpgradeStrategyType_ROLLING, MaxUnavailable: 2}
+	batches, err := UpgradeBatches(newInstances("g1", "g2", "g3", "g4", "g5"), strategy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var applied []string
+	apply := func(ctx context.Context, batch []*AppInstanceData) ([]*appmanager.AppInstance, error) {
+		var done []*appmanager.AppInstance
+		for _, instance := range batch {
+			applied = append(applied, instance.InstanceName)
+			done = append(done, &appmanager.AppInstance{Name: instance.InstanceName})
+		}
+
+		return done, nil
+	}
+
+	// The second batch fails the health gate
+	healthy := func(ctx context.Context, instance *AppInstanceData) error {
+		if instance.InstanceName == "app-g4" {
+			return fmt.Errorf("timed out waiting for pods readiness")
+		}
+
+		return nil
+	}
+
+	doneList, touched, err := RunUpgradeBatches(context.Background(), batches, strategy, apply, healthy)
+	if err == nil || doneList != nil {
+		t.Fatalf("expected the upgrade to halt, got %v", doneList)
+	}
+
+	expected := []string{"app-g1", "app-g2", "app-g3", "app-g4"}
+	if !reflect.DeepEqual(applied, expected) || !reflect.DeepEqual(instanceNames(touched), expected) {
+		t.Fatalf("unexpected instances upgraded %v, touched %v", applied, instanceNames(touched))
+	}
+
+	// All the instances at once are not checked by the health gate
+	doneList, _, err = RunUpgradeBatches(context.Background(), [][]*AppInstanceData{newInstances("g4")}, nil, apply, healthy)
+	if err != nil || len(doneList) != 1 {
+		t.Fatalf("unexpected result %v: %v", doneList, err)
+	}
+}
@@ -0,0 +1,314 @@
+// Author  <dorzheho@cisco.com>
+
+package appmanager
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	batchv1beta1listers "k8s.io/client-go/listers/batch/v1beta1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	pb "cisco.com/son/apphcd/api/v1/appmanager"
+	appcommon "cisco.com/son/apphcd/app/common"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+// Time the informers resync their caches after
+const watchResyncPeriod = 10 * time.Minute
+
+// errInformersStopped is returned if the informers are stopped before their caches are synced
+var errInformersStopped = errors.New("the watch informers are stopped")
+
+// watchInformers caches the workloads and pods of the application instances
+type watchInformers struct {
+	deployments appslisters.DeploymentLister
+	jobs        batchlisters.JobLister
+	cronJobs    batchv1beta1listers.CronJobLister
+	pods        corelisters.PodLister
+	synced      []cache.InformerSynced
+	stopCh      chan struct{} // Closed once the informers must stop
+}
+
+// startWatchInformers starts the shared informers on the objects labeled by the Controller. Every change calls notify
+func startWatchInformers(kc kubernetes.Interface, notify func()) *watchInformers {
+	factory := informers.NewSharedInformerFactoryWithOptions(kc, watchResyncPeriod,
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			// Workloads and pods of the application instances carry the application name label
+			opts.LabelSelector = appmgrcommon.MonAppLabelBasename
+		}))
+
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { notify() },
+		UpdateFunc: func(interface{}, interface{}) { notify() },
+		DeleteFunc: func(interface{}) { notify() },
+	}
+
+	deployments := factory.Apps().V1().Deployments()
+	jobs := factory.Batch().V1().Jobs()
+	cronJobs := factory.Batch().V1beta1().CronJobs()
+	pods := factory.Core().V1().Pods()
+
+	i := &watchInformers{
+		deployments: deployments.Lister(),
+		jobs:        jobs.Lister(),
+		cronJobs:    cronJobs.Lister(),
+		pods:        pods.Lister(),
+		stopCh:      make(chan struct{}),
+	}
+
+	for _, informer := range []cache.SharedIndexInformer{deployments.Informer(), jobs.Informer(),
+		cronJobs.Informer(), pods.Informer()} {
+		informer.AddEventHandler(handler)
+		i.synced = append(i.synced, informer.HasSynced)
+	}
+
+	factory.Start(i.stopCh)
+
+	return i
+}
+
+// stop stops the informers
+func (i *watchInformers) stop() {
+	close(i.stopCh)
+}
+
+// waitForSync waits until the caches are synced, the informers are stopped or the context is done
+func (i *watchInformers) waitForSync(ctx context.Context) error {
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		defer close(stopCh)
+
+		select {
+		case <-ctx.Done():
+		case <-i.stopCh:
+		case <-done:
+		}
+	}()
+
+	if cache.WaitForCacheSync(stopCh, i.synced...) {
+		return nil
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return errInformersStopped
+}
+
+// list gives back the instances found in the caches by application and instance name.
+// Disabled instances have no workloads, hence they are not listed
+func (i *watchInformers) list() (map[string]*watchedInstance, error) {
+	pods, err := i.pods.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	// Pods of an instance carry the instance name as the release label
+	instancePods := make(map[string][]*corev1.Pod)
+	for _, p := range pods {
+		key := p.Namespace + "/" + appcommon.MapGet(p.Labels, appmgrcommon.PodLabelRelease)
+		instancePods[key] = append(instancePods[key], p)
+	}
+
+	instances := make(map[string]*watchedInstance)
+	add := func(meta *metav1.ObjectMeta, ai *pb.Instance) {
+		appName := appcommon.MapGet(meta.Annotations, appmgrcommon.AppAnnotationBaseName)
+		if appName == "" {
+			return
+		}
+
+		ai.Containers = containerStates(instancePods[meta.Namespace+"/"+meta.Name])
+		instances[appName+"/"+ai.Name] = &watchedInstance{appName: appName, instance: ai}
+	}
+
+	deployments, err := i.deployments.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range deployments {
+		add(&w.ObjectMeta, deploymentInstance(w))
+	}
+
+	jobs, err := i.jobs.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range jobs {
+		add(&w.ObjectMeta, jobInstance(w))
+	}
+
+	cronJobs, err := i.cronJobs.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range cronJobs {
+		add(&w.ObjectMeta, cronJobInstance(w))
+	}
+
+	return instances, nil
+}
+
+// workloadInstance creates a new instance from the annotations of its workload
+func workloadInstance(meta *metav1.ObjectMeta) *pb.Instance {
+	return &pb.Instance{
+		Name:        meta.Name,
+		Id:          appcommon.MapGet(meta.Annotations, appmgrcommon.AppInstanceAnnotationId),
+		Version:     appcommon.MapGet(meta.Annotations, appmgrcommon.AppInstanceAnnotationVersion),
+		RootGroupId: appcommon.MapGet(meta.Annotations, appmgrcommon.AppInstanceAnnotationRootGroupId),
+		GroupId:     appcommon.MapGet(meta.Annotations, appmgrcommon.AppInstanceAnnotationGroupId),
+		ImageRepo:   appcommon.MapGet(meta.Annotations, appmgrcommon.AppInstanceAnnotationImageRepoName),
+		ImageName:   appcommon.MapGet(meta.Annotations, appmgrcommon.AppInstanceAnnotationImageName),
+		ImageTag:    appcommon.MapGet(meta.Annotations, appmgrcommon.AppInstanceAnnotationImageTag),
+		Cycle:       appcommon.MapGet(meta.Annotations, appmgrcommon.AppAnnotationCycle),
+		Namespace:   meta.Namespace,
+		Scale:       "-",
+		CreateDate:  meta.CreationTimestamp.UTC().Format(time.RFC3339),
+	}
+}
+
+// deploymentInstance creates a new daemon instance
+func deploymentInstance(w *appsv1.Deployment) *pb.Instance {
+	ai := workloadInstance(&w.ObjectMeta)
+
+	if w.Spec.Replicas != nil {
+		ai.Scale = strconv.Itoa(int(*w.Spec.Replicas))
+		ai.DesiredReplicas = uint32(*w.Spec.Replicas)
+	}
+	ai.ReadyReplicas = uint32(w.Status.ReadyReplicas)
+	ai.AvailableReplicas = uint32(w.Status.AvailableReplicas)
+
+	if w.Status.ReadyReplicas > 0 && w.Status.UnavailableReplicas == 0 {
+		ai.State = "active"
+	} else {
+		ai.State = "unavailable"
+	}
+
+	return ai
+}
+
+// jobInstance creates a new run-once instance
+func jobInstance(w *batchv1.Job) *pb.Instance {
+	ai := workloadInstance(&w.ObjectMeta)
+
+	f := &pb.RunOnceFields{
+		Active:    int64(w.Status.Active),
+		Succeeded: int64(w.Status.Succeeded),
+		Failed:    int64(w.Status.Failed),
+	}
+
+	if w.Spec.Completions != nil {
+		f.Completions = int64(*w.Spec.Completions)
+	}
+
+	if w.Status.StartTime != nil {
+		f.StartTime = w.Status.StartTime.UTC().Format(time.RFC3339)
+	}
+
+	if w.Status.CompletionTime != nil {
+		f.CompletionTime = w.Status.CompletionTime.UTC().Format(time.RFC3339)
+	}
+
+	ai.CycleFields = &pb.Instance_RunOnceFields{RunOnceFields: f}
+
+	switch {
+	case w.Status.Active > 0:
+		ai.State = "active"
+	case w.Status.Failed > 0:
+		ai.State = "failed"
+	default:
+		ai.State = "succeeded"
+	}
+
+	return ai
+}
+
+// cronJobInstance creates a new periodic instance
+func cronJobInstance(w *batchv1beta1.CronJob) *pb.Instance {
+	ai := workloadInstance(&w.ObjectMeta)
+
+	f := &pb.PeriodicFields{Schedule: w.Spec.Schedule}
+
+	if w.Status.LastScheduleTime != nil {
+		f.LastScheduleTime = w.Status.LastScheduleTime.UTC().Format(time.RFC3339)
+	}
+
+	if w.Spec.SuccessfulJobsHistoryLimit != nil {
+		f.SuccessfulJobsHistoryLimit = int64(*w.Spec.SuccessfulJobsHistoryLimit)
+	}
+
+	if w.Spec.FailedJobsHistoryLimit != nil {
+		f.FailedJobsHistoryLimit = int64(*w.Spec.FailedJobsHistoryLimit)
+	}
+
+	ai.CycleFields = &pb.Instance_PeriodicFields{PeriodicFields: f}
+
+	ai.State = "active"
+	if w.Spec.Suspend != nil && *w.Spec.Suspend {
+		ai.State = "suspended"
+	}
+
+	return ai
+}
+
+// containerStates gives back the containers of the instance pods. A container waiting in any pod
+// is reported by the waiting reason, e.g. CrashLoopBackOff, then a terminated one by its reason
+func containerStates(pods []*corev1.Pod) []*pb.Instance_Container {
+	const (
+		running = iota
+		terminated
+		waiting
+	)
+
+	type state struct {
+		container *pb.Instance_Container
+		severity  int
+	}
+
+	states := make(map[string]*state)
+	for _, p := range pods {
+		for _, cs := range p.Status.ContainerStatuses {
+			s := &state{container: &pb.Instance_Container{Name: cs.Name, Image: cs.Image, State: "running"}}
+			switch {
+			case cs.State.Waiting != nil:
+				s.container.State, s.severity = cs.State.Waiting.Reason, waiting
+			case cs.State.Terminated != nil:
+				s.container.State, s.severity = cs.State.Terminated.Reason, terminated
+			}
+
+			if old, ok := states[cs.Name]; !ok || s.severity > old.severity {
+				states[cs.Name] = s
+			}
+		}
+	}
+
+	var containers []*pb.Instance_Container
+	for _, s := range states {
+		containers = append(containers, s.container)
+	}
+
+	sort.Slice(containers, func(i, j int) bool { return containers[i].Name < containers[j].Name })
+
+	return containers
+}
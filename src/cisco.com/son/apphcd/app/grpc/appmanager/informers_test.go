@@ -0,0 +1,104 @@
+package appmanager
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	batchv1beta1listers "k8s.io/client-go/listers/batch/v1beta1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+func newIndexer(t *testing.T, objs ...interface{}) cache.Indexer {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, obj := range objs {
+		if err := indexer.Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return indexer
+}
+
+func TestInformersList(t *testing.T) {
+	replicas := int32(2)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "first-g1", Namespace: "first", Annotations: map[string]string{
+			appmgrcommon.AppAnnotationBaseName:            "first",
+			appmgrcommon.AppAnnotationCycle:               appmgrcommon.TypeDaemon,
+			appmgrcommon.AppInstanceAnnotationGroupId:     "g1",
+			appmgrcommon.AppInstanceAnnotationRootGroupId: "root",
+		}},
+		Spec:   appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{ReadyReplicas: 1, AvailableReplicas: 1, UnavailableReplicas: 1},
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "second-g1", Namespace: "second", Annotations: map[string]string{
+			appmgrcommon.AppAnnotationBaseName: "second",
+			appmgrcommon.AppAnnotationCycle:    appmgrcommon.TypeRunOnce,
+		}},
+		Status: batchv1.JobStatus{Failed: 1},
+	}
+
+	pods := []interface{}{
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "first-g1-a", Namespace: "first",
+				Labels: map[string]string{appmgrcommon.PodLabelRelease: "first-g1"}},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "first", Image: "first:1.0", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			}},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "first-g1-b", Namespace: "first",
+				Labels: map[string]string{appmgrcommon.PodLabelRelease: "first-g1"}},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "first", Image: "first:1.0", State: corev1.ContainerState{
+					Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+			}},
+		},
+	}
+
+	i := &watchInformers{
+		deployments: appslisters.NewDeploymentLister(newIndexer(t, deployment)),
+		jobs:        batchlisters.NewJobLister(newIndexer(t, job)),
+		cronJobs:    batchv1beta1listers.NewCronJobLister(newIndexer(t)),
+		pods:        corelisters.NewPodLister(newIndexer(t, pods...)),
+	}
+
+	instances, err := i.list()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(instances) != 2 {
+		t.Fatalf("unexpected instances: %v", instances)
+	}
+
+	first := instances["first/first-g1"]
+	if first == nil || first.appName != "first" {
+		t.Fatalf("unexpected instances: %v", instances)
+	}
+
+	if ai := first.instance; ai.State != "unavailable" || ai.Scale != "2" || ai.ReadyReplicas != 1 ||
+		ai.GroupId != "g1" || ai.RootGroupId != "root" || ai.Namespace != "first" {
+		t.Fatalf("unexpected instance: %v", ai)
+	}
+
+	if c := first.instance.Containers; len(c) != 1 || c[0].State != "CrashLoopBackOff" {
+		t.Fatalf("unexpected containers: %v", c)
+	}
+
+	second := instances["second/second-g1"]
+	if second == nil || second.instance.State != "failed" || second.instance.GetRunOnceFields().GetFailed() != 1 {
+		t.Fatalf("unexpected instances: %v", instances)
+	}
+}
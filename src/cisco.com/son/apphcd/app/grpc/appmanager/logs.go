@@ -0,0 +1,161 @@
+// Author  <dorzheho@cisco.com>
+
+package appmanager
+
+import (
+	"bufio"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	pb "cisco.com/son/apphcd/api/v1/appmanager"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+// Maximum size of a log line. Longer lines fail the stream of the container
+const maxLogLineSize = 1 << 20
+
+// logSource is a container of an application instance pod
+type logSource struct {
+	instance  string
+	namespace string
+	pod       string
+	container string
+}
+
+// logSources gives back the containers of the application instances matching the request
+func (mgr *manager) logSources(ctx context.Context, req *pb.StreamAppLogsRequest) ([]*logSource, error) {
+	appsReq := &pb.GetAppsRequest{Name: req.Name}
+	if req.GroupId != "" {
+		appsReq.GroupIds = []string{req.GroupId}
+	}
+
+	resp, err := mgr.adapter.GetApps(ctx, appsReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Status != pb.Status_SUCCESS {
+		return nil, nil
+	}
+
+	apps := &pb.AppsInfo{}
+	if err := ptypes.UnmarshalAny(resp.Body, apps); err != nil {
+		return nil, err
+	}
+
+	app, ok := apps.Apps[req.Name]
+	if !ok {
+		return nil, nil
+	}
+
+	var sources []*logSource
+	for _, i := range app.Instances {
+		selector := labels.SelectorFromSet(labels.Set{appmgrcommon.PodLabelApp: i.Name, appmgrcommon.PodLabelRelease: i.Name})
+		pods, err := mgr.kc.CoreV1().Pods(i.Namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pod := range pods.Items {
+			for _, c := range pod.Spec.Containers {
+				if req.Container != "" && req.Container != c.Name {
+					continue
+				}
+
+				sources = append(sources, &logSource{instance: i.Name, namespace: pod.Namespace, pod: pod.Name, container: c.Name})
+			}
+		}
+	}
+
+	return sources, nil
+}
+
+// podLogOptions converts the request to the options of the Kubernetes logs request
+func podLogOptions(req *pb.StreamAppLogsRequest) (*corev1.PodLogOptions, error) {
+	opts := &corev1.PodLogOptions{Follow: req.Follow, Timestamps: true}
+
+	if req.TailLines > 0 {
+		tailLines := req.TailLines
+		opts.TailLines = &tailLines
+	}
+
+	if req.SinceTime != nil {
+		t, err := ptypes.Timestamp(req.SinceTime)
+		if err != nil {
+			return nil, err
+		}
+
+		sinceTime := metav1.NewTime(t)
+		opts.SinceTime = &sinceTime
+	}
+
+	return opts, nil
+}
+
+// readLogs sends the log lines of the container until the logs are finished or the context is done
+func readLogs(ctx context.Context, kc kubernetes.Interface, src *logSource, opts corev1.PodLogOptions, lines chan<- *pb.LogLine) error {
+	opts.Container = src.container
+
+	rc, err := kc.CoreV1().Pods(src.namespace).GetLogs(src.pod, &opts).Stream()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	// Reading followed logs blocks until a new line is written, closing the stream interrupts it
+	go func() {
+		<-ctx.Done()
+		rc.Close()
+	}()
+
+	scanner := bufio.NewScanner(rc)
+	scanner.Buffer(make([]byte, 64*1024), maxLogLineSize)
+
+	for scanner.Scan() {
+		l := parseLogLine(scanner.Text())
+		l.Instance = src.instance
+		l.Pod = src.pod
+		l.Container = src.container
+
+		select {
+		case lines <- l:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return scanner.Err()
+}
+
+// parseLogLine splits the timestamp added by Kubernetes from the log line
+func parseLogLine(text string) *pb.LogLine {
+	l := &pb.LogLine{Line: text}
+
+	parts := strings.SplitN(text, " ", 2)
+	if len(parts) != 2 {
+		return l
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return l
+	}
+
+	if l.Timestamp, err = ptypes.TimestampProto(t); err != nil {
+		return l
+	}
+
+	l.Line = parts[1]
+	return l
+}
@@ -0,0 +1,40 @@
+package appmanager
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+
+	pb "cisco.com/son/apphcd/api/v1/appmanager"
+)
+
+func TestParseLogLine(t *testing.T) {
+	l := parseLogLine("2019-07-01T10:00:00.123456789Z starting server on :8080")
+	if l.Line != "starting server on :8080" || l.Timestamp == nil {
+		t.Fatalf("unexpected line: %v", l)
+	}
+
+	ts, _ := ptypes.Timestamp(l.Timestamp)
+	if ts.Nanosecond() != 123456789 {
+		t.Fatalf("unexpected timestamp: %v", ts)
+	}
+
+	if l := parseLogLine("no timestamp here"); l.Line != "no timestamp here" || l.Timestamp != nil {
+		t.Fatalf("unexpected line: %v", l)
+	}
+}
+
+func TestPodLogOptions(t *testing.T) {
+	opts, err := podLogOptions(&pb.StreamAppLogsRequest{Name: "first", TailLines: 10, Follow: true, SinceTime: ptypes.TimestampNow()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !opts.Follow || !opts.Timestamps || opts.TailLines == nil || *opts.TailLines != 10 || opts.SinceTime == nil {
+		t.Fatalf("unexpected options: %v", opts)
+	}
+
+	if opts, _ := podLogOptions(&pb.StreamAppLogsRequest{Name: "first"}); opts.TailLines != nil || opts.SinceTime != nil {
+		t.Fatalf("unexpected options: %v", opts)
+	}
+}
@@ -0,0 +1,372 @@
+// Author  <dorzheho@cisco.com>
+
+package appmanager
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+
+	pb "cisco.com/son/apphcd/api/v1/appmanager"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+const (
+	// Time the changes are collected for before the instances are refreshed, e.g. pods of a rolling upgrade
+	watchCoalescePeriod = time.Second
+	// Number of event batches a stream may fall behind before it's closed
+	watchStreamBuffer = 64
+)
+
+// errShuttingDown is returned for the streams closed by the Controller shutdown
+var errShuttingDown = errors.New("the controller is shutting down")
+
+// errStreamBehind is returned for the streams not keeping up with the changes
+var errStreamBehind = errors.New("the watch fell behind, start a new one")
+
+// watchedInstance is an application instance kept in the snapshot
+type watchedInstance struct {
+	appName  string
+	instance *pb.Instance
+}
+
+// watcher keeps a snapshot of the application instances and streams their changes to the subscribers.
+// The snapshot is built from the informer caches and refreshed when the workloads or pods change and
+// when the applications are mutated. The informers run only while there are subscribers
+type watcher struct {
+	adapter     appmgrcommon.AppManagerAdapter
+	kc          *kubernetes.Clientset       // Nil if the adapter is not backed by a Kubernetes cluster
+	changed     chan struct{}               // Signals that the snapshot may be stale
+	refreshMu   sync.Mutex                  // Serializes the snapshot refreshes
+	mu          sync.Mutex                  // Protects the fields below
+	informers   *watchInformers             // Running informers. Nil if there are no subscribers
+	instances   map[string]*watchedInstance // Snapshot by application and instance name. Nil if stale
+	subscribers map[*subscriber]struct{}    // Running streams
+	stopped     bool                        // Set once the watcher is stopped
+}
+
+// subscriber is a running WatchApps stream
+type subscriber struct {
+	req    *pb.WatchAppsRequest
+	events chan []*pb.WatchAppsEvent // Closed if the watcher is stopped or the stream falls behind
+	err    error                     // Reason the events were closed for
+}
+
+// newWatcher creates a new watcher
+func newWatcher(adapter appmgrcommon.AppManagerAdapter, kc *kubernetes.Clientset) *watcher {
+	return &watcher{
+		adapter:     adapter,
+		kc:          kc,
+		changed:     make(chan struct{}, 1),
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// run refreshes the snapshot on changes until the context is done
+func (w *watcher) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			w.stop()
+			return
+		case <-w.changed:
+		}
+
+		select {
+		case <-ctx.Done():
+			w.stop()
+			return
+		case <-time.After(watchCoalescePeriod):
+		}
+
+		// The changes received meanwhile are covered by the refresh
+		select {
+		case <-w.changed:
+		default:
+		}
+
+		if err := w.refresh(ctx); err != nil {
+			logrus.WithFields(logrus.Fields{"error": err}).Warn("Unable to refresh watched applications")
+		}
+	}
+}
+
+// notify tells the watcher the instances may have changed
+func (w *watcher) notify() {
+	select {
+	case w.changed <- struct{}{}:
+	default:
+	}
+}
+
+// subscribe registers a new stream. Gives back the ADDED events for the existing instances
+func (w *watcher) subscribe(ctx context.Context, req *pb.WatchAppsRequest) (*subscriber, []*pb.WatchAppsEvent, error) {
+	w.refreshMu.Lock()
+	defer w.refreshMu.Unlock()
+
+	w.mu.Lock()
+	if w.stopped {
+		w.mu.Unlock()
+		return nil, nil, errShuttingDown
+	}
+
+	s := &subscriber{req: req, events: make(chan []*pb.WatchAppsEvent, watchStreamBuffer)}
+	w.subscribers[s] = struct{}{}
+
+	// The informers run as long as there are subscribers
+	if w.informers == nil && w.kc != nil {
+		w.informers = startWatchInformers(w.kc, w.notify)
+	}
+
+	instances, informers := w.instances, w.informers
+	w.mu.Unlock()
+
+	if instances == nil {
+		var err error
+		if instances, err = w.fetch(ctx, informers); err != nil {
+			w.unsubscribe(s)
+			return nil, nil, err
+		}
+
+		w.mu.Lock()
+		w.publish(instances)
+		w.mu.Unlock()
+	}
+
+	var keys []string
+	for key := range instances {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var events []*pb.WatchAppsEvent
+	for _, key := range keys {
+		if e := newEvent(pb.EventType_ADDED, instances[key]); matchEvent(req, e) {
+			events = append(events, e)
+		}
+	}
+
+	return s, events, nil
+}
+
+// unsubscribe removes the stream. The informers are stopped with the last one
+func (w *watcher) unsubscribe(s *subscriber) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.subscribers, s)
+	w.release()
+}
+
+// stop closes the running streams and rejects the new ones
+func (w *watcher) stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.stopped = true
+	for s := range w.subscribers {
+		s.err = errShuttingDown
+		close(s.events)
+		delete(w.subscribers, s)
+	}
+
+	w.release()
+}
+
+// release stops the informers and drops the snapshot if there are no subscribers.
+// Must be called with the watcher locked
+func (w *watcher) release() {
+	if len(w.subscribers) > 0 {
+		return
+	}
+
+	if w.informers != nil {
+		w.informers.stop()
+		w.informers = nil
+	}
+
+	w.instances = nil
+}
+
+// refresh takes a new snapshot of the instances and sends the differences to the subscribers.
+// The instances are fetched without the watcher locked, so the streams are not blocked meanwhile
+func (w *watcher) refresh(ctx context.Context) error {
+	w.refreshMu.Lock()
+	defer w.refreshMu.Unlock()
+
+	w.mu.Lock()
+	informers := w.informers
+	idle := len(w.subscribers) == 0
+	w.mu.Unlock()
+
+	if idle {
+		return nil
+	}
+
+	instances, err := w.fetch(ctx, informers)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.publish(instances)
+
+	return nil
+}
+
+// publish replaces the snapshot and sends the differences to the subscribers.
+// Must be called with the watcher locked
+func (w *watcher) publish(instances map[string]*watchedInstance) {
+	// The last subscriber may be gone meanwhile
+	if len(w.subscribers) == 0 {
+		return
+	}
+
+	var events []*pb.WatchAppsEvent
+	if w.instances != nil {
+		events = diffInstances(w.instances, instances)
+	}
+
+	w.instances = instances
+
+	for s := range w.subscribers {
+		var matched []*pb.WatchAppsEvent
+		for _, e := range events {
+			if matchEvent(s.req, e) {
+				matched = append(matched, e)
+			}
+		}
+
+		if len(matched) == 0 {
+			continue
+		}
+
+		select {
+		case s.events <- matched:
+		default:
+			// The stream doesn't keep up with the changes. The client has to start a new watch
+			s.err = errStreamBehind
+			close(s.events)
+			delete(w.subscribers, s)
+		}
+	}
+
+	w.release()
+}
+
+// fetch gives back the running instances by application and instance name. The instances are listed
+// from the informer caches. The adapter is queried only if it's not backed by a Kubernetes cluster
+func (w *watcher) fetch(ctx context.Context, informers *watchInformers) (map[string]*watchedInstance, error) {
+	if informers != nil {
+		if err := informers.waitForSync(ctx); err != nil {
+			return nil, err
+		}
+
+		return informers.list()
+	}
+
+	resp, err := w.adapter.GetApps(ctx, &pb.GetAppsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make(map[string]*watchedInstance)
+
+	switch resp.Status {
+	case pb.Status_NOT_FOUND:
+		return instances, nil
+	case pb.Status_SUCCESS:
+	default:
+		return nil, errors.New(resp.Message)
+	}
+
+	apps := &pb.AppsInfo{}
+	if err := ptypes.UnmarshalAny(resp.Body, apps); err != nil {
+		return nil, err
+	}
+
+	for appName, app := range apps.Apps {
+		for _, i := range app.Instances {
+			instances[appName+"/"+i.Name] = &watchedInstance{appName: appName, instance: i}
+		}
+	}
+
+	return instances, nil
+}
+
+// diffInstances gives back the events turning the old snapshot into the new one
+func diffInstances(old, new map[string]*watchedInstance) []*pb.WatchAppsEvent {
+	var keys []string
+	for key := range old {
+		keys = append(keys, key)
+	}
+	for key := range new {
+		if _, ok := old[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var events []*pb.WatchAppsEvent
+	for _, key := range keys {
+		o, n := old[key], new[key]
+		switch {
+		case o == nil:
+			events = append(events, newEvent(pb.EventType_ADDED, n))
+		case n == nil:
+			events = append(events, newEvent(pb.EventType_DELETED, o))
+		case !proto.Equal(o.instance, n.instance):
+			events = append(events, newEvent(pb.EventType_MODIFIED, n))
+		}
+	}
+
+	return events
+}
+
+// newEvent creates a new event for the instance
+func newEvent(eventType pb.EventType, i *watchedInstance) *pb.WatchAppsEvent {
+	return &pb.WatchAppsEvent{
+		Type:      eventType,
+		AppName:   i.appName,
+		Instance:  i.instance,
+		Timestamp: ptypes.TimestampNow(),
+	}
+}
+
+// matchEvent tells whether the event matches the filters of the request
+func matchEvent(req *pb.WatchAppsRequest, e *pb.WatchAppsEvent) bool {
+	if req.Name != "" && req.Name != e.AppName {
+		return false
+	}
+
+	if req.RootGroupId != "" && req.RootGroupId != e.Instance.RootGroupId {
+		return false
+	}
+
+	if req.Cycle != "" {
+		if cycle, _ := appmgrcommon.AppTypeToAppCycle(req.Cycle); cycle != e.Instance.Cycle {
+			return false
+		}
+	}
+
+	if len(req.GroupIds) == 0 {
+		return true
+	}
+
+	for _, gid := range req.GroupIds {
+		if gid == e.Instance.GroupId {
+			return true
+		}
+	}
+
+	return false
+}
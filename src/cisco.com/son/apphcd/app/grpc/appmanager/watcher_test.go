@@ -0,0 +1,65 @@
+package appmanager
+
+import (
+	"testing"
+
+	pb "cisco.com/son/apphcd/api/v1/appmanager"
+)
+
+func TestDiffInstances(t *testing.T) {
+	old := map[string]*watchedInstance{
+		"first/first-g1":  {appName: "first", instance: &pb.Instance{Name: "first-g1", State: "active"}},
+		"first/first-g2":  {appName: "first", instance: &pb.Instance{Name: "first-g2", State: "active"}},
+		"second/second-1": {appName: "second", instance: &pb.Instance{Name: "second-1", State: "active"}},
+	}
+
+	new := map[string]*watchedInstance{
+		"first/first-g1": {appName: "first", instance: &pb.Instance{Name: "first-g1", State: "active"}},
+		"first/first-g2": {appName: "first", instance: &pb.Instance{Name: "first-g2", State: "unavailable"}},
+		"third/third-1":  {appName: "third", instance: &pb.Instance{Name: "third-1", State: "active"}},
+	}
+
+	events := diffInstances(old, new)
+
+	expected := []struct {
+		eventType pb.EventType
+		name      string
+	}{
+		{pb.EventType_MODIFIED, "first-g2"},
+		{pb.EventType_DELETED, "second-1"},
+		{pb.EventType_ADDED, "third-1"},
+	}
+
+	if len(events) != len(expected) {
+		t.Fatalf("unexpected events: %v", events)
+	}
+
+	for i, e := range expected {
+		if events[i].Type != e.eventType || events[i].Instance.Name != e.name {
+			t.Fatalf("unexpected event %d: %v", i, events[i])
+		}
+	}
+}
+
+func TestMatchEvent(t *testing.T) {
+	e := &pb.WatchAppsEvent{
+		AppName:  "first",
+		Instance: &pb.Instance{Name: "first-g1", GroupId: "g1", Cycle: "daemon"},
+	}
+
+	if !matchEvent(&pb.WatchAppsRequest{}, e) {
+		t.Fatal("empty request matches any event")
+	}
+
+	if !matchEvent(&pb.WatchAppsRequest{Name: "first", GroupIds: []string{"g2", "g1"}, Cycle: "daemon"}, e) {
+		t.Fatal("event matches the request")
+	}
+
+	if matchEvent(&pb.WatchAppsRequest{GroupIds: []string{"g2"}}, e) {
+		t.Fatal("event of another group matched")
+	}
+
+	if matchEvent(&pb.WatchAppsRequest{Cycle: "periodic"}, e) {
+		t.Fatal("event of another cycle matched")
+	}
+}
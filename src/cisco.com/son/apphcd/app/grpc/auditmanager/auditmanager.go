@@ -0,0 +1,73 @@
+// Author  <dorzheho@cisco.com>
+
+package auditmanager
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "cisco.com/son/apphcd/api/v1/auditmanager"
+	"cisco.com/son/apphcd/app/common/audit"
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/requestid"
+)
+
+// Number of records returned unless the limit is set
+const defaultLimit = 1000
+
+type manager struct {
+	logger *audit.Logger
+}
+
+// New creates a new AuditManager service
+func New(logger *audit.Logger) pb.AuditManagerServer {
+	return &manager{logger: logger}
+}
+
+func (mgr *manager) ListAuditRecords(ctx context.Context, req *pb.ListAuditRecordsRequest) (*pb.ListAuditRecordsResponse, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "AuditManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received ListAuditRecordsRequest")
+
+	if mgr.logger == nil {
+		return nil, status.Error(codes.Unimplemented, "audit log is disabled")
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	f := &audit.Filter{Identity: req.Identity, App: req.AppName, Action: req.Action, Limit: int(req.Limit)}
+	if f.Limit == 0 {
+		f.Limit = defaultLimit
+	}
+
+	if req.StartTime != nil {
+		t, err := ptypes.Timestamp(req.StartTime)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start time: %v", err)
+		}
+		f.Start = t
+	}
+
+	if req.EndTime != nil {
+		t, err := ptypes.Timestamp(req.EndTime)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end time: %v", err)
+		}
+		f.End = t
+	}
+
+	records, err := mgr.logger.List(f)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ListAuditRecordsResponse{Records: records}, nil
+}
@@ -0,0 +1,359 @@
+// Author  <dorzheho@cisco.com>
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"k8s.io/api/core/v1"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	"cisco.com/son/apphcd/api/v1/clustermanager"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+	clumgrcommon "cisco.com/son/apphcd/app/grpc/clustermanager/common"
+)
+
+const (
+	clusterName = "apphoster"
+
+	// Resources reported for every node
+	nodeCpuCores  = 4
+	nodeMemoryMiB = 8192
+	nodeStorageGb = 100
+)
+
+// node is a cluster node
+type node struct {
+	id       string
+	hostname string
+	master   bool
+	state    clustermanager.State
+}
+
+type memoryCluMgrAdapter struct {
+	mu      sync.Mutex                       // Guards the nodes and the quotas
+	id      string                           // Cluster ID
+	created time.Time                        // Cluster creation time
+	appmgr  appmgrcommon.AppManagerAdapter   // Provides the cluster workloads
+	nodes   map[string]*node                 // Nodes by hostname
+	quotas  map[string]*clustermanager.Quota // Resource quotas by namespace
+}
+
+// NewAdapter creates in-memory Cluster manager adapter.
+// The cluster consists of a single master node at the beginning.
+// Workloads are fetched from the Application manager adapter
+func NewAdapter(appmgrAdapter appmgrcommon.AppManagerAdapter) *memoryCluMgrAdapter {
+	adapter := &memoryCluMgrAdapter{
+		id:      "c-" + shortId(),
+		created: time.Now(),
+		appmgr:  appmgrAdapter,
+		nodes:   make(map[string]*node),
+		quotas:  make(map[string]*clustermanager.Quota),
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "localhost"
+	}
+
+	adapter.addNode(hostname, true)
+
+	return adapter
+}
+
+// GetKubeConfig fetches Kubernetes cluster configuration.
+// There is no Kubernetes cluster behind the adapter hence the configuration is empty
+func (adapter *memoryCluMgrAdapter) GetKubeConfig(ctx context.Context, req *clustermanager.GetKubeConfigRequest) (*clustermanager.GetKubeConfigResponse, error) {
+	return &clustermanager.GetKubeConfigResponse{}, nil
+}
+
+// GetClusterInfo shows AppHoster Cluster information
+func (adapter *memoryCluMgrAdapter) GetClusterInfo(ctx context.Context, req *clustermanager.GetClusterInfoRequest, ns *v1.Namespace) (*clustermanager.Response, error) {
+	apps, err := adapter.getApps()
+	if err != nil {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
+	}
+
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	body := &clustermanager.GetClusterInfoResponseBody{}
+	body.Id = adapter.id
+	body.ClusterName = clusterName
+	body.CreationDate = adapter.created.UTC().Format(time.RFC3339)
+	body.Condition = &clustermanager.Condition{}
+	body.Condition.State = clustermanager.State_active
+
+	var cpuAllocated float64
+	var memAllocated uint32
+
+	body.Workloads = &clustermanager.GetClusterInfoResponseBody_Workloads{}
+	body.Workloads.Apps = []*clustermanager.GetClusterInfoResponseBody_App{}
+
+	var names []string
+	for name := range apps.GetApps() {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		w := &clustermanager.GetClusterInfoResponseBody_App{}
+		w.Name = name
+
+		for _, i := range apps.Apps[name].Instances {
+			w.NumberOfInstances++
+			if i.State != "disabled" {
+				w.Active = true
+				body.Workloads.NumberOfActiveInstances++
+				cpuAllocated += i.GetResources().GetRequests().GetCpu()
+				memAllocated += i.GetResources().GetRequests().GetMemory()
+			}
+		}
+
+		body.Workloads.NumberOfInstances += w.NumberOfInstances
+		if w.Active {
+			body.Workloads.NumberOfActiveApps++
+		}
+
+		body.Workloads.Apps = append(body.Workloads.Apps, w)
+	}
+
+	body.Workloads.NumberOfApps = uint32(len(names))
+
+	nodes := adapter.sortedNodes()
+
+	body.CpuCores = &clustermanager.Cpu{}
+	body.CpuCores.Total = fmt.Sprintf("%d", nodeCpuCores*len(nodes))
+	body.CpuCores.Allocated = fmt.Sprintf("%.2f", cpuAllocated)
+	body.Memory = getMemory(uint32(nodeMemoryMiB*len(nodes)), memAllocated)
+	body.NumberOfNodes = uint32(len(nodes))
+
+	body.Nodes = []*clustermanager.GetClusterInfoResponseBody_Node{}
+	for _, n := range nodes {
+		gn := &clustermanager.GetClusterInfoResponseBody_Node{}
+		gn.Id = n.id
+		gn.Hostname = n.hostname
+		gn.Master = n.master
+		gn.Worker = true
+		gn.Etcd = n.master
+		gn.CpuCores = &clustermanager.Cpu{}
+		gn.CpuCores.Total = fmt.Sprintf("%d", nodeCpuCores)
+		gn.CpuCores.Allocated = fmt.Sprintf("%.2f", cpuAllocated/float64(len(nodes)))
+		gn.Memory = getMemory(nodeMemoryMiB, memAllocated/uint32(len(nodes)))
+		gn.Condition = &clustermanager.Condition{}
+		gn.Condition.State = n.state
+		gn.LocalStorage = &clustermanager.GetClusterInfoResponseBody_Node_Storage{}
+		gn.LocalStorage.Total = fmt.Sprintf("%d", nodeStorageGb)
+		gn.LocalStorage.Free = fmt.Sprintf("%d", nodeStorageGb)
+		gn.LocalStorage.Used = "0"
+		body.Nodes = append(body.Nodes, gn)
+	}
+
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "Cluster information", body)
+}
+
+// CreateNode adds a new node to the cluster
+func (adapter *memoryCluMgrAdapter) CreateNode(ctx context.Context, req *clustermanager.CreateNodeRequest) (*clustermanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	if req.GetHostname() == "" {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, "hostname is required", nil)
+	}
+
+	if _, ok := adapter.nodes[req.GetHostname()]; ok {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR,
+			fmt.Sprintf("node %s already exists", req.GetHostname()), nil)
+	}
+
+	n := adapter.addNode(req.GetHostname(), req.GetMaster())
+
+	body := &clustermanager.CreateNodeResponseBody{}
+	body.Hostname = n.hostname
+	body.Master = n.master
+	body.Id = n.id
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "The new node was added", body)
+}
+
+// DeleteNode removes the node from the cluster
+func (adapter *memoryCluMgrAdapter) DeleteNode(ctx context.Context, req *clustermanager.DeleteNodeRequest) (*clustermanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	n, err := adapter.getNodeByHostname(req.GetHostname())
+	if err != nil {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// The cluster cannot survive without master
+	if n.master {
+		masters := 0
+		for _, node := range adapter.nodes {
+			if node.master {
+				masters++
+			}
+		}
+
+		if masters == 1 {
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR,
+				fmt.Sprintf("cannot delete the last master node %s", n.hostname), nil)
+		}
+	}
+
+	delete(adapter.nodes, n.hostname)
+
+	body := &clustermanager.DeleteNodeResponseBody{}
+	body.Id = n.id
+	body.Hostname = n.hostname
+
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "The node was deleted", body)
+}
+
+// UpdateNodeState sets the node to the requested state
+func (adapter *memoryCluMgrAdapter) UpdateNodeState(ctx context.Context, req *clustermanager.UpdateNodeStateRequest) (*clustermanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	n, err := adapter.getNodeByHostname(req.GetHostname())
+	if err != nil {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
+	}
+
+	n.state = req.GetState()
+
+	body := &clustermanager.UpdateNodesStateResponseBody{}
+	body.State = n.state
+	body.Hostname = n.hostname
+	body.Id = n.id
+
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "The node was set to the new state", body)
+}
+
+// SetClusterResourceQuotas applies resource quotas on the namespaces
+func (adapter *memoryCluMgrAdapter) SetClusterResourceQuotas(ctx context.Context, req *clustermanager.SetClusterResourceQuotasRequest) (*clustermanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	rbody := &clustermanager.SetGetClusterResourceQuotasResponseBody{}
+
+	for _, q := range req.Quotas {
+		adapter.quotas[q.Namespace] = &clustermanager.Quota{Namespace: q.Namespace, Cpu: q.Cpu, Memory: q.Memory}
+		rbody.Quotas = append(rbody.Quotas, q)
+	}
+
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "Successfully applied resource quotas", rbody)
+}
+
+// GetClusterResourceQuotas fetches resource quotas of the namespaces.
+// Namespaces without quotas are reported with zero values
+func (adapter *memoryCluMgrAdapter) GetClusterResourceQuotas(ctx context.Context, req *clustermanager.GetClusterResourceQuotasRequest) (*clustermanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	rbody := &clustermanager.SetGetClusterResourceQuotasResponseBody{}
+
+	for _, ns := range req.Namespaces {
+		q := &clustermanager.Quota{}
+		q.Namespace = ns
+		if existing, ok := adapter.quotas[ns]; ok {
+			q.Cpu = existing.Cpu
+			q.Memory = existing.Memory
+		}
+
+		rbody.Quotas = append(rbody.Quotas, q)
+	}
+
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "List of quotas", rbody)
+}
+
+// DeleteClusterResourceQuotas removes resource quotas from the namespace
+func (adapter *memoryCluMgrAdapter) DeleteClusterResourceQuotas(ctx context.Context, req *clustermanager.DeleteClusterResourceQuotasRequest) (*clustermanager.Response, error) {
+	adapter.mu.Lock()
+	defer adapter.mu.Unlock()
+
+	if _, ok := adapter.quotas[req.Namespace]; !ok {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR,
+			fmt.Sprintf("resourcequotas %q not found", req.Namespace), nil)
+	}
+
+	delete(adapter.quotas, req.Namespace)
+
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS,
+		"Quotas were removed successfully from the namespace "+req.Namespace, nil)
+}
+
+// getApps fetches the applications deployed in the cluster
+func (adapter *memoryCluMgrAdapter) getApps() (*appmanager.AppsInfo, error) {
+	apps := &appmanager.AppsInfo{}
+
+	resp, err := adapter.appmgr.GetApps(context.Background(), &appmanager.GetAppsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.Status {
+	case appmanager.Status_SUCCESS:
+		if err := ptypes.UnmarshalAny(resp.Body, apps); err != nil {
+			return nil, err
+		}
+	case appmanager.Status_NOT_FOUND:
+	default:
+		return nil, fmt.Errorf("cannot fetch applications: %s", resp.Message)
+	}
+
+	return apps, nil
+}
+
+// addNode adds a new active node
+func (adapter *memoryCluMgrAdapter) addNode(hostname string, master bool) *node {
+	n := &node{id: adapter.id + ":m-" + shortId(), hostname: hostname, master: master, state: clustermanager.State_active}
+	adapter.nodes[hostname] = n
+	return n
+}
+
+// getNodeByHostname finds the node by its hostname
+func (adapter *memoryCluMgrAdapter) getNodeByHostname(hostname string) (*node, error) {
+	n, ok := adapter.nodes[hostname]
+	if !ok {
+		return nil, fmt.Errorf("node %s not found", hostname)
+	}
+
+	return n, nil
+}
+
+// sortedNodes gives back the nodes sorted by hostname
+func (adapter *memoryCluMgrAdapter) sortedNodes() []*node {
+	var nodes []*node
+	for _, n := range adapter.nodes {
+		nodes = append(nodes, n)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].hostname < nodes[j].hostname
+	})
+
+	return nodes
+}
+
+// getMemory creates memory information, the values are in MiB
+func getMemory(total, allocated uint32) *clustermanager.Memory {
+	m := &clustermanager.Memory{}
+	m.Total = fmt.Sprintf("%d", total)
+	m.Allocated = fmt.Sprintf("%d", allocated)
+	m.Free = fmt.Sprintf("%d", total-allocated)
+	return m
+}
+
+// shortId generates a short random ID
+func shortId() string {
+	return strings.Replace(uuid.New().String(), "-", "", -1)[:5]
+}
@@ -3,6 +3,7 @@
 package rancher
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -10,13 +11,13 @@ import (
 	"time"
 
 	managementClient "github.com/rancher/types/client/management/v3"
-	projectClient "github.com/rancher/types/client/project/v3"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"k8s.io/api/core/v1"
 
 	"cisco.com/son/apphcd/api/v1/clustermanager"
 	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/requestid"
 	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
 	clumgrcommon "cisco.com/son/apphcd/app/grpc/clustermanager/common"
 	"cisco.com/son/apphcd/app/grpc/clustermanager/common/lcm"
@@ -26,21 +27,17 @@ import (
 )
 
 type rancherCluMgrAdapter struct {
-	mc *rancher.MasterClient // Master client
-	sc *projectClient.Client // ApphosterServices project client
+	clients *rancher.Clients // Clients of the Applications and the ApphosterServices projects
 }
 
 // NewAdapter creates Rancher Cluster manager adapter
-func NewAdapter(masterClient *rancher.MasterClient, svcsProjectClient *projectClient.Client) *rancherCluMgrAdapter {
-	return &rancherCluMgrAdapter{
-		mc: masterClient,
-		sc: svcsProjectClient,
-	}
+func NewAdapter(clients *rancher.Clients) *rancherCluMgrAdapter {
+	return &rancherCluMgrAdapter{clients: clients}
 }
 
 // GetKubeConfig fetches Kubernetes cluster configuration
-func (adapter *rancherCluMgrAdapter) GetKubeConfig(req *clustermanager.GetKubeConfigRequest) (*clustermanager.GetKubeConfigResponse, error) {
-	c, err := rancher.ClusterKubeConfig(adapter.mc)
+func (adapter *rancherCluMgrAdapter) GetKubeConfig(ctx context.Context, req *clustermanager.GetKubeConfigRequest) (*clustermanager.GetKubeConfigResponse, error) {
+	c, err := rancher.ClusterKubeConfig(adapter.clients.Apps())
 	if err != nil {
 		return nil, err
 	}
@@ -48,15 +45,15 @@ func (adapter *rancherCluMgrAdapter) GetKubeConfig(req *clustermanager.GetKubeCo
 	conf := &clustermanager.GetKubeConfigResponse{}
 	conf.Kubeconfig = c
 
-	logrus.WithFields(logrus.Fields{"service": "ClusterManager", "type": "grpc"}).Info("Sending response")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"service": "ClusterManager", "type": "grpc"}).Info("Sending response")
 
 	return conf, nil
 }
 
 // GetClusterInfo shows AppHoster Cluster information
-func (adapter *rancherCluMgrAdapter) GetClusterInfo(req *clustermanager.GetClusterInfoRequest, ns *v1.Namespace) (*clustermanager.Response, error) {
+func (adapter *rancherCluMgrAdapter) GetClusterInfo(ctx context.Context, req *clustermanager.GetClusterInfoRequest, ns *v1.Namespace) (*clustermanager.Response, error) {
 
-	col, err := adapter.mc.ManagementClient.Cluster.List(rancher.DefaultListOpts())
+	col, err := adapter.clients.Apps().ManagementClient.Cluster.List(rancher.DefaultListOpts())
 	if err != nil {
 		return nil, err
 	}
@@ -73,13 +70,13 @@ func (adapter *rancherCluMgrAdapter) GetClusterInfo(req *clustermanager.GetClust
 		m := json.RawMessage(a)
 		out, err := m.MarshalJSON()
 		if err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
 		var u lcm.Upgrade
 		err = json.Unmarshal(out, &u)
 		if err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
 		body.LastUpgradeStatus = &clustermanager.GetClusterInfoResponseBody_UpgradeStatus{}
@@ -95,18 +92,18 @@ func (adapter *rancherCluMgrAdapter) GetClusterInfo(req *clustermanager.GetClust
 		}
 	}
 
-	monEndpoint, err := rancher.GetEndpoint(adapter.sc, adapter.mc.ManagementClient, grpccommon.ServiceMonitoringNamespace,
+	monEndpoint, err := rancher.GetEndpoint(adapter.clients.Svcs().ProjectClient, adapter.clients.Apps().ManagementClient, grpccommon.ServiceMonitoringNamespace,
 		grpccommon.ServiceMonitoringName, viper.GetString(appcommon.EnvApphSvcsUrlExternalIp))
 	if err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
 	if body.SvcMonitorUrl, err = clumgrcommon.GetServicesMonitorUrl(monEndpoint); err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
 	if body.CpuCores.Allocated, err = getAllocatedCpu(c.Requested["cpu"]); err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
 	body.Memory, err = getMemory(c.Allocatable["memory"], c.Requested["memory"])
@@ -125,9 +122,9 @@ func (adapter *rancherCluMgrAdapter) GetClusterInfo(req *clustermanager.GetClust
 	var numOfActiveInstances uint32
 	activeInstances := make(map[string]uint32)
 
-	collection, err := adapter.mc.ProjectClient.App.List(rancher.DefaultListOpts())
+	collection, err := adapter.clients.Apps().ProjectClient.App.List(rancher.DefaultListOpts())
 	if err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
 	for _, item := range collection.Data {
@@ -157,9 +154,9 @@ func (adapter *rancherCluMgrAdapter) GetClusterInfo(req *clustermanager.GetClust
 		body.Workloads.Apps = append(body.Workloads.Apps, w)
 	}
 
-	nc, err := adapter.mc.ManagementClient.Node.List(rancher.DefaultListOpts())
+	nc, err := adapter.clients.Apps().ManagementClient.Node.List(rancher.DefaultListOpts())
 	if nil != err {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
 	body.NumberOfNodes = uint32(len(collection.Data))
@@ -168,7 +165,7 @@ func (adapter *rancherCluMgrAdapter) GetClusterInfo(req *clustermanager.GetClust
 	for _, n := range nc.Data {
 		node := &clustermanager.GetClusterInfoResponseBody_Node{}
 		if node.MonitorUrl, err = clumgrcommon.GetNodeMonitorUrl(monEndpoint, n.IPAddress); err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 		node.Id = n.ID
 		node.Hostname = n.Hostname
@@ -181,7 +178,7 @@ func (adapter *rancherCluMgrAdapter) GetClusterInfo(req *clustermanager.GetClust
 		node.CpuCores.Total = n.Allocatable["cpu"]
 		nCpu, err := getAllocatedCpu(n.Requested["cpu"])
 		if err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
 		node.CpuCores.Allocated = nCpu
@@ -209,14 +206,14 @@ func (adapter *rancherCluMgrAdapter) GetClusterInfo(req *clustermanager.GetClust
 
 		sCap, err := grpccommon.GetStorageGbInt(n.Capacity["ephemeral-storage"])
 		if err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
 		s.Total = fmt.Sprintf("%d", sCap)
 
 		sFree, err := grpccommon.GetStorageGbInt(n.Allocatable["ephemeral-storage"])
 		if err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
 		sFree = sFree / 1024 / 1024
@@ -228,18 +225,18 @@ func (adapter *rancherCluMgrAdapter) GetClusterInfo(req *clustermanager.GetClust
 		body.Nodes = append(body.Nodes, node)
 	}
 
-	return clumgrcommon.GenerateResponse(clustermanager.Status_SUCCESS, "Cluster information", body)
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "Cluster information", body)
 }
 
-func (adapter *rancherCluMgrAdapter) CreateNode(req *clustermanager.CreateNodeRequest) (*clustermanager.Response, error) {
+func (adapter *rancherCluMgrAdapter) CreateNode(ctx context.Context, req *clustermanager.CreateNodeRequest) (*clustermanager.Response, error) {
 
-	col, err := adapter.mc.ManagementClient.Node.List(rancher.DefaultListOpts())
+	col, err := adapter.clients.Apps().ManagementClient.Node.List(rancher.DefaultListOpts())
 	if nil != err {
-		return clumgrcommon.GenerateResponse(1, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, 1, err.Error(), nil)
 	}
 
 	if len(col.Data) == 0 {
-		return clumgrcommon.GenerateResponse(1, "no AppHoster nodes found", nil)
+		return clumgrcommon.GenerateResponse(ctx, 1, "no AppHoster nodes found", nil)
 	}
 
 	//for _, n := range col.Data {
@@ -249,7 +246,7 @@ func (adapter *rancherCluMgrAdapter) CreateNode(req *clustermanager.CreateNodeRe
 
 	//clusterTokenCollection, err := adapter.client.ManagementClient.ClusterRegistrationToken.List(rancher.DefaultListOpts())
 	//if nil != err {
-	//	return common.GenerateResponse(1, err.Error(), nil)
+	//	return common.GenerateResponse(ctx, 1, err.Error(), nil)
 	//}
 	//
 	//var clusterToken *managementClient.ClusterRegistrationToken
@@ -261,7 +258,7 @@ func (adapter *rancherCluMgrAdapter) CreateNode(req *clustermanager.CreateNodeRe
 	//
 	//	clusterToken, err = adapter.client.ManagementClient.ClusterRegistrationToken.Create(crt)
 	//	if nil != err {
-	//		return common.GenerateResponse(1, err.Error(), nil)
+	//		return common.GenerateResponse(ctx, 1, err.Error(), nil)
 	//	}
 	//
 	//} else {
@@ -306,40 +303,40 @@ func (adapter *rancherCluMgrAdapter) CreateNode(req *clustermanager.CreateNodeRe
 	body.Hostname = req.GetHostname()
 	body.Master = req.GetMaster()
 	body.Id = "dummyID"
-	return clumgrcommon.GenerateResponse(clustermanager.Status_SUCCESS, "The new node was added", body)
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "The new node was added", body)
 }
 
-func (adapter *rancherCluMgrAdapter) DeleteNode(req *clustermanager.DeleteNodeRequest) (*clustermanager.Response, error) {
+func (adapter *rancherCluMgrAdapter) DeleteNode(ctx context.Context, req *clustermanager.DeleteNodeRequest) (*clustermanager.Response, error) {
 
-	node, err := getNodeByHostname(adapter.mc, req.GetHostname())
+	node, err := getNodeByHostname(adapter.clients.Apps(), req.GetHostname())
 	if err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
-	if err := adapter.mc.ManagementClient.Node.ActionDrain(node, drainOpts()); err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+	if err := adapter.clients.Apps().ManagementClient.Node.ActionDrain(node, drainOpts()); err != nil {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
-	if err := waitForDrained(adapter.mc, req.GetHostname()); err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+	if err := waitForDrained(adapter.clients.Apps(), req.GetHostname()); err != nil {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
-	if err := adapter.mc.ManagementClient.Node.Delete(node); err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+	if err := adapter.clients.Apps().ManagementClient.Node.Delete(node); err != nil {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
 	body := &clustermanager.DeleteNodeResponseBody{}
 	body.Id = node.ID
 	body.Hostname = node.Hostname
 
-	return clumgrcommon.GenerateResponse(clustermanager.Status_SUCCESS, "The node was deleted", body)
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "The node was deleted", body)
 }
 
-func (adapter *rancherCluMgrAdapter) UpdateNodeState(req *clustermanager.UpdateNodeStateRequest) (*clustermanager.Response, error) {
+func (adapter *rancherCluMgrAdapter) UpdateNodeState(ctx context.Context, req *clustermanager.UpdateNodeStateRequest) (*clustermanager.Response, error) {
 
-	node, err := getNodeByHostname(adapter.mc, req.GetHostname())
+	node, err := getNodeByHostname(adapter.clients.Apps(), req.GetHostname())
 	if err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
 	body := &clustermanager.UpdateNodesStateResponseBody{}
@@ -347,26 +344,26 @@ func (adapter *rancherCluMgrAdapter) UpdateNodeState(req *clustermanager.UpdateN
 	switch req.GetState() {
 
 	case clustermanager.State_unschedulable:
-		if err := adapter.mc.ManagementClient.Node.ActionCordon(node); err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		if err := adapter.clients.Apps().ManagementClient.Node.ActionCordon(node); err != nil {
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
 		body.State = clustermanager.State_unschedulable
 
 	case clustermanager.State_maintenance:
-		if err := adapter.mc.ManagementClient.Node.ActionDrain(node, drainOpts()); err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		if err := adapter.clients.Apps().ManagementClient.Node.ActionDrain(node, drainOpts()); err != nil {
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
-		if err := waitForDrained(adapter.mc, req.GetHostname()); err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		if err := waitForDrained(adapter.clients.Apps(), req.GetHostname()); err != nil {
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
 		body.State = clustermanager.State_maintenance
 
 	case clustermanager.State_active:
-		if err := adapter.mc.ManagementClient.Node.ActionUncordon(node); err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		if err := adapter.clients.Apps().ManagementClient.Node.ActionUncordon(node); err != nil {
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
 		body.State = clustermanager.State_active
@@ -375,21 +372,21 @@ func (adapter *rancherCluMgrAdapter) UpdateNodeState(req *clustermanager.UpdateN
 	body.Hostname = node.Hostname
 	body.Id = node.ID
 
-	return clumgrcommon.GenerateResponse(clustermanager.Status_SUCCESS, "The node was set to the new state", body)
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "The node was set to the new state", body)
 }
 
 // Implemented in the clustermanager package
-func (adapter *rancherCluMgrAdapter) SetClusterResourceQuotas(req *clustermanager.SetClusterResourceQuotasRequest) (*clustermanager.Response, error) {
+func (adapter *rancherCluMgrAdapter) SetClusterResourceQuotas(ctx context.Context, req *clustermanager.SetClusterResourceQuotasRequest) (*clustermanager.Response, error) {
 	return nil, nil
 }
 
 // Implemented in the clustermanager package
-func (adapter *rancherCluMgrAdapter) GetClusterResourceQuotas(req *clustermanager.GetClusterResourceQuotasRequest) (*clustermanager.Response, error) {
+func (adapter *rancherCluMgrAdapter) GetClusterResourceQuotas(ctx context.Context, req *clustermanager.GetClusterResourceQuotasRequest) (*clustermanager.Response, error) {
 	return nil, nil
 }
 
 // Implemented in the clustermanager package 
-func (adapter *rancherCluMgrAdapter) DeleteClusterResourceQuotas(req *clustermanager.DeleteClusterResourceQuotasRequest) (*clustermanager.Response, error) {
+func (adapter *rancherCluMgrAdapter) DeleteClusterResourceQuotas(ctx context.Context, req *clustermanager.DeleteClusterResourceQuotasRequest) (*clustermanager.Response, error) {
 	return nil, nil
 }
 
@@ -4,15 +4,21 @@ package clustermanager
 
 import (
 	"context"
+	"errors"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"cisco.com/son/apphcd/api/v1/clustermanager"
 	pb "cisco.com/son/apphcd/api/v1/clustermanager"
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/longrunning"
+	"cisco.com/son/apphcd/app/common/metrics"
 	"cisco.com/son/apphcd/app/common/mutex"
+	"cisco.com/son/apphcd/app/common/requestid"
 	clumgrcommon "cisco.com/son/apphcd/app/grpc/clustermanager/common"
 	"cisco.com/son/apphcd/app/grpc/clustermanager/common/lcm"
 	grpccommon "cisco.com/son/apphcd/app/grpc/common"
@@ -27,42 +33,49 @@ const (
 const cmdIsUpgradAvailable = "apph-upgrade.sh -o available"
 
 type manager struct {
-	adapter clumgrcommon.ClusterManagerAdapter
-	kc      *kubernetes.Clientset
+	adapter    clumgrcommon.ClusterManagerAdapter
+	kc         *kubernetes.Clientset // Nil if the adapter is not backed by a Kubernetes cluster
+	operations *longrunning.Registry // Long-running operations
 }
 
-func New(adapter clumgrcommon.ClusterManagerAdapter, clientset *kubernetes.Clientset) pb.ClusterManagerServer {
-	return &manager{adapter: adapter, kc: clientset}
+func New(adapter clumgrcommon.ClusterManagerAdapter, clientset *kubernetes.Clientset, operations *longrunning.Registry) pb.ClusterManagerServer {
+	return &manager{adapter: adapter, kc: clientset, operations: operations}
 }
 
 func (mgr *manager) GetKubeConfig(ctx context.Context, req *pb.GetKubeConfigRequest) (*pb.GetKubeConfigResponse, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "ClusterManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "ClusterManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received GetKubeConfigRequest")
 
-	return mgr.adapter.GetKubeConfig(req)
+	return mgr.adapter.GetKubeConfig(ctx, req)
 }
 
 func (mgr *manager) UpgradeCluster(ctx context.Context, req *pb.UpgradeClusterRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "ClusterManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "ClusterManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received UpgradeClusterRequest")
 
 	if mutex.IsLocked(mutex.LockActionUpgradeCluster) {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_IN_PROGRESS, "AppHoster cluster upgrade in progress", nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_IN_PROGRESS, "AppHoster cluster upgrade in progress", nil)
 	}
 
-	client, err := grpccommon.CreateSshClient()
+	if mgr.kc == nil {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, "cluster upgrade is not supported by the adapter", nil)
+	}
+
+	client, err := grpccommon.CreateSshClient(ctx)
 	if err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
 	// Create session
 	s, err := client.NewSession()
 	if err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
 	defer s.Close()
@@ -70,133 +83,169 @@ func (mgr *manager) UpgradeCluster(ctx context.Context, req *pb.UpgradeClusterRe
 	err = s.Run(cmdIsUpgradAvailable)
 	if err != nil {
 		if strings.Contains(errorUpgradeNotAvailable, err.Error()) {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, "no upgrade available", nil)
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, "no upgrade available", nil)
 		}
 
 		if strings.Contains(errorUpgradeMultipleArtifacts, err.Error()) {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, "found multiple upgrade resources", nil)
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, "found multiple upgrade resources", nil)
 		}
 
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, "internal error", nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, "internal error", nil)
 	}
 
-	logrus.Debug("Upgrade available")
+	requestid.Logger(ctx).Debug("Upgrade available")
 
 	ns, err := mgr.kc.CoreV1().Namespaces().Get("default", metav1.GetOptions{})
 	if err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// The lock is released once the upgrade is finished
+	if !mutex.TryLock(mutex.LockActionUpgradeCluster, "UpgradeCluster", auth.IdentityName(ctx)) {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_IN_PROGRESS, "AppHoster cluster is locked", nil)
+	}
+
+	op, err := mgr.operations.Run(ctx, "ClusterManager/UpgradeCluster", "cluster", auth.IdentityName(ctx), func(ctx context.Context) (proto.Message, error) {
+		defer mutex.Unlock(mutex.LockActionUpgradeCluster)
+
+		metrics.ClusterUpgradesInProgress.Inc()
+		defer metrics.ClusterUpgradesInProgress.Dec()
+
+		u := lcm.UpgradeCluster(ctx, client, mgr.kc, ns)
+		if u.Status == "ERROR" {
+			return nil, errors.New(u.ErrorMessage)
+		}
+
+		resp, _ := clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "AppHoster cluster upgrade completed", nil)
+		return resp, nil
+	})
+	if err != nil {
+		mutex.Unlock(mutex.LockActionUpgradeCluster)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
-	value, _ := clumgrcommon.GenerateResponse(clustermanager.Status_IN_PROGRESS, "AppHoster cluster upgrade in progress", nil)
-	mutex.Lock(mutex.LockActionUpgradeCluster, value)
 
-	go lcm.UpgradeCluster(client, mgr.kc, ns)
-	return value, nil
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_IN_PROGRESS, "AppHoster cluster upgrade in progress", op.Proto())
 }
 
 func (mgr *manager) GetClusterInfo(ctx context.Context, req *pb.GetClusterInfoRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "ClusterManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "ClusterManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received GetClusterInfoRequest")
 
 	if mutex.IsLocked(mutex.LockActionUpgradeCluster) {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_IN_PROGRESS, "AppHoster cluster upgrade in progress", nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_IN_PROGRESS, "AppHoster cluster upgrade in progress", nil)
+	}
+
+	// The adapter is not backed by a Kubernetes cluster hence there is no upgrade status
+	if mgr.kc == nil {
+		return mgr.adapter.GetClusterInfo(ctx, req, nil)
 	}
 
 	ns, err := mgr.kc.CoreV1().Namespaces().Get("default", metav1.GetOptions{})
 	if err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
-	return mgr.adapter.GetClusterInfo(req, ns)
+	return mgr.adapter.GetClusterInfo(ctx, req, ns)
 }
 
 func (mgr *manager) CreateNode(ctx context.Context, req *pb.CreateNodeRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "ClusterManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "ClusterManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received CreateNodeRequest")
 
-	if mutex.IsLocked(mutex.LockActionAny) {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_IN_PROGRESS, "AppHoster cluster is locked", nil)
+	if !mutex.TryLock(mutex.LockActionCreateNode, "CreateNode", auth.IdentityName(ctx)) {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_IN_PROGRESS, "AppHoster cluster is locked", nil)
 	}
-
-	mutex.Lock(mutex.LockActionCreateNode, nil)
 	defer mutex.Unlock(mutex.LockActionCreateNode)
 
-	return mgr.adapter.CreateNode(req)
+	return mgr.adapter.CreateNode(ctx, req)
 }
 
 func (mgr *manager) DeleteNode(ctx context.Context, req *pb.DeleteNodeRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "ClusterManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "ClusterManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received DeleteNodeRequest")
 
-	if mutex.IsLocked(mutex.LockActionAny) {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_IN_PROGRESS, "AppHoster cluster is locked", nil)
+	if !mutex.TryLock(mutex.LockActionDeleteNode, "DeleteNode", auth.IdentityName(ctx)) {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_IN_PROGRESS, "AppHoster cluster is locked", nil)
 	}
-
-	mutex.Lock(mutex.LockActionDeleteNode, nil)
 	defer mutex.Unlock(mutex.LockActionDeleteNode)
 
-	return mgr.adapter.DeleteNode(req)
+	return mgr.adapter.DeleteNode(ctx, req)
 }
 
 func (mgr *manager) UpdateNodeState(ctx context.Context, req *pb.UpdateNodeStateRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "ClusterManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "ClusterManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received UpdateNodeStateRequest")
 
-	if mutex.IsLocked(mutex.LockActionAny) {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_IN_PROGRESS, "AppHoster cluster is locked", nil)
+	if !mutex.TryLock(mutex.LockActionUpdateNodeState, "UpdateNodeState", auth.IdentityName(ctx)) {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_IN_PROGRESS, "AppHoster cluster is locked", nil)
 	}
-
-	mutex.Lock(mutex.LockActionUpdateNodeState, nil)
 	defer mutex.Unlock(mutex.LockActionUpdateNodeState)
 
-	return mgr.adapter.UpdateNodeState(req)
+	return mgr.adapter.UpdateNodeState(ctx, req)
 }
 
 func (mgr *manager) SetClusterResourceQuotas(ctx context.Context, req *pb.SetClusterResourceQuotasRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "ClusterManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "ClusterManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received SetClusterResourceQuotas")
 
 	if err := req.Validate(); err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Quotas are kept by the adapter
+	if mgr.kc == nil {
+		return mgr.adapter.SetClusterResourceQuotas(ctx, req)
 	}
 
 	rbody := &pb.SetGetClusterResourceQuotasResponseBody{}
 
 	for _, q := range req.Quotas {
-		if err := resourcemgr.CreateUpdateResourceQuotas(mgr.kc, q.Namespace, q.Cpu, q.Memory); err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		if err := resourcemgr.CreateUpdateResourceQuotas(ctx, mgr.kc, q.Namespace, q.Cpu, q.Memory); err != nil {
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
 		rbody.Quotas = append(rbody.Quotas, q)
 	}
 
-	return clumgrcommon.GenerateResponse(clustermanager.Status_SUCCESS, "Successfully applied resource quotas", rbody)
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "Successfully applied resource quotas", rbody)
 }
 
 func (mgr *manager) GetClusterResourceQuotas(ctx context.Context, req *pb.GetClusterResourceQuotasRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "ClusterManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "ClusterManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received GetClusterResourceQuotas")
 
 	if err := req.Validate(); err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
+	}
+
+	// Quotas are kept by the adapter
+	if mgr.kc == nil {
+		return mgr.adapter.GetClusterResourceQuotas(ctx, req)
 	}
 
 	rbody := &pb.SetGetClusterResourceQuotasResponseBody{}
 
 	for _, ns := range req.Namespaces {
-		cpu, memory, err := resourcemgr.GetResourceQuotas(mgr.kc, ns)
+		cpu, memory, err := resourcemgr.GetResourceQuotas(ctx, mgr.kc, ns)
 		if err != nil {
-			return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+			return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 		}
 
 		q := &pb.Quota{}
@@ -206,19 +255,25 @@ func (mgr *manager) GetClusterResourceQuotas(ctx context.Context, req *pb.GetClu
 		rbody.Quotas = append(rbody.Quotas, q)
 	}
 
-	return clumgrcommon.GenerateResponse(clustermanager.Status_SUCCESS, "List of quotas", rbody)
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS, "List of quotas", rbody)
 }
 
 func (mgr *manager) DeleteClusterResourceQuotas(ctx context.Context, req *pb.DeleteClusterResourceQuotasRequest) (*pb.Response, error) {
-	logrus.WithFields(logrus.Fields{
-		"service": "ClusterManager",
-		"type":    "grpc",
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "ClusterManager",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
 	}).Info("Received DeleteClusterResourceQuotas")
 
-	if err := resourcemgr.DeleteResourceQuotas(mgr.kc, req.Namespace); err != nil {
-		return clumgrcommon.GenerateResponse(clustermanager.Status_ERROR, err.Error(), nil)
+	// Quotas are kept by the adapter
+	if mgr.kc == nil {
+		return mgr.adapter.DeleteClusterResourceQuotas(ctx, req)
+	}
+
+	if err := resourcemgr.DeleteResourceQuotas(ctx, mgr.kc, req.Namespace); err != nil {
+		return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_ERROR, err.Error(), nil)
 	}
 
-	return clumgrcommon.GenerateResponse(clustermanager.Status_SUCCESS,
+	return clumgrcommon.GenerateResponse(ctx, clustermanager.Status_SUCCESS,
 		"Quotas were removed successfully from the namespace "+req.Namespace, nil)
 }
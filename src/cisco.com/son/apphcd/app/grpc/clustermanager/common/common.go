@@ -4,18 +4,20 @@ package common
 
 import (
 	"bytes"
+	"context"
 	"text/template"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/sirupsen/logrus"
-	"github.com/spf13/viper"
 
 	"cisco.com/son/apphcd/api/v1/clustermanager"
+	"cisco.com/son/apphcd/app/common/config"
+	"cisco.com/son/apphcd/app/common/requestid"
 )
 
 // GenerateResponse creates appropriate response
-func GenerateResponse(statusCode clustermanager.Status, msg string, m proto.Message) (*clustermanager.Response, error) {
+func GenerateResponse(ctx context.Context, statusCode clustermanager.Status, msg string, m proto.Message) (*clustermanager.Response, error) {
 	var err error
 	resp := new(clustermanager.Response)
 
@@ -37,11 +39,11 @@ func GenerateResponse(statusCode clustermanager.Status, msg string, m proto.Mess
 	// If the protobuf message is nil we assume that an error occurred
 	// hence the error message is printed
 	if statusCode == clustermanager.Status_ERROR {
-		logrus.Error(msg)
+		requestid.Logger(ctx).Error(msg)
 	}
 
-	logrus.WithFields(logrus.Fields{"service": "ClusterManager", "type": "grpc", "status": statusCode}).Info("Sending response")
-	logrus.Debugf("Response message: %q", resp.String())
+	requestid.Logger(ctx).WithFields(logrus.Fields{"service": "ClusterManager", "type": "grpc", "status": statusCode}).Info("Sending response")
+	requestid.Logger(ctx).Debugf("Response message: %q", resp.String())
 
 	return resp, nil
 }
@@ -69,7 +71,7 @@ func GetNodeMonitorUrl(endpoint, hostname string) (string, error) {
 		Hostname        string
 	}
 
-	t, err := template.New("node").Parse(viper.GetString(TemplatesUrlMonitorNodes))
+	t, err := template.New("node").Parse(config.Current().MonitorTemplate(TemplatesUrlMonitorNodes))
 	if err != nil {
 		return "", err
 	}
@@ -90,7 +92,7 @@ func GetServicesMonitorUrl(endpoint string) (string, error) {
 		MonitorEndpoint string
 	}
 
-	t, err := template.New("services").Parse(viper.GetString(TemplatesUrlMonitorServices))
+	t, err := template.New("services").Parse(config.Current().MonitorTemplate(TemplatesUrlMonitorServices))
 	if err != nil {
 		return "", err
 	}
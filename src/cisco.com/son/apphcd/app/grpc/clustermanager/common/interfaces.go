@@ -3,6 +3,8 @@
 package common
 
 import (
+	"context"
+
 	"k8s.io/api/core/v1"
 
 	"cisco.com/son/apphcd/api/v1/clustermanager"
@@ -10,12 +12,12 @@ import (
 
 // ClusterManagerAdapter interface
 type ClusterManagerAdapter interface {
-	GetKubeConfig(request *clustermanager.GetKubeConfigRequest) (*clustermanager.GetKubeConfigResponse, error)
-	GetClusterInfo(request *clustermanager.GetClusterInfoRequest, namespace *v1.Namespace) (*clustermanager.Response, error)
-	CreateNode(request *clustermanager.CreateNodeRequest) (*clustermanager.Response, error)
-	DeleteNode(request *clustermanager.DeleteNodeRequest) (*clustermanager.Response, error)
-	UpdateNodeState(request *clustermanager.UpdateNodeStateRequest) (*clustermanager.Response, error)
-	SetClusterResourceQuotas(request *clustermanager.SetClusterResourceQuotasRequest) (*clustermanager.Response, error)
-	GetClusterResourceQuotas(request *clustermanager.GetClusterResourceQuotasRequest) (*clustermanager.Response, error)
-	DeleteClusterResourceQuotas(request *clustermanager.DeleteClusterResourceQuotasRequest) (*clustermanager.Response, error)
+	GetKubeConfig(ctx context.Context, request *clustermanager.GetKubeConfigRequest) (*clustermanager.GetKubeConfigResponse, error)
+	GetClusterInfo(ctx context.Context, request *clustermanager.GetClusterInfoRequest, namespace *v1.Namespace) (*clustermanager.Response, error)
+	CreateNode(ctx context.Context, request *clustermanager.CreateNodeRequest) (*clustermanager.Response, error)
+	DeleteNode(ctx context.Context, request *clustermanager.DeleteNodeRequest) (*clustermanager.Response, error)
+	UpdateNodeState(ctx context.Context, request *clustermanager.UpdateNodeStateRequest) (*clustermanager.Response, error)
+	SetClusterResourceQuotas(ctx context.Context, request *clustermanager.SetClusterResourceQuotasRequest) (*clustermanager.Response, error)
+	GetClusterResourceQuotas(ctx context.Context, request *clustermanager.GetClusterResourceQuotasRequest) (*clustermanager.Response, error)
+	DeleteClusterResourceQuotas(ctx context.Context, request *clustermanager.DeleteClusterResourceQuotasRequest) (*clustermanager.Response, error)
 }
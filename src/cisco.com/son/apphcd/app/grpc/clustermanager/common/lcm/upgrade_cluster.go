@@ -3,6 +3,7 @@
 package lcm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -18,7 +19,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	appcommon "cisco.com/son/apphcd/app/common"
-	"cisco.com/son/apphcd/app/common/mutex"
+	"cisco.com/son/apphcd/app/common/longrunning"
+	"cisco.com/son/apphcd/app/common/requestid"
 	clumgrcommon "cisco.com/son/apphcd/app/grpc/clustermanager/common"
 )
 
@@ -42,8 +44,8 @@ type Upgrade struct {
 	Components   []*Component         `json:"Components"`
 }
 
-// UpgradeCluster responses for upgrading Apphoster cluster
-func UpgradeCluster(sshclient *ssh.Client, kc *kubernetes.Clientset, ns *v1.Namespace) {
+// UpgradeCluster responses for upgrading Apphoster cluster. Gives back the upgrade result once finalized
+func UpgradeCluster(ctx context.Context, sshclient *ssh.Client, kc *kubernetes.Clientset, ns *v1.Namespace) *Upgrade {
 
 	u := &Upgrade{}
 	u.Status = "OK"
@@ -51,23 +53,24 @@ func UpgradeCluster(sshclient *ssh.Client, kc *kubernetes.Clientset, ns *v1.Name
 	// Create session
 	s, err := sshclient.NewSession()
 	if err != nil {
-		finalize(sshclient, kc, ns, u, err)
-		return
+		finalize(ctx, sshclient, kc, ns, u, err)
+		return u
 	}
 
 	defer s.Close()
 
 	remoteHost := sshclient.RemoteAddr()
 	cmd := fmt.Sprintf("%s -d %s", cmdPrepare, viper.GetString(appcommon.EnvApphcPrivateDockerRegistry))
-	logrus.WithFields(logrus.Fields{"host": remoteHost, "cmd": cmd}).Info("Preparing for upgrade")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"host": remoteHost, "cmd": cmd}).Info("Preparing for upgrade")
 	o, err := s.Output(cmd)
 	fields := strings.Fields(string(o))
 	if err != nil {
-		finalize(sshclient, kc, ns, u, err)
-		return
+		finalize(ctx, sshclient, kc, ns, u, err)
+		return u
 	}
 
-	logrus.WithFields(logrus.Fields{"host": remoteHost, "cmd": cmd, "components": fields}).Info("Preparing for upgrade")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"host": remoteHost, "cmd": cmd, "components": fields}).Info("Preparing for upgrade")
+	longrunning.Step(ctx, "upgrade prepared")
 
 	gitEndpoint := "http://intucell:intucell@" + viper.GetString(appcommon.EnvApphcGitServerEndpoint)
 
@@ -93,7 +96,7 @@ func UpgradeCluster(sshclient *ssh.Client, kc *kubernetes.Clientset, ns *v1.Name
 				if err != nil {
 					c.Status = "ERROR"
 					c.ErrorMessage = err.Error()
-					logrus.Error(err)
+					requestid.Logger(ctx).Error(err)
 					return
 				}
 
@@ -104,20 +107,21 @@ func UpgradeCluster(sshclient *ssh.Client, kc *kubernetes.Clientset, ns *v1.Name
 				cmd := fmt.Sprintf("%s -d %s -c %s -g %s",
 					cmdUpgrade, viper.GetString(appcommon.EnvApphcPrivateDockerRegistry), component, gitEndpoint)
 
-				logrus.WithFields(logrus.Fields{"host": remoteHost, "component": component, "cmd": cmd}).Info("Upgrading component")
+				requestid.Logger(ctx).WithFields(logrus.Fields{"host": remoteHost, "component": component, "cmd": cmd}).Info("Upgrading component")
 				o, err := s.CombinedOutput(cmd)
 				if logrus.GetLevel() == logrus.DebugLevel {
-					logrus.WithFields(logrus.Fields{"host": remoteHost, "component": component, "output": string(o)}).Debug("Upgrading component")
+					requestid.Logger(ctx).WithFields(logrus.Fields{"host": remoteHost, "component": component, "output": string(o)}).Debug("Upgrading component")
 				}
 
 				if err != nil {
 					c.Status = "ERROR"
 					c.ErrorMessage = err.Error()
-					logrus.Error(err)
+					requestid.Logger(ctx).Error(err)
 					return
 				}
 
-				logrus.WithFields(logrus.Fields{"host": remoteHost, "component": component, "cmd": cmd, "status": "OK"}).Info("Upgrading component")
+				requestid.Logger(ctx).WithFields(logrus.Fields{"host": remoteHost, "component": component, "cmd": cmd, "status": "OK"}).Info("Upgrading component")
+				longrunning.Step(ctx, "component %s upgraded", component)
 
 			}(u, component)
 
@@ -128,8 +132,8 @@ func UpgradeCluster(sshclient *ssh.Client, kc *kubernetes.Clientset, ns *v1.Name
 	wg.Wait()
 
 	if ! upgradeController {
-		finalize(sshclient, kc, ns, u, nil)
-		return
+		finalize(ctx, sshclient, kc, ns, u, nil)
+		return u
 	}
 
 	c := new(Component)
@@ -142,58 +146,59 @@ func UpgradeCluster(sshclient *ssh.Client, kc *kubernetes.Clientset, ns *v1.Name
 			c.ErrorMessage = err.Error()
 		}
 		u.Components = append(u.Components, c)
-		finalize(sshclient, kc, ns, u, err)
+		finalize(ctx, sshclient, kc, ns, u, err)
 	}(u, c, err)
 
 	s, err = sshclient.NewSession()
 	if err != nil {
-		return
+		return u
 	}
 
 	defer s.Close()
 
 	cmd = fmt.Sprintf("%s -d %s -c %s -g %s",
 		cmdUpgrade, viper.GetString(appcommon.EnvApphcPrivateDockerRegistry), componentNameApphosterController, gitEndpoint)
-	logrus.WithFields(logrus.Fields{"host": remoteHost, "component": componentNameApphosterController, "cmd": cmd}).Info("Upgrading component")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"host": remoteHost, "component": componentNameApphosterController, "cmd": cmd}).Info("Upgrading component")
 	o, err = s.CombinedOutput(cmd)
 
 	// The below lines will be executed only if controller is running outside of AppHoster (for example , running in IDE)
 	if logrus.GetLevel() == logrus.DebugLevel {
-		logrus.WithFields(logrus.Fields{"host": remoteHost, "component": componentNameApphosterController, "output": string(o)}).Info("Upgrading component")
+		requestid.Logger(ctx).WithFields(logrus.Fields{"host": remoteHost, "component": componentNameApphosterController, "output": string(o)}).Info("Upgrading component")
 	}
 
 	if err != nil {
-		return
+		return u
 	}
 
-	logrus.WithFields(logrus.Fields{"host": remoteHost, "component": componentNameApphosterController, "cmd": cmd, "status": "OK"}).Info("Upgrading component")
-	logrus.Info("Upgrade completed successfully")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"host": remoteHost, "component": componentNameApphosterController, "cmd": cmd, "status": "OK"}).Info("Upgrading component")
+	longrunning.Step(ctx, "component %s upgraded", componentNameApphosterController)
+	requestid.Logger(ctx).Info("Upgrade completed successfully")
+	return u
 }
 
 // finalize is the last function executed during upgrade:
 // - cleans up temporary stuff
 // - creates appropriate entries in the default namespace annotations
-// - removes mutex
-func finalize(sshclient *ssh.Client, kc *kubernetes.Clientset, ns *v1.Namespace, u *Upgrade, err error) {
+func finalize(ctx context.Context, sshclient *ssh.Client, kc *kubernetes.Clientset, ns *v1.Namespace, u *Upgrade, err error) {
 	defer sshclient.Close()
 
 	s, _ := sshclient.NewSession()
 
 	remoteHost := sshclient.RemoteAddr()
 
-	logrus.WithFields(logrus.Fields{"host": remoteHost, "cmd": cmdClean}).Info("Cleaning up")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"host": remoteHost, "cmd": cmdClean}).Info("Cleaning up")
 	if err := s.Run(cmdClean); err != nil {
 		return
 	}
 
-	logrus.WithFields(logrus.Fields{"host": remoteHost, "status": "OK"}).Info("Cleaning up")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"host": remoteHost, "status": "OK"}).Info("Cleaning up")
 
 	u.LastUpgrade = ptypes.TimestampNow()
 
 	if err != nil {
 		u.Status = "ERROR"
 		u.ErrorMessage = err.Error()
-		logrus.Error(err)
+		requestid.Logger(ctx).Error(err)
 	} else {
 		for _, s := range u.Components {
 			if s.ErrorMessage != "" {
@@ -208,5 +213,4 @@ func finalize(sshclient *ssh.Client, kc *kubernetes.Clientset, ns *v1.Namespace,
 
 	appcommon.MapAdd(ns.Annotations, clumgrcommon.NamespaceAnnotationApphosterUpgradeStatus, string(b))
 	_, _ = kc.CoreV1().Namespaces().Update(ns)
-	mutex.Unlock(mutex.LockActionUpgradeCluster)
 }
@@ -3,6 +3,7 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -27,7 +28,14 @@ const (
 )
 
 func KubeClientset() (*kubernetes.Clientset, error) {
-	config, err := clientcmd.BuildConfigFromFlags("", appcommon.ApphcKubeconfigPath)
+	kubeconfig := appcommon.ApphcKubeconfigPath
+
+	// Fall back to the in-cluster configuration if the kubeconfig file is not created
+	if _, err := os.Stat(kubeconfig); os.IsNotExist(err) {
+		kubeconfig = ""
+	}
+
+	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
 	if err != nil {
 		return nil, err
 	}
@@ -35,8 +43,8 @@ func KubeClientset() (*kubernetes.Clientset, error) {
 	return kubernetes.NewForConfig(config)
 }
 
-func CreateSshClient() (*ssh.Client, error) {
-	if err := syncer.Clone("http", gitRepoUserName, gitRepoPassword, viper.GetString(appcommon.EnvApphcGitServerEndpoint),
+func CreateSshClient(ctx context.Context) (*ssh.Client, error) {
+	if err := syncer.Clone(ctx, "http", gitRepoUserName, gitRepoPassword, viper.GetString(appcommon.EnvApphcGitServerEndpoint),
 		gitRepoName, gitRepoTargetDir, gitRepoBranch); err != nil {
 		return nil, err
 	}
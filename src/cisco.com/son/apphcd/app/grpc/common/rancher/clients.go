@@ -3,7 +3,10 @@
 package rancher
 
 import (
+	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rancher/norman/clientbase"
 	clusterClient "github.com/rancher/types/client/cluster/v3"
@@ -11,6 +14,8 @@ import (
 	projectClient "github.com/rancher/types/client/project/v3"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
+
+	"cisco.com/son/apphcd/app/common/metrics"
 )
 
 type MasterClient struct {
@@ -70,6 +75,7 @@ func (mc *MasterClient) newClusterClient() error {
 	if err != nil {
 		return err
 	}
+	instrumentClient(&cc.APIBaseClient)
 	mc.ClusterClient = cc
 
 	return nil
@@ -84,6 +90,7 @@ func (mc *MasterClient) newManagementClient() error {
 	if err != nil {
 		return err
 	}
+	instrumentClient(&mClient.APIBaseClient)
 	mc.ManagementClient = mClient
 
 	return nil
@@ -99,6 +106,7 @@ func (mc *MasterClient) newProjectClient() error {
 	if err != nil {
 		return err
 	}
+	instrumentClient(&pc.APIBaseClient)
 	mc.ProjectClient = pc
 	return nil
 }
@@ -120,6 +128,35 @@ func createClientOpts(config *ServerConfig) *clientbase.ClientOpts {
 	return options
 }
 
+// instrumentClient times the Rancher API requests sent by the client
+func instrumentClient(c *clientbase.APIBaseClient) {
+	c.Ops.Client.Transport = &timedTransport{base: c.Ops.Client.Transport}
+}
+
+// timedTransport records time taken by the requests sent through the base transport
+type timedTransport struct {
+	base http.RoundTripper // Nil for the default transport
+}
+
+// RoundTrip sends the request and records time taken by it
+func (t *timedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+
+	metrics.RancherRequestDuration.WithLabelValues(req.Method, code).Observe(time.Since(start).Seconds())
+	return resp, err
+}
+
 // CheckProject verifies s matches the valid project ID of <cluster>:<project>
 func CheckProject(s string) []string {
 	clusterProject := strings.Split(s, ":")
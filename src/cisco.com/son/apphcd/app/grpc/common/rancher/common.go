@@ -312,7 +312,7 @@ func RelocateCoreServices(c *MasterClient) error {
 
 		if ns != nil {
 			// Move the namespace to dedicated project
-			if err := c.ClusterClient.Namespace.ActionMove(ns, &clusterClient.NamespaceMove{project.ID}); err != nil {
+			if err := c.ClusterClient.Namespace.ActionMove(ns, &clusterClient.NamespaceMove{ProjectID: project.ID}); err != nil {
 				return err
 			}
 		}
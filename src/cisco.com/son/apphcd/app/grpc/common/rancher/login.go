@@ -34,6 +34,16 @@ type CACertResponse struct {
 
 // LoginSetup logs in to the Rancher server and creates appropriate identity file
 func LoginSetup(serverName, token, projectName string) error {
+	cf, err := loginConfig(serverName, token, projectName)
+	if err != nil {
+		return err
+	}
+
+	return cf.Write()
+}
+
+// loginConfig logs in to the Rancher server and gives back the identity file content without writing it
+func loginConfig(serverName, token, projectName string) (Config, error) {
 
 	// path to the file
 	path := os.ExpandEnv("${HOME}/.apphc/adapters/rancher/projects/" + projectName + ".json")
@@ -41,7 +51,7 @@ func LoginSetup(serverName, token, projectName string) error {
 	// load configuration file from path
 	cf, err := loadConfig(path)
 	if err != nil {
-		return err
+		return cf, err
 	}
 
 	// if no server name provided , use the default one
@@ -54,7 +64,7 @@ func LoginSetup(serverName, token, projectName string) error {
 	// Validate the url and drop the path
 	u, err := url.Parse(serverName)
 	if err != nil {
-		return err
+		return cf, err
 	}
 
 	u.Path = ""
@@ -64,14 +74,14 @@ func LoginSetup(serverName, token, projectName string) error {
 	if token != "" {
 		auth := strings.Split(token, ":")
 		if len(auth) != 2 {
-			return errors.New("invalid token")
+			return cf, errors.New("invalid token")
 		}
 		serverConfig.AccessKey = auth[0]
 		serverConfig.SecretKey = auth[1]
 		serverConfig.TokenKey = token
 	} else {
 		// This can be removed once username and password is accepted
-		return errors.New("token is required")
+		return cf, errors.New("token is required")
 	}
 
 	// Create new management client
@@ -82,17 +92,17 @@ func LoginSetup(serverName, token, projectName string) error {
 			// we get here so grab the cacert and see if the user accepts the server
 			c, err = getCertFromServer(serverConfig)
 			if nil != err {
-				return err
+				return cf, err
 			}
 		} else {
-			return err
+			return cf, err
 		}
 	}
 
 	// Get project context
 	project, err := GetProjectContext(c, projectName)
 	if err != nil {
-		return err
+		return cf, err
 	}
 
 	// Set the default server and project for the user
@@ -100,7 +110,7 @@ func LoginSetup(serverName, token, projectName string) error {
 	cf.CurrentServer = serverName
 	cf.Servers[serverName] = serverConfig
 
-	return cf.Write()
+	return cf, nil
 }
 
 // Get certificate from server
@@ -0,0 +1,95 @@
+// Author  <dorzheho@cisco.com>
+
+package rancher
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Clients keeps the clients of the Applications and the Services projects.
+// The clients are re-created once the server endpoint or the token changes
+type Clients struct {
+	login    sync.Mutex   // Serializes the logins
+	mu       sync.RWMutex // Protects the fields below
+	apps     *MasterClient
+	svcs     *MasterClient
+	endpoint string
+	token    string
+}
+
+// NewClients logs in to the Rancher server and creates the clients of the projects
+func NewClients(endpoint, token string) (*Clients, error) {
+	c := &Clients{}
+	if err := c.Login(endpoint, token); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Login re-creates the clients unless logged in to the endpoint with the token already.
+// The current clients are kept if the login fails
+func (c *Clients) Login(endpoint, token string) error {
+	c.login.Lock()
+	defer c.login.Unlock()
+
+	c.mu.RLock()
+	current := c.apps != nil && c.endpoint == endpoint && c.token == token
+	c.mu.RUnlock()
+
+	if current {
+		return nil
+	}
+
+	logrus.WithFields(logrus.Fields{"endpoint": endpoint}).Info("Logging in to Rancher server")
+
+	appsConfig, err := loginConfig(endpoint, token, AppsProjectName)
+	if err != nil {
+		return err
+	}
+
+	svcsConfig, err := loginConfig(endpoint, token, SvcsProjectName)
+	if err != nil {
+		return err
+	}
+
+	apps, err := NewMasterClient(appsConfig.Server())
+	if err != nil {
+		return err
+	}
+
+	svcs, err := NewMasterClient(svcsConfig.Server())
+	if err != nil {
+		return err
+	}
+
+	// The identity files of the CLI are written once logged in, so they never refer to a server the login failed to
+	for _, cf := range []Config{appsConfig, svcsConfig} {
+		if err := cf.Write(); err != nil {
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	c.apps, c.svcs, c.endpoint, c.token = apps, svcs, endpoint, token
+	c.mu.Unlock()
+
+	logrus.WithFields(logrus.Fields{"endpoint": endpoint, "status": "OK"}).Info("Logging in to Rancher server")
+	return nil
+}
+
+// Apps gives back the current client of the Applications project
+func (c *Clients) Apps() *MasterClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.apps
+}
+
+// Svcs gives back the current client of the Services project
+func (c *Clients) Svcs() *MasterClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.svcs
+}
@@ -3,19 +3,27 @@
 package resourcemgr
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+
+	"cisco.com/son/apphcd/app/common/tracing"
 )
 
 // CreateUpdateLimitRange creates or updates LimitRange object
-func CreateUpdateLimitRange(c *kubernetes.Clientset, namespace string, cpu float64, memory uint32, defaultCpu , defaultMemory string) error {
+func CreateUpdateLimitRange(ctx context.Context, c kubernetes.Interface, namespace string, cpu float64, memory uint32, defaultCpu , defaultMemory string) (err error) {
+	_, span := tracing.Start(ctx, "resourcemgr.CreateUpdateLimitRange")
+	span.SetAttributes(attribute.String("k8s.namespace", namespace))
+	defer tracing.Finish(span, &err)
+
 	lr := &corev1.LimitRange{}
 	lr.Name = namespace
 	lr.Namespace = namespace
@@ -73,8 +81,12 @@ func CreateUpdateLimitRange(c *kubernetes.Clientset, namespace string, cpu float
 }
 
 // DeleteLimitRange deletes appropriate limits object
-func DeleteLimitRange(c *kubernetes.Clientset, namespace string) error {
-	err := c.CoreV1().LimitRanges(namespace).Delete(namespace, &metav1.DeleteOptions{})
+func DeleteLimitRange(ctx context.Context, c kubernetes.Interface, namespace string) (err error) {
+	_, span := tracing.Start(ctx, "resourcemgr.DeleteLimitRange")
+	span.SetAttributes(attribute.String("k8s.namespace", namespace))
+	defer tracing.Finish(span, &err)
+
+	err = c.CoreV1().LimitRanges(namespace).Delete(namespace, &metav1.DeleteOptions{})
 	if errors.IsNotFound(err) {
 		return nil
 	}
@@ -83,7 +95,11 @@ func DeleteLimitRange(c *kubernetes.Clientset, namespace string) error {
 }
 
 // GetResourcesLimit fetches CPU and Memory limits from existing LimitRanges object
-func GetResourcesLimit(c *kubernetes.Clientset, namespace string) (cpu float64, memory uint32, err error) {
+func GetResourcesLimit(ctx context.Context, c kubernetes.Interface, namespace string) (cpu float64, memory uint32, err error) {
+	_, span := tracing.Start(ctx, "resourcemgr.GetResourcesLimit")
+	span.SetAttributes(attribute.String("k8s.namespace", namespace))
+	defer tracing.Finish(span, &err)
+
 	// Get existing limits
 	el, err := c.CoreV1().LimitRanges(namespace).Get(namespace, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
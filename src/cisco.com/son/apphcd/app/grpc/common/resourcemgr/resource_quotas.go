@@ -1,19 +1,27 @@
 package resourcemgr
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+
+	"cisco.com/son/apphcd/app/common/tracing"
 )
 
 // CreateUpdateResourceQuotas creates or updates ResourceQuota object
-func CreateUpdateResourceQuotas(c *kubernetes.Clientset, namespace string, cpu float64, memory uint32) error {
+func CreateUpdateResourceQuotas(ctx context.Context, c kubernetes.Interface, namespace string, cpu float64, memory uint32) (err error) {
+	_, span := tracing.Start(ctx, "resourcemgr.CreateUpdateResourceQuotas")
+	span.SetAttributes(attribute.String("k8s.namespace", namespace))
+	defer tracing.Finish(span, &err)
+
 	q := &corev1.ResourceQuota{}
 	q.Name = namespace
 	q.Namespace = namespace
@@ -46,12 +54,20 @@ func CreateUpdateResourceQuotas(c *kubernetes.Clientset, namespace string, cpu f
 }
 
 // DeleteResourceQuotas deletes appropriate ResourceQuotas object
-func DeleteResourceQuotas(c *kubernetes.Clientset, namespace string) error {
+func DeleteResourceQuotas(ctx context.Context, c kubernetes.Interface, namespace string) (err error) {
+	_, span := tracing.Start(ctx, "resourcemgr.DeleteResourceQuotas")
+	span.SetAttributes(attribute.String("k8s.namespace", namespace))
+	defer tracing.Finish(span, &err)
+
 	return c.CoreV1().ResourceQuotas(namespace).Delete(namespace, &metav1.DeleteOptions{})
 }
 
 // GetResourceQuotas fetches CPU and Memory quotas from existing ResourceQuotas object
-func GetResourceQuotas(c *kubernetes.Clientset, namespace string) (cpu float64, memory uint32, err error) {
+func GetResourceQuotas(ctx context.Context, c kubernetes.Interface, namespace string) (cpu float64, memory uint32, err error) {
+	_, span := tracing.Start(ctx, "resourcemgr.GetResourceQuotas")
+	span.SetAttributes(attribute.String("k8s.namespace", namespace))
+	defer tracing.Finish(span, &err)
+
 	// Get existing quota
 	q, err := c.CoreV1().ResourceQuotas(namespace).Get(namespace, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
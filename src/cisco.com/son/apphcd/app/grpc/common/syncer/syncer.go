@@ -3,13 +3,26 @@
 package syncer
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/src-d/go-git.v4"
 	"gopkg.in/src-d/go-git.v4/plumbing"
 	"gopkg.in/src-d/go-git.v4/plumbing/object"
+	"gopkg.in/src-d/go-git.v4/plumbing/storer"
+
+	"cisco.com/son/apphcd/app/common/metrics"
+	"cisco.com/son/apphcd/app/common/requestid"
+	"cisco.com/son/apphcd/app/common/tracing"
 )
 
 const (
@@ -20,11 +33,16 @@ const (
 )
 
 // Clone creates local charts cache
-func Clone(protocol, username, password, ip, repo, targetDir, branchName string) error {
+func Clone(ctx context.Context, protocol, username, password, ip, repo, targetDir, branchName string) (err error) {
+	defer metrics.ObserveStep(metrics.StepGitClone, time.Now(), &err)
+
+	_, span := tracing.Start(ctx, "syncer.Clone")
+	span.SetAttributes(attribute.String("git.repository", repo))
+	defer tracing.Finish(span, &err)
 
 	url := fmt.Sprintf("%s://%s:%s@%s/%s/%s", protocol, username, password, ip,username, repo)
 
-	logrus.WithFields(logrus.Fields{"url": url, "target": targetDir}).Debug("Cloning repository")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"url": url, "target": targetDir}).Debug("Cloning repository")
 	r, err := git.PlainClone(targetDir, false,
 		&git.CloneOptions{
 			URL:               url,
@@ -38,8 +56,8 @@ func Clone(protocol, username, password, ip, repo, targetDir, branchName string)
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"name": branchName}).Debug("Checking out branch")
-	if _, err := checkout(r, branchName, true); err != nil {
+	requestid.Logger(ctx).WithFields(logrus.Fields{"name": branchName}).Debug("Checking out branch")
+	if _, err := checkout(ctx, r, branchName, true); err != nil {
 		return err
 	}
 
@@ -47,21 +65,26 @@ func Clone(protocol, username, password, ip, repo, targetDir, branchName string)
 }
 
 // Pull updates local cache
-func Pull(repoPath, branchName string) error {
+func Pull(ctx context.Context, repoPath, branchName string) (err error) {
+	defer metrics.ObserveStep(metrics.StepGitPull, time.Now(), &err)
+
+	_, span := tracing.Start(ctx, "syncer.Pull")
+	span.SetAttributes(attribute.String("git.path", repoPath))
+	defer tracing.Finish(span, &err)
 
-	logrus.WithFields(logrus.Fields{"path": repoPath}).Debug("Finding repository path")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"path": repoPath}).Debug("Finding repository path")
 
 	// Open local repo
 	r, err := git.PlainOpen(repoPath)
 	if err != nil {
-		logrus.Error(repoPath)
+		requestid.Logger(ctx).Error(repoPath)
 		return err
 	}
 
 	// Checkout branch
-	logrus.WithFields(logrus.Fields{"name": branchName}).Debug("Checking out branch")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"name": branchName}).Debug("Checking out branch")
 
-	w, err := checkout(r, branchName, true)
+	w, err := checkout(ctx, r, branchName, true)
 	if err != nil {
 		switch err.Error() {
 		case errReferenceNotFound:
@@ -71,7 +94,7 @@ func Pull(repoPath, branchName string) error {
 		}
 	}
 
-	logrus.WithFields(logrus.Fields{"remote": defaultRemoteName}).Debug("Pulling changes")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"remote": defaultRemoteName}).Debug("Pulling changes")
 
 	// Pull the branch delta
 	if err := w.Pull(&git.PullOptions{RemoteName: defaultRemoteName, Force: true}); err != nil {
@@ -84,13 +107,13 @@ func Pull(repoPath, branchName string) error {
 }
 
 // Check out appropriate branch
-func checkout(wt *git.Repository, branchName string, force bool) (*git.Worktree, error) {
+func checkout(ctx context.Context, wt *git.Repository, branchName string, force bool) (*git.Worktree, error) {
 	w, err := wt.Worktree()
 	if err != nil {
 		return nil, err
 	}
 
-	logrus.WithFields(logrus.Fields{"name": branchName}).Debug("Checking out branch")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"name": branchName}).Debug("Checking out branch")
 
 	return w, w.Checkout(&git.CheckoutOptions{
 		Hash:  plumbing.NewHash(branchName),
@@ -99,9 +122,14 @@ func checkout(wt *git.Repository, branchName string, force bool) (*git.Worktree,
 }
 
 // Push pushes the changes
-func Push(repoPath, description string) error {
+func Push(ctx context.Context, repoPath, description string) (err error) {
+	defer metrics.ObserveStep(metrics.StepGitPush, time.Now(), &err)
 
-	logrus.WithFields(logrus.Fields{"repoPath": repoPath}).Debug("Opening repository")
+	_, span := tracing.Start(ctx, "syncer.Push")
+	span.SetAttributes(attribute.String("git.path", repoPath))
+	defer tracing.Finish(span, &err)
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"repoPath": repoPath}).Debug("Opening repository")
 
 	r, err := git.PlainOpen(repoPath)
 	if err != nil {
@@ -113,13 +141,13 @@ func Push(repoPath, description string) error {
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"repoPath": repoPath}).Debug("Adding changes")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"repoPath": repoPath}).Debug("Adding changes")
 
 	if err := w.AddGlob("."); err != nil {
 		return err
 	}
 
-	logrus.Debug("Committing changes")
+	requestid.Logger(ctx).Debug("Committing changes")
 
 	// Commits the current staging are to the repository, with the new file
 	// just created. We should provide the object.Signature of Author of the commit.
@@ -135,9 +163,344 @@ func Push(repoPath, description string) error {
 		return err
 	}
 
-	logrus.WithFields(logrus.Fields{"repository": h.String()}).Debug("Pushing changes")
+	requestid.Logger(ctx).WithFields(logrus.Fields{"repository": h.String()}).Debug("Pushing changes")
 
 	return r.Push(&git.PushOptions{
 		RemoteName: defaultRemoteName,
 	})
 }
+
+// Restore restores the directory of the repository as it was at the revision. The latest revision
+// holding the directory is used if the revision is empty. Gives back the hash of the revision used
+func Restore(ctx context.Context, repoPath, revision, dir string) (hash string, err error) {
+	defer metrics.ObserveStep(metrics.StepGitRestore, time.Now(), &err)
+
+	_, span := tracing.Start(ctx, "syncer.Restore")
+	span.SetAttributes(attribute.String("git.path", repoPath))
+	defer tracing.Finish(span, &err)
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"repoPath": repoPath, "revision": revision,
+		"dir": dir}).Debug("Restoring directory")
+
+	r, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return "", err
+	}
+
+	var commit *object.Commit
+	var tree *object.Tree
+	if revision != "" {
+		commit, tree, err = revisionTree(r, revision, dir)
+	} else {
+		commit, tree, err = latestTree(r, dir)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Files of the directory are replaced with the ones of the revision
+	target := filepath.Join(repoPath, dir)
+	if err := os.RemoveAll(target); err != nil {
+		return "", err
+	}
+
+	if err := tree.Files().ForEach(func(f *object.File) error {
+		return writeFile(filepath.Join(target, f.Name), f)
+	}); err != nil {
+		return "", err
+	}
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"repoPath": repoPath, "revision": commit.Hash.String(),
+		"dir": dir, "status": "OK"}).Debug("Restoring directory")
+
+	return commit.Hash.String(), nil
+}
+
+// revisionTree gives back the commit of the revision and the tree of the directory at the commit
+func revisionTree(r *git.Repository, revision, dir string) (*object.Commit, *object.Tree, error) {
+	h, err := r.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot find revision %s: %s", revision, err.Error())
+	}
+
+	commit, err := r.CommitObject(*h)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tree, err := dirTree(commit, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if tree == nil {
+		return nil, nil, fmt.Errorf("cannot find %s at revision %s", dir, revision)
+	}
+
+	return commit, tree, nil
+}
+
+// latestTree gives back the latest commit holding the directory and the tree of the directory at the commit
+func latestTree(r *git.Repository, dir string) (*object.Commit, *object.Tree, error) {
+	commits, err := r.Log(&git.LogOptions{Order: git.LogOrderCommitterTime})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer commits.Close()
+
+	for {
+		commit, err := commits.Next()
+		if err == io.EOF {
+			return nil, nil, fmt.Errorf("cannot find %s in the repository history", dir)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		tree, err := dirTree(commit, dir)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if tree != nil {
+			return commit, tree, nil
+		}
+	}
+}
+
+// dirTree gives back the tree of the directory at the commit. Nil if the directory doesn't exist
+func dirTree(commit *object.Commit, dir string) (*object.Tree, error) {
+	root, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := root.Tree(dir)
+	if err == object.ErrDirectoryNotFound {
+		return nil, nil
+	}
+
+	return tree, err
+}
+
+// writeFile writes the file of the repository to the path
+func writeFile(path string, f *object.File) error {
+	mode, err := f.Mode.ToOSFileMode()
+	if err != nil {
+		return err
+	}
+
+	content, err := f.Contents()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, []byte(content), mode)
+}
+
+// Commit is a commit of the repository changing a directory
+type Commit struct {
+	Hash    string    // Commit hash
+	When    time.Time // Time the commit was made
+	Message string    // Commit message
+	Dirs    []string  // Changed subdirectories of the directory
+}
+
+// newCommit creates the commit changing the subdirectories
+func newCommit(c *object.Commit, dirs []string) *Commit {
+	return &Commit{Hash: c.Hash.String(), When: c.Committer.When, Message: strings.TrimSpace(c.Message), Dirs: dirs}
+}
+
+// Walk calls fn for the commits changing the directory of the repository, the latest first. The history is walked
+// back from the revision, HEAD if empty. Every commit holds the changed subdirectories of the directory down to
+// the depth, e.g. a/b for the depth of 2. The walk stops once fn gives back false. Only the repository objects
+// are read, the working tree is not touched
+func Walk(ctx context.Context, repoPath, revision, dir string, depth int, fn func(c *Commit) (bool, error)) (err error) {
+	defer metrics.ObserveStep(metrics.StepGitLog, time.Now(), &err)
+
+	_, span := tracing.Start(ctx, "syncer.Walk")
+	span.SetAttributes(attribute.String("git.path", repoPath))
+	defer tracing.Finish(span, &err)
+
+	requestid.Logger(ctx).WithFields(logrus.Fields{"repoPath": repoPath, "revision": revision,
+		"dir": dir}).Debug("Reading repository history")
+
+	r, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return err
+	}
+
+	opts := &git.LogOptions{Order: git.LogOrderCommitterTime}
+	if revision != "" {
+		from, err := resolveCommit(r, revision)
+		if err != nil {
+			return err
+		}
+		opts.From = from.Hash
+	}
+
+	iter, err := r.Log(opts)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	err = iter.ForEach(func(c *object.Commit) error {
+		dirs, err := changedDirs(c, dir, depth)
+		if err != nil || len(dirs) == 0 {
+			return err
+		}
+
+		next, err := fn(newCommit(c, dirs))
+		if err != nil {
+			return err
+		}
+
+		if !next {
+			return storer.ErrStop
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// changedDirs gives back the subdirectories of the directory down to the depth changed by the commit
+func changedDirs(c *object.Commit, dir string, depth int) ([]string, error) {
+	tree, err := dirTree(c, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first parent is the one the commit was made on
+	var parentTree *object.Tree
+	if c.NumParents() > 0 {
+		parent, err := c.Parent(0)
+		if err != nil {
+			return nil, err
+		}
+
+		if parentTree, err = dirTree(parent, dir); err != nil {
+			return nil, err
+		}
+	}
+
+	if tree == nil && parentTree == nil || tree != nil && parentTree != nil && tree.Hash == parentTree.Hash {
+		return nil, nil
+	}
+
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make(map[string]bool)
+	for _, change := range changes {
+		name := change.To.Name
+		if name == "" {
+			name = change.From.Name
+		}
+
+		// Files above the depth belong to no subdirectory
+		if parts := strings.Split(name, "/"); len(parts) > depth {
+			changed[strings.Join(parts[:depth], "/")] = true
+		}
+	}
+
+	var dirs []string
+	for d := range changed {
+		dirs = append(dirs, d)
+	}
+
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+// resolveCommit gives back the commit of the revision, which is a branch, a tag, a commit hash or its prefix.
+// The prefix is looked up in the history of HEAD
+func resolveCommit(r *git.Repository, revision string) (*object.Commit, error) {
+	if h, err := r.ResolveRevision(plumbing.Revision(revision)); err == nil {
+		return r.CommitObject(*h)
+	}
+
+	iter, err := r.Log(&git.LogOptions{Order: git.LogOrderCommitterTime})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var found *object.Commit
+	err = iter.ForEach(func(c *object.Commit) error {
+		if !strings.HasPrefix(c.Hash.String(), revision) {
+			return nil
+		}
+
+		if found != nil {
+			return fmt.Errorf("revision %s is ambiguous", revision)
+		}
+
+		found = c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("cannot find revision %s", revision)
+	}
+
+	return found, nil
+}
+
+// Files gives back the commit of the revision and the content of the directory files at the commit by their
+// paths relative to the directory. No files are given back if the directory doesn't exist at the revision
+func Files(ctx context.Context, repoPath, revision, dir string) (*Commit, map[string][]byte, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{"repoPath": repoPath, "revision": revision,
+		"dir": dir}).Debug("Reading directory")
+
+	r, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h, err := r.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot find revision %s: %s", revision, err.Error())
+	}
+
+	c, err := r.CommitObject(*h)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tree, err := dirTree(c, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if tree == nil {
+		return newCommit(c, nil), nil, nil
+	}
+
+	files := make(map[string][]byte)
+	err = tree.Files().ForEach(func(f *object.File) error {
+		content, err := f.Contents()
+		if err != nil {
+			return err
+		}
+
+		files[f.Name] = []byte(content)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newCommit(c, nil), files, nil
+}
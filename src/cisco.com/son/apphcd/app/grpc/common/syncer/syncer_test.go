@@ -0,0 +1,98 @@
+package syncer
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+func commit(t *testing.T, w *git.Worktree, files map[string]string) string {
+	for name, content := range files {
+		path := filepath.Join(w.Filesystem.Root(), name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.AddGlob("."); err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := w.Commit("update", &git.CommitOptions{
+		All:    true,
+		Author: &object.Signature{Name: "test", Email: "test@cisco.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return h.String()
+}
+
+func readFile(t *testing.T, path string) string {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(content)
+}
+
+func TestRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syncer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := commit(t, w, map[string]string{"nginx/web/1.0/values.yaml": "tag: 1.0\n"})
+	commit(t, w, map[string]string{"nginx/web/1.0/values.yaml": "tag: 1.1\n"})
+
+	values := filepath.Join(dir, "nginx/web/1.0/values.yaml")
+
+	// The chart is restored as it was at the revision
+	hash, err := Restore(context.Background(), dir, first, "nginx/web/1.0")
+	if err != nil || hash != first {
+		t.Fatalf("unexpected revision %s: %v", hash, err)
+	}
+	if content := readFile(t, values); content != "tag: 1.0\n" {
+		t.Fatalf("unexpected content %q", content)
+	}
+
+	// The latest revision holding the chart is used once the chart is removed
+	if err := os.RemoveAll(filepath.Join(dir, "nginx")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Restore(context.Background(), dir, "", "nginx/web/1.0"); err != nil {
+		t.Fatal(err)
+	}
+	if content := readFile(t, values); content != "tag: 1.1\n" {
+		t.Fatalf("unexpected content %q", content)
+	}
+
+	if _, err := Restore(context.Background(), dir, first, "nginx/web/2.0"); err == nil {
+		t.Fatal("expected missing chart to fail")
+	}
+	if _, err := Restore(context.Background(), dir, "", "redis/db/1.0"); err == nil {
+		t.Fatal("expected chart missing from the history to fail")
+	}
+}
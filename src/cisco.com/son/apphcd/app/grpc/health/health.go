@@ -0,0 +1,88 @@
+// Author  <dorzheho@cisco.com>
+
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc/health"
+	pb "google.golang.org/grpc/health/grpc_health_v1"
+
+	apphealth "cisco.com/son/apphcd/app/common/health"
+)
+
+// Interval the readiness is checked at
+const checkInterval = 5 * time.Second
+
+// Server serves the standard gRPC Health service. The serving status is driven by the readiness checks
+type Server struct {
+	*health.Server
+	checker *apphealth.Checker
+	streams context.Context
+}
+
+// New creates a new Health service. The watching streams are closed once the streams context is done
+func New(streams context.Context, checker *apphealth.Checker) *Server {
+	s := &Server{Server: health.NewServer(), checker: checker, streams: streams}
+
+	// Not serving until the readiness is checked
+	s.SetServingStatus("", pb.HealthCheckResponse_NOT_SERVING)
+
+	return s
+}
+
+// Run checks the readiness at the interval until the streams context is done. The serving status of the
+// controller and of the services is updated once per check for all the watching streams
+func (s *Server) Run(services []string) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		status := pb.HealthCheckResponse_SERVING
+		if s.checker.Ready(s.streams).Status != apphealth.StatusOk {
+			status = pb.HealthCheckResponse_NOT_SERVING
+		}
+
+		s.SetServingStatus("", status)
+		for _, service := range services {
+			s.SetServingStatus(service, status)
+		}
+
+		select {
+		case <-s.streams.Done():
+			s.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Watch sends the serving status whenever it changes, until the stream or the streams context is done
+func (s *Server) Watch(req *pb.HealthCheckRequest, stream pb.Health_WatchServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-s.streams.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	wrapped := grpc_middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = ctx
+
+	return s.Server.Watch(req, &watchStream{wrapped})
+}
+
+// watchStream sends the serving status over the wrapped stream
+type watchStream struct {
+	*grpc_middleware.WrappedServerStream
+}
+
+func (s *watchStream) Send(resp *pb.HealthCheckResponse) error {
+	return s.SendMsg(resp)
+}
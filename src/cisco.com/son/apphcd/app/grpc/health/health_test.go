@@ -0,0 +1,108 @@
+package health
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	pb "google.golang.org/grpc/health/grpc_health_v1"
+
+	apphealth "cisco.com/son/apphcd/app/common/health"
+)
+
+// fakeWatchStream passes the sent serving statuses over to the channel
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	statuses chan pb.HealthCheckResponse_ServingStatus
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) SendMsg(m interface{}) error {
+	s.statuses <- m.(*pb.HealthCheckResponse).Status
+	return nil
+}
+
+func (s *fakeWatchStream) Send(m *pb.HealthCheckResponse) error {
+	return s.SendMsg(m)
+}
+
+func checkStatus(t *testing.T, s *Server, service string, expected pb.HealthCheckResponse_ServingStatus) {
+	resp, err := s.Check(context.Background(), &pb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Status != expected {
+		t.Fatalf("expected %s, got %s", expected, resp.Status)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	var checks int32
+	checker := apphealth.NewChecker(time.Second)
+	checker.Register("git", func(ctx context.Context) error {
+		atomic.AddInt32(&checks, 1)
+		return nil
+	})
+
+	streams, closeStreams := context.WithCancel(context.Background())
+	s := New(streams, checker)
+	checkStatus(t, s, "", pb.HealthCheckResponse_NOT_SERVING)
+
+	const watchers = 3
+	var watches []*fakeWatchStream
+	done := make(chan error, watchers)
+	for i := 0; i < watchers; i++ {
+		stream := &fakeWatchStream{ctx: context.Background(), statuses: make(chan pb.HealthCheckResponse_ServingStatus, 10)}
+		watches = append(watches, stream)
+
+		go func() {
+			done <- s.Watch(&pb.HealthCheckRequest{Service: "svc"}, stream)
+		}()
+	}
+
+	go s.Run([]string{"svc"})
+
+	for _, stream := range watches {
+		for status := range stream.statuses {
+			if status == pb.HealthCheckResponse_SERVING {
+				break
+			}
+		}
+	}
+
+	// The readiness is checked once for all the watching streams
+	if n := atomic.LoadInt32(&checks); n != 1 {
+		t.Fatalf("expected single readiness check, got %d", n)
+	}
+
+	checkStatus(t, s, "svc", pb.HealthCheckResponse_SERVING)
+
+	closeStreams()
+	for i := 0; i < watchers; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("watch expected to end once the streams are closed")
+		}
+	}
+
+	// Not serving once the streams are closed on shutdown
+	for i := 0; ; i++ {
+		resp, err := s.Check(context.Background(), &pb.HealthCheckRequest{})
+		if err == nil && resp.Status == pb.HealthCheckResponse_NOT_SERVING {
+			break
+		}
+
+		if i == 100 {
+			t.Fatal("expected not serving once the streams are closed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,116 @@
+// Author  <dorzheho@cisco.com>
+
+package operations
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "cisco.com/son/apphcd/api/v1/operations"
+	"cisco.com/son/apphcd/app/common/auth"
+	"cisco.com/son/apphcd/app/common/longrunning"
+	"cisco.com/son/apphcd/app/common/requestid"
+)
+
+// Maximum time WaitOperation waits for an operation
+const maxWaitTimeout = 10 * time.Minute
+
+type manager struct {
+	registry *longrunning.Registry
+}
+
+// New creates a new Operations service
+func New(registry *longrunning.Registry) pb.OperationsServer {
+	return &manager{registry: registry}
+}
+
+func (mgr *manager) GetOperation(ctx context.Context, req *pb.GetOperationRequest) (*pb.Operation, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "Operations",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received GetOperationRequest")
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	o, err := mgr.registry.Get(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return o.Proto(), nil
+}
+
+func (mgr *manager) ListOperations(ctx context.Context, req *pb.ListOperationsRequest) (*pb.ListOperationsResponse, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "Operations",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received ListOperationsRequest")
+
+	return &pb.ListOperationsResponse{Operations: mgr.registry.List(req.Method, req.Target, req.Running)}, nil
+}
+
+func (mgr *manager) CancelOperation(ctx context.Context, req *pb.CancelOperationRequest) (*pb.Operation, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "Operations",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received CancelOperationRequest")
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	o, err := mgr.registry.Cancel(req.Id)
+	if err == longrunning.ErrNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return o.Proto(), nil
+}
+
+func (mgr *manager) WaitOperation(ctx context.Context, req *pb.WaitOperationRequest) (*pb.Operation, error) {
+	requestid.Logger(ctx).WithFields(logrus.Fields{
+		"service":  "Operations",
+		"type":     "grpc",
+		"identity": auth.IdentityName(ctx),
+	}).Info("Received WaitOperationRequest")
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	timeout := maxWaitTimeout
+	if req.Timeout != nil {
+		d, err := ptypes.Duration(req.Timeout)
+		if err != nil || d < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timeout %v", req.Timeout)
+		}
+
+		if d < timeout {
+			timeout = d
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	o, err := mgr.registry.Wait(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return o.Proto(), nil
+}
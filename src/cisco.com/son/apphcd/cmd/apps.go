@@ -0,0 +1,570 @@
+// Author  <dorzheho@cisco.com>
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/cobra"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+	"cisco.com/son/apphcd/api/v1/operations"
+	"cisco.com/son/apphcd/app/common/statuscode"
+	appmgrcommon "cisco.com/son/apphcd/app/grpc/appmanager/common"
+)
+
+// appSelector holds the flags selecting application instances
+type appSelector struct {
+	version     string
+	rootGroupId string
+	groupIds    []string
+}
+
+// addFlags adds the selector flags to the command
+func (s *appSelector) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&s.version, "version", "", "application version")
+	cmd.Flags().StringVar(&s.rootGroupId, "root-group-id", "", "root group ID")
+	cmd.Flags().StringSliceVar(&s.groupIds, "group-ids", nil, "group IDs")
+}
+
+// upgradeStrategy holds the flags setting the upgrade strategy
+type upgradeStrategy struct {
+	kind             string
+	maxUnavailable   uint32
+	canaryGroupIds   []string
+	healthCheckDelay uint32
+}
+
+// addFlags adds the upgrade strategy flags to the command
+func (s *upgradeStrategy) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&s.kind, "strategy", "", "upgrade strategy: all-at-once, rolling or canary")
+	cmd.Flags().Uint32Var(&s.maxUnavailable, "max-unavailable", 0, "maximum number of instances upgraded at once by rolling strategy")
+	cmd.Flags().StringSliceVar(&s.canaryGroupIds, "canary-group-ids", nil, "group IDs of the instances upgraded first by canary strategy")
+	cmd.Flags().Uint32Var(&s.healthCheckDelay, "health-check-delay", 0, "seconds to wait after each batch before checking the instances health")
+}
+
+// set sets the upgrade strategy of the request if the strategy flag is given
+func (s *upgradeStrategy) set(req proto.Message) error {
+	if s.kind == "" {
+		return nil
+	}
+
+	t, ok := appmanager.UpgradeStrategyType_value[strings.ToUpper(strings.Replace(s.kind, "-", "_", -1))]
+	if !ok {
+		return fmt.Errorf("unsupported upgrade strategy %s", s.kind)
+	}
+
+	strategy := &appmanager.UpgradeStrategy{
+		Type:             appmanager.UpgradeStrategyType(t),
+		MaxUnavailable:   s.maxUnavailable,
+		CanaryGroupIds:   s.canaryGroupIds,
+		HealthCheckDelay: s.healthCheckDelay,
+	}
+
+	switch r := req.(type) {
+	case *appmanager.UpgradeAppRequest:
+		r.Strategy = strategy
+	case *appmanager.UpdateAppRequest:
+		r.Strategy = strategy
+	}
+
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(newAppsCmd())
+}
+
+// newAppsCmd creates the client sub-commands of the Application manager
+func newAppsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apps",
+		Short: "Manage applications",
+	}
+
+	addClientFlags(cmd)
+
+	cmd.AddCommand(
+		newAppsDeployCmd("create", "Create a new application", func() proto.Message { return &appmanager.CreateAppRequest{} },
+			func(ctx context.Context, c appmanager.AppManagerClient, req proto.Message) (*appmanager.Response, error) {
+				return c.CreateApp(ctx, req.(*appmanager.CreateAppRequest))
+			}),
+		newAppsDeployCmd("upgrade", "Upgrade instances of an application", func() proto.Message { return &appmanager.UpgradeAppRequest{} },
+			func(ctx context.Context, c appmanager.AppManagerClient, req proto.Message) (*appmanager.Response, error) {
+				return c.UpgradeApp(ctx, req.(*appmanager.UpgradeAppRequest))
+			}),
+		newAppsDeployCmd("update", "Update configuration of application instances", func() proto.Message { return &appmanager.UpdateAppRequest{} },
+			func(ctx context.Context, c appmanager.AppManagerClient, req proto.Message) (*appmanager.Response, error) {
+				return c.UpdateApp(ctx, req.(*appmanager.UpdateAppRequest))
+			}),
+		newAppsGetCmd(),
+		newAppsDeleteCmd(),
+		newAppsRollbackCmd(),
+		newAppsScaleCmd(),
+		newAppsRevisionsCmd(),
+		newAppsRevisionCmd(),
+		newAppsEnableDisableCmd("enable", "Enable application instances", false),
+		newAppsEnableDisableCmd("disable", "Disable application instances", true),
+	)
+
+	return cmd
+}
+
+// setAsync makes the request run in background
+func setAsync(req proto.Message) {
+	switch r := req.(type) {
+	case *appmanager.CreateAppRequest:
+		r.Async = true
+	case *appmanager.UpgradeAppRequest:
+		r.Async = true
+	case *appmanager.UpdateAppRequest:
+		r.Async = true
+	}
+}
+
+// setDryRun makes the request plan the changes without applying them
+func setDryRun(req proto.Message) {
+	switch r := req.(type) {
+	case *appmanager.CreateAppRequest:
+		r.DryRun = true
+	case *appmanager.UpgradeAppRequest:
+		r.DryRun = true
+	case *appmanager.UpdateAppRequest:
+		r.DryRun = true
+	}
+}
+
+// newAppsDeployCmd creates a sub-command sending the request read from file
+func newAppsDeployCmd(use, short string, newRequest func() proto.Message,
+	send func(ctx context.Context, c appmanager.AppManagerClient, req proto.Message) (*appmanager.Response, error)) *cobra.Command {
+	var file string
+	var async, dryRun bool
+	var strategy upgradeStrategy
+
+	cmd := &cobra.Command{
+		Use:   use + " -f FILE",
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := newRequest()
+			if err := readRequest(file, req); err != nil {
+				return err
+			}
+
+			if async {
+				setAsync(req)
+			}
+
+			if dryRun {
+				setDryRun(req)
+			}
+
+			if err := strategy.set(req); err != nil {
+				return err
+			}
+
+			return runApps(cmd.OutOrStdout(), func(ctx context.Context, c appmanager.AppManagerClient) (*appmanager.Response, error) {
+				return send(ctx, c, req)
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "request in YAML or JSON, - reads the standard input")
+	cmd.Flags().BoolVar(&async, "async", false, "return right away with the operation running in background")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the planned changes without applying them")
+
+	// Only upgrade and update requests have a strategy
+	if _, ok := newRequest().(interface {
+		GetStrategy() *appmanager.UpgradeStrategy
+	}); ok {
+		strategy.addFlags(cmd)
+	}
+
+	return cmd
+}
+
+// newAppsGetCmd creates the sub-command getting the running applications
+func newAppsGetCmd() *cobra.Command {
+	var selector appSelector
+	var cycle string
+	var verbose bool
+
+	cmd := &cobra.Command{
+		Use:   "get [NAME]",
+		Short: "Get running applications",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &appmanager.GetAppsRequest{
+				Version:     selector.version,
+				Cycle:       cycle,
+				RootGroupId: selector.rootGroupId,
+				GroupIds:    selector.groupIds,
+				Verbose:     verbose,
+			}
+
+			if len(args) > 0 {
+				req.Name = args[0]
+			}
+
+			return runApps(cmd.OutOrStdout(), func(ctx context.Context, c appmanager.AppManagerClient) (*appmanager.Response, error) {
+				return c.GetApps(ctx, req)
+			})
+		},
+	}
+
+	selector.addFlags(cmd)
+	cmd.Flags().StringVar(&cycle, "cycle", "", "application type: periodic, daemon or run_once")
+	cmd.Flags().BoolVar(&verbose, "verbose", false, "get containers and public endpoints of the instances")
+	return cmd
+}
+
+// newAppsDeleteCmd creates the sub-command deleting application instances
+func newAppsDeleteCmd() *cobra.Command {
+	var selector appSelector
+	var purge, async bool
+
+	cmd := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete application instances",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &appmanager.DeleteAppRequest{
+				Name:        args[0],
+				Version:     selector.version,
+				RootGroupId: selector.rootGroupId,
+				GroupIds:    selector.groupIds,
+				Purge:       purge,
+				Async:       async,
+			}
+
+			return runApps(cmd.OutOrStdout(), func(ctx context.Context, c appmanager.AppManagerClient) (*appmanager.Response, error) {
+				return c.DeleteApp(ctx, req)
+			})
+		},
+	}
+
+	selector.addFlags(cmd)
+	cmd.Flags().BoolVar(&purge, "purge", false, "remove the application from catalog as well")
+	cmd.Flags().BoolVar(&async, "async", false, "return right away with the operation running in background")
+	return cmd
+}
+
+// newAppsRollbackCmd creates the sub-command rolling back application instances
+func newAppsRollbackCmd() *cobra.Command {
+	var selector appSelector
+	var revision string
+	var async bool
+
+	cmd := &cobra.Command{
+		Use:   "rollback NAME",
+		Short: "Roll back application instances to a previous chart version or catalog revision",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &appmanager.RollbackAppRequest{
+				Name:        args[0],
+				Version:     selector.version,
+				Revision:    revision,
+				RootGroupId: selector.rootGroupId,
+				GroupIds:    selector.groupIds,
+				Async:       async,
+			}
+
+			return runApps(cmd.OutOrStdout(), func(ctx context.Context, c appmanager.AppManagerClient) (*appmanager.Response, error) {
+				return c.RollbackApp(ctx, req)
+			})
+		},
+	}
+
+	selector.addFlags(cmd)
+	cmd.Flags().StringVar(&revision, "revision", "", "apps catalog revision the charts are restored from")
+	cmd.Flags().BoolVar(&async, "async", false, "return right away with the operation running in background")
+	return cmd
+}
+
+// newAppsScaleCmd creates the sub-command changing the number of replicas of application instances
+func newAppsScaleCmd() *cobra.Command {
+	var selector appSelector
+	var replicas uint32
+	var async bool
+
+	cmd := &cobra.Command{
+		Use:   "scale NAME",
+		Short: "Change the number of replicas of daemon application instances",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &appmanager.ScaleAppRequest{
+				Name:        args[0],
+				Replicas:    replicas,
+				Version:     selector.version,
+				RootGroupId: selector.rootGroupId,
+				GroupIds:    selector.groupIds,
+				Async:       async,
+			}
+
+			return runApps(cmd.OutOrStdout(), func(ctx context.Context, c appmanager.AppManagerClient) (*appmanager.Response, error) {
+				return c.ScaleApp(ctx, req)
+			})
+		},
+	}
+
+	selector.addFlags(cmd)
+	cmd.Flags().Uint32Var(&replicas, "replicas", 1, "number of replicas of every application instance")
+	cmd.Flags().BoolVar(&async, "async", false, "return right away with the operation running in background")
+	return cmd
+}
+
+// newAppsRevisionsCmd creates the sub-command listing the revisions of the application charts
+func newAppsRevisionsCmd() *cobra.Command {
+	var template, version string
+	var limit uint32
+
+	cmd := &cobra.Command{
+		Use:   "revisions NAME",
+		Short: "List revisions of the application charts, the latest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &appmanager.ListAppRevisionsRequest{
+				Name:     args[0],
+				Template: template,
+				Version:  version,
+				Limit:    limit,
+			}
+
+			return runApps(cmd.OutOrStdout(), func(ctx context.Context, c appmanager.AppManagerClient) (*appmanager.Response, error) {
+				return c.ListAppRevisions(ctx, req)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&template, "template", "", "template (application instance) name")
+	cmd.Flags().StringVar(&version, "version", "", "chart version")
+	cmd.Flags().Uint32Var(&limit, "limit", 0, "maximum number of revisions")
+	return cmd
+}
+
+// newAppsRevisionCmd creates the sub-command getting a revision of an application chart
+func newAppsRevisionCmd() *cobra.Command {
+	var req appmanager.GetAppRevisionRequest
+
+	cmd := &cobra.Command{
+		Use:   "revision NAME REVISION",
+		Short: "Get a revision of an application chart and the changes made by it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req.Name, req.Revision = args[0], args[1]
+
+			return runApps(cmd.OutOrStdout(), func(ctx context.Context, c appmanager.AppManagerClient) (*appmanager.Response, error) {
+				return c.GetAppRevision(ctx, &req)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&req.Template, "template", "", "template (application instance) name")
+	cmd.Flags().StringVar(&req.Version, "version", "", "chart version")
+	cmd.Flags().StringVar(&req.BaseRevision, "base-revision", "", "revision the chart is compared with, the previous one if omitted")
+	cmd.Flags().StringVar(&req.BaseVersion, "base-version", "", "chart version the chart is compared with")
+	return cmd
+}
+
+// newAppsEnableDisableCmd creates the sub-command enabling or disabling application instances
+func newAppsEnableDisableCmd(use, short string, disable bool) *cobra.Command {
+	var selector appSelector
+
+	cmd := &cobra.Command{
+		Use:   use + " NAME",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &appmanager.EnableDisableAppRequest{
+				Name:        args[0],
+				Version:     selector.version,
+				RootGroupId: selector.rootGroupId,
+				GroupIds:    selector.groupIds,
+				Disable:     disable,
+			}
+
+			return runApps(cmd.OutOrStdout(), func(ctx context.Context, c appmanager.AppManagerClient) (*appmanager.Response, error) {
+				return c.EnableDisableApp(ctx, req)
+			})
+		},
+	}
+
+	selector.addFlags(cmd)
+	return cmd
+}
+
+// runApps connects to the controller, sends the request and prints the response
+func runApps(w io.Writer, send func(ctx context.Context, c appmanager.AppManagerClient) (*appmanager.Response, error)) error {
+	conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := requestContext()
+	defer cancel()
+
+	resp, err := send(ctx, appmanager.NewAppManagerClient(conn))
+	if err != nil {
+		// The controller may report the failed responses by the status codes
+		resp = &appmanager.Response{}
+		if !statuscode.Response(err, resp) {
+			return err
+		}
+	}
+
+	failed := resp.Status == appmanager.Status_ERROR || resp.Status == appmanager.Status_NOT_FOUND
+	return printResponse(w, resp, resp.Status, failed)
+}
+
+// writeAppsTable writes the Application manager response body. Gives back false if the body is not known
+func writeAppsTable(w io.Writer, m proto.Message) bool {
+	switch body := m.(type) {
+	case *appmanager.AppsInfo:
+		writeRow(w, "NAME", "INSTANCE", "VERSION", "ROOT GROUP", "GROUP", "CYCLE", "STATE", "READY", "IMAGE", "UPDATED")
+		names := make([]string, 0, len(body.Apps))
+		for name := range body.Apps {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			for _, i := range body.Apps[name].Instances {
+				writeRow(w, name, i.Name, i.Version, i.RootGroupId, i.GroupId, i.Cycle, i.State, readyReplicas(i),
+					imageName(i.ImageRepo, i.ImageName, i.ImageTag), i.UpdateDate)
+			}
+		}
+
+	case *appmanager.App:
+		writeAppInstances(w, []*appmanager.App{body})
+
+	case *appmanager.Apps:
+		writeAppInstances(w, body.Apps)
+
+	case *appmanager.AppsActivation:
+		writeRow(w, "NAME", "INSTANCE", "VERSION", "ROOT GROUP", "GROUP")
+		names := make([]string, 0, len(body.Apps))
+		for name := range body.Apps {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			for _, i := range body.Apps[name].Instances {
+				writeRow(w, name, i.Name, i.Version, i.RootGroupId, i.GroupId)
+			}
+		}
+
+	case *appmanager.Plan:
+		writeRow(w, "NAME", "CYCLE", "INSTANCE", "ACTION", "CURRENT", "VERSION", "ROOT GROUP", "GROUP")
+		for _, i := range body.Instances {
+			writeRow(w, body.Name, body.Cycle, i.Name, i.Action, i.CurrentVersion, i.Version, i.RootGroupId, i.GroupId)
+		}
+
+		// Changes of values.yaml follow the table
+		for _, i := range body.Instances {
+			if i.Diff != "" {
+				fmt.Fprintf(w, "\n%s", i.Diff)
+			}
+		}
+
+	case *appmanager.AppRevisions:
+		writeRow(w, "REVISION", "TEMPLATE", "VERSION", "TIMESTAMP", "DESCRIPTION")
+		for _, r := range body.Revisions {
+			writeRevision(w, r)
+		}
+
+	case *appmanager.AppRevisionDiff:
+		writeRow(w, "REVISION", "TEMPLATE", "VERSION", "TIMESTAMP", "DESCRIPTION")
+		writeRevision(w, body.Revision)
+		if body.Base != nil {
+			writeRevision(w, body.Base)
+		}
+
+		// Changes against the base revision follow the revisions
+		fmt.Fprintln(w)
+		writeRow(w, "KIND", "KEY", "CHANGE", "FROM", "TO")
+		if body.Image != nil {
+			writeValueChange(w, "image", body.Image)
+		}
+		for _, c := range body.EnvVars {
+			writeValueChange(w, "env", c)
+		}
+		for _, c := range body.Configs {
+			writeValueChange(w, "config", c)
+		}
+		for _, c := range body.Values {
+			writeValueChange(w, "value", c)
+		}
+
+	case *operations.Operation:
+		writeRow(w, "OPERATION", "METHOD", "TARGET", "STATE")
+		writeRow(w, body.Id, body.Method, body.Target, body.State)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// writeAppInstances writes the instances of the applications
+func writeAppInstances(w io.Writer, apps []*appmanager.App) {
+	writeRow(w, "NAME", "CYCLE", "INSTANCE", "VERSION", "ROOT GROUP", "GROUP")
+	for _, a := range apps {
+		if len(a.Instances) == 0 {
+			writeRow(w, a.Name, a.Cycle, "", "", "", "")
+		}
+
+		for _, i := range a.Instances {
+			writeRow(w, a.Name, a.Cycle, i.Name, i.Version, i.RootGroupId, i.GroupId)
+		}
+	}
+}
+
+// writeRevision writes the revision of the application chart
+func writeRevision(w io.Writer, r *appmanager.AppRevision) {
+	revision := r.Revision
+	if len(revision) > 8 {
+		revision = revision[:8]
+	}
+
+	writeRow(w, revision, r.Template, r.Version, ptypes.TimestampString(r.Timestamp), r.Description)
+}
+
+// writeValueChange writes the value changed between the revisions
+func writeValueChange(w io.Writer, kind string, c *appmanager.ValueChange) {
+	writeRow(w, kind, c.Key, strings.ToLower(strings.TrimPrefix(c.Type.String(), "VALUE_")), c.From, c.To)
+}
+
+// readyReplicas gives back the ready replicas of daemon application instance out of the desired ones
+func readyReplicas(i *appmanager.Instance) string {
+	if i.Cycle != appmgrcommon.TypeDaemon {
+		return ""
+	}
+
+	return fmt.Sprintf("%d/%d", i.ReadyReplicas, i.DesiredReplicas)
+}
+
+// imageName gives back the image reference of the instance
+func imageName(repo, name, tag string) string {
+	var parts []string
+	for _, p := range []string{repo, name} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+
+	image := strings.Join(parts, "/")
+	if image != "" && tag != "" {
+		image = fmt.Sprintf("%s:%s", image, tag)
+	}
+
+	return image
+}
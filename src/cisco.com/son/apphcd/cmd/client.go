@@ -0,0 +1,288 @@
+// Author  <dorzheho@cisco.com>
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	"cisco.com/son/apphcd/app/common/idempotency"
+)
+
+// Output formats of the client sub-commands
+const (
+	outputTable = "table"
+	outputJson  = "json"
+	outputYaml  = "yaml"
+)
+
+// clientOptions holds the flags of the client sub-commands
+type clientOptions struct {
+	server   string        // Controller address
+	token    string        // Bearer token
+	tls      bool          // Whether the controller serves TLS
+	caFile   string        // CA bundle verifying the controller certificate
+	certFile string        // Client certificate (mutual TLS)
+	keyFile  string        // Client private key (mutual TLS)
+	insecure bool          // Skip verification of the controller certificate
+	timeout  time.Duration // Time the request is given up after
+	output   string        // Output format
+	key      string        // Idempotency key. Retries with the same key are not run again
+}
+
+var client clientOptions
+
+// addClientFlags adds the connection and the output flags to the command and its sub-commands
+func addClientFlags(cmd *cobra.Command) {
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&client.server, "server", "localhost:10000", "controller address")
+	flags.StringVar(&client.token, "token", os.Getenv("APPHC_BEARER_TOKEN"), "bearer token, defaults to $APPHC_BEARER_TOKEN")
+	flags.BoolVar(&client.tls, "tls", false, "connect over TLS")
+	flags.StringVar(&client.caFile, "ca-file", "", "CA bundle verifying the controller certificate")
+	flags.StringVar(&client.certFile, "cert-file", "", "client certificate for mutual TLS")
+	flags.StringVar(&client.keyFile, "key-file", "", "client private key for mutual TLS")
+	flags.BoolVar(&client.insecure, "insecure-skip-verify", false, "don't verify the controller certificate")
+	flags.DurationVar(&client.timeout, "timeout", 10*time.Minute, "time the request is given up after")
+	flags.StringVarP(&client.output, "output", "o", outputTable, "output format: table, json or yaml")
+	flags.StringVar(&client.key, "idempotency-key", "", "key making retries of the request give back its outcome instead of running again")
+
+	// Once the arguments are valid, the errors are the ones of the requests and are logged by main
+	cmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+	}
+}
+
+// bearerCredentials attaches the bearer token to every request
+type bearerCredentials struct {
+	token  string
+	secure bool
+}
+
+// GetRequestMetadata gives back the authorization header
+func (c bearerCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.token}, nil
+}
+
+// RequireTransportSecurity tells whether the token can be sent over plain connection
+func (c bearerCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+// dial connects to the controller
+func dial() (*grpc.ClientConn, error) {
+	switch client.output {
+	case outputTable, outputJson, outputYaml:
+	default:
+		return nil, fmt.Errorf("unsupported output format %q", client.output)
+	}
+
+	secure := client.tls || client.caFile != "" || client.certFile != "" || client.insecure
+
+	var opts []grpc.DialOption
+	if secure {
+		config, err := clientTlsConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	if client.token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerCredentials{token: client.token, secure: secure}))
+	}
+
+	return grpc.Dial(client.server, opts...)
+}
+
+// clientTlsConfig creates TLS configuration according to the flags
+func clientTlsConfig() (*tls.Config, error) {
+	config := &tls.Config{InsecureSkipVerify: client.insecure}
+
+	if client.caFile != "" {
+		data, err := ioutil.ReadFile(client.caFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificates found in %s", client.caFile)
+		}
+	}
+
+	if client.certFile != "" || client.keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(client.certFile, client.keyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// requestContext gives back the context of a request according to the timeout and the idempotency key flags
+func requestContext() (context.Context, context.CancelFunc) {
+	ctx := context.Background()
+	if client.key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, idempotency.HeaderKey, client.key)
+	}
+
+	if client.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, client.timeout)
+}
+
+// readRequest reads the request from YAML or JSON file. Dash stands for the standard input
+func readRequest(file string, req proto.Message) error {
+	if file == "" {
+		return fmt.Errorf("request file is required")
+	}
+
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(file)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return unmarshalRequest(data, req)
+}
+
+// unmarshalRequest decodes the request from YAML or JSON. The field names are the ones of the API
+func unmarshalRequest(data []byte, req proto.Message) error {
+	// JSON is a subset of YAML
+	data, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return err
+	}
+
+	if err := jsonpb.Unmarshal(bytes.NewReader(data), req); err != nil {
+		return fmt.Errorf("invalid request: %v", err)
+	}
+
+	return nil
+}
+
+// response is the response of the Application and the Cluster managers
+type response interface {
+	proto.Message
+	GetMessage() string
+	GetBody() *any.Any
+	GetRequestId() string
+}
+
+// printResponse writes the response in the output format.
+// Gives back an error if the response tells the request failed
+func printResponse(w io.Writer, resp response, status fmt.Stringer, failed bool) error {
+	switch client.output {
+	case outputJson, outputYaml:
+		if err := printMessage(w, resp, client.output); err != nil {
+			return err
+		}
+
+	default:
+		if err := printTable(w, resp, status); err != nil {
+			return err
+		}
+	}
+
+	if failed {
+		return fmt.Errorf("request %s failed with status %s: %s", resp.GetRequestId(), status, resp.GetMessage())
+	}
+
+	return nil
+}
+
+// printMessage writes the message in JSON or YAML format
+func printMessage(w io.Writer, m proto.Message, format string) error {
+	marshaler := jsonpb.Marshaler{OrigName: true, EmitDefaults: true, Indent: "  "}
+	data, err := marshaler.MarshalToString(m)
+	if err != nil {
+		return err
+	}
+
+	out := []byte(data + "\n")
+	if format == outputYaml {
+		if out, err = yaml.JSONToYAML(out); err != nil {
+			return err
+		}
+	}
+
+	_, err = w.Write(out)
+	return err
+}
+
+// printTable writes the status of the response followed by its body as a table.
+// The body is written in YAML unless its type is known
+func printTable(w io.Writer, resp response, status fmt.Stringer) error {
+	if _, err := fmt.Fprintf(w, "%s: %s\n", status, resp.GetMessage()); err != nil {
+		return err
+	}
+
+	if resp.GetBody() == nil {
+		return nil
+	}
+
+	var body ptypes.DynamicAny
+	if err := ptypes.UnmarshalAny(resp.GetBody(), &body); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(tw)
+
+	if ok := writeAppsTable(tw, body.Message) || writeClusterTable(tw, body.Message); !ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+
+		return printMessage(w, body.Message, outputYaml)
+	}
+
+	return tw.Flush()
+}
+
+// writeRow writes a row of tab separated columns
+func writeRow(w io.Writer, columns ...interface{}) {
+	values := make([]string, len(columns))
+	for i, c := range columns {
+		values[i] = fmt.Sprint(c)
+		if values[i] == "" {
+			values[i] = "-"
+		}
+	}
+
+	fmt.Fprintln(w, strings.Join(values, "\t"))
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"cisco.com/son/apphcd/api/v1/appmanager"
+)
+
+func TestUnmarshalRequest(t *testing.T) {
+	yamlReq := `
+name: demo
+cycle: daemon
+app_state: enabled
+group_ids: [g1, g2]
+spec:
+  image:
+    repo: nginx
+    tag: "1.17"
+`
+	req := &appmanager.CreateAppRequest{}
+	if err := unmarshalRequest([]byte(yamlReq), req); err != nil {
+		t.Fatal(err)
+	}
+
+	if req.Name != "demo" || req.AppState != appmanager.AppStateAfterDeployment_enabled ||
+		len(req.GroupIds) != 2 || req.Spec.Image.Tag != "1.17" {
+		t.Fatalf("unexpected request %v", req)
+	}
+
+	req = &appmanager.CreateAppRequest{}
+	if err := unmarshalRequest([]byte(`{"name": "demo", "groupIds": ["g1"]}`), req); err != nil {
+		t.Fatal(err)
+	}
+
+	if req.Name != "demo" || len(req.GroupIds) != 1 {
+		t.Fatalf("unexpected request %v", req)
+	}
+
+	if err := unmarshalRequest([]byte("nmae: demo"), &appmanager.CreateAppRequest{}); err == nil {
+		t.Fatal("unknown field expected to be rejected")
+	}
+}
+
+func TestPrintResponse(t *testing.T) {
+	body, err := ptypes.MarshalAny(&appmanager.App{Name: "demo", Cycle: "daemon",
+		Instances: []*appmanager.AppInstance{{Name: "demo-r1-g1", Version: "1.0", RootGroupId: "r1", GroupId: "g1"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := &appmanager.Response{Status: appmanager.Status_SUCCESS, Message: "Application deployed successfully", Body: body}
+
+	client.output = outputTable
+	var out bytes.Buffer
+	if err := printResponse(&out, resp, resp.Status, false); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 || lines[0] != "SUCCESS: Application deployed successfully" ||
+		strings.Join(strings.Fields(lines[3]), " ") != "demo daemon demo-r1-g1 1.0 r1 g1" {
+		t.Fatalf("unexpected table:\n%s", out.String())
+	}
+
+	client.output = outputYaml
+	out.Reset()
+	if err := printResponse(&out, resp, resp.Status, true); err == nil {
+		t.Fatal("failed response expected to give back an error")
+	}
+
+	if !strings.Contains(out.String(), "status: SUCCESS") || !strings.Contains(out.String(), "name: demo-r1-g1") {
+		t.Fatalf("unexpected YAML:\n%s", out.String())
+	}
+}
@@ -0,0 +1,268 @@
+// Author  <dorzheho@cisco.com>
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"cisco.com/son/apphcd/api/v1/clustermanager"
+	"cisco.com/son/apphcd/app/common/statuscode"
+)
+
+func init() {
+	RootCmd.AddCommand(newClusterCmd(), newNodesCmd())
+}
+
+// newClusterCmd creates the client sub-commands of the Cluster manager managing the cluster
+func newClusterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cluster",
+		Short: "Manage AppHoster cluster",
+	}
+
+	addClientFlags(cmd)
+
+	info := &cobra.Command{
+		Use:   "info [HOSTNAME]",
+		Short: "Get information about the cluster or a node",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &clustermanager.GetClusterInfoRequest{}
+			if len(args) > 0 {
+				req.Hostname = args[0]
+			}
+
+			return runCluster(cmd.OutOrStdout(), func(ctx context.Context, c clustermanager.ClusterManagerClient) (*clustermanager.Response, error) {
+				return c.GetClusterInfo(ctx, req)
+			})
+		},
+	}
+
+	upgrade := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the cluster",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCluster(cmd.OutOrStdout(), func(ctx context.Context, c clustermanager.ClusterManagerClient) (*clustermanager.Response, error) {
+				return c.UpgradeCluster(ctx, &clustermanager.UpgradeClusterRequest{})
+			})
+		},
+	}
+
+	cmd.AddCommand(info, upgrade, newQuotasCmd())
+	return cmd
+}
+
+// newQuotasCmd creates the sub-commands managing the resource quotas
+func newQuotasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quotas",
+		Short: "Manage resource quotas of the namespaces",
+	}
+
+	get := &cobra.Command{
+		Use:   "get NAMESPACE...",
+		Short: "Get resource quotas of the namespaces",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &clustermanager.GetClusterResourceQuotasRequest{Namespaces: args}
+			return runCluster(cmd.OutOrStdout(), func(ctx context.Context, c clustermanager.ClusterManagerClient) (*clustermanager.Response, error) {
+				return c.GetClusterResourceQuotas(ctx, req)
+			})
+		},
+	}
+
+	var file string
+	set := &cobra.Command{
+		Use:   "set -f FILE",
+		Short: "Set resource quotas of the namespaces",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &clustermanager.SetClusterResourceQuotasRequest{}
+			if err := readRequest(file, req); err != nil {
+				return err
+			}
+
+			return runCluster(cmd.OutOrStdout(), func(ctx context.Context, c clustermanager.ClusterManagerClient) (*clustermanager.Response, error) {
+				return c.SetClusterResourceQuotas(ctx, req)
+			})
+		},
+	}
+
+	set.Flags().StringVarP(&file, "file", "f", "", "quotas in YAML or JSON, - reads the standard input")
+
+	del := &cobra.Command{
+		Use:   "delete NAMESPACE",
+		Short: "Delete resource quotas of the namespace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &clustermanager.DeleteClusterResourceQuotasRequest{Namespace: args[0]}
+			return runCluster(cmd.OutOrStdout(), func(ctx context.Context, c clustermanager.ClusterManagerClient) (*clustermanager.Response, error) {
+				return c.DeleteClusterResourceQuotas(ctx, req)
+			})
+		},
+	}
+
+	cmd.AddCommand(get, set, del)
+	return cmd
+}
+
+// newNodesCmd creates the client sub-commands of the Cluster manager managing the nodes
+func newNodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nodes",
+		Short: "Manage nodes of AppHoster cluster",
+	}
+
+	addClientFlags(cmd)
+
+	cmd.AddCommand(
+		newNodeStateCmd("drain", "Move the workloads off the node and stop scheduling on it", clustermanager.State_maintenance),
+		newNodeStateCmd("cordon", "Stop scheduling new workloads on the node", clustermanager.State_unschedulable),
+		newNodeStateCmd("uncordon", "Resume scheduling new workloads on the node", clustermanager.State_active),
+		&cobra.Command{
+			Use:   "delete HOSTNAME",
+			Short: "Remove the node from the cluster",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				req := &clustermanager.DeleteNodeRequest{Hostname: args[0]}
+				return runCluster(cmd.OutOrStdout(), func(ctx context.Context, c clustermanager.ClusterManagerClient) (*clustermanager.Response, error) {
+					return c.DeleteNode(ctx, req)
+				})
+			},
+		},
+	)
+
+	return cmd
+}
+
+// newNodeStateCmd creates the sub-command changing state of the node
+func newNodeStateCmd(use, short string, state clustermanager.State) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " HOSTNAME",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &clustermanager.UpdateNodeStateRequest{Hostname: args[0], State: state}
+			return runCluster(cmd.OutOrStdout(), func(ctx context.Context, c clustermanager.ClusterManagerClient) (*clustermanager.Response, error) {
+				return c.UpdateNodeState(ctx, req)
+			})
+		},
+	}
+}
+
+// runCluster connects to the controller, sends the request and prints the response
+func runCluster(w io.Writer, send func(ctx context.Context, c clustermanager.ClusterManagerClient) (*clustermanager.Response, error)) error {
+	conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := requestContext()
+	defer cancel()
+
+	resp, err := send(ctx, clustermanager.NewClusterManagerClient(conn))
+	if err != nil {
+		// The controller may report the failed responses by the status codes
+		resp = &clustermanager.Response{}
+		if !statuscode.Response(err, resp) {
+			return err
+		}
+	}
+
+	return printResponse(w, resp, resp.Status, resp.Status == clustermanager.Status_ERROR)
+}
+
+// writeClusterTable writes the Cluster manager response body. Gives back false if the body is not known
+func writeClusterTable(w io.Writer, m proto.Message) bool {
+	switch body := m.(type) {
+	case *clustermanager.GetClusterInfoResponseBody:
+		writeRow(w, "CLUSTER", "ID", "STATUS", "NODES", "CPU", "MEMORY", "APPS", "INSTANCES")
+		writeRow(w, body.ClusterName, body.Id, conditionStatus(body.Condition), body.NumberOfNodes,
+			cpuUsage(body.CpuCores), memoryUsage(body.Memory),
+			body.GetWorkloads().GetNumberOfApps(), body.GetWorkloads().GetNumberOfInstances())
+
+		if len(body.Nodes) > 0 {
+			writeRow(w)
+			writeRow(w, "HOSTNAME", "IP", "STATE", "STATUS", "ROLES", "CPU", "MEMORY")
+			for _, n := range body.Nodes {
+				writeRow(w, n.Hostname, n.ExternalIp, n.GetCondition().GetState(), conditionStatus(n.Condition),
+					nodeRoles(n), cpuUsage(n.CpuCores), memoryUsage(n.Memory))
+			}
+		}
+
+	case *clustermanager.SetGetClusterResourceQuotasResponseBody:
+		writeRow(w, "NAMESPACE", "CPU", "MEMORY")
+		for _, q := range body.Quotas {
+			writeRow(w, q.Namespace, q.Cpu, q.Memory)
+		}
+
+	case *clustermanager.UpdateNodesStateResponseBody:
+		writeRow(w, "HOSTNAME", "ID", "STATE")
+		writeRow(w, body.Hostname, body.Id, body.State)
+
+	case *clustermanager.DeleteNodeResponseBody:
+		writeRow(w, "HOSTNAME", "ID")
+		writeRow(w, body.Hostname, body.Id)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// conditionStatus gives back the condition status followed by the errors
+func conditionStatus(c *clustermanager.Condition) string {
+	if c == nil {
+		return ""
+	}
+
+	if len(c.Errors) == 0 {
+		return c.Status.String()
+	}
+
+	return c.Status.String() + ": " + strings.Join(c.Errors, "; ")
+}
+
+// cpuUsage gives back the allocated and the total CPU cores
+func cpuUsage(c *clustermanager.Cpu) string {
+	if c == nil {
+		return ""
+	}
+
+	return c.Allocated + "/" + c.Total
+}
+
+// memoryUsage gives back the allocated and the total memory
+func memoryUsage(m *clustermanager.Memory) string {
+	if m == nil {
+		return ""
+	}
+
+	return m.Allocated + "/" + m.Total
+}
+
+// nodeRoles gives back the roles of the node
+func nodeRoles(n *clustermanager.GetClusterInfoResponseBody_Node) string {
+	var roles []string
+	if n.Master {
+		roles = append(roles, "master")
+	}
+
+	if n.Worker {
+		roles = append(roles, "worker")
+	}
+
+	if n.Etcd {
+		roles = append(roles, "etcd")
+	}
+
+	return strings.Join(roles, ",")
+}
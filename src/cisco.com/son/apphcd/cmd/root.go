@@ -12,6 +12,8 @@ import (
 	"github.com/spf13/viper"
 
 	appcommon "cisco.com/son/apphcd/app/common"
+	"cisco.com/son/apphcd/app/common/config"
+	"cisco.com/son/apphcd/app/common/ratelimit"
 	"cisco.com/son/apphcd/app/controller"
 	"cisco.com/son/apphcd/app/grpc/apphcmanager/version"
 	"cisco.com/son/apphcd/app/grpc/common/rancher"
@@ -30,6 +32,7 @@ var (
 	configFile string // File containing
 	levelDebug bool   // Whether need to set debug level for logs
 	ver        bool   // Print version
+	adapter    string // Adapters implementing the services
 )
 
 // This represents the base command when called without any sub-commands
@@ -38,6 +41,8 @@ var RootCmd = &cobra.Command{
 	Short: "Cisco SON AppHoster Controller",
 	Long:  `To get started run apphcd`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		// The client sub-commands don't need the controller configuration
+		initConfig()
 		return serve()
 	},
 }
@@ -49,14 +54,17 @@ func Execute() error {
 }
 
 func init() {
-	// Initialize configuration
-	cobra.OnInitialize(initConfig)
-
 	// Set debug level for all logs
-	RootCmd.PersistentFlags().BoolVarP(&levelDebug, "debug", "d", false, "run controller in debug mode")
+	RootCmd.Flags().BoolVarP(&levelDebug, "debug", "d", false, "run controller in debug mode")
 
 	// Prints the version
-	RootCmd.PersistentFlags().BoolVarP(&ver, "version", "v", false, "output version")
+	RootCmd.Flags().BoolVarP(&ver, "version", "v", false, "output version")
+
+	// Sets the adapters
+	RootCmd.Flags().StringVar(&adapter, "adapter", "", "adapters to use: rancher, native or memory")
+	if err := viper.BindPFlag(appcommon.EnvApphcAdapter, RootCmd.Flags().Lookup("adapter")); err != nil {
+		logrus.Fatal(err)
+	}
 }
 
 func formatVersion(v *version.Version, short bool) string {
@@ -87,8 +95,12 @@ func initConfig() {
 	viper.AddConfigPath(appcommon.ApphcHomePath) // adding home directory as first search path
 
 	// If a config file is found, read it in.
+	// The in-memory adapters can run without configuration file
 	if err := viper.ReadInConfig(); err != nil {
-		logrus.Fatal(err)
+		inMemory := adapter == appcommon.AdapterMemory || os.Getenv("APPHC_ADAPTER") == appcommon.AdapterMemory
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok || !inMemory {
+			logrus.Fatal(err)
+		}
 	}
 
 	logrus.Info("Config file: ", viper.ConfigFileUsed())
@@ -100,6 +112,7 @@ func initConfig() {
 
 	// Bind keys to appropriate environment variables
 	err := viper.BindEnv(appcommon.EnvApphcLogFormat,
+		appcommon.EnvApphcLogLevel,
 		appcommon.EnvApphSvcsUrlExternalIp,
 		appcommon.EnvApphExternalIp,
 		appcommon.EnvApphcNetworkPort,
@@ -115,6 +128,38 @@ func initConfig() {
 		appcommon.EnvApphMasterNodeUser,
 		appcommon.EnvApphMasterNodeIp,
 		appcommon.EnvApphcPurgeAppMetadata,
+		appcommon.EnvApphcAppTemplatesPath,
+		appcommon.EnvApphcAdapter,
+		appcommon.EnvApphcAppsImageValidationEnabled,
+		appcommon.EnvApphcTlsEnabled,
+		appcommon.EnvApphcTlsCertFile,
+		appcommon.EnvApphcTlsKeyFile,
+		appcommon.EnvApphcTlsClientCaFile,
+		appcommon.EnvApphcShutdownTimeout,
+		appcommon.EnvApphcAuthTokensFile,
+		appcommon.EnvApphcAuthJwtJwksFile,
+		appcommon.EnvApphcAuthJwtIssuer,
+		appcommon.EnvApphcAuthJwtAudience,
+		appcommon.EnvApphcAuthJwtIdentityClaim,
+		appcommon.EnvApphcAuthJwtGroupsClaim,
+		appcommon.EnvApphcAuthPolicyFile,
+		appcommon.EnvApphcLocksTtl,
+		appcommon.EnvApphcLocksNamespace,
+		appcommon.EnvApphcOperationsRetention,
+		appcommon.EnvApphcTracingExporter,
+		appcommon.EnvApphcTracingOtlpEndpoint,
+		appcommon.EnvApphcTracingFile,
+		appcommon.EnvApphcAuditEnabled,
+		appcommon.EnvApphcAuditFile,
+		appcommon.EnvApphcAuditMaxSize,
+		appcommon.EnvApphcAuditMaxBackups,
+		appcommon.EnvApphcHealthCheckTimeout,
+		appcommon.EnvApphcRateLimitRps,
+		appcommon.EnvApphcRateLimitBurst,
+		appcommon.EnvApphcRateLimitMethods,
+		appcommon.EnvApphcOperationsMaxRunning,
+		appcommon.EnvApphcStatusCodesEnabled,
+		appcommon.EnvApphcIdempotencyWindow,
 		rancher.EnvApphcAdaptersRancherClusterName,
 		rancher.EnvApphcAdaptersRancherServerEndpoint,
 		rancher.EnvApphcAdaptersRancherServerCredsToken,
@@ -129,17 +174,39 @@ func initConfig() {
 	}
 
 	// Set default values
-	viper.SetDefault(appcommon.EnvApphcLogFormat, appcommon.LogFormatText)
+	viper.SetDefault(appcommon.EnvApphcLogFormat, string(appcommon.LogFormatText))
+	viper.SetDefault(appcommon.EnvApphcLogLevel, logrus.InfoLevel.String())
 	viper.SetDefault(appcommon.EnvApphcNetworkPort, 10000)
 	viper.SetDefault(appcommon.EnvApphcCachePath, "/tmp/.cache")
 	viper.SetDefault(appcommon.EnvApphcAppsUpgradePolicyRecreate, true)
 	viper.SetDefault(appcommon.EnvApphcAppsRollbackEnabled, true)
 	viper.SetDefault(appcommon.EnvApphcInternalAuthorizationEnabled, true)
-	viper.SetDefault(appcommon.EnvApphcBearerToken, "YXBwaG9zdGVyLWRlcGxveWVyLTIwMTgK") // apphoster-deployer-2018 in base64
 	viper.SetDefault(appcommon.EnvApphcAdaptersRancherEnabled, true)
 	viper.SetDefault(appcommon.EnvApphMasterNodeUser, "intucell")
 	viper.SetDefault(appcommon.EnvApphcAppFlexApiPort, 7000)
 	viper.SetDefault(appcommon.EnvApphcPurgeAppMetadata, true)
+	viper.SetDefault(appcommon.EnvApphcAppTemplatesPath, "/opt/cisco/apphc/app_templates")
+	viper.SetDefault(appcommon.EnvApphcTlsEnabled, false)
+	viper.SetDefault(appcommon.EnvApphcTlsCertFile, "/opt/cisco/apphc/tls/tls.crt")
+	viper.SetDefault(appcommon.EnvApphcTlsKeyFile, "/opt/cisco/apphc/tls/tls.key")
+	viper.SetDefault(appcommon.EnvApphcShutdownTimeout, 30)
+	viper.SetDefault(appcommon.EnvApphcAuthJwtIdentityClaim, "sub")
+	viper.SetDefault(appcommon.EnvApphcAuthJwtGroupsClaim, "groups")
+	viper.SetDefault(appcommon.EnvApphcLocksTtl, 60)
+	viper.SetDefault(appcommon.EnvApphcLocksNamespace, "default")
+	viper.SetDefault(appcommon.EnvApphcOperationsRetention, 3600)
+	viper.SetDefault(appcommon.EnvApphcTracingOtlpEndpoint, "http://localhost:4318/v1/traces")
+	viper.SetDefault(appcommon.EnvApphcTracingFile, "/tmp/apphc-traces.json")
+	viper.SetDefault(appcommon.EnvApphcAuditEnabled, true)
+	viper.SetDefault(appcommon.EnvApphcAuditFile, "/tmp/.audit/audit.jsonl")
+	viper.SetDefault(appcommon.EnvApphcAuditMaxSize, 10)
+	viper.SetDefault(appcommon.EnvApphcAuditMaxBackups, 5)
+	viper.SetDefault(appcommon.EnvApphcHealthCheckTimeout, 5)
+	viper.SetDefault(appcommon.EnvApphcRateLimitRps, 0)
+	viper.SetDefault(appcommon.EnvApphcRateLimitBurst, 40)
+	viper.SetDefault(appcommon.EnvApphcOperationsMaxRunning, 10)
+	viper.SetDefault(appcommon.EnvApphcStatusCodesEnabled, false)
+	viper.SetDefault(appcommon.EnvApphcIdempotencyWindow, 3600)
 	viper.SetDefault(rancher.EnvApphcAdaptersRancherClusterName, "apphoster")
 	viper.SetDefault(rancher.EnvApphcAdaptersRancherCatalogProto, "http")
 	viper.SetDefault(rancher.EnvApphcAdaptersRancherCatalogPassword, "catalog")
@@ -149,44 +216,110 @@ func initConfig() {
 	viper.SetDefault(rancher.EnvApphcAdaptersRancherServerCredsToken, "kubeconfig-user-vxg8h:rf94p78gx2mk9fbmchvq7r9xbmzphhz42pltpskj2q8rdsf626n2sf")
 	viper.AutomaticEnv()
 
-	var formatter logrus.Formatter
-	if viper.Get(appcommon.EnvApphcLogFormat).(appcommon.LogFormat) == appcommon.LogFormatJson {
-		formatter = &logrus.JSONFormatter{}
-	} else if viper.Get(appcommon.EnvApphcLogFormat).(appcommon.LogFormat) == appcommon.LogFormatText {
-		formatter = &logrus.TextFormatter{}
+	// Adapters are chosen according to the legacy property unless set explicitly
+	if viper.GetString(appcommon.EnvApphcAdapter) == "" {
+		if viper.GetBool(appcommon.EnvApphcAdaptersRancherEnabled) {
+			viper.Set(appcommon.EnvApphcAdapter, appcommon.AdapterRancher)
+		} else {
+			viper.Set(appcommon.EnvApphcAdapter, appcommon.AdapterNative)
+		}
 	}
 
-	logrus.SetFormatter(formatter)
+	// There is no Docker registry behind the in-memory adapters
+	viper.SetDefault(appcommon.EnvApphcAppsImageValidationEnabled, viper.GetString(appcommon.EnvApphcAdapter) != appcommon.AdapterMemory)
 
-	// Set debug level
+	// Debug flag sets the level unless configured explicitly
 	if levelDebug {
-		logrus.SetLevel(logrus.DebugLevel)
+		viper.SetDefault(appcommon.EnvApphcLogLevel, logrus.DebugLevel.String())
+	}
+
+	settings, err := config.Load()
+	if err != nil {
+		logrus.WithError(err).Fatal("configuration problem")
 	}
 
+	config.Store(settings)
+	applyLogging(settings)
+	config.OnReload(applyLogging)
+
 	verification()
+
+	// Reload the settings whenever the config file changes
+	config.Watch()
+}
+
+// applyLogging sets the format and the level of the logs
+func applyLogging(s *config.Settings) error {
+	switch s.LogFormat {
+	case appcommon.LogFormatJson:
+		logrus.SetFormatter(&logrus.JSONFormatter{})
+	default:
+		logrus.SetFormatter(&logrus.TextFormatter{})
+	}
+
+	logrus.SetLevel(s.LogLevel)
+	return nil
 }
 
 func verification() {
 
-	if viper.GetString(appcommon.EnvApphcAppFlexApiHost) == "" {
+	switch viper.GetString(appcommon.EnvApphcAdapter) {
+	case appcommon.AdapterRancher, appcommon.AdapterNative, appcommon.AdapterMemory:
+	default:
 		logrus.WithFields(logrus.Fields{
-			"property": "APPHC_FLEX_API_HOST",
+			"property": "APPHC_ADAPTER",
 		}).Fatalf("configuration problem")
 	}
 
-	if viper.GetBool(appcommon.EnvApphcAdaptersRancherEnabled) {
-		if viper.GetString(rancher.EnvApphcAdaptersRancherServerEndpoint) == "" {
+	if viper.GetBool(appcommon.EnvApphcTlsEnabled) {
+		if viper.GetString(appcommon.EnvApphcTlsCertFile) == "" {
 			logrus.WithFields(logrus.Fields{
-				"property": "APPHC_ADAPTERS_RANCHER_SERVER_ENDPOINT",
+				"property": "APPHC_TLS_CERT_FILE",
 			}).Fatalf("configuration problem")
 		}
 
-		if viper.GetString(rancher.EnvApphcAdaptersRancherServerCredsToken) == "" {
+		if viper.GetString(appcommon.EnvApphcTlsKeyFile) == "" {
 			logrus.WithFields(logrus.Fields{
-				"property": "APPHC_ADAPTERS_RANCHER_SERVER_CREDS_TOKEN",
+				"property": "APPHC_TLS_KEY_FILE",
 			}).Fatalf("configuration problem")
 		}
 	}
+
+	// Locks are renewed every third of the TTL
+	if viper.GetInt(appcommon.EnvApphcLocksTtl) < 3 {
+		logrus.WithFields(logrus.Fields{
+			"property": "APPHC_LOCKS_TTL",
+		}).Fatalf("configuration problem")
+	}
+
+	if viper.GetFloat64(appcommon.EnvApphcRateLimitRps) > 0 && viper.GetInt(appcommon.EnvApphcRateLimitBurst) < 1 {
+		logrus.WithFields(logrus.Fields{
+			"property": "APPHC_RATE_LIMIT_BURST",
+		}).Fatalf("configuration problem")
+	}
+
+	if _, err := ratelimit.ParseMethodLimits(viper.GetString(appcommon.EnvApphcRateLimitMethods)); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"property": "APPHC_RATE_LIMIT_METHODS",
+		}).Fatalf("configuration problem: %v", err)
+	}
+
+	if viper.GetInt(appcommon.EnvApphcIdempotencyWindow) < 0 {
+		logrus.WithFields(logrus.Fields{
+			"property": "APPHC_IDEMPOTENCY_WINDOW",
+		}).Fatalf("configuration problem")
+	}
+
+	// The in-memory adapters do not deploy anything
+	if viper.GetString(appcommon.EnvApphcAdapter) == appcommon.AdapterMemory {
+		return
+	}
+
+	if viper.GetString(appcommon.EnvApphcAppFlexApiHost) == "" {
+		logrus.WithFields(logrus.Fields{
+			"property": "APPHC_FLEX_API_HOST",
+		}).Fatalf("configuration problem")
+	}
 }
 
 func serve() error {